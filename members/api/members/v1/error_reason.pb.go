@@ -0,0 +1,212 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.3
+// source: members/v1/error_reason.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ErrorReason int32
+
+const (
+	ErrorReason_MEMBERS_UNSPECIFIED      ErrorReason = 0
+	ErrorReason_INSUFFICIENT_POINTS      ErrorReason = 1
+	ErrorReason_POINTS_HOLD_NOT_FOUND    ErrorReason = 2
+	ErrorReason_POINTS_HOLD_RELEASED     ErrorReason = 3
+	ErrorReason_POINTS_HOLD_CAPTURED     ErrorReason = 4
+	ErrorReason_INVALID_POINTS_HOLD      ErrorReason = 5
+	ErrorReason_COUPON_NOT_FOUND         ErrorReason = 6
+	ErrorReason_COUPON_UNAVAILABLE       ErrorReason = 7
+	ErrorReason_COUPON_EXPIRED           ErrorReason = 8
+	ErrorReason_COUPON_NOT_APPLICABLE    ErrorReason = 9
+	ErrorReason_RESERVATION_NOT_FOUND    ErrorReason = 10
+	ErrorReason_RESERVATION_RELEASED     ErrorReason = 11
+	ErrorReason_RESERVATION_REDEEMED     ErrorReason = 12
+	ErrorReason_INVALID_COUPON           ErrorReason = 13
+	ErrorReason_INVALID_RESERVATION      ErrorReason = 14
+	ErrorReason_INVALID_GRANT            ErrorReason = 15
+	ErrorReason_POINTS_HOLD_NOT_CAPTURED ErrorReason = 16
+	ErrorReason_RESERVATION_NOT_REDEEMED ErrorReason = 17
+	ErrorReason_INVALID_POINTS_REFUND    ErrorReason = 18
+)
+
+// Enum value maps for ErrorReason.
+var (
+	ErrorReason_name = map[int32]string{
+		0:  "MEMBERS_UNSPECIFIED",
+		1:  "INSUFFICIENT_POINTS",
+		2:  "POINTS_HOLD_NOT_FOUND",
+		3:  "POINTS_HOLD_RELEASED",
+		4:  "POINTS_HOLD_CAPTURED",
+		5:  "INVALID_POINTS_HOLD",
+		6:  "COUPON_NOT_FOUND",
+		7:  "COUPON_UNAVAILABLE",
+		8:  "COUPON_EXPIRED",
+		9:  "COUPON_NOT_APPLICABLE",
+		10: "RESERVATION_NOT_FOUND",
+		11: "RESERVATION_RELEASED",
+		12: "RESERVATION_REDEEMED",
+		13: "INVALID_COUPON",
+		14: "INVALID_RESERVATION",
+		15: "INVALID_GRANT",
+		16: "POINTS_HOLD_NOT_CAPTURED",
+		17: "RESERVATION_NOT_REDEEMED",
+		18: "INVALID_POINTS_REFUND",
+	}
+	ErrorReason_value = map[string]int32{
+		"MEMBERS_UNSPECIFIED":      0,
+		"INSUFFICIENT_POINTS":      1,
+		"POINTS_HOLD_NOT_FOUND":    2,
+		"POINTS_HOLD_RELEASED":     3,
+		"POINTS_HOLD_CAPTURED":     4,
+		"INVALID_POINTS_HOLD":      5,
+		"COUPON_NOT_FOUND":         6,
+		"COUPON_UNAVAILABLE":       7,
+		"COUPON_EXPIRED":           8,
+		"COUPON_NOT_APPLICABLE":    9,
+		"RESERVATION_NOT_FOUND":    10,
+		"RESERVATION_RELEASED":     11,
+		"RESERVATION_REDEEMED":     12,
+		"INVALID_COUPON":           13,
+		"INVALID_RESERVATION":      14,
+		"INVALID_GRANT":            15,
+		"POINTS_HOLD_NOT_CAPTURED": 16,
+		"RESERVATION_NOT_REDEEMED": 17,
+		"INVALID_POINTS_REFUND":    18,
+	}
+)
+
+func (x ErrorReason) Enum() *ErrorReason {
+	p := new(ErrorReason)
+	*p = x
+	return p
+}
+
+func (x ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_members_v1_error_reason_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorReason) Type() protoreflect.EnumType {
+	return &file_members_v1_error_reason_proto_enumTypes[0]
+}
+
+func (x ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorReason.Descriptor instead.
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_members_v1_error_reason_proto_rawDescGZIP(), []int{0}
+}
+
+var File_members_v1_error_reason_proto protoreflect.FileDescriptor
+
+var file_members_v1_error_reason_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0a, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2a, 0xea, 0x03, 0x0a, 0x0b,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x4d,
+	0x45, 0x4d, 0x42, 0x45, 0x52, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e, 0x53, 0x55, 0x46, 0x46, 0x49, 0x43,
+	0x49, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x53, 0x10, 0x01, 0x12, 0x19, 0x0a,
+	0x15, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x53, 0x5f, 0x48, 0x4f, 0x4c, 0x44, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x4f, 0x49, 0x4e,
+	0x54, 0x53, 0x5f, 0x48, 0x4f, 0x4c, 0x44, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x53, 0x5f, 0x48, 0x4f, 0x4c,
+	0x44, 0x5f, 0x43, 0x41, 0x50, 0x54, 0x55, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x53, 0x5f, 0x48,
+	0x4f, 0x4c, 0x44, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x55, 0x50, 0x4f, 0x4e, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x43,
+	0x4f, 0x55, 0x50, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c,
+	0x45, 0x10, 0x07, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x55, 0x50, 0x4f, 0x4e, 0x5f, 0x45, 0x58,
+	0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x08, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x55, 0x50, 0x4f,
+	0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x42, 0x4c, 0x45,
+	0x10, 0x09, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x0a, 0x12, 0x18, 0x0a,
+	0x14, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4c,
+	0x45, 0x41, 0x53, 0x45, 0x44, 0x10, 0x0b, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x53, 0x45, 0x52,
+	0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x44, 0x45, 0x45, 0x4d, 0x45, 0x44, 0x10,
+	0x0c, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x4f, 0x55,
+	0x50, 0x4f, 0x4e, 0x10, 0x0d, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x0e, 0x12, 0x11,
+	0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x54, 0x10,
+	0x0f, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x53, 0x5f, 0x48, 0x4f, 0x4c, 0x44,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x41, 0x50, 0x54, 0x55, 0x52, 0x45, 0x44, 0x10, 0x10, 0x12,
+	0x1c, 0x0a, 0x18, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x52, 0x45, 0x44, 0x45, 0x45, 0x4d, 0x45, 0x44, 0x10, 0x11, 0x12, 0x19, 0x0a,
+	0x15, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x53, 0x5f,
+	0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x10, 0x12, 0x42, 0x5b, 0x0a, 0x0a, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x2d, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x6b,
+	0x72, 0x61, 0x74, 0x6f, 0x73, 0x2d, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x2f, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0xa2, 0x02, 0x0c, 0x41, 0x50, 0x49, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_members_v1_error_reason_proto_rawDescOnce sync.Once
+	file_members_v1_error_reason_proto_rawDescData = file_members_v1_error_reason_proto_rawDesc
+)
+
+func file_members_v1_error_reason_proto_rawDescGZIP() []byte {
+	file_members_v1_error_reason_proto_rawDescOnce.Do(func() {
+		file_members_v1_error_reason_proto_rawDescData = protoimpl.X.CompressGZIP(file_members_v1_error_reason_proto_rawDescData)
+	})
+	return file_members_v1_error_reason_proto_rawDescData
+}
+
+var file_members_v1_error_reason_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_members_v1_error_reason_proto_goTypes = []interface{}{
+	(ErrorReason)(0), // 0: members.v1.ErrorReason
+}
+var file_members_v1_error_reason_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_members_v1_error_reason_proto_init() }
+func file_members_v1_error_reason_proto_init() {
+	if File_members_v1_error_reason_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_members_v1_error_reason_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_members_v1_error_reason_proto_goTypes,
+		DependencyIndexes: file_members_v1_error_reason_proto_depIdxs,
+		EnumInfos:         file_members_v1_error_reason_proto_enumTypes,
+	}.Build()
+	File_members_v1_error_reason_proto = out.File
+	file_members_v1_error_reason_proto_rawDesc = nil
+	file_members_v1_error_reason_proto_goTypes = nil
+	file_members_v1_error_reason_proto_depIdxs = nil
+}
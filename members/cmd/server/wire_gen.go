@@ -31,8 +31,18 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 	greeterRepo := data.NewGreeterRepo(dataData, logger)
 	greeterUsecase := biz.NewGreeterUsecase(greeterRepo, logger)
 	greeterService := service.NewGreeterService(greeterUsecase)
-	grpcServer := server.NewGRPCServer(confServer, greeterService, logger)
-	httpServer := server.NewHTTPServer(confServer, greeterService, logger)
+	pointsRepo := data.NewPointsRepo(dataData, logger)
+	pointsHoldRepo := data.NewPointsHoldRepo(dataData, logger)
+	transaction := data.NewTransaction(dataData)
+	pointsUsecase := biz.NewPointsUsecase(pointsRepo, pointsHoldRepo, transaction, logger)
+	couponRepo := data.NewCouponRepo(dataData, logger)
+	couponReservationRepo := data.NewCouponReservationRepo(dataData, logger)
+	couponUsecase := biz.NewCouponUsecase(couponRepo, couponReservationRepo, transaction, logger)
+	membersService := service.NewMembersService(pointsUsecase, couponUsecase)
+	benefitsService := service.NewBenefitsService(pointsUsecase, couponUsecase)
+	membersAdminService := service.NewMembersAdminService(pointsUsecase, couponUsecase)
+	grpcServer := server.NewGRPCServer(confServer, greeterService, membersService, benefitsService, membersAdminService, logger)
+	httpServer := server.NewHTTPServer(confServer, greeterService, membersService, membersAdminService, logger)
 	app := newApp(logger, grpcServer, httpServer)
 	return app, func() {
 		cleanup()
@@ -0,0 +1,270 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/go-kratos/kratos-layout/members/api/members/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrCouponNotFound is coupon not found.
+	ErrCouponNotFound = errors.NotFound(v1.ErrorReason_COUPON_NOT_FOUND.String(), "coupon not found")
+	// ErrCouponUnavailable is returned for a coupon used or reserved by another payment.
+	ErrCouponUnavailable = errors.Conflict(v1.ErrorReason_COUPON_UNAVAILABLE.String(), "coupon unavailable")
+	// ErrCouponExpired is returned for an expired coupon.
+	ErrCouponExpired = errors.BadRequest(v1.ErrorReason_COUPON_EXPIRED.String(), "coupon expired")
+	// ErrCouponNotApplicable is returned when an order is below the coupon's minimum spend.
+	ErrCouponNotApplicable = errors.BadRequest(v1.ErrorReason_COUPON_NOT_APPLICABLE.String(), "coupon not applicable")
+	// ErrInvalidCoupon is returned when issuing a malformed coupon.
+	ErrInvalidCoupon = errors.BadRequest(v1.ErrorReason_INVALID_COUPON.String(), "invalid coupon")
+	// ErrReservationNotFound is coupon reservation not found.
+	ErrReservationNotFound = errors.NotFound(v1.ErrorReason_RESERVATION_NOT_FOUND.String(), "coupon reservation not found")
+	// ErrReservationReleased is returned when reserving or redeeming a released reservation.
+	ErrReservationReleased = errors.Conflict(v1.ErrorReason_RESERVATION_RELEASED.String(), "coupon reservation already released")
+	// ErrReservationRedeemed is returned when releasing a redeemed reservation.
+	ErrReservationRedeemed = errors.Conflict(v1.ErrorReason_RESERVATION_REDEEMED.String(), "coupon reservation already redeemed")
+	// ErrReservationNotRedeemed is returned when returning a coupon never redeemed.
+	ErrReservationNotRedeemed = errors.Conflict(v1.ErrorReason_RESERVATION_NOT_REDEEMED.String(), "coupon reservation not redeemed")
+	// ErrInvalidReservation is returned for a malformed reservation, or one
+	// reusing the number of a different reservation.
+	ErrInvalidReservation = errors.BadRequest(v1.ErrorReason_INVALID_RESERVATION.String(), "invalid coupon reservation")
+)
+
+// CouponStatus is the status of a coupon.
+type CouponStatus string
+
+const (
+	CouponAvailable CouponStatus = "available"
+	CouponReserved  CouponStatus = "reserved"
+	CouponUsed      CouponStatus = "used"
+)
+
+// Coupon is a fixed amount off an order that reaches its minimum spend.
+type Coupon struct {
+	ID        int64
+	UserID    int64
+	Title     string
+	Amount    int64
+	MinSpend  int64
+	Status    CouponStatus
+	ExpireAt  time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Discount is what the coupon takes off an order amount.
+func (c *Coupon) Discount(orderAmount int64) int64 {
+	if c.Amount > orderAmount {
+		return orderAmount
+	}
+	return c.Amount
+}
+
+// CouponReservation is a coupon set aside for a payment until redeemed or released.
+type CouponReservation struct {
+	ID            int64
+	ReservationNo string
+	CouponID      int64
+	UserID        int64
+	Amount        int64
+	BizNo         string
+	Status        BenefitStatus
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// CouponRepo is a Coupon repo.
+type CouponRepo interface {
+	Save(context.Context, *Coupon) (*Coupon, error)
+	// Lock finds a coupon and locks it for the transaction carried by ctx.
+	Lock(ctx context.Context, id int64) (*Coupon, error)
+	UpdateStatus(ctx context.Context, id int64, status CouponStatus) error
+	ListByUser(ctx context.Context, userID int64, status CouponStatus) ([]*Coupon, error)
+}
+
+// CouponReservationRepo is a CouponReservation repo.
+type CouponReservationRepo interface {
+	Create(context.Context, *CouponReservation) error
+	// Lock finds a reservation and locks it for the transaction carried by ctx.
+	Lock(ctx context.Context, reservationNo string) (*CouponReservation, error)
+	UpdateStatus(ctx context.Context, id int64, status BenefitStatus) error
+}
+
+// CouponUsecase is a coupon usecase.
+type CouponUsecase struct {
+	coupons      CouponRepo
+	reservations CouponReservationRepo
+	tx           Transaction
+	log          *log.Helper
+}
+
+// NewCouponUsecase new a coupon usecase.
+func NewCouponUsecase(coupons CouponRepo, reservations CouponReservationRepo, tx Transaction, logger log.Logger) *CouponUsecase {
+	return &CouponUsecase{coupons: coupons, reservations: reservations, tx: tx, log: log.NewHelper(logger)}
+}
+
+// Issue issues a coupon to a user.
+func (uc *CouponUsecase) Issue(ctx context.Context, c *Coupon) (*Coupon, error) {
+	if c.Amount <= 0 || c.MinSpend < 0 || !c.ExpireAt.After(time.Now()) {
+		return nil, ErrInvalidCoupon
+	}
+	c.Status = CouponAvailable
+	uc.log.WithContext(ctx).Infof("Issue: %q worth %d to user %d", c.Title, c.Amount, c.UserID)
+	return uc.coupons.Save(ctx, c)
+}
+
+// ListCoupons lists the coupons of a user, all of them for an empty status.
+func (uc *CouponUsecase) ListCoupons(ctx context.Context, userID int64, status CouponStatus) ([]*Coupon, error) {
+	return uc.coupons.ListByUser(ctx, userID, status)
+}
+
+// Reserve sets a coupon aside for a payment of orderAmount.
+func (uc *CouponUsecase) Reserve(ctx context.Context, r *CouponReservation, orderAmount int64) (*CouponReservation, error) {
+	if r.ReservationNo == "" || orderAmount <= 0 {
+		return nil, ErrInvalidReservation
+	}
+	var rv *CouponReservation
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		prev, err := uc.reservations.Lock(ctx, r.ReservationNo)
+		switch {
+		case errors.Is(err, ErrReservationNotFound):
+		case err != nil:
+			return err
+		case prev.Status == BenefitReleased:
+			return ErrReservationReleased
+		case prev.CouponID != r.CouponID || prev.UserID != r.UserID:
+			return ErrInvalidReservation
+		default:
+			rv = prev
+			return nil
+		}
+		c, err := uc.coupons.Lock(ctx, r.CouponID)
+		if err != nil {
+			return err
+		}
+		switch {
+		case c.UserID != r.UserID:
+			return ErrCouponNotFound
+		case c.Status != CouponAvailable:
+			return ErrCouponUnavailable
+		case !time.Now().Before(c.ExpireAt):
+			return ErrCouponExpired
+		case orderAmount < c.MinSpend:
+			return ErrCouponNotApplicable
+		}
+		if err := uc.coupons.UpdateStatus(ctx, c.ID, CouponReserved); err != nil {
+			return err
+		}
+		r.Amount = c.Discount(orderAmount)
+		r.Status = BenefitHeld
+		if err := uc.reservations.Create(ctx, r); err != nil {
+			return err
+		}
+		rv = r
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("Reserve: coupon %d by %s for %d off", rv.CouponID, rv.ReservationNo, rv.Amount)
+	return rv, nil
+}
+
+// Redeem uses a reserved coupon.
+func (uc *CouponUsecase) Redeem(ctx context.Context, reservationNo string) (*CouponReservation, error) {
+	var rv *CouponReservation
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		r, err := uc.reservations.Lock(ctx, reservationNo)
+		if err != nil {
+			return err
+		}
+		rv = r
+		switch r.Status {
+		case BenefitSpent:
+			return nil
+		case BenefitReleased:
+			return ErrReservationReleased
+		}
+		if err := uc.coupons.UpdateStatus(ctx, r.CouponID, CouponUsed); err != nil {
+			return err
+		}
+		r.Status = BenefitSpent
+		return uc.reservations.UpdateStatus(ctx, r.ID, BenefitSpent)
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("Redeem: %s", reservationNo)
+	return rv, nil
+}
+
+// Release makes a reserved coupon available again. A reservation released
+// before it arrived is recorded as released, so that it is refused when it
+// does arrive.
+func (uc *CouponUsecase) Release(ctx context.Context, reservationNo string) (*CouponReservation, error) {
+	if reservationNo == "" {
+		return nil, ErrInvalidReservation
+	}
+	var rv *CouponReservation
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		r, err := uc.reservations.Lock(ctx, reservationNo)
+		if errors.Is(err, ErrReservationNotFound) {
+			rv = &CouponReservation{ReservationNo: reservationNo, Status: BenefitReleased}
+			return uc.reservations.Create(ctx, rv)
+		}
+		if err != nil {
+			return err
+		}
+		rv = r
+		switch r.Status {
+		case BenefitReleased:
+			return nil
+		case BenefitSpent:
+			return ErrReservationRedeemed
+		}
+		if err := uc.coupons.UpdateStatus(ctx, r.CouponID, CouponAvailable); err != nil {
+			return err
+		}
+		r.Status = BenefitReleased
+		return uc.reservations.UpdateStatus(ctx, r.ID, BenefitReleased)
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("Release: %s", reservationNo)
+	return rv, nil
+}
+
+// Return makes the coupon of a redeemed reservation available again, as its
+// payment was refunded.
+func (uc *CouponUsecase) Return(ctx context.Context, reservationNo string) (*CouponReservation, error) {
+	var rv *CouponReservation
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		r, err := uc.reservations.Lock(ctx, reservationNo)
+		if err != nil {
+			return err
+		}
+		rv = r
+		switch r.Status {
+		case BenefitReturned:
+			return nil
+		case BenefitSpent:
+		default:
+			return ErrReservationNotRedeemed
+		}
+		if err := uc.coupons.UpdateStatus(ctx, r.CouponID, CouponAvailable); err != nil {
+			return err
+		}
+		r.Status = BenefitReturned
+		return uc.reservations.UpdateStatus(ctx, r.ID, BenefitReturned)
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("Return: %s", reservationNo)
+	return rv, nil
+}
@@ -0,0 +1,331 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/go-kratos/kratos-layout/members/api/members/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrInsufficientPoints is returned when the available points are short.
+	ErrInsufficientPoints = errors.BadRequest(v1.ErrorReason_INSUFFICIENT_POINTS.String(), "insufficient points")
+	// ErrPointsHoldNotFound is points hold not found.
+	ErrPointsHoldNotFound = errors.NotFound(v1.ErrorReason_POINTS_HOLD_NOT_FOUND.String(), "points hold not found")
+	// ErrPointsHoldReleased is returned when holding or capturing released points.
+	ErrPointsHoldReleased = errors.Conflict(v1.ErrorReason_POINTS_HOLD_RELEASED.String(), "points hold already released")
+	// ErrPointsHoldCaptured is returned when releasing captured points.
+	ErrPointsHoldCaptured = errors.Conflict(v1.ErrorReason_POINTS_HOLD_CAPTURED.String(), "points hold already captured")
+	// ErrInvalidPointsHold is returned for a malformed hold, or one reusing
+	// the number of a different hold.
+	ErrInvalidPointsHold = errors.BadRequest(v1.ErrorReason_INVALID_POINTS_HOLD.String(), "invalid points hold")
+	// ErrPointsHoldNotCaptured is returned when refunding points never captured.
+	ErrPointsHoldNotCaptured = errors.Conflict(v1.ErrorReason_POINTS_HOLD_NOT_CAPTURED.String(), "points hold not captured")
+	// ErrInvalidPointsRefund is returned for a refund without a number or a
+	// positive amount, or one for more than the points left to refund.
+	ErrInvalidPointsRefund = errors.BadRequest(v1.ErrorReason_INVALID_POINTS_REFUND.String(), "invalid points refund")
+	// ErrInvalidGrant is returned for a grant without a number or positive points.
+	ErrInvalidGrant = errors.BadRequest(v1.ErrorReason_INVALID_GRANT.String(), "invalid grant")
+)
+
+// PointValue is what a point is worth in cents, 100 points make a yuan.
+const PointValue = 1
+
+// BenefitStatus is the status of a points hold or a coupon reservation.
+type BenefitStatus string
+
+const (
+	BenefitHeld     BenefitStatus = "held"
+	BenefitSpent    BenefitStatus = "spent"
+	BenefitReleased BenefitStatus = "released"
+	// BenefitReturned is a redeemed coupon returned by a refund.
+	BenefitReturned BenefitStatus = "returned"
+)
+
+// PointsBizType is why points changed.
+type PointsBizType string
+
+const (
+	PointsBizGrant   PointsBizType = "grant"
+	PointsBizPayment PointsBizType = "payment"
+	PointsBizRefund  PointsBizType = "refund"
+)
+
+// PointsAccount is the points a user holds.
+type PointsAccount struct {
+	UserID  int64
+	Balance int64
+	// Frozen is the part of the balance held for pending payments.
+	Frozen    int64
+	UpdatedAt time.Time
+}
+
+// PointsEntry is a line of the points journal, Change is negative when spent.
+type PointsEntry struct {
+	ID           int64
+	UserID       int64
+	Change       int64
+	BalanceAfter int64
+	BizType      PointsBizType
+	BizNo        string
+	Remark       string
+	CreatedAt    time.Time
+}
+
+// PointsHold is points frozen for a payment until captured or released.
+type PointsHold struct {
+	ID     int64
+	HoldNo string
+	UserID int64
+	Points int64
+	BizNo  string
+	Status BenefitStatus
+	// Refunded is the captured points credited back by refunds.
+	Refunded  int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Amount is what the held points are worth in cents.
+func (h *PointsHold) Amount() int64 {
+	return h.Points * PointValue
+}
+
+// PointsRepo is a PointsAccount repo.
+type PointsRepo interface {
+	// Find returns the account of a user, a zero one if there is none.
+	Find(ctx context.Context, userID int64) (*PointsAccount, error)
+	// Credit adds points, creating the account when needed, and returns the
+	// balance after.
+	Credit(ctx context.Context, userID, points int64) (int64, error)
+	// Freeze moves available points to frozen, or returns ErrInsufficientPoints.
+	Freeze(ctx context.Context, userID, points int64) error
+	Unfreeze(ctx context.Context, userID, points int64) error
+	// DebitFrozen takes frozen points off the balance and returns the balance after.
+	DebitFrozen(ctx context.Context, userID, points int64) (int64, error)
+	// HasEntry reports whether the journal has an entry of a business.
+	HasEntry(ctx context.Context, bizType PointsBizType, bizNo string) (bool, error)
+	AppendEntry(context.Context, *PointsEntry) error
+}
+
+// PointsHoldRepo is a PointsHold repo.
+type PointsHoldRepo interface {
+	Create(context.Context, *PointsHold) error
+	// Lock finds a hold and locks it for the transaction carried by ctx.
+	Lock(ctx context.Context, holdNo string) (*PointsHold, error)
+	UpdateStatus(ctx context.Context, id int64, status BenefitStatus) error
+	AddRefunded(ctx context.Context, id, points int64) error
+}
+
+// PointsUsecase is a points usecase.
+type PointsUsecase struct {
+	points PointsRepo
+	holds  PointsHoldRepo
+	tx     Transaction
+	log    *log.Helper
+}
+
+// NewPointsUsecase new a points usecase.
+func NewPointsUsecase(points PointsRepo, holds PointsHoldRepo, tx Transaction, logger log.Logger) *PointsUsecase {
+	return &PointsUsecase{points: points, holds: holds, tx: tx, log: log.NewHelper(logger)}
+}
+
+// GetAccount returns the points of a user.
+func (uc *PointsUsecase) GetAccount(ctx context.Context, userID int64) (*PointsAccount, error) {
+	return uc.points.Find(ctx, userID)
+}
+
+// Grant credits points once per grant number.
+func (uc *PointsUsecase) Grant(ctx context.Context, userID, points int64, grantNo, remark string) (*PointsAccount, error) {
+	if points <= 0 || grantNo == "" {
+		return nil, ErrInvalidGrant
+	}
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		seen, err := uc.points.HasEntry(ctx, PointsBizGrant, grantNo)
+		if err != nil || seen {
+			return err
+		}
+		balance, err := uc.points.Credit(ctx, userID, points)
+		if err != nil {
+			return err
+		}
+		return uc.points.AppendEntry(ctx, &PointsEntry{
+			UserID:       userID,
+			Change:       points,
+			BalanceAfter: balance,
+			BizType:      PointsBizGrant,
+			BizNo:        grantNo,
+			Remark:       remark,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("Grant: %d points to user %d by %s", points, userID, grantNo)
+	return uc.points.Find(ctx, userID)
+}
+
+// Hold freezes points for a payment, no more than what covers maxAmount.
+func (uc *PointsUsecase) Hold(ctx context.Context, h *PointsHold, maxAmount int64) (*PointsHold, error) {
+	if maxAmount > 0 && h.Points*PointValue > maxAmount {
+		h.Points = maxAmount / PointValue
+	}
+	if h.HoldNo == "" || h.Points <= 0 {
+		return nil, ErrInvalidPointsHold
+	}
+	var rv *PointsHold
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		prev, err := uc.holds.Lock(ctx, h.HoldNo)
+		switch {
+		case errors.Is(err, ErrPointsHoldNotFound):
+		case err != nil:
+			return err
+		case prev.Status == BenefitReleased:
+			return ErrPointsHoldReleased
+		case prev.UserID != h.UserID || prev.Points != h.Points:
+			return ErrInvalidPointsHold
+		default:
+			rv = prev
+			return nil
+		}
+		if err := uc.points.Freeze(ctx, h.UserID, h.Points); err != nil {
+			return err
+		}
+		h.Status = BenefitHeld
+		if err := uc.holds.Create(ctx, h); err != nil {
+			return err
+		}
+		rv = h
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("Hold: %s %d points of user %d", rv.HoldNo, rv.Points, rv.UserID)
+	return rv, nil
+}
+
+// Capture spends held points.
+func (uc *PointsUsecase) Capture(ctx context.Context, holdNo string) (*PointsHold, error) {
+	var rv *PointsHold
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		h, err := uc.holds.Lock(ctx, holdNo)
+		if err != nil {
+			return err
+		}
+		rv = h
+		switch h.Status {
+		case BenefitSpent:
+			return nil
+		case BenefitReleased:
+			return ErrPointsHoldReleased
+		}
+		balance, err := uc.points.DebitFrozen(ctx, h.UserID, h.Points)
+		if err != nil {
+			return err
+		}
+		if err := uc.points.AppendEntry(ctx, &PointsEntry{
+			UserID:       h.UserID,
+			Change:       -h.Points,
+			BalanceAfter: balance,
+			BizType:      PointsBizPayment,
+			BizNo:        h.HoldNo,
+			Remark:       h.BizNo,
+		}); err != nil {
+			return err
+		}
+		h.Status = BenefitSpent
+		return uc.holds.UpdateStatus(ctx, h.ID, BenefitSpent)
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("Capture: %s", holdNo)
+	return rv, nil
+}
+
+// Release unfreezes held points. A hold released before it arrived is
+// recorded as released, so that it is refused when it does arrive.
+func (uc *PointsUsecase) Release(ctx context.Context, holdNo string) (*PointsHold, error) {
+	if holdNo == "" {
+		return nil, ErrInvalidPointsHold
+	}
+	var rv *PointsHold
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		h, err := uc.holds.Lock(ctx, holdNo)
+		if errors.Is(err, ErrPointsHoldNotFound) {
+			rv = &PointsHold{HoldNo: holdNo, Status: BenefitReleased}
+			return uc.holds.Create(ctx, rv)
+		}
+		if err != nil {
+			return err
+		}
+		rv = h
+		switch h.Status {
+		case BenefitReleased:
+			return nil
+		case BenefitSpent:
+			return ErrPointsHoldCaptured
+		}
+		if err := uc.points.Unfreeze(ctx, h.UserID, h.Points); err != nil {
+			return err
+		}
+		h.Status = BenefitReleased
+		return uc.holds.UpdateStatus(ctx, h.ID, BenefitReleased)
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("Release: %s", holdNo)
+	return rv, nil
+}
+
+// Refund credits back captured points worth amount, once per refund number.
+func (uc *PointsUsecase) Refund(ctx context.Context, refundNo, holdNo string, amount int64) (*PointsHold, error) {
+	if refundNo == "" || amount <= 0 {
+		return nil, ErrInvalidPointsRefund
+	}
+	var rv *PointsHold
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		h, err := uc.holds.Lock(ctx, holdNo)
+		if err != nil {
+			return err
+		}
+		rv = h
+		if h.Status != BenefitSpent {
+			return ErrPointsHoldNotCaptured
+		}
+		seen, err := uc.points.HasEntry(ctx, PointsBizRefund, refundNo)
+		if err != nil || seen {
+			return err
+		}
+		points := amount / PointValue
+		if points > h.Points-h.Refunded {
+			return ErrInvalidPointsRefund
+		}
+		balance, err := uc.points.Credit(ctx, h.UserID, points)
+		if err != nil {
+			return err
+		}
+		if err := uc.points.AppendEntry(ctx, &PointsEntry{
+			UserID:       h.UserID,
+			Change:       points,
+			BalanceAfter: balance,
+			BizType:      PointsBizRefund,
+			BizNo:        refundNo,
+			Remark:       h.HoldNo,
+		}); err != nil {
+			return err
+		}
+		h.Refunded += points
+		return uc.holds.AddRefunded(ctx, h.ID, points)
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("Refund: %s of %s worth %d", refundNo, holdNo, amount)
+	return rv, nil
+}
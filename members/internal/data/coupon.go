@@ -0,0 +1,170 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Coupon is the coupons table.
+type Coupon struct {
+	ID        int64  `gorm:"primaryKey"`
+	UserID    int64  `gorm:"index:idx_coupons_user,priority:1"`
+	Title     string `gorm:"size:128"`
+	Amount    int64
+	MinSpend  int64
+	Status    string    `gorm:"size:16"`
+	ExpireAt  time.Time `gorm:"index:idx_coupons_user,priority:2"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CouponReservation is the coupon_reservations table.
+type CouponReservation struct {
+	ID            int64  `gorm:"primaryKey"`
+	ReservationNo string `gorm:"size:64;uniqueIndex"`
+	CouponID      int64  `gorm:"index"`
+	UserID        int64
+	Amount        int64
+	BizNo         string `gorm:"size:64"`
+	Status        string `gorm:"size:16"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type couponRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewCouponRepo .
+func NewCouponRepo(data *Data, logger log.Logger) biz.CouponRepo {
+	return &couponRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *couponRepo) Save(ctx context.Context, c *biz.Coupon) (*biz.Coupon, error) {
+	po := &Coupon{
+		ID:       c.ID,
+		UserID:   c.UserID,
+		Title:    c.Title,
+		Amount:   c.Amount,
+		MinSpend: c.MinSpend,
+		Status:   string(c.Status),
+		ExpireAt: c.ExpireAt,
+	}
+	if err := r.data.DB(ctx).Save(po).Error; err != nil {
+		return nil, err
+	}
+	return toCoupon(po), nil
+}
+
+func (r *couponRepo) Lock(ctx context.Context, id int64) (*biz.Coupon, error) {
+	var po Coupon
+	err := r.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).First(&po, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrCouponNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toCoupon(&po), nil
+}
+
+func (r *couponRepo) UpdateStatus(ctx context.Context, id int64, status biz.CouponStatus) error {
+	return r.data.DB(ctx).Model(&Coupon{ID: id}).Update("status", string(status)).Error
+}
+
+func (r *couponRepo) ListByUser(ctx context.Context, userID int64, status biz.CouponStatus) ([]*biz.Coupon, error) {
+	db := r.data.DB(ctx).Where("user_id = ?", userID)
+	if status != "" {
+		db = db.Where("status = ?", string(status))
+	}
+	var pos []*Coupon
+	if err := db.Order("expire_at").Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	rv := make([]*biz.Coupon, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toCoupon(po))
+	}
+	return rv, nil
+}
+
+func toCoupon(po *Coupon) *biz.Coupon {
+	return &biz.Coupon{
+		ID:        po.ID,
+		UserID:    po.UserID,
+		Title:     po.Title,
+		Amount:    po.Amount,
+		MinSpend:  po.MinSpend,
+		Status:    biz.CouponStatus(po.Status),
+		ExpireAt:  po.ExpireAt,
+		CreatedAt: po.CreatedAt,
+		UpdatedAt: po.UpdatedAt,
+	}
+}
+
+type couponReservationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewCouponReservationRepo .
+func NewCouponReservationRepo(data *Data, logger log.Logger) biz.CouponReservationRepo {
+	return &couponReservationRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *couponReservationRepo) Create(ctx context.Context, cr *biz.CouponReservation) error {
+	po := &CouponReservation{
+		ReservationNo: cr.ReservationNo,
+		CouponID:      cr.CouponID,
+		UserID:        cr.UserID,
+		Amount:        cr.Amount,
+		BizNo:         cr.BizNo,
+		Status:        string(cr.Status),
+	}
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return err
+	}
+	cr.ID, cr.CreatedAt, cr.UpdatedAt = po.ID, po.CreatedAt, po.UpdatedAt
+	return nil
+}
+
+func (r *couponReservationRepo) Lock(ctx context.Context, reservationNo string) (*biz.CouponReservation, error) {
+	var po CouponReservation
+	err := r.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("reservation_no = ?", reservationNo).First(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrReservationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &biz.CouponReservation{
+		ID:            po.ID,
+		ReservationNo: po.ReservationNo,
+		CouponID:      po.CouponID,
+		UserID:        po.UserID,
+		Amount:        po.Amount,
+		BizNo:         po.BizNo,
+		Status:        biz.BenefitStatus(po.Status),
+		CreatedAt:     po.CreatedAt,
+		UpdatedAt:     po.UpdatedAt,
+	}, nil
+}
+
+func (r *couponReservationRepo) UpdateStatus(ctx context.Context, id int64, status biz.BenefitStatus) error {
+	return r.data.DB(ctx).Model(&CouponReservation{ID: id}).Update("status", string(status)).Error
+}
@@ -1,24 +1,75 @@
 package data
 
 import (
+	"context"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
 	"github.com/go-kratos/kratos-layout/internal/conf"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewGreeterRepo)
+var ProviderSet = wire.NewSet(
+	NewData,
+	NewTransaction,
+	NewGreeterRepo,
+	NewPointsRepo,
+	NewPointsHoldRepo,
+	NewCouponRepo,
+	NewCouponReservationRepo,
+)
 
 // Data .
 type Data struct {
-	// TODO wrapped database client
+	db *gorm.DB
 }
 
+type contextTxKey struct{}
+
 // NewData .
 func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
+	db, err := gorm.Open(mysql.Open(c.Database.Source), &gorm.Config{})
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := db.AutoMigrate(
+		&PointsAccount{},
+		&PointsEntry{},
+		&PointsHold{},
+		&Coupon{},
+		&CouponReservation{},
+	); err != nil {
+		return nil, nil, err
+	}
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	}
+	return &Data{db: db}, cleanup, nil
+}
+
+// NewTransaction .
+func NewTransaction(d *Data) biz.Transaction {
+	return d
+}
+
+// InTx runs fn in a transaction, repos called with the ctx passed to fn join it.
+func (d *Data) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return d.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, contextTxKey{}, tx))
+	})
+}
+
+// DB returns the transaction carried by ctx, or the plain handle outside one.
+func (d *Data) DB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value(contextTxKey{}).(*gorm.DB); ok {
+		return tx
 	}
-	return &Data{}, cleanup, nil
+	return d.db.WithContext(ctx)
 }
@@ -0,0 +1,216 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PointsAccount is the points_accounts table.
+type PointsAccount struct {
+	ID        int64 `gorm:"primaryKey"`
+	UserID    int64 `gorm:"uniqueIndex"`
+	Balance   int64
+	Frozen    int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// PointsEntry is the points_entries table.
+type PointsEntry struct {
+	ID           int64 `gorm:"primaryKey"`
+	UserID       int64 `gorm:"index"`
+	Change       int64
+	BalanceAfter int64
+	BizType      string `gorm:"size:16;uniqueIndex:idx_points_entries_biz,priority:1"`
+	BizNo        string `gorm:"size:64;uniqueIndex:idx_points_entries_biz,priority:2"`
+	Remark       string `gorm:"size:255"`
+	CreatedAt    time.Time
+}
+
+// PointsHold is the points_holds table.
+type PointsHold struct {
+	ID        int64  `gorm:"primaryKey"`
+	HoldNo    string `gorm:"size:64;uniqueIndex"`
+	UserID    int64  `gorm:"index"`
+	Points    int64
+	BizNo     string `gorm:"size:64"`
+	Status    string `gorm:"size:16"`
+	Refunded  int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type pointsRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewPointsRepo .
+func NewPointsRepo(data *Data, logger log.Logger) biz.PointsRepo {
+	return &pointsRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *pointsRepo) Find(ctx context.Context, userID int64) (*biz.PointsAccount, error) {
+	var po PointsAccount
+	err := r.data.DB(ctx).Where("user_id = ?", userID).First(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &biz.PointsAccount{UserID: userID}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &biz.PointsAccount{
+		UserID:    po.UserID,
+		Balance:   po.Balance,
+		Frozen:    po.Frozen,
+		UpdatedAt: po.UpdatedAt,
+	}, nil
+}
+
+func (r *pointsRepo) Credit(ctx context.Context, userID, points int64) (int64, error) {
+	err := r.data.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"balance": gorm.Expr("balance + ?", points)}),
+	}).Create(&PointsAccount{UserID: userID, Balance: points}).Error
+	if err != nil {
+		return 0, err
+	}
+	return r.balance(ctx, userID)
+}
+
+func (r *pointsRepo) Freeze(ctx context.Context, userID, points int64) error {
+	res := r.data.DB(ctx).Model(&PointsAccount{}).
+		Where("user_id = ? AND balance - frozen >= ?", userID, points).
+		Update("frozen", gorm.Expr("frozen + ?", points))
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return biz.ErrInsufficientPoints
+	}
+	return nil
+}
+
+func (r *pointsRepo) Unfreeze(ctx context.Context, userID, points int64) error {
+	return r.data.DB(ctx).Model(&PointsAccount{}).
+		Where("user_id = ? AND frozen >= ?", userID, points).
+		Update("frozen", gorm.Expr("frozen - ?", points)).Error
+}
+
+func (r *pointsRepo) DebitFrozen(ctx context.Context, userID, points int64) (int64, error) {
+	res := r.data.DB(ctx).Model(&PointsAccount{}).
+		Where("user_id = ? AND frozen >= ?", userID, points).
+		Updates(map[string]interface{}{
+			"balance": gorm.Expr("balance - ?", points),
+			"frozen":  gorm.Expr("frozen - ?", points),
+		})
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return 0, fmt.Errorf("user %d has less than %d points frozen", userID, points)
+	}
+	return r.balance(ctx, userID)
+}
+
+func (r *pointsRepo) balance(ctx context.Context, userID int64) (int64, error) {
+	var po PointsAccount
+	if err := r.data.DB(ctx).Select("balance").Where("user_id = ?", userID).First(&po).Error; err != nil {
+		return 0, err
+	}
+	return po.Balance, nil
+}
+
+func (r *pointsRepo) HasEntry(ctx context.Context, bizType biz.PointsBizType, bizNo string) (bool, error) {
+	var n int64
+	err := r.data.DB(ctx).Model(&PointsEntry{}).
+		Where("biz_type = ? AND biz_no = ?", string(bizType), bizNo).
+		Count(&n).Error
+	return n > 0, err
+}
+
+func (r *pointsRepo) AppendEntry(ctx context.Context, e *biz.PointsEntry) error {
+	po := &PointsEntry{
+		UserID:       e.UserID,
+		Change:       e.Change,
+		BalanceAfter: e.BalanceAfter,
+		BizType:      string(e.BizType),
+		BizNo:        e.BizNo,
+		Remark:       e.Remark,
+	}
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return err
+	}
+	e.ID, e.CreatedAt = po.ID, po.CreatedAt
+	return nil
+}
+
+type pointsHoldRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewPointsHoldRepo .
+func NewPointsHoldRepo(data *Data, logger log.Logger) biz.PointsHoldRepo {
+	return &pointsHoldRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *pointsHoldRepo) Create(ctx context.Context, h *biz.PointsHold) error {
+	po := &PointsHold{
+		HoldNo: h.HoldNo,
+		UserID: h.UserID,
+		Points: h.Points,
+		BizNo:  h.BizNo,
+		Status: string(h.Status),
+	}
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return err
+	}
+	h.ID, h.CreatedAt, h.UpdatedAt = po.ID, po.CreatedAt, po.UpdatedAt
+	return nil
+}
+
+func (r *pointsHoldRepo) Lock(ctx context.Context, holdNo string) (*biz.PointsHold, error) {
+	var po PointsHold
+	err := r.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("hold_no = ?", holdNo).First(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrPointsHoldNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &biz.PointsHold{
+		ID:        po.ID,
+		HoldNo:    po.HoldNo,
+		UserID:    po.UserID,
+		Points:    po.Points,
+		BizNo:     po.BizNo,
+		Status:    biz.BenefitStatus(po.Status),
+		Refunded:  po.Refunded,
+		CreatedAt: po.CreatedAt,
+		UpdatedAt: po.UpdatedAt,
+	}, nil
+}
+
+func (r *pointsHoldRepo) UpdateStatus(ctx context.Context, id int64, status biz.BenefitStatus) error {
+	return r.data.DB(ctx).Model(&PointsHold{ID: id}).Update("status", string(status)).Error
+}
+
+func (r *pointsHoldRepo) AddRefunded(ctx context.Context, id, points int64) error {
+	return r.data.DB(ctx).Model(&PointsHold{ID: id}).Update("refunded", gorm.Expr("refunded + ?", points)).Error
+}
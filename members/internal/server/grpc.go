@@ -4,6 +4,7 @@ import (
 	"github.com/go-kratos/kratos-layout/bff/api/helloworld/v1"
 	"github.com/go-kratos/kratos-layout/internal/conf"
 	"github.com/go-kratos/kratos-layout/internal/service"
+	membersv1 "github.com/go-kratos/kratos-layout/members/api/members/v1"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
@@ -11,7 +12,7 @@ import (
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, members *service.MembersService, benefits *service.BenefitsService, admin *service.MembersAdminService, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
@@ -28,5 +29,8 @@ func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, logger log.L
 	}
 	srv := grpc.NewServer(opts...)
 	v1.RegisterGreeterServer(srv, greeter)
+	membersv1.RegisterMembersServer(srv, members)
+	membersv1.RegisterBenefitsServer(srv, benefits)
+	membersv1.RegisterMembersAdminServer(srv, admin)
 	return srv
 }
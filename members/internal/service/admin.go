@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/go-kratos/kratos-layout/members/api/members/v1"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+)
+
+// MembersAdminService is a members back office service.
+type MembersAdminService struct {
+	v1.UnimplementedMembersAdminServer
+
+	points  *biz.PointsUsecase
+	coupons *biz.CouponUsecase
+}
+
+// NewMembersAdminService new a members back office service.
+func NewMembersAdminService(points *biz.PointsUsecase, coupons *biz.CouponUsecase) *MembersAdminService {
+	return &MembersAdminService{points: points, coupons: coupons}
+}
+
+// GrantPoints implements v1.MembersAdminServer.
+func (s *MembersAdminService) GrantPoints(ctx context.Context, in *v1.GrantPointsRequest) (*v1.PointsAccount, error) {
+	a, err := s.points.Grant(ctx, in.UserId, in.Points, in.GrantNo, in.Remark)
+	if err != nil {
+		return nil, err
+	}
+	return toPointsAccountProto(a), nil
+}
+
+// IssueCoupon implements v1.MembersAdminServer.
+func (s *MembersAdminService) IssueCoupon(ctx context.Context, in *v1.IssueCouponRequest) (*v1.Coupon, error) {
+	c, err := s.coupons.Issue(ctx, &biz.Coupon{
+		UserID:   in.UserId,
+		Title:    in.Title,
+		Amount:   in.Amount,
+		MinSpend: in.MinSpend,
+		ExpireAt: in.ExpireAt.AsTime(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toCouponProto(c), nil
+}
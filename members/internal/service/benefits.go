@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/go-kratos/kratos-layout/members/api/members/v1"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+)
+
+// BenefitsService lets payment spend points and coupons.
+type BenefitsService struct {
+	v1.UnimplementedBenefitsServer
+
+	points  *biz.PointsUsecase
+	coupons *biz.CouponUsecase
+}
+
+// NewBenefitsService new a benefits service.
+func NewBenefitsService(points *biz.PointsUsecase, coupons *biz.CouponUsecase) *BenefitsService {
+	return &BenefitsService{points: points, coupons: coupons}
+}
+
+// HoldPoints implements v1.BenefitsServer.
+func (s *BenefitsService) HoldPoints(ctx context.Context, in *v1.HoldPointsRequest) (*v1.PointsHold, error) {
+	h, err := s.points.Hold(ctx, &biz.PointsHold{
+		HoldNo: in.HoldNo,
+		UserID: in.UserId,
+		Points: in.Points,
+		BizNo:  in.BizNo,
+	}, in.MaxAmount)
+	if err != nil {
+		return nil, err
+	}
+	return toPointsHoldProto(h), nil
+}
+
+// CapturePoints implements v1.BenefitsServer.
+func (s *BenefitsService) CapturePoints(ctx context.Context, in *v1.CapturePointsRequest) (*v1.PointsHold, error) {
+	h, err := s.points.Capture(ctx, in.HoldNo)
+	if err != nil {
+		return nil, err
+	}
+	return toPointsHoldProto(h), nil
+}
+
+// ReleasePoints implements v1.BenefitsServer.
+func (s *BenefitsService) ReleasePoints(ctx context.Context, in *v1.ReleasePointsRequest) (*v1.PointsHold, error) {
+	h, err := s.points.Release(ctx, in.HoldNo)
+	if err != nil {
+		return nil, err
+	}
+	return toPointsHoldProto(h), nil
+}
+
+// ReserveCoupon implements v1.BenefitsServer.
+func (s *BenefitsService) ReserveCoupon(ctx context.Context, in *v1.ReserveCouponRequest) (*v1.CouponReservation, error) {
+	r, err := s.coupons.Reserve(ctx, &biz.CouponReservation{
+		ReservationNo: in.ReservationNo,
+		CouponID:      in.CouponId,
+		UserID:        in.UserId,
+		BizNo:         in.BizNo,
+	}, in.OrderAmount)
+	if err != nil {
+		return nil, err
+	}
+	return toCouponReservationProto(r), nil
+}
+
+// RedeemCoupon implements v1.BenefitsServer.
+func (s *BenefitsService) RedeemCoupon(ctx context.Context, in *v1.RedeemCouponRequest) (*v1.CouponReservation, error) {
+	r, err := s.coupons.Redeem(ctx, in.ReservationNo)
+	if err != nil {
+		return nil, err
+	}
+	return toCouponReservationProto(r), nil
+}
+
+// ReleaseCoupon implements v1.BenefitsServer.
+func (s *BenefitsService) ReleaseCoupon(ctx context.Context, in *v1.ReleaseCouponRequest) (*v1.CouponReservation, error) {
+	r, err := s.coupons.Release(ctx, in.ReservationNo)
+	if err != nil {
+		return nil, err
+	}
+	return toCouponReservationProto(r), nil
+}
+
+// RefundPoints implements v1.BenefitsServer.
+func (s *BenefitsService) RefundPoints(ctx context.Context, in *v1.RefundPointsRequest) (*v1.PointsHold, error) {
+	h, err := s.points.Refund(ctx, in.RefundNo, in.HoldNo, in.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return toPointsHoldProto(h), nil
+}
+
+// ReturnCoupon implements v1.BenefitsServer.
+func (s *BenefitsService) ReturnCoupon(ctx context.Context, in *v1.ReturnCouponRequest) (*v1.CouponReservation, error) {
+	r, err := s.coupons.Return(ctx, in.ReservationNo)
+	if err != nil {
+		return nil, err
+	}
+	return toCouponReservationProto(r), nil
+}
+
+func toPointsHoldProto(h *biz.PointsHold) *v1.PointsHold {
+	return &v1.PointsHold{
+		HoldNo:   h.HoldNo,
+		UserId:   h.UserID,
+		Points:   h.Points,
+		Amount:   h.Amount(),
+		Status:   benefitStatuses[h.Status],
+		Refunded: h.Refunded,
+	}
+}
+
+func toCouponReservationProto(r *biz.CouponReservation) *v1.CouponReservation {
+	return &v1.CouponReservation{
+		ReservationNo: r.ReservationNo,
+		CouponId:      r.CouponID,
+		UserId:        r.UserID,
+		Amount:        r.Amount,
+		Status:        benefitStatuses[r.Status],
+	}
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/go-kratos/kratos-layout/members/api/members/v1"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MembersService is a members service.
+type MembersService struct {
+	v1.UnimplementedMembersServer
+
+	points  *biz.PointsUsecase
+	coupons *biz.CouponUsecase
+}
+
+// NewMembersService new a members service.
+func NewMembersService(points *biz.PointsUsecase, coupons *biz.CouponUsecase) *MembersService {
+	return &MembersService{points: points, coupons: coupons}
+}
+
+// GetPoints implements v1.MembersServer.
+func (s *MembersService) GetPoints(ctx context.Context, in *v1.GetPointsRequest) (*v1.PointsAccount, error) {
+	a, err := s.points.GetAccount(ctx, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return toPointsAccountProto(a), nil
+}
+
+// ListCoupons implements v1.MembersServer.
+func (s *MembersService) ListCoupons(ctx context.Context, in *v1.ListCouponsRequest) (*v1.ListCouponsReply, error) {
+	cs, err := s.coupons.ListCoupons(ctx, in.UserId, couponStatuses[in.Status])
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListCouponsReply{Coupons: make([]*v1.Coupon, 0, len(cs))}
+	for _, c := range cs {
+		reply.Coupons = append(reply.Coupons, toCouponProto(c))
+	}
+	return reply, nil
+}
+
+var couponStatuses = map[v1.CouponStatus]biz.CouponStatus{
+	v1.CouponStatus_COUPON_AVAILABLE: biz.CouponAvailable,
+	v1.CouponStatus_COUPON_RESERVED:  biz.CouponReserved,
+	v1.CouponStatus_COUPON_USED:      biz.CouponUsed,
+}
+
+var benefitStatuses = map[biz.BenefitStatus]v1.BenefitStatus{
+	biz.BenefitHeld:     v1.BenefitStatus_BENEFIT_HELD,
+	biz.BenefitSpent:    v1.BenefitStatus_BENEFIT_SPENT,
+	biz.BenefitReleased: v1.BenefitStatus_BENEFIT_RELEASED,
+	biz.BenefitReturned: v1.BenefitStatus_BENEFIT_RETURNED,
+}
+
+func toPointsAccountProto(a *biz.PointsAccount) *v1.PointsAccount {
+	return &v1.PointsAccount{UserId: a.UserID, Balance: a.Balance, Frozen: a.Frozen}
+}
+
+func toCouponProto(c *biz.Coupon) *v1.Coupon {
+	pb := &v1.Coupon{
+		Id:       c.ID,
+		UserId:   c.UserID,
+		Title:    c.Title,
+		Amount:   c.Amount,
+		MinSpend: c.MinSpend,
+		ExpireAt: timestamppb.New(c.ExpireAt),
+	}
+	for k, v := range couponStatuses {
+		if v == c.Status {
+			pb.Status = k
+		}
+	}
+	return pb
+}
@@ -0,0 +1,295 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.3
+// source: order/v1/error_reason.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ErrorReason int32
+
+const (
+	ErrorReason_ORDER_UNSPECIFIED             ErrorReason = 0
+	ErrorReason_ORDER_NOT_FOUND               ErrorReason = 1
+	ErrorReason_INVALID_ORDER                 ErrorReason = 2
+	ErrorReason_ILLEGAL_ORDER_TRANSITION      ErrorReason = 3
+	ErrorReason_ORDER_VERSION_CONFLICT        ErrorReason = 4
+	ErrorReason_ORDER_ALREADY_PAID            ErrorReason = 5
+	ErrorReason_ORDER_ALREADY_CANCELLED       ErrorReason = 6
+	ErrorReason_CART_ITEM_NOT_FOUND           ErrorReason = 7
+	ErrorReason_CART_FULL                     ErrorReason = 8
+	ErrorReason_SKU_UNAVAILABLE               ErrorReason = 9
+	ErrorReason_INVALID_CART_ITEM             ErrorReason = 10
+	ErrorReason_INVALID_PROMOTION             ErrorReason = 11
+	ErrorReason_PROMOTION_NOT_FOUND           ErrorReason = 12
+	ErrorReason_COUPON_UNAVAILABLE            ErrorReason = 13
+	ErrorReason_INVALID_COUPON                ErrorReason = 14
+	ErrorReason_AFTER_SALE_NOT_FOUND          ErrorReason = 15
+	ErrorReason_INVALID_AFTER_SALE            ErrorReason = 16
+	ErrorReason_AFTER_SALE_NOT_ALLOWED        ErrorReason = 17
+	ErrorReason_ILLEGAL_AFTER_SALE_TRANSITION ErrorReason = 18
+	ErrorReason_AFTER_SALE_VERSION_CONFLICT   ErrorReason = 19
+	ErrorReason_INVOICE_TITLE_NOT_FOUND       ErrorReason = 20
+	ErrorReason_INVALID_INVOICE_TITLE         ErrorReason = 21
+	ErrorReason_INVOICE_NOT_FOUND             ErrorReason = 22
+	ErrorReason_INVALID_INVOICE               ErrorReason = 23
+	ErrorReason_ORDER_ALREADY_INVOICED        ErrorReason = 24
+	ErrorReason_INVOICE_VERSION_CONFLICT      ErrorReason = 25
+	ErrorReason_FLASH_SALE_NOT_FOUND          ErrorReason = 26
+	ErrorReason_FLASH_SALE_NOT_STARTED        ErrorReason = 27
+	ErrorReason_FLASH_SALE_ENDED              ErrorReason = 28
+	ErrorReason_FLASH_SALE_SOLD_OUT           ErrorReason = 29
+	ErrorReason_FLASH_SALE_LIMIT_EXCEEDED     ErrorReason = 30
+	ErrorReason_INVALID_CHALLENGE             ErrorReason = 31
+	ErrorReason_FLASH_SALE_ATTEMPT_NOT_FOUND  ErrorReason = 32
+	ErrorReason_INVALID_FLASH_SALE_ATTEMPT    ErrorReason = 33
+	ErrorReason_REGION_NOT_DELIVERED          ErrorReason = 34
+	ErrorReason_INVALID_SHIPMENT              ErrorReason = 35
+	ErrorReason_SHIPMENT_EXISTS               ErrorReason = 36
+)
+
+// Enum value maps for ErrorReason.
+var (
+	ErrorReason_name = map[int32]string{
+		0:  "ORDER_UNSPECIFIED",
+		1:  "ORDER_NOT_FOUND",
+		2:  "INVALID_ORDER",
+		3:  "ILLEGAL_ORDER_TRANSITION",
+		4:  "ORDER_VERSION_CONFLICT",
+		5:  "ORDER_ALREADY_PAID",
+		6:  "ORDER_ALREADY_CANCELLED",
+		7:  "CART_ITEM_NOT_FOUND",
+		8:  "CART_FULL",
+		9:  "SKU_UNAVAILABLE",
+		10: "INVALID_CART_ITEM",
+		11: "INVALID_PROMOTION",
+		12: "PROMOTION_NOT_FOUND",
+		13: "COUPON_UNAVAILABLE",
+		14: "INVALID_COUPON",
+		15: "AFTER_SALE_NOT_FOUND",
+		16: "INVALID_AFTER_SALE",
+		17: "AFTER_SALE_NOT_ALLOWED",
+		18: "ILLEGAL_AFTER_SALE_TRANSITION",
+		19: "AFTER_SALE_VERSION_CONFLICT",
+		20: "INVOICE_TITLE_NOT_FOUND",
+		21: "INVALID_INVOICE_TITLE",
+		22: "INVOICE_NOT_FOUND",
+		23: "INVALID_INVOICE",
+		24: "ORDER_ALREADY_INVOICED",
+		25: "INVOICE_VERSION_CONFLICT",
+		26: "FLASH_SALE_NOT_FOUND",
+		27: "FLASH_SALE_NOT_STARTED",
+		28: "FLASH_SALE_ENDED",
+		29: "FLASH_SALE_SOLD_OUT",
+		30: "FLASH_SALE_LIMIT_EXCEEDED",
+		31: "INVALID_CHALLENGE",
+		32: "FLASH_SALE_ATTEMPT_NOT_FOUND",
+		33: "INVALID_FLASH_SALE_ATTEMPT",
+		34: "REGION_NOT_DELIVERED",
+		35: "INVALID_SHIPMENT",
+		36: "SHIPMENT_EXISTS",
+	}
+	ErrorReason_value = map[string]int32{
+		"ORDER_UNSPECIFIED":             0,
+		"ORDER_NOT_FOUND":               1,
+		"INVALID_ORDER":                 2,
+		"ILLEGAL_ORDER_TRANSITION":      3,
+		"ORDER_VERSION_CONFLICT":        4,
+		"ORDER_ALREADY_PAID":            5,
+		"ORDER_ALREADY_CANCELLED":       6,
+		"CART_ITEM_NOT_FOUND":           7,
+		"CART_FULL":                     8,
+		"SKU_UNAVAILABLE":               9,
+		"INVALID_CART_ITEM":             10,
+		"INVALID_PROMOTION":             11,
+		"PROMOTION_NOT_FOUND":           12,
+		"COUPON_UNAVAILABLE":            13,
+		"INVALID_COUPON":                14,
+		"AFTER_SALE_NOT_FOUND":          15,
+		"INVALID_AFTER_SALE":            16,
+		"AFTER_SALE_NOT_ALLOWED":        17,
+		"ILLEGAL_AFTER_SALE_TRANSITION": 18,
+		"AFTER_SALE_VERSION_CONFLICT":   19,
+		"INVOICE_TITLE_NOT_FOUND":       20,
+		"INVALID_INVOICE_TITLE":         21,
+		"INVOICE_NOT_FOUND":             22,
+		"INVALID_INVOICE":               23,
+		"ORDER_ALREADY_INVOICED":        24,
+		"INVOICE_VERSION_CONFLICT":      25,
+		"FLASH_SALE_NOT_FOUND":          26,
+		"FLASH_SALE_NOT_STARTED":        27,
+		"FLASH_SALE_ENDED":              28,
+		"FLASH_SALE_SOLD_OUT":           29,
+		"FLASH_SALE_LIMIT_EXCEEDED":     30,
+		"INVALID_CHALLENGE":             31,
+		"FLASH_SALE_ATTEMPT_NOT_FOUND":  32,
+		"INVALID_FLASH_SALE_ATTEMPT":    33,
+		"REGION_NOT_DELIVERED":          34,
+		"INVALID_SHIPMENT":              35,
+		"SHIPMENT_EXISTS":               36,
+	}
+)
+
+func (x ErrorReason) Enum() *ErrorReason {
+	p := new(ErrorReason)
+	*p = x
+	return p
+}
+
+func (x ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_v1_error_reason_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorReason) Type() protoreflect.EnumType {
+	return &file_order_v1_error_reason_proto_enumTypes[0]
+}
+
+func (x ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorReason.Descriptor instead.
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_order_v1_error_reason_proto_rawDescGZIP(), []int{0}
+}
+
+var File_order_v1_error_reason_proto protoreflect.FileDescriptor
+
+var file_order_v1_error_reason_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2a, 0xbd, 0x07, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13,
+	0x0a, 0x0f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x4c, 0x4c, 0x45, 0x47, 0x41,
+	0x4c, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x56, 0x45,
+	0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x04,
+	0x12, 0x16, 0x0a, 0x12, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44,
+	0x59, 0x5f, 0x50, 0x41, 0x49, 0x44, 0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c,
+	0x4c, 0x45, 0x44, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x41, 0x52, 0x54, 0x5f, 0x49, 0x54,
+	0x45, 0x4d, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x07, 0x12, 0x0d,
+	0x0a, 0x09, 0x43, 0x41, 0x52, 0x54, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x08, 0x12, 0x13, 0x0a,
+	0x0f, 0x53, 0x4b, 0x55, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45,
+	0x10, 0x09, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x41,
+	0x52, 0x54, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x10, 0x0a, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x0b,
+	0x12, 0x17, 0x0a, 0x13, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x0c, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f, 0x55,
+	0x50, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10,
+	0x0d, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x4f, 0x55,
+	0x50, 0x4f, 0x4e, 0x10, 0x0e, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x46, 0x54, 0x45, 0x52, 0x5f, 0x53,
+	0x41, 0x4c, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x0f, 0x12,
+	0x16, 0x0a, 0x12, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x46, 0x54, 0x45, 0x52,
+	0x5f, 0x53, 0x41, 0x4c, 0x45, 0x10, 0x10, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x46, 0x54, 0x45, 0x52,
+	0x5f, 0x53, 0x41, 0x4c, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45,
+	0x44, 0x10, 0x11, 0x12, 0x21, 0x0a, 0x1d, 0x49, 0x4c, 0x4c, 0x45, 0x47, 0x41, 0x4c, 0x5f, 0x41,
+	0x46, 0x54, 0x45, 0x52, 0x5f, 0x53, 0x41, 0x4c, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x12, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x46, 0x54, 0x45, 0x52, 0x5f,
+	0x53, 0x41, 0x4c, 0x45, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e,
+	0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x13, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x56, 0x4f, 0x49,
+	0x43, 0x45, 0x5f, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0x14, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
+	0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x10, 0x15, 0x12,
+	0x15, 0x0a, 0x11, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x16, 0x12, 0x13, 0x0a, 0x0f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x5f, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x10, 0x17, 0x12, 0x1a, 0x0a, 0x16, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x49, 0x4e, 0x56,
+	0x4f, 0x49, 0x43, 0x45, 0x44, 0x10, 0x18, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x4e, 0x56, 0x4f, 0x49,
+	0x43, 0x45, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c,
+	0x49, 0x43, 0x54, 0x10, 0x19, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x4c, 0x41, 0x53, 0x48, 0x5f, 0x53,
+	0x41, 0x4c, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x1a, 0x12,
+	0x1a, 0x0a, 0x16, 0x46, 0x4c, 0x41, 0x53, 0x48, 0x5f, 0x53, 0x41, 0x4c, 0x45, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x1b, 0x12, 0x14, 0x0a, 0x10, 0x46,
+	0x4c, 0x41, 0x53, 0x48, 0x5f, 0x53, 0x41, 0x4c, 0x45, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10,
+	0x1c, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x4c, 0x41, 0x53, 0x48, 0x5f, 0x53, 0x41, 0x4c, 0x45, 0x5f,
+	0x53, 0x4f, 0x4c, 0x44, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x1d, 0x12, 0x1d, 0x0a, 0x19, 0x46, 0x4c,
+	0x41, 0x53, 0x48, 0x5f, 0x53, 0x41, 0x4c, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x45,
+	0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x1e, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x48, 0x41, 0x4c, 0x4c, 0x45, 0x4e, 0x47, 0x45, 0x10, 0x1f,
+	0x12, 0x20, 0x0a, 0x1c, 0x46, 0x4c, 0x41, 0x53, 0x48, 0x5f, 0x53, 0x41, 0x4c, 0x45, 0x5f, 0x41,
+	0x54, 0x54, 0x45, 0x4d, 0x50, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x20, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x46, 0x4c,
+	0x41, 0x53, 0x48, 0x5f, 0x53, 0x41, 0x4c, 0x45, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4d, 0x50, 0x54,
+	0x10, 0x21, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x47, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x22, 0x12, 0x14, 0x0a, 0x10,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x48, 0x49, 0x50, 0x4d, 0x45, 0x4e, 0x54,
+	0x10, 0x23, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x48, 0x49, 0x50, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x45,
+	0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x24, 0x42, 0x53, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x67, 0x6f, 0x2d, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x6b, 0x72, 0x61, 0x74,
+	0x6f, 0x73, 0x2d, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0xa2,
+	0x02, 0x0a, 0x41, 0x50, 0x49, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_order_v1_error_reason_proto_rawDescOnce sync.Once
+	file_order_v1_error_reason_proto_rawDescData = file_order_v1_error_reason_proto_rawDesc
+)
+
+func file_order_v1_error_reason_proto_rawDescGZIP() []byte {
+	file_order_v1_error_reason_proto_rawDescOnce.Do(func() {
+		file_order_v1_error_reason_proto_rawDescData = protoimpl.X.CompressGZIP(file_order_v1_error_reason_proto_rawDescData)
+	})
+	return file_order_v1_error_reason_proto_rawDescData
+}
+
+var file_order_v1_error_reason_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_order_v1_error_reason_proto_goTypes = []interface{}{
+	(ErrorReason)(0), // 0: order.v1.ErrorReason
+}
+var file_order_v1_error_reason_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_order_v1_error_reason_proto_init() }
+func file_order_v1_error_reason_proto_init() {
+	if File_order_v1_error_reason_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_order_v1_error_reason_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_order_v1_error_reason_proto_goTypes,
+		DependencyIndexes: file_order_v1_error_reason_proto_depIdxs,
+		EnumInfos:         file_order_v1_error_reason_proto_enumTypes,
+	}.Build()
+	File_order_v1_error_reason_proto = out.File
+	file_order_v1_error_reason_proto_rawDesc = nil
+	file_order_v1_error_reason_proto_goTypes = nil
+	file_order_v1_error_reason_proto_depIdxs = nil
+}
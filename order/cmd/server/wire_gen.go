@@ -23,7 +23,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, order *conf.Order, logger log.Logger) (*kratos.App, func(), error) {
 	dataData, cleanup, err := data.NewData(confData, logger)
 	if err != nil {
 		return nil, nil, err
@@ -31,10 +31,129 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 	greeterRepo := data.NewGreeterRepo(dataData, logger)
 	greeterUsecase := biz.NewGreeterUsecase(greeterRepo, logger)
 	greeterService := service.NewGreeterService(greeterUsecase)
-	grpcServer := server.NewGRPCServer(confServer, greeterService, logger)
-	httpServer := server.NewHTTPServer(confServer, greeterService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	orderRepo := data.NewOrderRepo(dataData, logger)
+	transitionRepo := data.NewTransitionRepo(dataData, logger)
+	transaction := data.NewTransaction(dataData)
+	stateMachine := biz.NewStateMachine(orderRepo, transitionRepo, transaction, logger)
+	paymentClient, cleanup2, err := data.NewPaymentClient(confData)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	paymentRepo := data.NewPaymentRepo(paymentClient, logger)
+	inventoryClient, cleanup3, err := data.NewInventoryClient(confData)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	stockRepo := data.NewStockRepo(inventoryClient, logger)
+	catalogClient, cleanup4, err := data.NewCatalogClient(confData)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	skuRepo := data.NewSkuRepo(catalogClient, logger)
+	promotionRepo := data.NewPromotionRepo(dataData, logger)
+	memberPriceRepo := data.NewMemberPriceRepo(dataData, logger)
+	couponRepo := data.NewCouponRepo(dataData, logger)
+	freightClient, cleanup5, err := data.NewFreightClient(confData)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	freightRepo := data.NewFreightRepo(freightClient, logger)
+	promotionUsecase := biz.NewPromotionUsecase(promotionRepo, memberPriceRepo, couponRepo, freightRepo, logger)
+	delayQueue, cleanup6, err := data.NewDelayQueue(confData, dataData, logger)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	orchestrator := data.NewSagaOrchestrator(dataData, order, logger)
+	orderPolicy := data.NewOrderPolicy(order)
+	orderUsecase := biz.NewOrderUsecase(orderRepo, stateMachine, paymentRepo, stockRepo, skuRepo, promotionUsecase, delayQueue, orchestrator, orderPolicy, logger)
+	shipmentRepo := data.NewShipmentRepo(dataData, logger)
+	carrierAdapter, err := data.NewCarrierAdapter(confData, logger)
+	if err != nil {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	shipmentUsecase := biz.NewShipmentUsecase(shipmentRepo, orderUsecase, carrierAdapter, logger)
+	orderService := service.NewOrderService(orderUsecase, shipmentUsecase)
+	cartRepo, err := data.NewCartRepo(confData, dataData, logger)
+	if err != nil {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	cartUsecase := biz.NewCartUsecase(cartRepo, skuRepo, promotionUsecase, logger)
+	cartService := service.NewCartService(cartUsecase)
+	promotionService := service.NewPromotionService(promotionUsecase)
+	afterSaleRepo := data.NewAfterSaleRepo(dataData, logger)
+	afterSalePolicy := data.NewAfterSalePolicy(order)
+	afterSaleUsecase := biz.NewAfterSaleUsecase(afterSaleRepo, orderUsecase, delayQueue, afterSalePolicy, logger)
+	afterSaleService := service.NewAfterSaleService(afterSaleUsecase)
+	invoiceRepo := data.NewInvoiceRepo(dataData, logger)
+	invoiceProvider, err := data.NewInvoiceProvider(confData, logger)
+	if err != nil {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	invoiceUsecase := biz.NewInvoiceUsecase(invoiceRepo, orderUsecase, invoiceProvider, delayQueue, logger)
+	invoiceService := service.NewInvoiceService(invoiceUsecase)
+	flashSaleClient, cleanup7, err := data.NewFlashSaleClient(confData)
+	if err != nil {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	flashSaleRepo := data.NewFlashSaleRepo(flashSaleClient, logger)
+	flashSaleStore := data.NewFlashSaleStore(dataData, logger)
+	flashSalePolicy := data.NewFlashSalePolicy(order)
+	flashSaleUsecase := biz.NewFlashSaleUsecase(flashSaleRepo, flashSaleStore, orderUsecase, flashSalePolicy, logger)
+	flashSaleService := service.NewFlashSaleService(flashSaleUsecase)
+	grpcServer := server.NewGRPCServer(confServer, greeterService, orderService, cartService, promotionService, afterSaleService, invoiceService, flashSaleService, logger)
+	httpServer := server.NewHTTPServer(confServer, greeterService, orderService, cartService, promotionService, afterSaleService, invoiceService, flashSaleService, logger)
+	eventSubscriber := data.NewEventSubscriber(dataData, logger)
+	consumerServer := server.NewConsumerServer(eventSubscriber, delayQueue, orderUsecase, afterSaleUsecase, invoiceUsecase, logger)
+	runner := server.NewSagaRunner(orchestrator, order, logger)
+	flashSaleServer := server.NewFlashSaleServer(flashSaleUsecase, order, logger)
+	trackingServer := server.NewTrackingServer(shipmentUsecase, order, logger)
+	app := newApp(logger, grpcServer, httpServer, consumerServer, runner, flashSaleServer, trackingServer)
 	return app, func() {
+		cleanup7()
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -0,0 +1,615 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	v1 "github.com/go-kratos/kratos-layout/order/api/order/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrAfterSaleNotFound is after-sale ticket not found.
+	ErrAfterSaleNotFound = errors.NotFound(v1.ErrorReason_AFTER_SALE_NOT_FOUND.String(), "after-sale ticket not found")
+	// ErrInvalidAfterSale is returned for a ticket without items or reason,
+	// with items not in the order or more of them than are left, or for a
+	// command missing what it needs.
+	ErrInvalidAfterSale = errors.BadRequest(v1.ErrorReason_INVALID_AFTER_SALE.String(), "invalid after-sale ticket")
+	// ErrAfterSaleNotAllowed is returned for an order not delivered, or
+	// completed longer ago than the after-sale window.
+	ErrAfterSaleNotAllowed = errors.Forbidden(v1.ErrorReason_AFTER_SALE_NOT_ALLOWED.String(), "after-sale not allowed for the order")
+	// ErrAfterSaleConflict is returned by the Update of a repo when the
+	// ticket changed since it was read.
+	ErrAfterSaleConflict = errors.Conflict(v1.ErrorReason_AFTER_SALE_VERSION_CONFLICT.String(), "after-sale ticket changed concurrently")
+)
+
+// IllegalAfterSaleTransition is returned for a command a ticket does not
+// take in the status it is in.
+func IllegalAfterSaleTransition(from, to AfterSaleStatus) error {
+	return errors.Conflict(v1.ErrorReason_ILLEGAL_AFTER_SALE_TRANSITION.String(), "illegal after-sale transition").
+		WithMetadata(map[string]string{"from": string(from), "to": string(to)})
+}
+
+// TopicAfterSaleTimeout is the delay queue topic of the deadlines of
+// after-sale tickets, its tasks are ticket numbers.
+const TopicAfterSaleTimeout = "order_after_sale_timeout"
+
+// The defaults of AfterSalePolicy.
+const (
+	defaultAfterSaleWindow = 7 * 24 * time.Hour
+	defaultReviewTimeout   = 48 * time.Hour
+	defaultReturnTimeout   = 7 * 24 * time.Hour
+	defaultReceiveTimeout  = 7 * 24 * time.Hour
+	// refundRetry is how long a refunding ticket waits for its refund
+	// before asking for it again.
+	refundRetry = 10 * time.Minute
+)
+
+// AfterSalePolicy is how after-sale tickets are handled, zero fields
+// taking their defaults.
+type AfterSalePolicy struct {
+	// Window is how long after an order is completed tickets may be opened.
+	Window time.Duration
+	// ReviewTimeout is how long a merchant has to review a ticket before it
+	// is approved.
+	ReviewTimeout time.Duration
+	// ReturnTimeout is how long a buyer has to ship back the items of an
+	// approved ticket before it is closed.
+	ReturnTimeout time.Duration
+	// ReceiveTimeout is how long a merchant has to confirm receiving a
+	// return before it is taken as received.
+	ReceiveTimeout time.Duration
+}
+
+// AfterSaleKind is what a buyer asks for.
+type AfterSaleKind string
+
+const (
+	AfterSaleRefundOnly   AfterSaleKind = "refund_only"
+	AfterSaleReturnRefund AfterSaleKind = "return_refund"
+	AfterSaleExchange     AfterSaleKind = "exchange"
+)
+
+// AfterSaleStatus is the status of an after-sale ticket.
+type AfterSaleStatus string
+
+const (
+	// AfterSalePending is a ticket waiting for the merchant to review it.
+	AfterSalePending AfterSaleStatus = "pending_review"
+	// AfterSaleAwaitingReturn is a ticket approved, waiting for the buyer
+	// to ship the items back.
+	AfterSaleAwaitingReturn AfterSaleStatus = "awaiting_return"
+	// AfterSaleReturning is a ticket whose items are on their way back.
+	AfterSaleReturning AfterSaleStatus = "returning"
+	// AfterSaleRefunding is a ticket waiting for its refund.
+	AfterSaleRefunding AfterSaleStatus = "refunding"
+	// AfterSaleReshipping is an exchange whose return was received,
+	// waiting for the merchant to ship the replacement.
+	AfterSaleReshipping AfterSaleStatus = "reshipping"
+	AfterSaleCompleted  AfterSaleStatus = "completed"
+	AfterSaleRejected   AfterSaleStatus = "rejected"
+	// AfterSaleClosed is a ticket withdrawn by the buyer, or whose items
+	// were not shipped back in time.
+	AfterSaleClosed AfterSaleStatus = "closed"
+)
+
+// afterSaleTransitions are the moves of after-sale tickets. A refund only
+// is refunded once approved; a return is refunded, and an exchange
+// reshipped, once its items came back. A ticket is rejected on review or
+// when its return comes back not as it should, and closed when withdrawn
+// or when its items are not shipped back in time.
+var afterSaleTransitions = map[AfterSaleStatus][]AfterSaleStatus{
+	AfterSalePending:        {AfterSaleAwaitingReturn, AfterSaleRefunding, AfterSaleRejected, AfterSaleClosed},
+	AfterSaleAwaitingReturn: {AfterSaleReturning, AfterSaleClosed},
+	AfterSaleReturning:      {AfterSaleRefunding, AfterSaleReshipping, AfterSaleRejected},
+	AfterSaleRefunding:      {AfterSaleCompleted},
+	AfterSaleReshipping:     {AfterSaleCompleted},
+}
+
+// AfterSaleItem is a line of an order a ticket is about.
+type AfterSaleItem struct {
+	SkuID    int64
+	Quantity int32
+	// Amount is what is refunded for the items, 0 for an exchange.
+	Amount int64
+}
+
+// AfterSale is an after-sale ticket of a delivered order.
+type AfterSale struct {
+	ID         int64
+	TicketNo   string
+	OrderNo    string
+	UserID     int64
+	MerchantID int64
+	Kind       AfterSaleKind
+	Status     AfterSaleStatus
+	Items      []*AfterSaleItem
+	// RefundAmount sums the amounts of the items.
+	RefundAmount int64
+	Reason       string
+	Description  string
+	// Evidence are the URLs of the photos and videos the buyer attached.
+	Evidence []string
+	// MerchantNote is what the merchant said on review, such as why the
+	// ticket was rejected or where to ship the items back.
+	MerchantNote     string
+	ReturnCarrier    string
+	ReturnTrackingNo string
+	// ReshipCarrier and ReshipTrackingNo are the shipment of the
+	// replacement of an exchange.
+	ReshipCarrier    string
+	ReshipTrackingNo string
+	// RefundNo is the refund of the payment of the order asked for the
+	// ticket.
+	RefundNo string
+	// Deadline is when the ticket moves on by itself, zero when it waits
+	// for nothing.
+	Deadline    time.Time
+	Version     int64
+	CreatedAt   time.Time
+	ReviewedAt  time.Time
+	ReturnedAt  time.Time
+	ReceivedAt  time.Time
+	CompletedAt time.Time
+}
+
+// returns reports whether the items of the ticket are shipped back.
+func (a *AfterSale) returns() bool {
+	return a.Kind != AfterSaleRefundOnly
+}
+
+// open reports whether the ticket is still going on.
+func (a *AfterSale) open() bool {
+	return a.Status != AfterSaleCompleted && a.Status != AfterSaleRejected && a.Status != AfterSaleClosed
+}
+
+// AfterSaleFilter narrows ListAfterSales, zero fields match all.
+type AfterSaleFilter struct {
+	UserID     int64
+	MerchantID int64
+	OrderNo    string
+	Status     AfterSaleStatus
+}
+
+// AfterSaleRepo is an after-sale ticket repo.
+type AfterSaleRepo interface {
+	Save(context.Context, *AfterSale) (*AfterSale, error)
+	// Update saves a ticket and bumps its version if that is still the
+	// version read, or returns ErrAfterSaleConflict. Items are not updated.
+	Update(context.Context, *AfterSale) error
+	FindByTicketNo(ctx context.Context, ticketNo string) (*AfterSale, error)
+	ListByOrderNo(ctx context.Context, orderNo string) ([]*AfterSale, error)
+	List(ctx context.Context, filter *AfterSaleFilter, page, pageSize int) ([]*AfterSale, int64, error)
+	// ListWithDeadline lists the tickets with a deadline by id after
+	// afterID, without items.
+	ListWithDeadline(ctx context.Context, afterID int64, limit int) ([]*AfterSale, error)
+}
+
+// AfterSaleUsecase is an after-sale usecase. Tickets are opened by buyers
+// on delivered orders and reviewed by merchants; the refunds they end in
+// are refunds of the payment of the order.
+type AfterSaleUsecase struct {
+	repo   AfterSaleRepo
+	orders *OrderUsecase
+	queue  DelayQueue
+	policy AfterSalePolicy
+	log    *log.Helper
+}
+
+// NewAfterSaleUsecase new an after-sale usecase.
+func NewAfterSaleUsecase(repo AfterSaleRepo, orders *OrderUsecase, queue DelayQueue, policy *AfterSalePolicy, logger log.Logger) *AfterSaleUsecase {
+	uc := &AfterSaleUsecase{
+		repo:   repo,
+		orders: orders,
+		queue:  queue,
+		policy: *policy,
+		log:    log.NewHelper(logger),
+	}
+	if uc.policy.Window <= 0 {
+		uc.policy.Window = defaultAfterSaleWindow
+	}
+	if uc.policy.ReviewTimeout <= 0 {
+		uc.policy.ReviewTimeout = defaultReviewTimeout
+	}
+	if uc.policy.ReturnTimeout <= 0 {
+		uc.policy.ReturnTimeout = defaultReturnTimeout
+	}
+	if uc.policy.ReceiveTimeout <= 0 {
+		uc.policy.ReceiveTimeout = defaultReceiveTimeout
+	}
+	orders.OnRefunded(uc.refunded)
+	return uc
+}
+
+// NewTicketNo returns an after-sale ticket number that sorts by creation time.
+func NewTicketNo() string {
+	return "A" + NewOrderNo()[1:]
+}
+
+// afterSaleRefundNo is the number of the refund of a ticket, one of the
+// refunds of its order.
+func afterSaleRefundNo(a *AfterSale) string {
+	return orderRefundNo(a.OrderNo) + "-" + a.TicketNo
+}
+
+// CreateAfterSale opens a ticket on items of a shipped order, or of one
+// completed within the after-sale window. The amount refunded for items is
+// their share of what was paid for their line, the last of a line getting
+// what is left of it, so that a line refunded in several tickets adds up to
+// what was paid to the cent. Shipping fees are not refunded.
+func (uc *AfterSaleUsecase) CreateAfterSale(ctx context.Context, a *AfterSale) (*AfterSale, error) {
+	switch a.Kind {
+	case AfterSaleRefundOnly, AfterSaleReturnRefund, AfterSaleExchange:
+	default:
+		return nil, ErrInvalidAfterSale
+	}
+	if len(a.Items) == 0 || a.Reason == "" {
+		return nil, ErrInvalidAfterSale
+	}
+	o, err := uc.orders.repo.FindByOrderNo(ctx, a.OrderNo)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case o.Status == StatusShipped:
+	case o.Status == StatusCompleted && time.Since(o.CompletedAt) <= uc.policy.Window:
+	default:
+		return nil, ErrAfterSaleNotAllowed
+	}
+	a.UserID, a.MerchantID = o.UserID, o.MerchantID
+	a.TicketNo = NewTicketNo()
+	a.Status = AfterSalePending
+	a.Deadline = time.Now().Add(uc.policy.ReviewTimeout)
+	err = uc.orders.states.tx.InTx(ctx, func(ctx context.Context) error {
+		others, err := uc.repo.ListByOrderNo(ctx, o.OrderNo)
+		if err != nil {
+			return err
+		}
+		if err := takeItems(a, o, others); err != nil {
+			return err
+		}
+		if a, err = uc.repo.Save(ctx, a); err != nil {
+			return err
+		}
+		// Bumping the version of the order serializes the tickets of an
+		// order, so that two cannot take the same items.
+		return uc.orders.repo.Update(ctx, o)
+	})
+	if errors.Is(err, ErrOrderChanged) {
+		return nil, ErrOrderConflict
+	}
+	if err != nil {
+		return nil, err
+	}
+	uc.schedule(ctx, a)
+	uc.log.WithContext(ctx).Infof("CreateAfterSale: %s %s on %s for %d", a.TicketNo, a.Kind, a.OrderNo, a.RefundAmount)
+	return a, nil
+}
+
+// takeItems checks the items of a against what is left of the lines of o
+// once the other tickets of o took theirs, and sets their amounts.
+func takeItems(a *AfterSale, o *Order, others []*AfterSale) error {
+	taken := make(map[int64]int32)
+	refunded := make(map[int64]int32)
+	refundedAmount := make(map[int64]int64)
+	for _, other := range others {
+		if other.Status == AfterSaleRejected || other.Status == AfterSaleClosed {
+			continue
+		}
+		for _, it := range other.Items {
+			if other.Kind == AfterSaleExchange {
+				// Exchanged items come back once the exchange is done.
+				if other.open() {
+					taken[it.SkuID] += it.Quantity
+				}
+				continue
+			}
+			taken[it.SkuID] += it.Quantity
+			refunded[it.SkuID] += it.Quantity
+			refundedAmount[it.SkuID] += it.Amount
+		}
+	}
+	lines := make(map[int64]*OrderItem, len(o.Items))
+	for _, it := range o.Items {
+		lines[it.SkuID] = it
+	}
+	seen := make(map[int64]bool, len(a.Items))
+	a.RefundAmount = 0
+	for _, it := range a.Items {
+		l, ok := lines[it.SkuID]
+		if !ok || seen[it.SkuID] || it.Quantity <= 0 || it.Quantity > l.Quantity-taken[it.SkuID] {
+			return ErrInvalidAfterSale
+		}
+		seen[it.SkuID] = true
+		switch {
+		case a.Kind == AfterSaleExchange:
+			it.Amount = 0
+		case refunded[it.SkuID]+it.Quantity == l.Quantity:
+			it.Amount = l.PayAmount - refundedAmount[it.SkuID]
+		default:
+			it.Amount = l.PayAmount * int64(it.Quantity) / int64(l.Quantity)
+		}
+		a.RefundAmount += it.Amount
+	}
+	return nil
+}
+
+// GetAfterSale returns a ticket.
+func (uc *AfterSaleUsecase) GetAfterSale(ctx context.Context, ticketNo string) (*AfterSale, error) {
+	return uc.repo.FindByTicketNo(ctx, ticketNo)
+}
+
+// ListAfterSales lists tickets, newest first.
+func (uc *AfterSaleUsecase) ListAfterSales(ctx context.Context, filter *AfterSaleFilter, page, pageSize int) ([]*AfterSale, int64, error) {
+	page, pageSize = pagination(page, pageSize)
+	return uc.repo.List(ctx, filter, page, pageSize)
+}
+
+// ApproveAfterSale approves a ticket waiting for review: a refund only is
+// refunded, the items of the others are to be shipped back.
+func (uc *AfterSaleUsecase) ApproveAfterSale(ctx context.Context, ticketNo, note string) (*AfterSale, error) {
+	a, err := uc.repo.FindByTicketNo(ctx, ticketNo)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.approve(ctx, a, note); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (uc *AfterSaleUsecase) approve(ctx context.Context, a *AfterSale, note string) error {
+	if a.Status != AfterSalePending {
+		return IllegalAfterSaleTransition(a.Status, AfterSaleAwaitingReturn)
+	}
+	a.MerchantNote, a.ReviewedAt = note, time.Now()
+	if !a.returns() {
+		return uc.refund(ctx, a)
+	}
+	return uc.transit(ctx, a, AfterSaleAwaitingReturn, time.Now().Add(uc.policy.ReturnTimeout))
+}
+
+// RejectAfterSale rejects a ticket waiting for review, or whose return did
+// not come back as it should, saying why.
+func (uc *AfterSaleUsecase) RejectAfterSale(ctx context.Context, ticketNo, note string) (*AfterSale, error) {
+	if strings.TrimSpace(note) == "" {
+		return nil, ErrInvalidAfterSale
+	}
+	a, err := uc.repo.FindByTicketNo(ctx, ticketNo)
+	if err != nil {
+		return nil, err
+	}
+	a.MerchantNote = note
+	if a.Status == AfterSalePending {
+		a.ReviewedAt = time.Now()
+	}
+	if err := uc.transit(ctx, a, AfterSaleRejected, time.Time{}); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// ShipReturn records the shipment of the items of an approved ticket back
+// to the merchant.
+func (uc *AfterSaleUsecase) ShipReturn(ctx context.Context, ticketNo, carrier, trackingNo string) (*AfterSale, error) {
+	if carrier == "" || trackingNo == "" {
+		return nil, ErrInvalidAfterSale
+	}
+	a, err := uc.repo.FindByTicketNo(ctx, ticketNo)
+	if err != nil {
+		return nil, err
+	}
+	a.ReturnCarrier, a.ReturnTrackingNo, a.ReturnedAt = carrier, trackingNo, time.Now()
+	if err := uc.transit(ctx, a, AfterSaleReturning, time.Now().Add(uc.policy.ReceiveTimeout)); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// ReceiveReturn confirms the return of a ticket came back: a return is
+// refunded, an exchange waits for its replacement to be shipped.
+func (uc *AfterSaleUsecase) ReceiveReturn(ctx context.Context, ticketNo string) (*AfterSale, error) {
+	a, err := uc.repo.FindByTicketNo(ctx, ticketNo)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.receive(ctx, a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (uc *AfterSaleUsecase) receive(ctx context.Context, a *AfterSale) error {
+	if a.Status != AfterSaleReturning {
+		return IllegalAfterSaleTransition(a.Status, AfterSaleRefunding)
+	}
+	a.ReceivedAt = time.Now()
+	if a.Kind == AfterSaleExchange {
+		return uc.transit(ctx, a, AfterSaleReshipping, time.Time{})
+	}
+	return uc.refund(ctx, a)
+}
+
+// ShipReplacement records the shipment of the replacement of an exchange,
+// which completes it.
+func (uc *AfterSaleUsecase) ShipReplacement(ctx context.Context, ticketNo, carrier, trackingNo string) (*AfterSale, error) {
+	if carrier == "" || trackingNo == "" {
+		return nil, ErrInvalidAfterSale
+	}
+	a, err := uc.repo.FindByTicketNo(ctx, ticketNo)
+	if err != nil {
+		return nil, err
+	}
+	if a.Status != AfterSaleReshipping {
+		return nil, IllegalAfterSaleTransition(a.Status, AfterSaleCompleted)
+	}
+	a.ReshipCarrier, a.ReshipTrackingNo, a.CompletedAt = carrier, trackingNo, time.Now()
+	if err := uc.transit(ctx, a, AfterSaleCompleted, time.Time{}); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// CancelAfterSale withdraws a ticket whose items were not shipped back yet.
+func (uc *AfterSaleUsecase) CancelAfterSale(ctx context.Context, ticketNo string) (*AfterSale, error) {
+	a, err := uc.repo.FindByTicketNo(ctx, ticketNo)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.transit(ctx, a, AfterSaleClosed, time.Time{}); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// ExpireAfterSale moves a ticket on once its deadline passed: a ticket not
+// reviewed is approved, one whose items were not shipped back is closed, a
+// return not confirmed is taken as received, and a refund not completed is
+// asked for again.
+func (uc *AfterSaleUsecase) ExpireAfterSale(ctx context.Context, ticketNo string) error {
+	a, err := uc.repo.FindByTicketNo(ctx, ticketNo)
+	if errors.Is(err, ErrAfterSaleNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if a.Deadline.IsZero() {
+		return nil
+	}
+	if time.Now().Before(a.Deadline) {
+		return uc.queue.Schedule(ctx, TopicAfterSaleTimeout, a.TicketNo, a.Deadline)
+	}
+	switch a.Status {
+	case AfterSalePending:
+		err = uc.approve(ctx, a, "approved on review timeout")
+	case AfterSaleAwaitingReturn:
+		err = uc.transit(ctx, a, AfterSaleClosed, time.Time{})
+	case AfterSaleReturning:
+		err = uc.receive(ctx, a)
+	case AfterSaleRefunding:
+		err = uc.askRefund(ctx, a)
+	}
+	// Another move won the race, and scheduled what follows it.
+	if errors.Is(err, ErrAfterSaleConflict) {
+		return nil
+	}
+	return err
+}
+
+// RescheduleDeadlines schedules the deadline of every ticket again, for
+// queues that lose their tasks on restart.
+func (uc *AfterSaleUsecase) RescheduleDeadlines(ctx context.Context) (int, error) {
+	n := 0
+	var afterID int64
+	for {
+		as, err := uc.repo.ListWithDeadline(ctx, afterID, pendingBatch)
+		if err != nil {
+			return n, err
+		}
+		for _, a := range as {
+			if err := uc.queue.Schedule(ctx, TopicAfterSaleTimeout, a.TicketNo, a.Deadline); err != nil {
+				return n, err
+			}
+			afterID = a.ID
+			n++
+		}
+		if len(as) < pendingBatch {
+			return n, nil
+		}
+	}
+}
+
+// refund moves a to refunding and asks for its refund, which completes it
+// once done.
+func (uc *AfterSaleUsecase) refund(ctx context.Context, a *AfterSale) error {
+	a.RefundNo = afterSaleRefundNo(a)
+	if err := uc.transit(ctx, a, AfterSaleRefunding, time.Now().Add(refundRetry)); err != nil {
+		return err
+	}
+	// The deadline asks again if this fails.
+	if err := uc.askRefund(ctx, a); err != nil {
+		uc.log.WithContext(ctx).Errorf("AfterSale %s: %v", a.TicketNo, err)
+	}
+	return nil
+}
+
+// askRefund asks the payment service for the refund of a, and pushes its
+// deadline to ask again should it not complete.
+func (uc *AfterSaleUsecase) askRefund(ctx context.Context, a *AfterSale) error {
+	o, err := uc.orders.repo.FindByOrderNo(ctx, a.OrderNo)
+	if err != nil {
+		return err
+	}
+	if err := uc.orders.refund(ctx, o.OrderNo, o.TradeNo, a.RefundNo, a.RefundAmount, "after-sale "+a.TicketNo+": "+a.Reason); err != nil {
+		return err
+	}
+	a.Deadline = time.Now().Add(refundRetry)
+	if err := uc.repo.Update(ctx, a); err != nil {
+		return err
+	}
+	uc.schedule(ctx, a)
+	return nil
+}
+
+// refunded completes the ticket of a refund of an order, if any.
+func (uc *AfterSaleUsecase) refunded(ctx context.Context, o *Order, r *OrderRefund) error {
+	_, ticketNo, ok := strings.Cut(r.RefundNo, "-")
+	if !ok || !strings.HasPrefix(ticketNo, "A") {
+		return nil
+	}
+	a, err := uc.repo.FindByTicketNo(ctx, ticketNo)
+	if errors.Is(err, ErrAfterSaleNotFound) {
+		uc.log.WithContext(ctx).Warnf("Order %s: refund %s of unknown ticket", o.OrderNo, r.RefundNo)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if a.Status != AfterSaleRefunding || a.RefundNo != r.RefundNo {
+		return nil
+	}
+	a.CompletedAt = time.Now()
+	return uc.transit(ctx, a, AfterSaleCompleted, time.Time{})
+}
+
+// transit moves a to status to with deadline, saving the other fields
+// changed on a with it, and schedules the deadline. It leaves a's status,
+// deadline and version as they were on failure.
+func (uc *AfterSaleUsecase) transit(ctx context.Context, a *AfterSale, to AfterSaleStatus, deadline time.Time) error {
+	from, oldDeadline, version := a.Status, a.Deadline, a.Version
+	if !canTransitAfterSale(from, to) {
+		return IllegalAfterSaleTransition(from, to)
+	}
+	a.Status, a.Deadline = to, deadline
+	if err := uc.repo.Update(ctx, a); err != nil {
+		a.Status, a.Deadline, a.Version = from, oldDeadline, version
+		return err
+	}
+	uc.schedule(ctx, a)
+	uc.log.WithContext(ctx).Infof("AfterSale %s: %s -> %s", a.TicketNo, from, to)
+	return nil
+}
+
+// schedule schedules the deadline of a, if any. The ticket stands without
+// it, deadlines are scheduled again on start.
+func (uc *AfterSaleUsecase) schedule(ctx context.Context, a *AfterSale) {
+	if a.Deadline.IsZero() {
+		return
+	}
+	if err := uc.queue.Schedule(ctx, TopicAfterSaleTimeout, a.TicketNo, a.Deadline); err != nil {
+		uc.log.WithContext(ctx).Errorf("AfterSale %s: schedule deadline: %v", a.TicketNo, err)
+	}
+}
+
+func canTransitAfterSale(from, to AfterSaleStatus) bool {
+	for _, s := range afterSaleTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
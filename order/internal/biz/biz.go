@@ -1,6 +1,15 @@
 package biz
 
-import "github.com/google/wire"
+import (
+	"context"
+
+	"github.com/google/wire"
+)
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewGreeterUsecase)
+var ProviderSet = wire.NewSet(NewGreeterUsecase, NewStateMachine, NewOrderUsecase, NewCartUsecase, NewPromotionUsecase, NewAfterSaleUsecase, NewInvoiceUsecase, NewFlashSaleUsecase, NewShipmentUsecase)
+
+// Transaction runs fn in a database transaction carried by ctx.
+type Transaction interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,419 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	v1 "github.com/go-kratos/kratos-layout/order/api/order/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrCartItemNotFound is returned when changing a SKU the cart does not hold.
+	ErrCartItemNotFound = errors.NotFound(v1.ErrorReason_CART_ITEM_NOT_FOUND.String(), "cart item not found")
+	// ErrCartFull is returned when adding a SKU to a cart holding maxCartItems.
+	ErrCartFull = errors.BadRequest(v1.ErrorReason_CART_FULL.String(), "cart is full")
+	// ErrSkuUnavailable is returned when adding a SKU unknown, delisted or sold out.
+	ErrSkuUnavailable = errors.BadRequest(v1.ErrorReason_SKU_UNAVAILABLE.String(), "sku unavailable")
+	// ErrInvalidCartItem is returned for a cart without owner or a quantity out of range.
+	ErrInvalidCartItem = errors.BadRequest(v1.ErrorReason_INVALID_CART_ITEM.String(), "invalid cart item")
+)
+
+const (
+	// maxCartItems is how many SKUs a cart holds at most.
+	maxCartItems = 100
+	// maxCartQuantity is how many of a SKU a cart holds at most.
+	maxCartQuantity = 999
+	// maxGuestIDLen bounds the guest ids cart keys are made of.
+	maxGuestIDLen = 64
+)
+
+// CartOwner is whom a cart belongs to, a user or else a guest.
+type CartOwner struct {
+	UserID int64
+	// GuestID identifies the device of a guest until the guest logs in.
+	GuestID string
+	// MemberLevel prices the cart of a member, it is not part of its key.
+	MemberLevel int32
+}
+
+// Key is the key of the cart of the owner.
+func (o CartOwner) Key() string {
+	if o.UserID > 0 {
+		return fmt.Sprintf("u:%d", o.UserID)
+	}
+	return "g:" + o.GuestID
+}
+
+// IsGuest reports whether the owner is a guest.
+func (o CartOwner) IsGuest() bool {
+	return o.UserID <= 0
+}
+
+func (o CartOwner) valid() bool {
+	return o.UserID > 0 || (o.GuestID != "" && len(o.GuestID) <= maxGuestIDLen)
+}
+
+// CartItem is a SKU kept in a cart.
+type CartItem struct {
+	SkuID    int64
+	Quantity int32
+	// Selected items are the ones checked out.
+	Selected bool
+	// AddedPrice is the price of the SKU when it was added.
+	AddedPrice int64
+	AddedAt    time.Time
+}
+
+// CartRepo is a Cart repo.
+type CartRepo interface {
+	// Items returns the items of the cart of owner, none for a cart not kept.
+	Items(ctx context.Context, owner CartOwner) ([]*CartItem, error)
+	// Update replaces the items of the cart of owner with what fn makes of
+	// them, calling fn again when the cart changed meanwhile. An error of fn
+	// leaves the cart as it was.
+	Update(ctx context.Context, owner CartOwner, fn func([]*CartItem) ([]*CartItem, error)) error
+	// Merge replaces the items of the cart of to with what fn makes of them
+	// and the items of the cart of from, and drops the cart of from, all at
+	// once.
+	Merge(ctx context.Context, from, to CartOwner, fn func(from, to []*CartItem) []*CartItem) error
+}
+
+// Sku is a SKU of the shop as it sells now.
+type Sku struct {
+	ID         int64
+	SpuID      int64
+	MerchantID int64
+	Title      string
+	Image      string
+	Price      int64
+	Stock      int64
+	OnSale     bool
+}
+
+// SkuRepo reads SKUs from the shop.
+type SkuRepo interface {
+	// GetSkus returns the SKUs of ids by id, leaving out those unknown.
+	GetSkus(ctx context.Context, ids []int64) (map[int64]*Sku, error)
+}
+
+// CartLineStatus is whether the SKU of a cart line can be bought.
+type CartLineStatus string
+
+const (
+	CartLineAvailable CartLineStatus = "available"
+	// CartLineInsufficientStock is a line wanting more than the stock left.
+	CartLineInsufficientStock CartLineStatus = "insufficient_stock"
+	CartLineOutOfStock        CartLineStatus = "out_of_stock"
+	// CartLineDelisted is a line of a SKU no longer sold.
+	CartLineDelisted CartLineStatus = "delisted"
+)
+
+// CartLine is an item of a cart priced against the shop.
+type CartLine struct {
+	SkuID      int64
+	SpuID      int64
+	MerchantID int64
+	Title      string
+	Image      string
+	// Price is the current price, AddedPrice the price when added.
+	Price      int64
+	AddedPrice int64
+	Quantity   int32
+	// Amount is Price * Quantity.
+	Amount   int64
+	Selected bool
+	Status   CartLineStatus
+	Stock    int64
+	// DiscountAmount, PayAmount and Discounts price a selected line
+	// available as it would be ordered.
+	DiscountAmount int64
+	PayAmount      int64
+	Discounts      []*Discount
+}
+
+// Cart is a cart priced against the shop, latest added first.
+type Cart struct {
+	Owner CartOwner
+	Lines []*CartLine
+	// SelectedQuantity and SelectedAmount sum the selected lines available.
+	SelectedQuantity int32
+	SelectedAmount   int64
+	// ShippingFee, DiscountAmount and PayAmount price the selected lines
+	// available as they would be ordered.
+	ShippingFee       int64
+	DiscountAmount    int64
+	PayAmount         int64
+	ShippingDiscounts []*Discount
+	// CouponCodes are the coupons asked for that apply.
+	CouponCodes []string
+}
+
+// CartUsecase is a Cart usecase.
+type CartUsecase struct {
+	repo    CartRepo
+	skus    SkuRepo
+	pricing *PromotionUsecase
+	log     *log.Helper
+}
+
+// NewCartUsecase new a Cart usecase.
+func NewCartUsecase(repo CartRepo, skus SkuRepo, pricing *PromotionUsecase, logger log.Logger) *CartUsecase {
+	return &CartUsecase{repo: repo, skus: skus, pricing: pricing, log: log.NewHelper(logger)}
+}
+
+// GetCart returns the cart of owner priced against the current shop prices,
+// flagging the SKUs that cannot be bought, and prices the selected lines
+// available with the promotions running and couponCodes.
+func (uc *CartUsecase) GetCart(ctx context.Context, owner CartOwner, couponCodes []string) (*Cart, error) {
+	if !owner.valid() {
+		return nil, ErrInvalidCartItem
+	}
+	items, err := uc.repo.Items(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	return uc.price(ctx, owner, items, couponCodes)
+}
+
+// AddItem adds quantity of a SKU on sale to the cart of owner, selected, on
+// top of what it holds of it already and up to maxCartQuantity.
+func (uc *CartUsecase) AddItem(ctx context.Context, owner CartOwner, skuID int64, quantity int32) (*Cart, error) {
+	if !owner.valid() || skuID <= 0 || quantity <= 0 || quantity > maxCartQuantity {
+		return nil, ErrInvalidCartItem
+	}
+	skus, err := uc.skus.GetSkus(ctx, []int64{skuID})
+	if err != nil {
+		return nil, err
+	}
+	sku, ok := skus[skuID]
+	if !ok || !sku.OnSale || sku.Stock <= 0 {
+		return nil, ErrSkuUnavailable
+	}
+	err = uc.repo.Update(ctx, owner, func(items []*CartItem) ([]*CartItem, error) {
+		if it := findCartItem(items, skuID); it != nil {
+			it.Quantity = min(it.Quantity+quantity, maxCartQuantity)
+			it.Selected = true
+			return items, nil
+		}
+		if len(items) >= maxCartItems {
+			return nil, ErrCartFull
+		}
+		return append(items, &CartItem{
+			SkuID:      skuID,
+			Quantity:   quantity,
+			Selected:   true,
+			AddedPrice: sku.Price,
+			AddedAt:    time.Now(),
+		}), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uc.GetCart(ctx, owner, nil)
+}
+
+// UpdateItem sets the quantity of a SKU in the cart of owner.
+func (uc *CartUsecase) UpdateItem(ctx context.Context, owner CartOwner, skuID int64, quantity int32) (*Cart, error) {
+	if !owner.valid() || quantity <= 0 || quantity > maxCartQuantity {
+		return nil, ErrInvalidCartItem
+	}
+	err := uc.repo.Update(ctx, owner, func(items []*CartItem) ([]*CartItem, error) {
+		it := findCartItem(items, skuID)
+		if it == nil {
+			return nil, ErrCartItemNotFound
+		}
+		it.Quantity = quantity
+		return items, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uc.GetCart(ctx, owner, nil)
+}
+
+// RemoveItems removes SKUs from the cart of owner, ignoring those it does
+// not hold.
+func (uc *CartUsecase) RemoveItems(ctx context.Context, owner CartOwner, skuIDs []int64) (*Cart, error) {
+	if !owner.valid() {
+		return nil, ErrInvalidCartItem
+	}
+	remove := make(map[int64]bool, len(skuIDs))
+	for _, id := range skuIDs {
+		remove[id] = true
+	}
+	err := uc.repo.Update(ctx, owner, func(items []*CartItem) ([]*CartItem, error) {
+		kept := items[:0]
+		for _, it := range items {
+			if !remove[it.SkuID] {
+				kept = append(kept, it)
+			}
+		}
+		return kept, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uc.GetCart(ctx, owner, nil)
+}
+
+// SelectItems selects or unselects SKUs of the cart of owner, all of them
+// when skuIDs is empty.
+func (uc *CartUsecase) SelectItems(ctx context.Context, owner CartOwner, skuIDs []int64, selected bool) (*Cart, error) {
+	if !owner.valid() {
+		return nil, ErrInvalidCartItem
+	}
+	pick := make(map[int64]bool, len(skuIDs))
+	for _, id := range skuIDs {
+		pick[id] = true
+	}
+	err := uc.repo.Update(ctx, owner, func(items []*CartItem) ([]*CartItem, error) {
+		for _, it := range items {
+			if len(pick) == 0 || pick[it.SkuID] {
+				it.Selected = selected
+			}
+		}
+		return items, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uc.GetCart(ctx, owner, nil)
+}
+
+// MergeCart moves the cart of a guest into the cart of the user the guest
+// logged in as. Quantities of a SKU in both add up to maxCartQuantity, and
+// the latest added are kept when the carts hold more than maxCartItems.
+func (uc *CartUsecase) MergeCart(ctx context.Context, userID int64, memberLevel int32, guestID string) (*Cart, error) {
+	guest, user := CartOwner{GuestID: guestID}, CartOwner{UserID: userID}
+	if userID <= 0 || !guest.valid() {
+		return nil, ErrInvalidCartItem
+	}
+	err := uc.repo.Merge(ctx, guest, user, func(from, to []*CartItem) []*CartItem {
+		for _, g := range from {
+			it := findCartItem(to, g.SkuID)
+			if it == nil {
+				to = append(to, g)
+				continue
+			}
+			it.Quantity = min(it.Quantity+g.Quantity, maxCartQuantity)
+			it.Selected = it.Selected || g.Selected
+		}
+		if len(to) > maxCartItems {
+			sortCartItems(to)
+			to = to[:maxCartItems]
+		}
+		return to
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("MergeCart: guest %s into user %d", guestID, userID)
+	return uc.GetCart(ctx, CartOwner{UserID: userID, MemberLevel: memberLevel}, nil)
+}
+
+// price prices items against the shop and the promotions.
+func (uc *CartUsecase) price(ctx context.Context, owner CartOwner, items []*CartItem, couponCodes []string) (*Cart, error) {
+	c := &Cart{Owner: owner}
+	if len(items) == 0 {
+		return c, nil
+	}
+	sortCartItems(items)
+	ids := make([]int64, 0, len(items))
+	for _, it := range items {
+		ids = append(ids, it.SkuID)
+	}
+	skus, err := uc.skus.GetSkus(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, it := range items {
+		l := &CartLine{
+			SkuID:      it.SkuID,
+			AddedPrice: it.AddedPrice,
+			Price:      it.AddedPrice,
+			Quantity:   it.Quantity,
+			Selected:   it.Selected,
+			Status:     CartLineDelisted,
+		}
+		if sku, ok := skus[it.SkuID]; ok {
+			l.SpuID, l.MerchantID, l.Title, l.Image = sku.SpuID, sku.MerchantID, sku.Title, sku.Image
+			l.Price, l.Stock = sku.Price, sku.Stock
+			switch {
+			case !sku.OnSale:
+				l.Status = CartLineDelisted
+			case sku.Stock <= 0:
+				l.Status = CartLineOutOfStock
+			case sku.Stock < int64(it.Quantity):
+				l.Status = CartLineInsufficientStock
+			default:
+				l.Status = CartLineAvailable
+			}
+		}
+		l.Amount = l.Price * int64(l.Quantity)
+		if l.Selected && l.Status == CartLineAvailable {
+			c.SelectedQuantity += l.Quantity
+			c.SelectedAmount += l.Amount
+		}
+		c.Lines = append(c.Lines, l)
+	}
+	return c, uc.promote(ctx, c, couponCodes)
+}
+
+// promote prices the selected lines available of c as they would be ordered.
+func (uc *CartUsecase) promote(ctx context.Context, c *Cart, couponCodes []string) error {
+	req := &PriceRequest{UserID: c.Owner.UserID, MemberLevel: c.Owner.MemberLevel, CouponCodes: couponCodes}
+	var lines []*CartLine
+	for _, l := range c.Lines {
+		if l.Selected && l.Status == CartLineAvailable {
+			lines = append(lines, l)
+			req.Items = append(req.Items, &PriceItem{
+				SkuID:      l.SkuID,
+				SpuID:      l.SpuID,
+				MerchantID: l.MerchantID,
+				Quantity:   l.Quantity,
+				Price:      l.Price,
+			})
+		}
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	priced, err := uc.pricing.Price(ctx, req)
+	if err != nil {
+		return err
+	}
+	for i, pl := range priced.Lines {
+		lines[i].DiscountAmount, lines[i].PayAmount, lines[i].Discounts = pl.DiscountAmount, pl.PayAmount, pl.Discounts
+	}
+	c.ShippingFee, c.DiscountAmount, c.PayAmount = priced.ShippingFee, priced.DiscountAmount, priced.PayAmount
+	c.CouponCodes = priced.CouponCodes
+	for _, s := range priced.Shipping {
+		c.ShippingDiscounts = append(c.ShippingDiscounts, s.Discounts...)
+	}
+	return nil
+}
+
+func findCartItem(items []*CartItem, skuID int64) *CartItem {
+	for _, it := range items {
+		if it.SkuID == skuID {
+			return it
+		}
+	}
+	return nil
+}
+
+// sortCartItems sorts items latest added first, by SKU for those added at
+// the same time.
+func sortCartItems(items []*CartItem) {
+	sort.Slice(items, func(i, j int) bool {
+		if !items[i].AddedAt.Equal(items[j].AddedAt) {
+			return items[i].AddedAt.After(items[j].AddedAt)
+		}
+		return items[i].SkuID > items[j].SkuID
+	})
+}
@@ -0,0 +1,262 @@
+package biz_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/data"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type fakeSkus map[int64]*biz.Sku
+
+func (f fakeSkus) GetSkus(_ context.Context, ids []int64) (map[int64]*biz.Sku, error) {
+	rv := make(map[int64]*biz.Sku, len(ids))
+	for _, id := range ids {
+		if s, ok := f[id]; ok {
+			cp := *s
+			rv[id] = &cp
+		}
+	}
+	return rv, nil
+}
+
+type fakePromotions struct{ biz.PromotionRepo }
+
+func (fakePromotions) ListActive(context.Context, time.Time) ([]*biz.Promotion, error) {
+	return nil, nil
+}
+
+type fakeMemberPrices struct{ biz.MemberPriceRepo }
+
+type fakeCoupons struct{ biz.CouponRepo }
+
+type fakeFreight struct{}
+
+func (fakeFreight) Quote(_ context.Context, _ string, items []*biz.PriceItem) (map[int64]int64, error) {
+	fees := make(map[int64]int64)
+	for _, it := range items {
+		fees[it.MerchantID] = 500
+	}
+	return fees, nil
+}
+
+func newCartUsecase(skus fakeSkus) *biz.CartUsecase {
+	pricing := biz.NewPromotionUsecase(fakePromotions{}, fakeMemberPrices{}, fakeCoupons{}, fakeFreight{}, log.DefaultLogger)
+	return biz.NewCartUsecase(data.NewMemoryCartRepo(), skus, pricing, log.DefaultLogger)
+}
+
+func testSkus() fakeSkus {
+	return fakeSkus{
+		1: {ID: 1, SpuID: 10, MerchantID: 100, Title: "tea", Price: 1000, Stock: 50, OnSale: true},
+		2: {ID: 2, SpuID: 20, MerchantID: 100, Title: "cup", Price: 250, Stock: 10, OnSale: true},
+		3: {ID: 3, SpuID: 30, MerchantID: 200, Title: "pot", Price: 9900, Stock: 5, OnSale: true},
+		4: {ID: 4, SpuID: 40, MerchantID: 200, Title: "tray", Price: 3000, Stock: 0, OnSale: true},
+		5: {ID: 5, SpuID: 50, MerchantID: 200, Title: "old", Price: 100, Stock: 9, OnSale: false},
+	}
+}
+
+func cartLine(t *testing.T, c *biz.Cart, skuID int64) *biz.CartLine {
+	t.Helper()
+	for _, l := range c.Lines {
+		if l.SkuID == skuID {
+			return l
+		}
+	}
+	t.Fatalf("cart has no line of sku %d", skuID)
+	return nil
+}
+
+func TestCartItems(t *testing.T) {
+	ctx := context.Background()
+	uc := newCartUsecase(testSkus())
+	owner := biz.CartOwner{UserID: 7}
+
+	if _, err := uc.AddItem(ctx, owner, 1, 2); err != nil {
+		t.Fatal(err)
+	}
+	c, err := uc.AddItem(ctx, owner, 1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Lines) != 1 || cartLine(t, c, 1).Quantity != 5 {
+		t.Fatalf("adding a sku twice: %+v", c.Lines)
+	}
+	if c, err = uc.AddItem(ctx, owner, 2, 1); err != nil {
+		t.Fatal(err)
+	}
+	if c.Lines[0].SkuID != 2 {
+		t.Errorf("latest added line is sku %d, want 2", c.Lines[0].SkuID)
+	}
+	if c.SelectedQuantity != 6 || c.SelectedAmount != 5*1000+250 {
+		t.Errorf("selected %d for %d, want 6 for 5250", c.SelectedQuantity, c.SelectedAmount)
+	}
+	if c.ShippingFee != 500 || c.PayAmount != 5250+500 {
+		t.Errorf("shipping %d pay %d, want 500 and 5750", c.ShippingFee, c.PayAmount)
+	}
+
+	if c, err = uc.AddItem(ctx, owner, 1, 999); err != nil {
+		t.Fatal(err)
+	}
+	if q := cartLine(t, c, 1).Quantity; q != 999 {
+		t.Errorf("quantity %d above the cap, want 999", q)
+	}
+
+	if c, err = uc.UpdateItem(ctx, owner, 2, 4); err != nil {
+		t.Fatal(err)
+	}
+	if q := cartLine(t, c, 2).Quantity; q != 4 {
+		t.Errorf("updated quantity %d, want 4", q)
+	}
+	if _, err = uc.UpdateItem(ctx, owner, 3, 1); !errors.Is(err, biz.ErrCartItemNotFound) {
+		t.Errorf("updating a sku not held: %v", err)
+	}
+	if _, err = uc.UpdateItem(ctx, owner, 2, 0); !errors.Is(err, biz.ErrInvalidCartItem) {
+		t.Errorf("updating to 0: %v", err)
+	}
+
+	if c, err = uc.SelectItems(ctx, owner, nil, false); err != nil {
+		t.Fatal(err)
+	}
+	if c.SelectedQuantity != 0 || c.PayAmount != 0 {
+		t.Errorf("nothing selected, got %d for %d", c.SelectedQuantity, c.PayAmount)
+	}
+	if c, err = uc.SelectItems(ctx, owner, []int64{2}, true); err != nil {
+		t.Fatal(err)
+	}
+	if cartLine(t, c, 1).Selected || !cartLine(t, c, 2).Selected || c.SelectedAmount != 4*250 {
+		t.Errorf("selecting sku 2 only: %+v, amount %d", c.Lines, c.SelectedAmount)
+	}
+
+	if c, err = uc.RemoveItems(ctx, owner, []int64{1, 42}); err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Lines) != 1 || c.Lines[0].SkuID != 2 {
+		t.Errorf("removing sku 1: %+v", c.Lines)
+	}
+}
+
+func TestCartAddUnavailable(t *testing.T) {
+	ctx := context.Background()
+	uc := newCartUsecase(testSkus())
+	owner := biz.CartOwner{GuestID: "device-1"}
+	for _, skuID := range []int64{4, 5, 42} {
+		if _, err := uc.AddItem(ctx, owner, skuID, 1); !errors.Is(err, biz.ErrSkuUnavailable) {
+			t.Errorf("adding sku %d: %v", skuID, err)
+		}
+	}
+	for _, q := range []int32{0, -1, 1000} {
+		if _, err := uc.AddItem(ctx, owner, 1, q); !errors.Is(err, biz.ErrInvalidCartItem) {
+			t.Errorf("adding %d: %v", q, err)
+		}
+	}
+	if _, err := uc.AddItem(ctx, biz.CartOwner{}, 1, 1); !errors.Is(err, biz.ErrInvalidCartItem) {
+		t.Errorf("adding without owner: %v", err)
+	}
+}
+
+func TestCartMerge(t *testing.T) {
+	ctx := context.Background()
+	uc := newCartUsecase(testSkus())
+	guest, user := biz.CartOwner{GuestID: "device-1"}, biz.CartOwner{UserID: 7}
+
+	for _, add := range []struct {
+		owner    biz.CartOwner
+		skuID    int64
+		quantity int32
+	}{
+		{user, 1, 1},
+		{guest, 1, 2},
+		{guest, 3, 1},
+	} {
+		if _, err := uc.AddItem(ctx, add.owner, add.skuID, add.quantity); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := uc.SelectItems(ctx, user, []int64{1}, false); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := uc.MergeCart(ctx, 7, 0, "device-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Lines) != 2 {
+		t.Fatalf("merged cart: %+v", c.Lines)
+	}
+	if l := cartLine(t, c, 1); l.Quantity != 3 || !l.Selected {
+		t.Errorf("sku in both carts: quantity %d selected %t, want 3 and selected", l.Quantity, l.Selected)
+	}
+	if l := cartLine(t, c, 3); l.Quantity != 1 {
+		t.Errorf("sku of the guest: quantity %d, want 1", l.Quantity)
+	}
+	g, err := uc.GetCart(ctx, guest, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g.Lines) != 0 {
+		t.Errorf("guest cart left with %d lines", len(g.Lines))
+	}
+
+	// Merging again, as a retried login would, changes nothing.
+	if c, err = uc.MergeCart(ctx, 7, 0, "device-1"); err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Lines) != 2 || cartLine(t, c, 1).Quantity != 3 {
+		t.Errorf("merging twice: %+v", c.Lines)
+	}
+}
+
+func TestCartFlagsChangedSkus(t *testing.T) {
+	ctx := context.Background()
+	skus := testSkus()
+	skus[4].Stock, skus[5].OnSale = 3, true
+	skus[6] = &biz.Sku{ID: 6, SpuID: 60, MerchantID: 300, Title: "gone", Price: 700, Stock: 3, OnSale: true}
+	uc := newCartUsecase(skus)
+	owner := biz.CartOwner{UserID: 7}
+	for _, skuID := range []int64{1, 2, 3, 4, 5, 6} {
+		if _, err := uc.AddItem(ctx, owner, skuID, 2); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	skus[1].Price = 1200
+	skus[2].Stock = 0
+	skus[3].Stock = 1
+	skus[5].OnSale = false
+	delete(skus, 6)
+	c, err := uc.GetCart(ctx, owner, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[int64]biz.CartLineStatus{
+		1: biz.CartLineAvailable,
+		2: biz.CartLineOutOfStock,
+		3: biz.CartLineInsufficientStock,
+		4: biz.CartLineAvailable,
+		5: biz.CartLineDelisted,
+		6: biz.CartLineDelisted,
+	}
+	for skuID, status := range want {
+		if l := cartLine(t, c, skuID); l.Status != status {
+			t.Errorf("sku %d is %s, want %s", skuID, l.Status, status)
+		}
+	}
+	if l := cartLine(t, c, 1); l.Price != 1200 || l.AddedPrice != 1000 || l.Amount != 2400 {
+		t.Errorf("repriced sku: price %d added at %d amount %d", l.Price, l.AddedPrice, l.Amount)
+	}
+	if l := cartLine(t, c, 6); l.Price != 700 {
+		t.Errorf("sku gone from the shop keeps price %d, want the price it was added at", l.Price)
+	}
+	// Only the lines available count, all of them selected.
+	if c.SelectedQuantity != 4 || c.SelectedAmount != 2400+6000 {
+		t.Errorf("selected %d for %d, want 4 for 8400", c.SelectedQuantity, c.SelectedAmount)
+	}
+	if c.PayAmount != 8400+2*500 {
+		t.Errorf("pay %d, want 9400", c.PayAmount)
+	}
+}
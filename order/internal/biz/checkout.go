@@ -0,0 +1,210 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-kratos/kratos-layout/pkg/saga"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// SagaCheckout is the name of the checkout saga, its sagas are identified by
+// parent order numbers.
+const SagaCheckout = "checkout"
+
+// Payment is how a checkout is paid, through a combined payment of the
+// payment service holding points and balance and charging the rest.
+type Payment struct {
+	// Points is the most points to spend.
+	Points int64
+	// Balance is the most balance to spend, in cents.
+	Balance int64
+	// Channel is the channel to charge the remainder through.
+	Channel string
+}
+
+// StockRepo reserves the stock of the items of parent orders in the shop,
+// the reservations numbered by parent order numbers. Each call is
+// idempotent.
+type StockRepo interface {
+	// Reserve reserves the stock of items, or returns ErrSkuUnavailable when
+	// a SKU is short and reserves nothing.
+	Reserve(ctx context.Context, reservationNo string, items []*OrderItem) error
+	// Confirm confirms reserved stock is sold.
+	Confirm(ctx context.Context, reservationNo string) error
+	// Release gives back reserved stock, also when it was not reserved yet.
+	Release(ctx context.Context, reservationNo string) error
+}
+
+// checkout is the payload of a checkout saga: the parent order priced by
+// CreateOrder and how it is paid, so that a saga resumed after a crash
+// places the very same orders.
+type checkout struct {
+	Parent  *ParentOrder
+	Payment *Payment
+}
+
+func parseCheckout(s *saga.Saga) (*checkout, error) {
+	var c checkout
+	if err := json.Unmarshal(s.Payload, &c); err != nil {
+		return nil, saga.Abort(err)
+	}
+	return &c, nil
+}
+
+// checkoutSaga returns the checkout saga. It places the orders and uses the
+// coupons, reserves the stock of their items, then opens their payment
+// when asked to; a step failing cancels the orders, releasing the coupons
+// and the stock, and cancels the payment.
+func (uc *OrderUsecase) checkoutSaga() *saga.Definition {
+	return &saga.Definition{
+		Name: SagaCheckout,
+		Steps: []saga.Step{
+			{Name: "create_orders", Action: uc.placeOrders, Compensate: uc.unplaceOrders},
+			{Name: "reserve_stock", Action: uc.reserveStock, Compensate: uc.releaseStock},
+			{Name: "create_payment", Action: uc.openPayment, Compensate: uc.cancelPayment},
+		},
+	}
+}
+
+// placeOrders saves the parent order of a checkout and uses its coupons,
+// unless it was saved before.
+func (uc *OrderUsecase) placeOrders(ctx context.Context, s *saga.Saga) error {
+	c, err := parseCheckout(s)
+	if err != nil {
+		return err
+	}
+	p := c.Parent
+	_, err = uc.repo.FindParent(ctx, p.ParentNo)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, ErrParentOrderNotFound) {
+		return err
+	}
+	err = uc.states.tx.InTx(ctx, func(ctx context.Context) error {
+		if p, err = uc.repo.SaveParent(ctx, p); err != nil {
+			return err
+		}
+		return uc.pricing.UseCoupons(ctx, p.UserID, p.CouponCodes, p.ParentNo)
+	})
+	if err != nil {
+		return permanent(err)
+	}
+	// The orders stand without it, pending orders are scheduled again on start.
+	if err := uc.queue.Schedule(ctx, TopicPayTimeout, p.ParentNo, p.ExpireAt); err != nil {
+		uc.log.WithContext(ctx).Errorf("Checkout %s: schedule expiry: %v", p.ParentNo, err)
+	}
+	return nil
+}
+
+// unplaceOrders cancels the orders of a checkout left pending.
+func (uc *OrderUsecase) unplaceOrders(ctx context.Context, s *saga.Saga) error {
+	p, err := uc.repo.FindParent(ctx, s.ID)
+	if errors.Is(err, ErrParentOrderNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return uc.cancelParent(ctx, p, "checkout failed", "checkout_failed")
+}
+
+// reserveStock reserves the stock of the items of all the orders of a
+// checkout at once.
+func (uc *OrderUsecase) reserveStock(ctx context.Context, s *saga.Saga) error {
+	c, err := parseCheckout(s)
+	if err != nil {
+		return err
+	}
+	var items []*OrderItem
+	for _, o := range c.Parent.Orders {
+		items = append(items, o.Items...)
+	}
+	return permanent(uc.stocks.Reserve(ctx, s.ID, items))
+}
+
+func (uc *OrderUsecase) releaseStock(ctx context.Context, s *saga.Saga) error {
+	return uc.stocks.Release(ctx, s.ID)
+}
+
+// openPayment opens the combined payment of a checkout paid through it and
+// records it on the parent order.
+func (uc *OrderUsecase) openPayment(ctx context.Context, s *saga.Saga) error {
+	c, err := parseCheckout(s)
+	if err != nil {
+		return err
+	}
+	if c.Payment == nil {
+		return nil
+	}
+	payNo, err := uc.payments.Pay(ctx, c.Parent, c.Payment)
+	if errors.Is(err, ErrOrderPaid) {
+		// Paid at once by points and balance on an attempt that timed out.
+		return nil
+	}
+	if err != nil {
+		return permanent(err)
+	}
+	s.Set("pay_no", payNo)
+	return uc.repo.SetPayNo(ctx, s.ID, payNo)
+}
+
+// cancelPayment cancels the combined payment of a checkout, releasing what
+// it held. One opened by an attempt that timed out is unknown here, its
+// payment once made is refunded as the orders are cancelled.
+func (uc *OrderUsecase) cancelPayment(ctx context.Context, s *saga.Saga) error {
+	payNo := s.Get("pay_no")
+	if payNo == "" {
+		return nil
+	}
+	return uc.payments.CancelPay(ctx, payNo)
+}
+
+// releaseCheckout gives back the stock and cancels the payment of a parent
+// order once all its orders are cancelled unpaid.
+func (uc *OrderUsecase) releaseCheckout(ctx context.Context, o *Order) {
+	p, err := uc.repo.FindParent(ctx, o.ParentNo)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("Order %s: release checkout: %v", o.OrderNo, err)
+		return
+	}
+	if !p.cancelled() || !p.unpaid() {
+		return
+	}
+	if err := uc.stocks.Release(ctx, p.ParentNo); err != nil {
+		uc.log.WithContext(ctx).Errorf("Order %s: release stock: %v", p.ParentNo, err)
+	}
+	if p.PayNo == "" {
+		return
+	}
+	if err := uc.payments.CancelPay(ctx, p.PayNo); err != nil {
+		uc.log.WithContext(ctx).Errorf("Order %s: cancel payment %s: %v", p.ParentNo, p.PayNo, err)
+	}
+}
+
+// confirmStock confirms the stock of a parent order paid is sold. A
+// reservation the shop refuses to confirm, such as one released as the
+// orders expired, is left to the inventory reconciliation.
+func (uc *OrderUsecase) confirmStock(ctx context.Context, p *ParentOrder) error {
+	err := uc.stocks.Confirm(ctx, p.ParentNo)
+	if saga.IsAborted(permanent(err)) {
+		uc.log.WithContext(ctx).Errorf("Order %s: confirm stock: %v", p.ParentNo, err)
+		return nil
+	}
+	return err
+}
+
+// permanent marks err final for a saga step when retrying it cannot help,
+// that is for a client error other than a timeout or a throttling.
+func permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	code := errors.FromError(err).Code
+	if code >= 400 && code < 500 && code != 408 && code != 429 {
+		return saga.Abort(err)
+	}
+	return err
+}
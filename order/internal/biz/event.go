@@ -0,0 +1,204 @@
+package biz
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+
+	paymentv1 "github.com/go-kratos/kratos-layout/payment/api/payment/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// The types of the payment events orders follow.
+const (
+	EventPaymentSucceeded = "payment.succeeded"
+	EventRefundCompleted  = "refund.completed"
+)
+
+// errRefundAdded stops a transition for a refund recorded before.
+var errRefundAdded = stderrors.New("refund already recorded")
+
+// PaymentEvent is an event published by the payment service.
+type PaymentEvent struct {
+	// ID is the id of the event in the payment outbox.
+	ID      string
+	Type    string
+	Key     string
+	Payload []byte
+}
+
+// EventSubscriber delivers the payment events, at least once each.
+type EventSubscriber interface {
+	// Subscribe calls handle for each event until ctx is done. An event
+	// handle fails for is delivered again later.
+	Subscribe(ctx context.Context, handle func(context.Context, *PaymentEvent) error) error
+}
+
+// HandlePaymentEvent moves orders on as their payments are paid and
+// refunded. Events may come twice and handling them again changes nothing.
+func (uc *OrderUsecase) HandlePaymentEvent(ctx context.Context, e *PaymentEvent) error {
+	unmarshal := protojson.UnmarshalOptions{DiscardUnknown: true}
+	switch e.Type {
+	case EventPaymentSucceeded:
+		var m paymentv1.PaymentSucceeded
+		if err := unmarshal.Unmarshal(e.Payload, &m); err != nil {
+			uc.log.WithContext(ctx).Errorf("HandlePaymentEvent: skipping %s %s: %v", e.Type, e.ID, err)
+			return nil
+		}
+		if m.Purpose != paymentv1.Purpose_PURPOSE_ORDER {
+			return nil
+		}
+		return uc.paid(ctx, &m)
+	case EventRefundCompleted:
+		var m paymentv1.RefundCompleted
+		if err := unmarshal.Unmarshal(e.Payload, &m); err != nil {
+			uc.log.WithContext(ctx).Errorf("HandlePaymentEvent: skipping %s %s: %v", e.Type, e.ID, err)
+			return nil
+		}
+		return uc.refunded(ctx, &m)
+	}
+	return nil
+}
+
+// paid moves the orders of the parent order of a payment to paid. A
+// payment arriving for orders cancelled meanwhile is refunded order by
+// order; one for a parent order paid already by another payment, or for
+// another amount, is refunded whole.
+func (uc *OrderUsecase) paid(ctx context.Context, m *paymentv1.PaymentSucceeded) error {
+	p, err := uc.findParentForEvent(ctx, m.BizNo)
+	if p == nil {
+		return err
+	}
+	if p.paidBy(m.TradeNo) {
+		for _, o := range p.Orders {
+			if o.TradeNo == m.TradeNo && o.Status == StatusRefunding {
+				// The refund may not have been asked for before a failure.
+				if err := uc.refundOrder(ctx, o, "order cancelled"); err != nil {
+					return err
+				}
+			}
+		}
+		if p.paidAfterCancel() {
+			return nil
+		}
+		// The listeners may have failed before.
+		return uc.notifyPaid(ctx, p)
+	}
+	switch {
+	case m.Amount != p.PayAmount:
+		return uc.refund(ctx, p.ParentNo, m.TradeNo, paymentRefundNo(m.TradeNo), m.Amount, "payment amount mismatch")
+	case len(p.pending()) == len(p.Orders):
+		if err := uc.payOrders(ctx, p, m, StatusPaid, "payment_succeeded"); err != nil {
+			return err
+		}
+		return uc.notifyPaid(ctx, p)
+	case p.cancelled() && p.unpaid():
+		if err := uc.payOrders(ctx, p, m, StatusRefunding, "paid_after_cancel"); err != nil {
+			return err
+		}
+		for _, o := range p.Orders {
+			if err := uc.refundOrder(ctx, o, "order cancelled before payment"); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return uc.refund(ctx, p.ParentNo, m.TradeNo, paymentRefundNo(m.TradeNo), m.Amount, "duplicate payment")
+	}
+}
+
+// payOrders records m as the payment of every order of p, moving them to
+// status to in a transaction.
+func (uc *OrderUsecase) payOrders(ctx context.Context, p *ParentOrder, m *paymentv1.PaymentSucceeded, to Status, event string) error {
+	return uc.states.tx.InTx(ctx, func(ctx context.Context) error {
+		for _, o := range p.Orders {
+			o.TradeNo, o.PaidAt = m.TradeNo, m.PaidAt.AsTime()
+			if err := uc.states.Transit(ctx, o, to, event, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// refunded adds a refund of the payment of an order to it, the order being
+// the one the refund number is of, and tells the OnRefunded listeners. A
+// refunding order refunded in full is cancelled, refunded in part it falls
+// back to where it was; an order refunded in another status, such as for an
+// after-sale ticket, stays where it is. Refunds of no order of the parent
+// order, or of payments that did not pay it, are ignored.
+func (uc *OrderUsecase) refunded(ctx context.Context, m *paymentv1.RefundCompleted) error {
+	orderNo := refundedOrderNo(m.RefundNo)
+	if orderNo == "" {
+		return nil
+	}
+	o, err := uc.findForEvent(ctx, orderNo)
+	if o == nil {
+		return err
+	}
+	if o.ParentNo != m.BizNo || o.TradeNo != m.TradeNo {
+		return nil
+	}
+	r := &OrderRefund{
+		OrderNo:  o.OrderNo,
+		RefundNo: m.RefundNo,
+		TradeNo:  m.TradeNo,
+		Amount:   m.Amount,
+	}
+	add := func(ctx context.Context) error {
+		added, err := uc.repo.AddRefund(ctx, r)
+		if err != nil {
+			return err
+		}
+		if !added {
+			return errRefundAdded
+		}
+		return nil
+	}
+	o.RefundedAmount += m.Amount
+	if o.Status != StatusRefunding {
+		err = uc.states.tx.InTx(ctx, func(ctx context.Context) error {
+			if err := add(ctx); err != nil {
+				return err
+			}
+			return uc.repo.Update(ctx, o)
+		})
+	} else {
+		to := o.resumed()
+		if o.RefundedAmount >= o.PayAmount {
+			to = StatusCancelled
+			o.CancelledAt = time.Now()
+		}
+		if err = uc.states.Transit(ctx, o, to, "refund_completed", add); err == nil && to == StatusCancelled {
+			uc.notifyCancelled(ctx, o)
+		}
+	}
+	if err != nil && !stderrors.Is(err, errRefundAdded) {
+		return err
+	}
+	return uc.notifyRefunded(ctx, o, r)
+}
+
+// findForEvent returns the order of an event, or nil without an error for
+// an order this service does not know.
+func (uc *OrderUsecase) findForEvent(ctx context.Context, orderNo string) (*Order, error) {
+	o, err := uc.repo.FindByOrderNo(ctx, orderNo)
+	if errors.Is(err, ErrOrderNotFound) {
+		uc.log.WithContext(ctx).Warnf("HandlePaymentEvent: unknown order %s", orderNo)
+		return nil, nil
+	}
+	return o, err
+}
+
+// findParentForEvent returns the parent order of an event, or nil without
+// an error for one this service does not know.
+func (uc *OrderUsecase) findParentForEvent(ctx context.Context, parentNo string) (*ParentOrder, error) {
+	p, err := uc.repo.FindParent(ctx, parentNo)
+	if errors.Is(err, ErrParentOrderNotFound) {
+		uc.log.WithContext(ctx).Warnf("HandlePaymentEvent: unknown parent order %s", parentNo)
+		return nil, nil
+	}
+	return p, err
+}
@@ -0,0 +1,392 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	v1 "github.com/go-kratos/kratos-layout/order/api/order/v1"
+	"github.com/go-kratos/kratos-layout/pkg/saga"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrFlashSaleNotFound is flash sale not found, also for one cancelled.
+	ErrFlashSaleNotFound = errors.NotFound(v1.ErrorReason_FLASH_SALE_NOT_FOUND.String(), "flash sale not found")
+	// ErrFlashSaleNotStarted is returned when joining a flash sale before
+	// it starts, or asking for a challenge before they are handed out.
+	ErrFlashSaleNotStarted = errors.Forbidden(v1.ErrorReason_FLASH_SALE_NOT_STARTED.String(), "flash sale not started")
+	// ErrFlashSaleEnded is returned when joining a flash sale ended, or
+	// asking for a challenge once it started.
+	ErrFlashSaleEnded = errors.Forbidden(v1.ErrorReason_FLASH_SALE_ENDED.String(), "flash sale ended")
+	// ErrFlashSaleSoldOut is returned when a flash sale has less stock left
+	// than asked for.
+	ErrFlashSaleSoldOut = errors.Conflict(v1.ErrorReason_FLASH_SALE_SOLD_OUT.String(), "flash sale sold out")
+	// ErrFlashSaleLimitExceeded is returned when a buyer asks for more than
+	// the flash sale leaves them.
+	ErrFlashSaleLimitExceeded = errors.Forbidden(v1.ErrorReason_FLASH_SALE_LIMIT_EXCEEDED.String(), "flash sale limit exceeded")
+	// ErrInvalidChallenge is returned for a challenge token not handed out to
+	// the buyer for the flash sale, or a solution that does not solve it.
+	ErrInvalidChallenge = errors.Forbidden(v1.ErrorReason_INVALID_CHALLENGE.String(), "invalid challenge")
+	// ErrFlashSaleAttemptNotFound is flash sale attempt not found.
+	ErrFlashSaleAttemptNotFound = errors.NotFound(v1.ErrorReason_FLASH_SALE_ATTEMPT_NOT_FOUND.String(), "flash sale attempt not found")
+	// ErrInvalidFlashSaleAttempt is returned for an attempt without buyer,
+	// quantity or address.
+	ErrInvalidFlashSaleAttempt = errors.BadRequest(v1.ErrorReason_INVALID_FLASH_SALE_ATTEMPT.String(), "invalid flash sale attempt")
+)
+
+const (
+	// defaultFlashSaleWarmAhead is how long before a flash sale starts it
+	// is warmed when FlashSalePolicy leaves it unset.
+	defaultFlashSaleWarmAhead = 10 * time.Minute
+	// defaultFlashSaleRetention is how long attempts are kept after their
+	// flash sale ends when FlashSalePolicy leaves it unset.
+	defaultFlashSaleRetention = 24 * time.Hour
+	// maxChallengeDifficulty bounds the leading zero bits of a solution.
+	maxChallengeDifficulty = 32
+)
+
+// FlashSale is a flash sale of the shop, selling up to Stock of a SKU at
+// Price between StartAt and EndAt, at most PerUserLimit to a buyer.
+type FlashSale struct {
+	ID           int64
+	MerchantID   int64
+	SkuID        int64
+	Price        int64
+	Stock        int64
+	PerUserLimit int32
+	StartAt      time.Time
+	EndAt        time.Time
+	Cancelled    bool
+}
+
+// FlashSaleAttemptStatus is the status of a flash sale attempt.
+type FlashSaleAttemptStatus string
+
+const (
+	// AttemptQueued is admitted, its order to be placed.
+	AttemptQueued FlashSaleAttemptStatus = "queued"
+	// AttemptSucceeded has its order placed.
+	AttemptSucceeded FlashSaleAttemptStatus = "succeeded"
+	// AttemptFailed could not place its order, its quantity given back to
+	// the flash sale.
+	AttemptFailed FlashSaleAttemptStatus = "failed"
+)
+
+// FlashSaleAttempt is a buyer buying in a flash sale, admitted and then
+// placed as an order in the background.
+type FlashSaleAttempt struct {
+	AttemptNo   string
+	FlashSaleID int64
+	UserID      int64
+	Quantity    int32
+	Address     *Address
+	Remark      string
+	Status      FlashSaleAttemptStatus
+	// ParentNo is the parent order placed.
+	ParentNo string
+	// FailureReason is the error reason an attempt failed with.
+	FailureReason string
+	CreatedAt     time.Time
+}
+
+// FlashSaleRepo reads the flash sales of the shop.
+type FlashSaleRepo interface {
+	// GetFlashSale returns a flash sale, or ErrFlashSaleNotFound.
+	GetFlashSale(ctx context.Context, id int64) (*FlashSale, error)
+	// ListStarting lists the flash sales not cancelled nor ended starting
+	// before a time.
+	ListStarting(ctx context.Context, before time.Time) ([]*FlashSale, error)
+}
+
+// FlashSaleStore keeps the flash sales about to start or running, their
+// stock, challenges and attempts, in a cache every instance shares. The
+// attempts admitted are queued to be placed, each taken by one instance at
+// a time on a lease it renews. What it keeps of a flash sale expires
+// retention after it ends.
+type FlashSaleStore interface {
+	// Warm loads a flash sale, and its stock unless loaded already.
+	Warm(ctx context.Context, f *FlashSale, retention time.Duration) error
+	// Challenge saves token as the challenge of a buyer for a warmed flash
+	// sale unless one is saved, and returns the one saved.
+	Challenge(ctx context.Context, flashSaleID, userID int64, token string) (string, error)
+	// Admit numbers an attempt uniquely, the number starting with the time
+	// it is admitted, and admits and queues it with the challenge token of
+	// its buyer at now, all at once: it takes its quantity off the stock of
+	// the flash sale and the limit of the buyer. A token admitted already
+	// returns the attempt it admitted. It returns ErrFlashSaleNotFound for
+	// a flash sale not warmed, ErrFlashSaleNotStarted, ErrFlashSaleEnded,
+	// ErrInvalidChallenge, ErrFlashSaleLimitExceeded or ErrFlashSaleSoldOut.
+	Admit(ctx context.Context, a *FlashSaleAttempt, token string, now time.Time) (*FlashSaleAttempt, error)
+	// Next takes the next attempt queued on a lease, waiting for one up to
+	// a second and returning nil after.
+	Next(ctx context.Context) (*FlashSaleAttempt, error)
+	// Renew renews the lease of an attempt taken.
+	Renew(ctx context.Context, attemptNo string) error
+	// Settle records the outcome of an attempt taken, and takes it off the
+	// queue. A failed attempt gives its quantity back to the flash sale and
+	// to the limit of its buyer, and frees the challenge token it used for
+	// the buyer to join again with. An attempt settled already is left as
+	// is.
+	Settle(ctx context.Context, a *FlashSaleAttempt) error
+	// Return gives back the quantity of a succeeded attempt whose order was
+	// cancelled, once.
+	Return(ctx context.Context, attemptNo string) error
+	// Requeue queues again the attempts taken and not settled whose lease
+	// ended, such as those of an instance that stopped, and returns how
+	// many. Those of the instances placing them are left to them.
+	Requeue(ctx context.Context) (int, error)
+	// FindAttempt returns an attempt, or ErrFlashSaleAttemptNotFound.
+	FindAttempt(ctx context.Context, attemptNo string) (*FlashSaleAttempt, error)
+}
+
+// FlashSalePolicy is how flash sales are run.
+type FlashSalePolicy struct {
+	// WarmAhead is how long before a flash sale starts it is warmed and
+	// challenges are handed out.
+	WarmAhead time.Duration
+	// Difficulty is the leading zero bits a solution takes.
+	Difficulty int32
+	// Retention is how long attempts are kept after their flash sale ends.
+	Retention time.Duration
+}
+
+// FlashSaleUsecase is a flash sale usecase. Buyers get a challenge ahead of
+// a flash sale and join it with its solution once it starts; joining takes
+// their quantity off the stock of the sale in the cache at once, so that a
+// rush turns most buyers down there, and queues an attempt that is placed
+// as an order in the background, at the flash sale price. The stock of the
+// SKU is still reserved as the order is placed, and an attempt failing
+// there gives its quantity back.
+type FlashSaleUsecase struct {
+	sales      FlashSaleRepo
+	store      FlashSaleStore
+	orders     *OrderUsecase
+	warmAhead  time.Duration
+	difficulty int
+	retention  time.Duration
+	log        *log.Helper
+}
+
+// NewFlashSaleUsecase new a flash sale usecase.
+func NewFlashSaleUsecase(sales FlashSaleRepo, store FlashSaleStore, orders *OrderUsecase, policy *FlashSalePolicy, logger log.Logger) *FlashSaleUsecase {
+	uc := &FlashSaleUsecase{
+		sales:      sales,
+		store:      store,
+		orders:     orders,
+		warmAhead:  policy.WarmAhead,
+		difficulty: int(min(max(policy.Difficulty, 0), maxChallengeDifficulty)),
+		retention:  policy.Retention,
+		log:        log.NewHelper(logger),
+	}
+	if uc.warmAhead <= 0 {
+		uc.warmAhead = defaultFlashSaleWarmAhead
+	}
+	if uc.retention <= 0 {
+		uc.retention = defaultFlashSaleRetention
+	}
+	orders.OnCancelled(uc.returnQuantity)
+	return uc
+}
+
+// FlashSaleChallenge is what a buyer solves to join a flash sale: a nonce
+// such that the SHA-256 of Token followed by it starts with Difficulty zero
+// bits. An attempt that fails frees the token, to join again with.
+type FlashSaleChallenge struct {
+	Token      string
+	Difficulty int
+	ExpireAt   time.Time
+}
+
+// GetChallenge returns the challenge of a buyer for a flash sale, handed
+// out from when the flash sale is warmed until it starts.
+func (uc *FlashSaleUsecase) GetChallenge(ctx context.Context, flashSaleID, userID int64) (*FlashSaleChallenge, error) {
+	if userID <= 0 {
+		return nil, ErrInvalidFlashSaleAttempt
+	}
+	f, err := uc.sales.GetFlashSale(ctx, flashSaleID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	switch {
+	case f.Cancelled:
+		return nil, ErrFlashSaleNotFound
+	case now.Before(f.StartAt.Add(-uc.warmAhead)):
+		return nil, ErrFlashSaleNotStarted
+	case !now.Before(f.StartAt):
+		return nil, ErrFlashSaleEnded
+	}
+	if err := uc.store.Warm(ctx, f, uc.retention); err != nil {
+		return nil, err
+	}
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	token, err := uc.store.Challenge(ctx, f.ID, userID, hex.EncodeToString(b))
+	if err != nil {
+		return nil, err
+	}
+	return &FlashSaleChallenge{Token: token, Difficulty: uc.difficulty, ExpireAt: f.EndAt}, nil
+}
+
+// JoinFlashSale admits an attempt with the challenge token of its buyer and
+// the nonce solving it, and queues it to be placed. Joining again with the
+// same token returns the attempt admitted.
+func (uc *FlashSaleUsecase) JoinFlashSale(ctx context.Context, a *FlashSaleAttempt, token, nonce string) (*FlashSaleAttempt, error) {
+	if a.FlashSaleID <= 0 || a.UserID <= 0 || a.Quantity <= 0 ||
+		a.Address == nil || a.Address.Name == "" || a.Address.Phone == "" || a.Address.Detail == "" {
+		return nil, ErrInvalidFlashSaleAttempt
+	}
+	if token == "" || !solved(token, nonce, uc.difficulty) {
+		return nil, ErrInvalidChallenge
+	}
+	now := time.Now()
+	a.Status, a.CreatedAt = AttemptQueued, now
+	a, err := uc.store.Admit(ctx, a, token, now)
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("JoinFlashSale: %s of user %d for %d of flash sale %d", a.AttemptNo, a.UserID, a.Quantity, a.FlashSaleID)
+	return a, nil
+}
+
+// GetAttempt returns a flash sale attempt.
+func (uc *FlashSaleUsecase) GetAttempt(ctx context.Context, attemptNo string) (*FlashSaleAttempt, error) {
+	return uc.store.FindAttempt(ctx, attemptNo)
+}
+
+// Place places the order of an attempt taken from the queue at the flash
+// sale price and settles it, renewing its lease first so that one retried
+// for long stays with its taker. An attempt the order service turns down
+// fails; any other error leaves it to be placed again, which finds the
+// order placed the first time, here or by another instance it went to.
+func (uc *FlashSaleUsecase) Place(ctx context.Context, a *FlashSaleAttempt) error {
+	if err := uc.store.Renew(ctx, a.AttemptNo); err != nil {
+		return err
+	}
+	p, err := uc.place(ctx, a)
+	switch {
+	case err == nil:
+		a.Status, a.ParentNo = AttemptSucceeded, p.ParentNo
+	case saga.IsAborted(permanent(err)):
+		a.Status, a.FailureReason = AttemptFailed, errors.FromError(err).Reason
+		uc.log.WithContext(ctx).Warnf("Flash sale attempt %s: %v", a.AttemptNo, err)
+	default:
+		return err
+	}
+	if err := uc.store.Settle(ctx, a); err != nil {
+		return err
+	}
+	uc.log.WithContext(ctx).Infof("Flash sale attempt %s: %s %s", a.AttemptNo, a.Status, a.ParentNo)
+	return nil
+}
+
+func (uc *FlashSaleUsecase) place(ctx context.Context, a *FlashSaleAttempt) (*ParentOrder, error) {
+	parentNo := flashSaleParentNo(a.AttemptNo)
+	f, err := uc.sales.GetFlashSale(ctx, a.FlashSaleID)
+	if err != nil {
+		return nil, err
+	}
+	if f.Cancelled {
+		return nil, ErrFlashSaleNotFound
+	}
+	o := &Order{
+		UserID:  a.UserID,
+		Items:   []*OrderItem{{SkuID: f.SkuID, Quantity: a.Quantity}},
+		Address: a.Address,
+		Remark:  a.Remark,
+	}
+	items, err := uc.orders.priceItems(ctx, o)
+	if err != nil {
+		return nil, err
+	}
+	priced, err := uc.orders.pricing.PriceFlashSale(ctx, items[0], f, o.Address)
+	if err != nil {
+		return nil, err
+	}
+	p, err := uc.orders.checkout(ctx, o, priced, parentNo, nil)
+	if errors.Is(err, saga.ErrExists) {
+		// Placed before, by an instance that stopped or on an attempt that
+		// failed to settle.
+		if p, err = uc.orders.repo.FindParent(ctx, parentNo); err == nil && p.cancelled() {
+			return nil, ErrOrderCancelled
+		}
+		if errors.Is(err, ErrParentOrderNotFound) {
+			// Its saga failed before the orders were saved.
+			return nil, ErrSkuUnavailable
+		}
+	}
+	return p, err
+}
+
+// Requeue queues again the attempts left taken by an instance that stopped
+// or stalled, once their lease ended.
+func (uc *FlashSaleUsecase) Requeue(ctx context.Context) (int, error) {
+	return uc.store.Requeue(ctx)
+}
+
+// Next takes the next attempt queued, or nil when none came for a second.
+func (uc *FlashSaleUsecase) Next(ctx context.Context) (*FlashSaleAttempt, error) {
+	return uc.store.Next(ctx)
+}
+
+// Warm warms the flash sales starting soon or running, and returns how
+// many it warmed.
+func (uc *FlashSaleUsecase) Warm(ctx context.Context) (int, error) {
+	fs, err := uc.sales.ListStarting(ctx, time.Now().Add(uc.warmAhead))
+	if err != nil {
+		return 0, err
+	}
+	for _, f := range fs {
+		if err := uc.store.Warm(ctx, f, uc.retention); err != nil {
+			return 0, err
+		}
+	}
+	return len(fs), nil
+}
+
+// returnQuantity gives back to its flash sale the quantity of an order
+// placed in it and cancelled.
+func (uc *FlashSaleUsecase) returnQuantity(ctx context.Context, o *Order) {
+	attemptNo := flashSaleAttemptNo(o.ParentNo)
+	if attemptNo == "" {
+		return
+	}
+	if err := uc.store.Return(ctx, attemptNo); err != nil {
+		uc.log.WithContext(ctx).Errorf("Order %s: return flash sale attempt %s: %v", o.OrderNo, attemptNo, err)
+	}
+}
+
+// flashSaleParentNo is the parent order number of the order of an attempt,
+// so that placing it again finds the order placed.
+func flashSaleParentNo(attemptNo string) string {
+	return "P" + attemptNo
+}
+
+// flashSaleAttemptNo returns the attempt of a parent order placed in a
+// flash sale, or "" for one that was not.
+func flashSaleAttemptNo(parentNo string) string {
+	if !strings.HasPrefix(parentNo, "PF") {
+		return ""
+	}
+	return parentNo[1:]
+}
+
+// solved reports whether the SHA-256 of token followed by nonce starts with
+// difficulty zero bits.
+func solved(token, nonce string, difficulty int) bool {
+	sum := sha256.Sum256([]byte(token + nonce))
+	for i := 0; i < difficulty; i++ {
+		if sum[i/8]&(0x80>>(i%8)) != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,488 @@
+package biz
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+
+	v1 "github.com/go-kratos/kratos-layout/order/api/order/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrInvoiceTitleNotFound is invoice title not found, or not of the user.
+	ErrInvoiceTitleNotFound = errors.NotFound(v1.ErrorReason_INVOICE_TITLE_NOT_FOUND.String(), "invoice title not found")
+	// ErrInvalidInvoiceTitle is returned for a title without name, a company
+	// title without a valid tax ID, or a user with too many titles.
+	ErrInvalidInvoiceTitle = errors.BadRequest(v1.ErrorReason_INVALID_INVOICE_TITLE.String(), "invalid invoice title")
+	// ErrInvoiceNotFound is invoice not found.
+	ErrInvoiceNotFound = errors.NotFound(v1.ErrorReason_INVOICE_NOT_FOUND.String(), "invoice not found")
+	// ErrInvalidInvoice is returned for an invoice of no orders or too many,
+	// of orders of another user, of several merchants, not completed, or
+	// refunded in full.
+	ErrInvalidInvoice = errors.BadRequest(v1.ErrorReason_INVALID_INVOICE.String(), "invalid invoice")
+	// ErrOrderInvoiced is returned when requesting an invoice for an order
+	// that has one.
+	ErrOrderInvoiced = errors.Conflict(v1.ErrorReason_ORDER_ALREADY_INVOICED.String(), "order already invoiced")
+	// ErrInvoiceConflict is returned by the Update of a repo when the
+	// invoice changed since it was read.
+	ErrInvoiceConflict = errors.Conflict(v1.ErrorReason_INVOICE_VERSION_CONFLICT.String(), "invoice changed concurrently")
+)
+
+// ErrInvoiceRejected is wrapped by the errors of an InvoiceProvider for an
+// invoice it refuses to issue, asking again would not help.
+var ErrInvoiceRejected = stderrors.New("invoice rejected")
+
+// TopicInvoice is the delay queue topic of the invoices to issue or reverse
+// with the provider, its tasks are invoice numbers.
+const TopicInvoice = "order_invoice"
+
+const (
+	// maxInvoiceTitles is how many titles a user may save.
+	maxInvoiceTitles = 20
+	// maxInvoiceOrders is how many orders an invoice may merge.
+	maxInvoiceOrders = 50
+)
+
+// InvoiceTitleKind is whom an invoice is made out to.
+type InvoiceTitleKind string
+
+const (
+	InvoicePersonal InvoiceTitleKind = "personal"
+	InvoiceCompany  InvoiceTitleKind = "company"
+)
+
+// InvoiceTitle is a title a user makes invoices out to. A company title
+// carries the tax ID of the company, and for a special VAT invoice its
+// address, phone and bank account.
+type InvoiceTitle struct {
+	ID          int64
+	UserID      int64
+	Kind        InvoiceTitleKind
+	Name        string
+	TaxID       string
+	Address     string
+	Phone       string
+	BankName    string
+	BankAccount string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// validate checks t is complete for its kind.
+func (t *InvoiceTitle) validate() error {
+	switch t.Kind {
+	case InvoicePersonal:
+		if t.Name == "" || t.TaxID != "" {
+			return ErrInvalidInvoiceTitle
+		}
+	case InvoiceCompany:
+		if t.Name == "" || !validTaxID(t.TaxID) {
+			return ErrInvalidInvoiceTitle
+		}
+	default:
+		return ErrInvalidInvoiceTitle
+	}
+	return nil
+}
+
+// validTaxID reports whether id looks like a taxpayer identification
+// number: the 18 characters of a unified social credit code, or the 15 or
+// 20 of the older numbers, digits and capital letters.
+func validTaxID(id string) bool {
+	if len(id) != 15 && len(id) != 18 && len(id) != 20 {
+		return false
+	}
+	for _, c := range id {
+		if (c < '0' || c > '9') && (c < 'A' || c > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// InvoiceStatus is the status of an invoice.
+type InvoiceStatus string
+
+const (
+	// InvoiceIssuing is an invoice waiting to be issued by the provider.
+	InvoiceIssuing InvoiceStatus = "issuing"
+	InvoiceIssued  InvoiceStatus = "issued"
+	// InvoiceFailed is an invoice the provider refused, its orders may be
+	// invoiced again.
+	InvoiceFailed InvoiceStatus = "failed"
+	// InvoiceReversing is an invoice waiting for the red invoice cancelling
+	// it, as its orders were refunded after it was requested.
+	InvoiceReversing InvoiceStatus = "reversing"
+	InvoiceReversed  InvoiceStatus = "reversed"
+)
+
+// InvoiceOrder is an order an invoice is for, with the amount invoiced.
+type InvoiceOrder struct {
+	OrderNo string
+	Amount  int64
+}
+
+// Invoice is a VAT invoice for completed orders of a merchant, for what is
+// left paid of them. An invoice whose orders are refunded once requested
+// is reversed and replaced by one for what is left.
+type Invoice struct {
+	ID         int64
+	InvoiceNo  string
+	UserID     int64
+	MerchantID int64
+	// Title is the title as it was when the invoice was requested.
+	Title *InvoiceTitle
+	// Email is where the invoice is sent, empty to only download it.
+	Email  string
+	Orders []*InvoiceOrder
+	Amount int64
+	Status InvoiceStatus
+	// ReplacesNo is the invoice this one replaces, if any.
+	ReplacesNo string
+	// ProviderNo is the number the provider issued the invoice under.
+	ProviderNo string
+	// FileURL is where the issued invoice is downloaded from.
+	FileURL    string
+	FailReason string
+	Version    int64
+	CreatedAt  time.Time
+	IssuedAt   time.Time
+	ReversedAt time.Time
+}
+
+// IssuedInvoice is what a provider issued an invoice as.
+type IssuedInvoice struct {
+	ProviderNo string
+	FileURL    string
+	IssuedAt   time.Time
+}
+
+// InvoiceProvider issues invoices with the tax system.
+type InvoiceProvider interface {
+	// Issue issues inv, idempotent on its InvoiceNo. It returns an error
+	// wrapping ErrInvoiceRejected for an invoice refused, as for one
+	// reversed before it was issued.
+	Issue(ctx context.Context, inv *Invoice) (*IssuedInvoice, error)
+	// Reverse issues the red invoice cancelling inv, idempotent. Reversing
+	// an invoice not issued yet records it reversed, so that an Issue
+	// arriving late issues nothing.
+	Reverse(ctx context.Context, inv *Invoice) error
+}
+
+// InvoiceFilter narrows ListInvoices, zero fields match all.
+type InvoiceFilter struct {
+	UserID     int64
+	MerchantID int64
+	OrderNo    string
+	Status     InvoiceStatus
+}
+
+// InvoiceRepo is an invoice and invoice title repo.
+type InvoiceRepo interface {
+	SaveTitle(context.Context, *InvoiceTitle) (*InvoiceTitle, error)
+	// UpdateTitle saves a title of its user, or returns ErrInvoiceTitleNotFound.
+	UpdateTitle(context.Context, *InvoiceTitle) error
+	// DeleteTitle deletes a title of a user, or returns ErrInvoiceTitleNotFound.
+	DeleteTitle(ctx context.Context, userID, id int64) error
+	FindTitle(ctx context.Context, id int64) (*InvoiceTitle, error)
+	ListTitles(ctx context.Context, userID int64) ([]*InvoiceTitle, error)
+
+	Save(context.Context, *Invoice) (*Invoice, error)
+	// Update saves an invoice and bumps its version if that is still the
+	// version read, or returns ErrInvoiceConflict. Orders are not updated.
+	Update(context.Context, *Invoice) error
+	FindByInvoiceNo(ctx context.Context, invoiceNo string) (*Invoice, error)
+	// FindActiveByOrderNo returns the invoice issuing or issued for an
+	// order, or ErrInvoiceNotFound.
+	FindActiveByOrderNo(ctx context.Context, orderNo string) (*Invoice, error)
+	List(ctx context.Context, filter *InvoiceFilter, page, pageSize int) ([]*Invoice, int64, error)
+	// ListUnsettled lists the invoices issuing or reversing by id after
+	// afterID, without orders.
+	ListUnsettled(ctx context.Context, afterID int64, limit int) ([]*Invoice, error)
+}
+
+// InvoiceUsecase is an invoice usecase. Users save the titles they make
+// invoices out to and request invoices for completed orders, issued by the
+// provider in the background.
+type InvoiceUsecase struct {
+	repo     InvoiceRepo
+	orders   *OrderUsecase
+	provider InvoiceProvider
+	queue    DelayQueue
+	log      *log.Helper
+}
+
+// NewInvoiceUsecase new an invoice usecase.
+func NewInvoiceUsecase(repo InvoiceRepo, orders *OrderUsecase, provider InvoiceProvider, queue DelayQueue, logger log.Logger) *InvoiceUsecase {
+	uc := &InvoiceUsecase{
+		repo:     repo,
+		orders:   orders,
+		provider: provider,
+		queue:    queue,
+		log:      log.NewHelper(logger),
+	}
+	orders.OnRefunded(uc.refunded)
+	return uc
+}
+
+// NewInvoiceNo returns an invoice number that sorts by creation time.
+func NewInvoiceNo() string {
+	return "I" + NewOrderNo()[1:]
+}
+
+// CreateInvoiceTitle saves a title of a user.
+func (uc *InvoiceUsecase) CreateInvoiceTitle(ctx context.Context, t *InvoiceTitle) (*InvoiceTitle, error) {
+	if t.UserID <= 0 {
+		return nil, ErrInvalidInvoiceTitle
+	}
+	if err := t.validate(); err != nil {
+		return nil, err
+	}
+	ts, err := uc.repo.ListTitles(ctx, t.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if len(ts) >= maxInvoiceTitles {
+		return nil, ErrInvalidInvoiceTitle
+	}
+	return uc.repo.SaveTitle(ctx, t)
+}
+
+// UpdateInvoiceTitle changes a title of a user. Invoices requested before
+// keep the title as it was.
+func (uc *InvoiceUsecase) UpdateInvoiceTitle(ctx context.Context, t *InvoiceTitle) (*InvoiceTitle, error) {
+	if err := t.validate(); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.UpdateTitle(ctx, t); err != nil {
+		return nil, err
+	}
+	return uc.repo.FindTitle(ctx, t.ID)
+}
+
+// DeleteInvoiceTitle deletes a title of a user.
+func (uc *InvoiceUsecase) DeleteInvoiceTitle(ctx context.Context, userID, id int64) error {
+	return uc.repo.DeleteTitle(ctx, userID, id)
+}
+
+// ListInvoiceTitles lists the titles of a user.
+func (uc *InvoiceUsecase) ListInvoiceTitles(ctx context.Context, userID int64) ([]*InvoiceTitle, error) {
+	return uc.repo.ListTitles(ctx, userID)
+}
+
+// RequestInvoice requests an invoice made out to a title of a user for
+// completed orders of theirs, a single one or several of a merchant merged,
+// for what is left paid of them once refunded. An order is invoiced once;
+// the invoice is issued in the background.
+func (uc *InvoiceUsecase) RequestInvoice(ctx context.Context, userID, titleID int64, orderNos []string, email string) (*Invoice, error) {
+	if len(orderNos) == 0 || len(orderNos) > maxInvoiceOrders {
+		return nil, ErrInvalidInvoice
+	}
+	t, err := uc.repo.FindTitle(ctx, titleID)
+	if err != nil {
+		return nil, err
+	}
+	if t.UserID != userID {
+		return nil, ErrInvoiceTitleNotFound
+	}
+	inv := &Invoice{
+		InvoiceNo: NewInvoiceNo(),
+		UserID:    userID,
+		Title:     t,
+		Email:     email,
+		Status:    InvoiceIssuing,
+	}
+	err = uc.orders.states.tx.InTx(ctx, func(ctx context.Context) error {
+		seen := make(map[string]bool, len(orderNos))
+		for _, no := range orderNos {
+			if seen[no] {
+				return ErrInvalidInvoice
+			}
+			seen[no] = true
+			o, err := uc.orders.repo.FindByOrderNo(ctx, no)
+			if err != nil {
+				return err
+			}
+			if o.UserID != userID || o.Status != StatusCompleted || (inv.MerchantID != 0 && o.MerchantID != inv.MerchantID) {
+				return ErrInvalidInvoice
+			}
+			inv.MerchantID = o.MerchantID
+			amount := o.PayAmount - o.RefundedAmount
+			if amount <= 0 {
+				return ErrInvalidInvoice
+			}
+			switch _, err := uc.repo.FindActiveByOrderNo(ctx, no); {
+			case err == nil:
+				return ErrOrderInvoiced.WithMetadata(map[string]string{"order_no": no})
+			case !errors.Is(err, ErrInvoiceNotFound):
+				return err
+			}
+			// Bumping the version of the order serializes the invoices of
+			// an order with each other and with its refunds.
+			if err := uc.orders.repo.Update(ctx, o); err != nil {
+				return err
+			}
+			inv.Orders = append(inv.Orders, &InvoiceOrder{OrderNo: no, Amount: amount})
+			inv.Amount += amount
+		}
+		inv, err = uc.repo.Save(ctx, inv)
+		return err
+	})
+	if errors.Is(err, ErrOrderChanged) {
+		return nil, ErrOrderConflict
+	}
+	if err != nil {
+		return nil, err
+	}
+	uc.schedule(ctx, inv)
+	uc.log.WithContext(ctx).Infof("RequestInvoice: %s of user %d for %d in %d orders", inv.InvoiceNo, userID, inv.Amount, len(inv.Orders))
+	return inv, nil
+}
+
+// GetInvoice returns an invoice.
+func (uc *InvoiceUsecase) GetInvoice(ctx context.Context, invoiceNo string) (*Invoice, error) {
+	return uc.repo.FindByInvoiceNo(ctx, invoiceNo)
+}
+
+// ListInvoices lists invoices, newest first.
+func (uc *InvoiceUsecase) ListInvoices(ctx context.Context, filter *InvoiceFilter, page, pageSize int) ([]*Invoice, int64, error) {
+	page, pageSize = pagination(page, pageSize)
+	return uc.repo.List(ctx, filter, page, pageSize)
+}
+
+// SettleInvoice issues an invoice issuing or reverses an invoice reversing
+// with the provider. It fails, to be retried, while the provider does.
+func (uc *InvoiceUsecase) SettleInvoice(ctx context.Context, invoiceNo string) error {
+	inv, err := uc.repo.FindByInvoiceNo(ctx, invoiceNo)
+	if errors.Is(err, ErrInvoiceNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	switch inv.Status {
+	case InvoiceIssuing:
+		issued, err := uc.provider.Issue(ctx, inv)
+		switch {
+		case stderrors.Is(err, ErrInvoiceRejected):
+			inv.Status, inv.FailReason = InvoiceFailed, err.Error()
+		case err != nil:
+			return err
+		default:
+			inv.Status = InvoiceIssued
+			inv.ProviderNo, inv.FileURL, inv.IssuedAt = issued.ProviderNo, issued.FileURL, issued.IssuedAt
+		}
+	case InvoiceReversing:
+		if err := uc.provider.Reverse(ctx, inv); err != nil {
+			return err
+		}
+		inv.Status, inv.ReversedAt = InvoiceReversed, time.Now()
+	default:
+		return nil
+	}
+	err = uc.repo.Update(ctx, inv)
+	if errors.Is(err, ErrInvoiceConflict) {
+		// Reversed meanwhile, its own task takes it on.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	uc.log.WithContext(ctx).Infof("Invoice %s: %s %s", inv.InvoiceNo, inv.Status, inv.ProviderNo)
+	return nil
+}
+
+// RescheduleInvoices schedules again the invoices left to settle, whose
+// tasks may have been lost, and returns how many.
+func (uc *InvoiceUsecase) RescheduleInvoices(ctx context.Context) (int, error) {
+	n := 0
+	var afterID int64
+	for {
+		invs, err := uc.repo.ListUnsettled(ctx, afterID, pendingBatch)
+		if err != nil {
+			return n, err
+		}
+		for _, inv := range invs {
+			if err := uc.queue.Schedule(ctx, TopicInvoice, inv.InvoiceNo, time.Now()); err != nil {
+				return n, err
+			}
+			afterID = inv.ID
+			n++
+		}
+		if len(invs) < pendingBatch {
+			return n, nil
+		}
+	}
+}
+
+// refunded reverses the invoice of an order refunded once it was requested,
+// replacing it by one for what is left paid of its orders, if anything.
+func (uc *InvoiceUsecase) refunded(ctx context.Context, o *Order, _ *OrderRefund) error {
+	inv, err := uc.repo.FindActiveByOrderNo(ctx, o.OrderNo)
+	if errors.Is(err, ErrInvoiceNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	next := &Invoice{
+		InvoiceNo:  NewInvoiceNo(),
+		UserID:     inv.UserID,
+		MerchantID: inv.MerchantID,
+		Title:      inv.Title,
+		Email:      inv.Email,
+		Status:     InvoiceIssuing,
+		ReplacesNo: inv.InvoiceNo,
+	}
+	changed := false
+	for _, l := range inv.Orders {
+		// Read again, o may not have its refunds when delivered twice.
+		o, err := uc.orders.repo.FindByOrderNo(ctx, l.OrderNo)
+		if err != nil {
+			return err
+		}
+		amount := o.PayAmount - o.RefundedAmount
+		if amount != l.Amount {
+			changed = true
+		}
+		if amount > 0 {
+			next.Orders = append(next.Orders, &InvoiceOrder{OrderNo: l.OrderNo, Amount: amount})
+			next.Amount += amount
+		}
+	}
+	if !changed {
+		return nil
+	}
+	inv.Status = InvoiceReversing
+	err = uc.orders.states.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.Update(ctx, inv); err != nil {
+			return err
+		}
+		if len(next.Orders) == 0 {
+			next = nil
+			return nil
+		}
+		next, err = uc.repo.Save(ctx, next)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	uc.schedule(ctx, inv)
+	if next != nil {
+		uc.schedule(ctx, next)
+	}
+	uc.log.WithContext(ctx).Infof("Invoice %s: reversing for refunds of %s", inv.InvoiceNo, o.OrderNo)
+	return nil
+}
+
+// schedule schedules inv to be settled now, RescheduleInvoices catching up
+// on failure.
+func (uc *InvoiceUsecase) schedule(ctx context.Context, inv *Invoice) {
+	if err := uc.queue.Schedule(ctx, TopicInvoice, inv.InvoiceNo, time.Now()); err != nil {
+		uc.log.WithContext(ctx).Errorf("Invoice %s: schedule: %v", inv.InvoiceNo, err)
+	}
+}
@@ -0,0 +1,592 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/go-kratos/kratos-layout/order/api/order/v1"
+	"github.com/go-kratos/kratos-layout/pkg/saga"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrOrderNotFound is order not found.
+	ErrOrderNotFound = errors.NotFound(v1.ErrorReason_ORDER_NOT_FOUND.String(), "order not found")
+	// ErrInvalidOrder is returned for an order without items or address, with
+	// a SKU twice, or with nothing to pay.
+	ErrInvalidOrder = errors.BadRequest(v1.ErrorReason_INVALID_ORDER.String(), "invalid order")
+	// ErrOrderPaid is returned when paying or cancelling an order paid already.
+	ErrOrderPaid = errors.Conflict(v1.ErrorReason_ORDER_ALREADY_PAID.String(), "order already paid")
+	// ErrOrderCancelled is returned when acting on a cancelled order.
+	ErrOrderCancelled = errors.Conflict(v1.ErrorReason_ORDER_ALREADY_CANCELLED.String(), "order already cancelled")
+)
+
+// ErrParentOrderNotFound is parent order not found.
+var ErrParentOrderNotFound = errors.NotFound(v1.ErrorReason_ORDER_NOT_FOUND.String(), "parent order not found")
+
+// ErrOrderChanged is returned by the Update of a repo when the order
+// changed since it was read.
+var ErrOrderChanged = stderrors.New("order changed concurrently")
+
+// Status is the status of an order.
+type Status string
+
+const (
+	StatusPending   Status = "pending_payment"
+	StatusPaid      Status = "paid"
+	StatusShipped   Status = "shipped"
+	StatusCompleted Status = "completed"
+	StatusCancelled Status = "cancelled"
+	// StatusRefunding is an order waiting for its payment to be refunded.
+	StatusRefunding Status = "refunding"
+)
+
+// Address is where an order is delivered, copied into the order.
+type Address struct {
+	Name     string
+	Phone    string
+	Province string
+	City     string
+	District string
+	Detail   string
+}
+
+// OrderItem is a line of an order.
+type OrderItem struct {
+	ID       int64
+	SkuID    int64
+	Title    string
+	Price    int64
+	Quantity int32
+	// Amount is Price * Quantity.
+	Amount         int64
+	DiscountAmount int64
+	// PayAmount is Amount - DiscountAmount.
+	PayAmount int64
+	Discounts []*Discount
+}
+
+// Order is an order of a user from a merchant, fulfilled and refunded on
+// its own. The orders of a checkout share a ParentOrder paid at once.
+type Order struct {
+	ID         int64
+	OrderNo    string
+	ParentNo   string
+	UserID     int64
+	MerchantID int64
+	Status     Status
+	Items      []*OrderItem
+	// ItemsAmount is the sum of the item amounts.
+	ItemsAmount int64
+	ShippingFee int64
+	// DiscountAmount sums the discounts of the items and of the shipping fee.
+	DiscountAmount int64
+	// PayAmount is what the buyer pays, ItemsAmount + ShippingFee - DiscountAmount.
+	PayAmount         int64
+	ShippingDiscounts []*Discount
+	Address           *Address
+	Remark            string
+	// ExpireAt is when the order is cancelled unless paid.
+	ExpireAt time.Time
+	// TradeNo is the payment that paid the order.
+	TradeNo        string
+	RefundedAmount int64
+	CancelReason   string
+	Version        int64
+	CreatedAt      time.Time
+	PaidAt         time.Time
+	ShippedAt      time.Time
+	CompletedAt    time.Time
+	CancelledAt    time.Time
+}
+
+// resumed is where a refunding order falls back to when refunded in part.
+func (o *Order) resumed() Status {
+	switch {
+	case !o.CompletedAt.IsZero():
+		return StatusCompleted
+	case !o.ShippedAt.IsZero():
+		return StatusShipped
+	}
+	return StatusPaid
+}
+
+// ParentOrder is a checkout of a user, split into an order per merchant.
+// It is paid with a single payment whose biz_no is ParentNo, and its amounts
+// are the sums of those of its orders.
+type ParentOrder struct {
+	ID             int64
+	ParentNo       string
+	UserID         int64
+	ItemsAmount    int64
+	ShippingFee    int64
+	DiscountAmount int64
+	PayAmount      int64
+	// CouponCodes are the coupons the checkout used.
+	CouponCodes []string
+	// PayNo is the combined payment the checkout opened, empty when the
+	// buyer pays on their own.
+	PayNo     string
+	ExpireAt  time.Time
+	CreatedAt time.Time
+	Orders    []*Order
+}
+
+// pending returns the orders of p waiting for payment.
+func (p *ParentOrder) pending() []*Order {
+	var os []*Order
+	for _, o := range p.Orders {
+		if o.Status == StatusPending {
+			os = append(os, o)
+		}
+	}
+	return os
+}
+
+// cancelled reports whether every order of p is cancelled.
+func (p *ParentOrder) cancelled() bool {
+	for _, o := range p.Orders {
+		if o.Status != StatusCancelled {
+			return false
+		}
+	}
+	return true
+}
+
+// paidBy reports whether an order of p was paid by tradeNo.
+func (p *ParentOrder) paidBy(tradeNo string) bool {
+	for _, o := range p.Orders {
+		if o.TradeNo == tradeNo {
+			return true
+		}
+	}
+	return false
+}
+
+// unpaid reports whether no order of p was paid.
+func (p *ParentOrder) unpaid() bool {
+	for _, o := range p.Orders {
+		if o.TradeNo != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// paidAfterCancel reports whether p was paid once its orders were cancelled
+// unpaid, the payment being refunded.
+func (p *ParentOrder) paidAfterCancel() bool {
+	for _, o := range p.Orders {
+		if !o.CancelledAt.IsZero() && o.CancelledAt.Before(o.PaidAt) {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderFilter narrows ListOrders, zero fields match all.
+type OrderFilter struct {
+	UserID   int64
+	ParentNo string
+	Status   Status
+}
+
+// OrderRefund is a refund of the payment of an order, recorded once.
+type OrderRefund struct {
+	ID        int64
+	OrderNo   string
+	RefundNo  string
+	TradeNo   string
+	Amount    int64
+	CreatedAt time.Time
+}
+
+// OrderRepo is an Order repo.
+type OrderRepo interface {
+	// SaveParent saves a new parent order with its orders and their items.
+	SaveParent(context.Context, *ParentOrder) (*ParentOrder, error)
+	// FindParent returns a parent order with its orders.
+	FindParent(ctx context.Context, parentNo string) (*ParentOrder, error)
+	// SetPayNo records the combined payment opened for a parent order.
+	SetPayNo(ctx context.Context, parentNo, payNo string) error
+	// Update saves an order and bumps its version if that is still the
+	// version read, or returns ErrOrderChanged. Items are not updated.
+	Update(context.Context, *Order) error
+	FindByOrderNo(ctx context.Context, orderNo string) (*Order, error)
+	List(ctx context.Context, filter *OrderFilter, page, pageSize int) ([]*Order, int64, error)
+	// ListPending lists pending orders by id after afterID, without items.
+	ListPending(ctx context.Context, afterID int64, limit int) ([]*Order, error)
+	// AddRefund records a refund and reports false when it was recorded before.
+	AddRefund(context.Context, *OrderRefund) (bool, error)
+}
+
+// PaymentRepo asks the payment service for payments and refunds.
+type PaymentRepo interface {
+	// Pay opens the combined payment of p paid as pay, reusing the one in
+	// flight, and returns its number. It returns ErrOrderPaid when p was
+	// paid already.
+	Pay(ctx context.Context, p *ParentOrder, pay *Payment) (string, error)
+	// CancelPay cancels a combined payment not paid yet, releasing what it held.
+	CancelPay(ctx context.Context, payNo string) error
+	// Refund refunds amount of a payment, idempotent on refundNo.
+	Refund(ctx context.Context, tradeNo, refundNo string, amount int64, reason string) error
+}
+
+// OrderUsecase is an Order usecase.
+type OrderUsecase struct {
+	repo       OrderRepo
+	states     *StateMachine
+	payments   PaymentRepo
+	stocks     StockRepo
+	skus       SkuRepo
+	pricing    *PromotionUsecase
+	queue      DelayQueue
+	sagas      *saga.Orchestrator
+	payTimeout time.Duration
+	cancelled  []func(context.Context, *Order)
+	// paidListeners are called as parent orders are paid, see OnPaid.
+	paidListeners []func(context.Context, *ParentOrder) error
+	// refundListeners are called as refunds are added, see OnRefunded.
+	refundListeners []func(context.Context, *Order, *OrderRefund) error
+	log             *log.Helper
+}
+
+// NewOrderUsecase new an Order usecase.
+func NewOrderUsecase(repo OrderRepo, states *StateMachine, payments PaymentRepo, stocks StockRepo, skus SkuRepo, pricing *PromotionUsecase, queue DelayQueue, sagas *saga.Orchestrator, policy *OrderPolicy, logger log.Logger) *OrderUsecase {
+	uc := &OrderUsecase{
+		repo:       repo,
+		states:     states,
+		payments:   payments,
+		stocks:     stocks,
+		skus:       skus,
+		pricing:    pricing,
+		queue:      queue,
+		sagas:      sagas,
+		payTimeout: policy.PayTimeout,
+		log:        log.NewHelper(logger),
+	}
+	if uc.payTimeout <= 0 {
+		uc.payTimeout = defaultPayTimeout
+	}
+	uc.OnCancelled(uc.releaseCoupons)
+	uc.OnCancelled(uc.releaseCheckout)
+	uc.OnPaid(uc.confirmStock)
+	sagas.Register(uc.checkoutSaga())
+	return uc
+}
+
+// NewOrderNo returns an order number that sorts by creation time.
+func NewOrderNo() string {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		panic(err)
+	}
+	return fmt.Sprintf("O%s%06d", time.Now().Format("20060102150405"), n.Int64())
+}
+
+// NewParentOrderNo returns a parent order number that sorts by creation time.
+func NewParentOrderNo() string {
+	return "P" + NewOrderNo()[1:]
+}
+
+// paymentRefundNo is the number of the refund of a whole payment that paid
+// no order, so that asking again never refunds it twice.
+func paymentRefundNo(tradeNo string) string {
+	return "R" + tradeNo
+}
+
+// orderRefundNo is the number of the refund of what is left paid of an
+// order. The refunds of an order are numbered R<order_no>, followed by a
+// dash and a suffix for those of part of it.
+func orderRefundNo(orderNo string) string {
+	return "R" + orderNo
+}
+
+// refundedOrderNo returns the order number of a refund number, or "" for a
+// refund of no order.
+func refundedOrderNo(refundNo string) string {
+	if !strings.HasPrefix(refundNo, "RO") {
+		return ""
+	}
+	orderNo, _, _ := strings.Cut(refundNo[1:], "-")
+	return orderNo
+}
+
+// CreateOrder checks out the items of o, delivered to its address, as a
+// parent order waiting for payment with an order per merchant, and
+// schedules its cancellation for when it expires. The items are priced
+// together at what the shop sells them for now, with the member prices of
+// memberLevel, the promotions running and the coupons of couponCodes that
+// apply, which the parent order then uses. Each order gets the discounts
+// of its items and the shipping fee of its merchant, so that the amounts of
+// the orders add up to those of the parent order to the cent.
+//
+// The checkout runs as a saga that also reserves the stock of the items and,
+// unless pay is nil, opens the payment of the parent order; when a step
+// fails the steps before it are undone and its error is returned.
+func (uc *OrderUsecase) CreateOrder(ctx context.Context, o *Order, memberLevel int32, couponCodes []string, pay *Payment) (*ParentOrder, error) {
+	items, err := uc.priceItems(ctx, o)
+	if err != nil {
+		return nil, err
+	}
+	priced, err := uc.pricing.Price(ctx, &PriceRequest{UserID: o.UserID, MemberLevel: memberLevel, Items: items, CouponCodes: couponCodes, Address: o.Address})
+	if err != nil {
+		return nil, err
+	}
+	p, err := uc.checkout(ctx, o, priced, NewParentOrderNo(), pay)
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("CreateOrder: %s of user %d for %d in %d orders", p.ParentNo, p.UserID, p.PayAmount, len(p.Orders))
+	return p, nil
+}
+
+// priceItems checks the items of o are on sale with stock, and returns them
+// to price at what the shop sells them for now, their titles set.
+func (uc *OrderUsecase) priceItems(ctx context.Context, o *Order) ([]*PriceItem, error) {
+	if o.UserID <= 0 || len(o.Items) == 0 || o.Address == nil || o.Address.Name == "" || o.Address.Phone == "" || o.Address.Detail == "" {
+		return nil, ErrInvalidOrder
+	}
+	ids := make([]int64, 0, len(o.Items))
+	seen := make(map[int64]bool, len(o.Items))
+	for _, it := range o.Items {
+		if it.SkuID <= 0 || it.Quantity <= 0 || seen[it.SkuID] {
+			return nil, ErrInvalidOrder
+		}
+		seen[it.SkuID] = true
+		ids = append(ids, it.SkuID)
+	}
+	skus, err := uc.skus.GetSkus(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*PriceItem, 0, len(o.Items))
+	for _, it := range o.Items {
+		sku, ok := skus[it.SkuID]
+		if !ok || !sku.OnSale || sku.Stock < int64(it.Quantity) {
+			return nil, ErrSkuUnavailable.WithMetadata(map[string]string{"sku_id": strconv.FormatInt(it.SkuID, 10)})
+		}
+		it.Title = sku.Title
+		items = append(items, &PriceItem{
+			SkuID:      sku.ID,
+			SpuID:      sku.SpuID,
+			MerchantID: sku.MerchantID,
+			Quantity:   it.Quantity,
+			Price:      sku.Price,
+		})
+	}
+	return items, nil
+}
+
+// checkout places the items of o priced as priced as the parent order
+// parentNo, running the checkout saga, and returns it as placed.
+func (uc *OrderUsecase) checkout(ctx context.Context, o *Order, priced *PriceResult, parentNo string, pay *Payment) (*ParentOrder, error) {
+	if priced.PayAmount <= 0 {
+		return nil, ErrInvalidOrder
+	}
+	p := split(o, priced)
+	p.ParentNo = parentNo
+	p.ExpireAt = time.Now().Add(uc.payTimeout)
+	for _, c := range p.Orders {
+		c.OrderNo, c.ParentNo = NewOrderNo(), p.ParentNo
+		c.Status, c.ExpireAt = StatusPending, p.ExpireAt
+	}
+	payload, err := json.Marshal(&checkout{Parent: p, Payment: pay})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.sagas.Run(ctx, SagaCheckout, p.ParentNo, payload); err != nil {
+		return nil, err
+	}
+	return uc.repo.FindParent(ctx, p.ParentNo)
+}
+
+// split splits the items of o priced as priced into an order per merchant,
+// in the order the merchants first appear in.
+func split(o *Order, priced *PriceResult) *ParentOrder {
+	p := &ParentOrder{
+		UserID:         o.UserID,
+		ItemsAmount:    priced.ItemsAmount,
+		ShippingFee:    priced.ShippingFee,
+		DiscountAmount: priced.DiscountAmount,
+		PayAmount:      priced.PayAmount,
+		CouponCodes:    priced.CouponCodes,
+	}
+	for _, s := range priced.Shipping {
+		c := &Order{
+			UserID:            o.UserID,
+			MerchantID:        s.MerchantID,
+			ShippingFee:       s.Fee,
+			DiscountAmount:    s.DiscountAmount,
+			ShippingDiscounts: s.Discounts,
+			Address:           o.Address,
+			Remark:            o.Remark,
+		}
+		for i, l := range priced.Lines {
+			if l.MerchantID != s.MerchantID {
+				continue
+			}
+			c.Items = append(c.Items, &OrderItem{
+				SkuID:          l.SkuID,
+				Title:          o.Items[i].Title,
+				Price:          l.Price,
+				Quantity:       l.Quantity,
+				Amount:         l.Amount,
+				DiscountAmount: l.DiscountAmount,
+				PayAmount:      l.PayAmount,
+				Discounts:      l.Discounts,
+			})
+			c.ItemsAmount += l.Amount
+			c.DiscountAmount += l.DiscountAmount
+		}
+		c.PayAmount = c.ItemsAmount + c.ShippingFee - c.DiscountAmount
+		p.Orders = append(p.Orders, c)
+	}
+	return p
+}
+
+// GetParentOrder returns a parent order with its orders.
+func (uc *OrderUsecase) GetParentOrder(ctx context.Context, parentNo string) (*ParentOrder, error) {
+	return uc.repo.FindParent(ctx, parentNo)
+}
+
+// GetOrder returns an order.
+func (uc *OrderUsecase) GetOrder(ctx context.Context, orderNo string) (*Order, error) {
+	return uc.repo.FindByOrderNo(ctx, orderNo)
+}
+
+// ListOrders lists orders, newest first.
+func (uc *OrderUsecase) ListOrders(ctx context.Context, filter *OrderFilter, page, pageSize int) ([]*Order, int64, error) {
+	page, pageSize = pagination(page, pageSize)
+	return uc.repo.List(ctx, filter, page, pageSize)
+}
+
+// CancelOrder cancels an order not shipped yet. An unpaid order is cancelled
+// at once, together with the other orders of its parent order since they
+// are paid at once; a paid one moves to refunding and is cancelled once
+// what it was paid is refunded, the other orders going on. Cancelling a
+// refunding order asks for its refund again.
+func (uc *OrderUsecase) CancelOrder(ctx context.Context, orderNo, reason string) (*Order, error) {
+	o, err := uc.repo.FindByOrderNo(ctx, orderNo)
+	if err != nil {
+		return nil, err
+	}
+	switch o.Status {
+	case StatusPending:
+		p, err := uc.repo.FindParent(ctx, o.ParentNo)
+		if err != nil {
+			return nil, err
+		}
+		if err := uc.cancelParent(ctx, p, reason, "cancelled"); err != nil {
+			return nil, err
+		}
+		for _, c := range p.Orders {
+			if c.OrderNo == o.OrderNo {
+				return c, nil
+			}
+		}
+		return o, nil
+	case StatusPaid:
+		o.CancelReason = reason
+		if err := uc.states.Transit(ctx, o, StatusRefunding, "cancel_requested", nil); err != nil {
+			return nil, err
+		}
+	case StatusRefunding:
+	default:
+		return nil, IllegalTransition(o.Status, StatusCancelled)
+	}
+	if err := uc.refundOrder(ctx, o, "order cancelled"); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// cancelParent cancels the pending orders of p in a transaction, for event.
+func (uc *OrderUsecase) cancelParent(ctx context.Context, p *ParentOrder, reason, event string) error {
+	pending := p.pending()
+	now := time.Now()
+	err := uc.states.tx.InTx(ctx, func(ctx context.Context) error {
+		for _, c := range pending {
+			c.CancelReason, c.CancelledAt = reason, now
+			if err := uc.states.Transit(ctx, c, StatusCancelled, event, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		// The orders transited before the failure were rolled back too.
+		for _, c := range pending {
+			c.Status, c.CancelReason, c.CancelledAt = StatusPending, "", time.Time{}
+		}
+		return err
+	}
+	for _, c := range pending {
+		uc.notifyCancelled(ctx, c)
+	}
+	return nil
+}
+
+// CompleteOrder marks a shipped order as received.
+func (uc *OrderUsecase) CompleteOrder(ctx context.Context, orderNo string) (*Order, error) {
+	o, err := uc.repo.FindByOrderNo(ctx, orderNo)
+	if err != nil {
+		return nil, err
+	}
+	o.CompletedAt = time.Now()
+	if err := uc.states.Transit(ctx, o, StatusCompleted, "completed", nil); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// refundOrder asks for what is left paid of o to be refunded.
+func (uc *OrderUsecase) refundOrder(ctx context.Context, o *Order, reason string) error {
+	return uc.refund(ctx, o.OrderNo, o.TradeNo, orderRefundNo(o.OrderNo), o.PayAmount-o.RefundedAmount, reason)
+}
+
+// refund asks for amount of a payment to be refunded for bizNo.
+func (uc *OrderUsecase) refund(ctx context.Context, bizNo, tradeNo, refundNo string, amount int64, reason string) error {
+	if err := uc.payments.Refund(ctx, tradeNo, refundNo, amount, reason); err != nil {
+		uc.log.WithContext(ctx).Errorf("Order %s: refund %s of %s: %v", bizNo, refundNo, tradeNo, err)
+		return err
+	}
+	uc.log.WithContext(ctx).Infof("Order %s: refunding %d of %s as %s, %s", bizNo, amount, tradeNo, refundNo, reason)
+	return nil
+}
+
+// releaseCoupons gives back the coupons of a parent order once all its
+// orders are cancelled, as the coupons were spread over all of them.
+func (uc *OrderUsecase) releaseCoupons(ctx context.Context, o *Order) {
+	p, err := uc.repo.FindParent(ctx, o.ParentNo)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("Order %s: release coupons: %v", o.OrderNo, err)
+		return
+	}
+	if len(p.CouponCodes) == 0 || !p.cancelled() {
+		return
+	}
+	if err := uc.pricing.ReleaseCoupons(ctx, p.ParentNo); err != nil {
+		uc.log.WithContext(ctx).Errorf("Order %s: release coupons: %v", p.ParentNo, err)
+	}
+}
+
+// pagination defaults a page number and size to valid values.
+func pagination(page, pageSize int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	return page, pageSize
+}
@@ -0,0 +1,90 @@
+package biz
+
+import "testing"
+
+func TestSplitSumsToParent(t *testing.T) {
+	items := []*PriceItem{
+		{SkuID: 1, SpuID: 10, MerchantID: 100, Quantity: 3, Price: 3333},
+		{SkuID: 2, SpuID: 20, MerchantID: 200, Quantity: 1, Price: 999},
+		{SkuID: 3, SpuID: 10, MerchantID: 100, Quantity: 7, Price: 101},
+		{SkuID: 4, SpuID: 40, MerchantID: 300, Quantity: 2, Price: 4999},
+		{SkuID: 5, SpuID: 50, MerchantID: 200, Quantity: 1, Price: 1},
+	}
+	tests := []struct {
+		name         string
+		memberPrices map[int64]int64
+		offers       []*offer
+		fees         map[int64]int64
+	}{
+		{name: "no offers", fees: map[int64]int64{100: 800, 200: 600}},
+		{
+			name:         "member prices and percentage",
+			memberPrices: map[int64]int64{1: 3000, 3: 99},
+			offers: []*offer{
+				promotionOffer(&Promotion{ID: 1, Name: "13% off", Kind: PromotionPercentage, Percent: 13}),
+			},
+			fees: map[int64]int64{100: 800, 200: 600, 300: 1000},
+		},
+		{
+			name: "stacked offers and free shipping",
+			offers: []*offer{
+				promotionOffer(&Promotion{ID: 1, Name: "7% off", Kind: PromotionPercentage, Percent: 7}),
+				promotionOffer(&Promotion{ID: 2, Name: "33 off 100", Kind: PromotionFullReduction, Tiers: []Tier{{Threshold: 10000, Reduction: 3333}}}),
+				promotionOffer(&Promotion{ID: 3, Name: "free shipping", Kind: PromotionFreeShipping, MinAmount: 5000, Scope: Scope{MerchantIDs: []int64{300}}}),
+				couponOffer(&Coupon{Code: "C1", Title: "17% off", Kind: CouponPercentOff, Percent: 17, MaxDiscount: 1999}),
+				couponOffer(&Coupon{Code: "C2", Title: "1 off", Kind: CouponAmountOff, Amount: 1, Scope: Scope{SpuIDs: []int64{10, 20}}}),
+				couponOffer(&Coupon{Code: "C3", Title: "free shipping", Kind: CouponFreeShipping}),
+			},
+			fees: map[int64]int64{100: 1200, 200: 600, 300: 1000},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &Order{UserID: 1}
+			for _, it := range items {
+				o.Items = append(o.Items, &OrderItem{SkuID: it.SkuID})
+			}
+			p := split(o, price(items, tt.memberPrices, tt.offers, tt.fees))
+			if (tt.memberPrices != nil || tt.offers != nil) && p.DiscountAmount == 0 {
+				t.Fatal("no discount applied")
+			}
+			if len(p.Orders) != 3 {
+				t.Fatalf("split into %d orders, want 3", len(p.Orders))
+			}
+			var itemsAmount, shippingFee, discount, pay int64
+			var lines int
+			for _, c := range p.Orders {
+				var cItems, cDiscount, cPay, cShipping int64
+				for _, it := range c.Items {
+					if it.PayAmount != it.Amount-it.DiscountAmount || it.PayAmount < 0 {
+						t.Errorf("item %d of merchant %d pays %d of %d less %d", it.SkuID, c.MerchantID, it.PayAmount, it.Amount, it.DiscountAmount)
+					}
+					cItems += it.Amount
+					cDiscount += it.DiscountAmount
+					cPay += it.PayAmount
+				}
+				for _, d := range c.ShippingDiscounts {
+					cShipping += d.Amount
+				}
+				if c.ItemsAmount != cItems || c.DiscountAmount != cDiscount+cShipping {
+					t.Errorf("order of merchant %d: items %d discount %d, its lines add up to %d and %d", c.MerchantID, c.ItemsAmount, c.DiscountAmount, cItems, cDiscount+cShipping)
+				}
+				if c.PayAmount != cPay+c.ShippingFee-cShipping {
+					t.Errorf("order of merchant %d pays %d, its lines and shipping add up to %d", c.MerchantID, c.PayAmount, cPay+c.ShippingFee-cShipping)
+				}
+				itemsAmount += c.ItemsAmount
+				shippingFee += c.ShippingFee
+				discount += c.DiscountAmount
+				pay += c.PayAmount
+				lines += len(c.Items)
+			}
+			if lines != len(items) {
+				t.Errorf("split %d items into orders, want %d", lines, len(items))
+			}
+			if itemsAmount != p.ItemsAmount || shippingFee != p.ShippingFee || discount != p.DiscountAmount || pay != p.PayAmount {
+				t.Errorf("orders add up to items %d shipping %d discount %d pay %d, parent has %d %d %d %d",
+					itemsAmount, shippingFee, discount, pay, p.ItemsAmount, p.ShippingFee, p.DiscountAmount, p.PayAmount)
+			}
+		})
+	}
+}
@@ -0,0 +1,358 @@
+package biz
+
+import (
+	"math/big"
+	"sort"
+	"strconv"
+)
+
+// DiscountKind is what a discount comes from.
+type DiscountKind string
+
+const (
+	DiscountMemberPrice   DiscountKind = "member_price"
+	DiscountPercentage    DiscountKind = "percentage"
+	DiscountFullReduction DiscountKind = "full_reduction"
+	DiscountCoupon        DiscountKind = "coupon"
+	DiscountFreeShipping  DiscountKind = "free_shipping"
+	DiscountFlashSale     DiscountKind = "flash_sale"
+)
+
+// Discount is a discount taken off an item or off a shipping fee.
+type Discount struct {
+	Kind DiscountKind
+	// Source is member, promotion:<id>, coupon:<code> or flash_sale:<id>.
+	Source string
+	Title  string
+	Amount int64
+}
+
+// PriceItem is an item to price at the price the shop sells it for.
+type PriceItem struct {
+	SkuID      int64
+	SpuID      int64
+	MerchantID int64
+	Quantity   int32
+	Price      int64
+}
+
+// PriceLine is an item priced.
+type PriceLine struct {
+	*PriceItem
+	// Amount is Price * Quantity, before any discount.
+	Amount         int64
+	DiscountAmount int64
+	// PayAmount is Amount - DiscountAmount.
+	PayAmount int64
+	Discounts []*Discount
+}
+
+func (l *PriceLine) discount(d *Discount) {
+	l.Discounts = append(l.Discounts, d)
+	l.DiscountAmount += d.Amount
+	l.PayAmount -= d.Amount
+}
+
+// ShippingCharge is the shipping fee of the items of a merchant.
+type ShippingCharge struct {
+	MerchantID     int64
+	Fee            int64
+	DiscountAmount int64
+	Discounts      []*Discount
+}
+
+// PriceResult is the breakdown of the price of items.
+type PriceResult struct {
+	Lines    []*PriceLine
+	Shipping []*ShippingCharge
+	// ItemsAmount sums the amounts of the lines, ShippingFee the fees of the
+	// merchants, DiscountAmount the discounts of both.
+	ItemsAmount    int64
+	ShippingFee    int64
+	DiscountAmount int64
+	// PayAmount is ItemsAmount + ShippingFee - DiscountAmount.
+	PayAmount int64
+	// CouponCodes are the coupons that gave a discount.
+	CouponCodes []string
+}
+
+// The stages offers apply in, each on what the stages before left to pay.
+const (
+	stagePercentage = iota
+	stageFullReduction
+	stageCoupon
+	stageFreeShipping
+	stageFreeShippingCoupon
+)
+
+// offer is a promotion or coupon as the engine applies it.
+type offer struct {
+	stage  int
+	kind   DiscountKind
+	source string
+	title  string
+	group  string
+	scope  *Scope
+	// coupon is the code of a coupon offer.
+	coupon string
+	// take returns what the offer takes off items in scope costing
+	// subtotal, or the least they must cost for free shipping.
+	take func(subtotal int64, quantity int32) int64
+}
+
+func (o *offer) shipping() bool {
+	return o.stage >= stageFreeShipping
+}
+
+// inScope returns the lines of the offer left to pay, of merchant unless 0,
+// with what they cost and their quantity.
+func (o *offer) inScope(lines []*PriceLine, merchantID int64) ([]*PriceLine, int64, int32) {
+	var in []*PriceLine
+	var subtotal int64
+	var quantity int32
+	for _, l := range lines {
+		if l.PayAmount <= 0 || (merchantID != 0 && l.MerchantID != merchantID) || !o.scope.matches(l.PriceItem) {
+			continue
+		}
+		in = append(in, l)
+		subtotal += l.PayAmount
+		quantity += l.Quantity
+	}
+	return in, subtotal, quantity
+}
+
+// value is what the offer takes off lines and shipping as they are.
+func (o *offer) value(lines []*PriceLine, shipping []*ShippingCharge) int64 {
+	if !o.shipping() {
+		_, subtotal, quantity := o.inScope(lines, 0)
+		return min(o.take(subtotal, quantity), subtotal)
+	}
+	var v int64
+	for _, s := range shipping {
+		if in, subtotal, quantity := o.inScope(lines, s.MerchantID); len(in) > 0 && subtotal >= o.take(subtotal, quantity) {
+			v += s.Fee - s.DiscountAmount
+		}
+	}
+	return v
+}
+
+// price prices items: member prices first, then the offers stage by stage,
+// each spreading what it takes over the lines in its scope. Of the offers
+// sharing a group only the one worth the most on its own applies, and
+// offers worth nothing do not apply.
+func price(items []*PriceItem, memberPrices map[int64]int64, offers []*offer, fees map[int64]int64) *PriceResult {
+	r := &PriceResult{}
+	for _, it := range items {
+		l := &PriceLine{PriceItem: it, Amount: it.Price * int64(it.Quantity)}
+		l.PayAmount = l.Amount
+		if mp, ok := memberPrices[it.SkuID]; ok && mp < it.Price {
+			l.discount(&Discount{
+				Kind:   DiscountMemberPrice,
+				Source: "member",
+				Title:  "member price",
+				Amount: (it.Price - mp) * int64(it.Quantity),
+			})
+		}
+		r.Lines = append(r.Lines, l)
+		if findShipping(r.Shipping, it.MerchantID) == nil {
+			r.Shipping = append(r.Shipping, &ShippingCharge{MerchantID: it.MerchantID, Fee: fees[it.MerchantID]})
+		}
+	}
+	for _, o := range exclusive(r.Lines, r.Shipping, offers) {
+		if o.shipping() {
+			applyShipping(o, r)
+			continue
+		}
+		in, subtotal, quantity := o.inScope(r.Lines, 0)
+		take := min(o.take(subtotal, quantity), subtotal)
+		if take <= 0 {
+			continue
+		}
+		weights := make([]int64, len(in))
+		for i, l := range in {
+			weights[i] = l.PayAmount
+		}
+		for i, share := range allocate(take, weights) {
+			if share > 0 {
+				in[i].discount(&Discount{Kind: o.kind, Source: o.source, Title: o.title, Amount: share})
+			}
+		}
+		if o.coupon != "" {
+			r.CouponCodes = append(r.CouponCodes, o.coupon)
+		}
+	}
+	for _, l := range r.Lines {
+		r.ItemsAmount += l.Amount
+		r.DiscountAmount += l.DiscountAmount
+	}
+	for _, s := range r.Shipping {
+		r.ShippingFee += s.Fee
+		r.DiscountAmount += s.DiscountAmount
+	}
+	r.PayAmount = r.ItemsAmount + r.ShippingFee - r.DiscountAmount
+	return r
+}
+
+// exclusive drops the offers worth nothing and those beaten within their
+// group, and sorts the rest by stage.
+func exclusive(lines []*PriceLine, shipping []*ShippingCharge, offers []*offer) []*offer {
+	best := make(map[string]*offer)
+	bestValue := make(map[string]int64)
+	var kept []*offer
+	for _, o := range offers {
+		v := o.value(lines, shipping)
+		if v <= 0 {
+			continue
+		}
+		if o.group == "" {
+			kept = append(kept, o)
+			continue
+		}
+		if b, ok := best[o.group]; !ok || v > bestValue[o.group] || (v == bestValue[o.group] && o.stage < b.stage) {
+			best[o.group], bestValue[o.group] = o, v
+		}
+	}
+	for _, o := range offers {
+		if o.group != "" && best[o.group] == o {
+			kept = append(kept, o)
+		}
+	}
+	sort.SliceStable(kept, func(i, j int) bool {
+		return kept[i].stage < kept[j].stage
+	})
+	return kept
+}
+
+// applyShipping waives the shipping fee of each merchant whose items in
+// the scope of o reach what it asks for, unless waived already.
+func applyShipping(o *offer, r *PriceResult) {
+	applied := false
+	for _, s := range r.Shipping {
+		if s.Fee <= s.DiscountAmount {
+			continue
+		}
+		in, subtotal, quantity := o.inScope(r.Lines, s.MerchantID)
+		if len(in) == 0 || subtotal < o.take(subtotal, quantity) {
+			continue
+		}
+		d := &Discount{Kind: DiscountFreeShipping, Source: o.source, Title: o.title, Amount: s.Fee - s.DiscountAmount}
+		s.Discounts = append(s.Discounts, d)
+		s.DiscountAmount += d.Amount
+		applied = true
+	}
+	if applied && o.coupon != "" {
+		r.CouponCodes = append(r.CouponCodes, o.coupon)
+	}
+}
+
+func findShipping(shipping []*ShippingCharge, merchantID int64) *ShippingCharge {
+	for _, s := range shipping {
+		if s.MerchantID == merchantID {
+			return s
+		}
+	}
+	return nil
+}
+
+// promotionOffer returns the offer of a promotion.
+func promotionOffer(p *Promotion) *offer {
+	o := &offer{
+		source: "promotion:" + strconv.FormatInt(p.ID, 10),
+		title:  p.Name,
+		group:  p.ExclusiveGroup,
+		scope:  &p.Scope,
+	}
+	switch p.Kind {
+	case PromotionPercentage:
+		o.stage, o.kind = stagePercentage, DiscountPercentage
+		o.take = func(subtotal int64, quantity int32) int64 {
+			if subtotal < p.MinAmount || quantity < p.MinQuantity {
+				return 0
+			}
+			return subtotal * int64(p.Percent) / 100
+		}
+	case PromotionFullReduction:
+		o.stage, o.kind = stageFullReduction, DiscountFullReduction
+		o.take = p.reduction
+	case PromotionFreeShipping:
+		o.stage, o.kind = stageFreeShipping, DiscountFreeShipping
+		o.take = func(int64, int32) int64 { return p.MinAmount }
+	}
+	return o
+}
+
+// couponOffer returns the offer of a coupon.
+func couponOffer(c *Coupon) *offer {
+	o := &offer{
+		stage:  stageCoupon,
+		kind:   DiscountCoupon,
+		source: "coupon:" + c.Code,
+		title:  c.Title,
+		group:  c.ExclusiveGroup,
+		scope:  &c.Scope,
+		coupon: c.Code,
+	}
+	switch c.Kind {
+	case CouponAmountOff:
+		o.take = func(subtotal int64, _ int32) int64 {
+			if subtotal < c.MinAmount {
+				return 0
+			}
+			return c.Amount
+		}
+	case CouponPercentOff:
+		o.take = func(subtotal int64, _ int32) int64 {
+			if subtotal < c.MinAmount {
+				return 0
+			}
+			take := subtotal * int64(c.Percent) / 100
+			if c.MaxDiscount > 0 {
+				take = min(take, c.MaxDiscount)
+			}
+			return take
+		}
+	case CouponFreeShipping:
+		o.stage = stageFreeShippingCoupon
+		o.take = func(int64, int32) int64 { return c.MinAmount }
+	}
+	return o
+}
+
+// allocate splits total over weights in proportion, to the cent: each
+// share is rounded down and the cents left go to the largest remainders,
+// the first of equal ones first. A zero weight gets nothing.
+func allocate(total int64, weights []int64) []int64 {
+	shares := make([]int64, len(weights))
+	var sum int64
+	for _, w := range weights {
+		sum += w
+	}
+	if sum <= 0 || total == 0 {
+		return shares
+	}
+	bigTotal, bigSum := big.NewInt(total), big.NewInt(sum)
+	remainders := make([]int64, len(weights))
+	left := total
+	for i, w := range weights {
+		q, m := new(big.Int).QuoRem(new(big.Int).Mul(bigTotal, big.NewInt(w)), bigSum, new(big.Int))
+		shares[i], remainders[i] = q.Int64(), m.Int64()
+		left -= shares[i]
+	}
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]] > remainders[order[b]]
+	})
+	for _, i := range order {
+		if left <= 0 {
+			break
+		}
+		if weights[i] > 0 {
+			shares[i]++
+			left--
+		}
+	}
+	return shares
+}
@@ -0,0 +1,56 @@
+package biz
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestAllocate(t *testing.T) {
+	tests := []struct {
+		name    string
+		total   int64
+		weights []int64
+		want    []int64
+	}{
+		{"no weights", 100, nil, []int64{}},
+		{"zero total", 0, []int64{1, 2}, []int64{0, 0}},
+		{"zero weights", 100, []int64{0, 0}, []int64{0, 0}},
+		{"exact", 60, []int64{1, 2, 3}, []int64{10, 20, 30}},
+		// 100/3 each, the cent left goes to the first of equal remainders.
+		{"equal remainders", 100, []int64{1, 1, 1}, []int64{34, 33, 33}},
+		// 10*1/6 = 1.67 and 10*5/6 = 8.33, the cent goes to the larger remainder.
+		{"largest remainder", 10, []int64{1, 5}, []int64{2, 8}},
+		{"zero weight gets nothing", 10, []int64{0, 3, 0, 3}, []int64{0, 5, 0, 5}},
+		{"one cent", 1, []int64{500, 300, 200}, []int64{1, 0, 0}},
+		{"large", math.MaxInt64 / 2, []int64{math.MaxInt64 / 2, math.MaxInt64 / 2}, []int64{math.MaxInt64/4 + 1, math.MaxInt64 / 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := allocate(tt.total, tt.weights); !slices.Equal(got, tt.want) {
+				t.Errorf("allocate(%d, %v) = %v, want %v", tt.total, tt.weights, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAllocateSumsToTotal(t *testing.T) {
+	weights := []int64{1999, 1, 333, 0, 4567, 89}
+	var paid int64
+	for _, w := range weights {
+		paid += w
+	}
+	for total := int64(0); total <= paid; total++ {
+		shares := allocate(total, weights)
+		var sum int64
+		for i, s := range shares {
+			if s < 0 || s > weights[i] {
+				t.Fatalf("allocate(%d, %v) = %v, share %d out of its weight", total, weights, shares, i)
+			}
+			sum += s
+		}
+		if sum != total {
+			t.Fatalf("allocate(%d, %v) = %v, sums to %d", total, weights, shares, sum)
+		}
+	}
+}
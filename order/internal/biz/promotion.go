@@ -0,0 +1,483 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	v1 "github.com/go-kratos/kratos-layout/order/api/order/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrInvalidPromotion is returned for a promotion whose rules make no sense.
+	ErrInvalidPromotion = errors.BadRequest(v1.ErrorReason_INVALID_PROMOTION.String(), "invalid promotion")
+	// ErrPromotionNotFound is promotion not found.
+	ErrPromotionNotFound = errors.NotFound(v1.ErrorReason_PROMOTION_NOT_FOUND.String(), "promotion not found")
+	// ErrCouponUnavailable is returned for a coupon unknown, of another user,
+	// used, or not valid now.
+	ErrCouponUnavailable = errors.BadRequest(v1.ErrorReason_COUPON_UNAVAILABLE.String(), "coupon unavailable")
+	// ErrInvalidCoupon is returned when issuing a coupon whose rules make no sense.
+	ErrInvalidCoupon = errors.BadRequest(v1.ErrorReason_INVALID_COUPON.String(), "invalid coupon")
+)
+
+// PromotionKind is the kind of a promotion.
+type PromotionKind string
+
+const (
+	// PromotionFullReduction (满减) takes the reduction of the highest tier
+	// the items reach.
+	PromotionFullReduction PromotionKind = "full_reduction"
+	// PromotionPercentage takes a percent off the items.
+	PromotionPercentage PromotionKind = "percentage"
+	// PromotionFreeShipping waives the shipping fee of a merchant whose
+	// items reach MinAmount.
+	PromotionFreeShipping PromotionKind = "free_shipping"
+)
+
+// Scope is the items a promotion or coupon applies to. An empty list
+// matches every item, an item must match each list set.
+type Scope struct {
+	SkuIDs      []int64
+	SpuIDs      []int64
+	MerchantIDs []int64
+}
+
+func (s *Scope) matches(it *PriceItem) bool {
+	return matchesAny(s.SkuIDs, it.SkuID) && matchesAny(s.SpuIDs, it.SpuID) && matchesAny(s.MerchantIDs, it.MerchantID)
+}
+
+func matchesAny(ids []int64, id int64) bool {
+	if len(ids) == 0 {
+		return true
+	}
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Tier is a threshold of a full reduction and what reaching it takes off.
+type Tier struct {
+	Threshold int64
+	Reduction int64
+}
+
+// Promotion is a promotion run on the items of orders.
+type Promotion struct {
+	ID    int64
+	Name  string
+	Kind  PromotionKind
+	Scope Scope
+	Tiers []Tier
+	// Repeat takes the reduction of a single tier for each time its
+	// threshold is reached, up to MaxReduction when set.
+	Repeat       bool
+	MaxReduction int64
+	// Percent is the percent a PromotionPercentage takes off.
+	Percent int32
+	// MinAmount and MinQuantity are what the items in scope must reach.
+	MinAmount   int64
+	MinQuantity int32
+	// ExclusiveGroup makes the promotions and coupons of a group not stack.
+	ExclusiveGroup string
+	StartAt        time.Time
+	EndAt          time.Time
+	Enabled        bool
+	CreatedAt      time.Time
+}
+
+func (p *Promotion) validate() error {
+	if p.Name == "" || !p.EndAt.After(p.StartAt) || p.MinAmount < 0 || p.MinQuantity < 0 {
+		return ErrInvalidPromotion
+	}
+	switch p.Kind {
+	case PromotionFullReduction:
+		if len(p.Tiers) == 0 || (p.Repeat && len(p.Tiers) != 1) || p.MaxReduction < 0 {
+			return ErrInvalidPromotion
+		}
+		for _, t := range p.Tiers {
+			if t.Threshold <= 0 || t.Reduction <= 0 || t.Reduction > t.Threshold {
+				return ErrInvalidPromotion
+			}
+		}
+	case PromotionPercentage:
+		if p.Percent < 1 || p.Percent > 99 {
+			return ErrInvalidPromotion
+		}
+	case PromotionFreeShipping:
+	default:
+		return ErrInvalidPromotion
+	}
+	return nil
+}
+
+// reduction is what a full reduction takes off items costing subtotal.
+func (p *Promotion) reduction(subtotal int64, _ int32) int64 {
+	if p.Repeat {
+		t := p.Tiers[0]
+		r := subtotal / t.Threshold * t.Reduction
+		if p.MaxReduction > 0 {
+			r = min(r, p.MaxReduction)
+		}
+		return r
+	}
+	var r int64
+	for _, t := range p.Tiers {
+		if subtotal >= t.Threshold && t.Reduction > r {
+			r = t.Reduction
+		}
+	}
+	return r
+}
+
+// PromotionRepo is a Promotion repo.
+type PromotionRepo interface {
+	Save(context.Context, *Promotion) (*Promotion, error)
+	SetEnabled(ctx context.Context, id int64, enabled bool) (*Promotion, error)
+	// List lists promotions, newest first, only those running at now unless
+	// now is zero.
+	List(ctx context.Context, now time.Time, page, pageSize int) ([]*Promotion, int64, error)
+	// ListActive lists the enabled promotions running at now, by id.
+	ListActive(ctx context.Context, now time.Time) ([]*Promotion, error)
+}
+
+// MemberPriceRepo keeps the prices of SKUs for members.
+type MemberPriceRepo interface {
+	// Set sets the prices of SKUs by id for a level, removing those of 0.
+	Set(ctx context.Context, level int32, prices map[int64]int64) error
+	// Lowest returns by SKU the lowest price of the levels up to level.
+	Lowest(ctx context.Context, level int32, skuIDs []int64) (map[int64]int64, error)
+}
+
+// CouponKind is the kind of a coupon.
+type CouponKind string
+
+const (
+	CouponAmountOff    CouponKind = "amount_off"
+	CouponPercentOff   CouponKind = "percent_off"
+	CouponFreeShipping CouponKind = "free_shipping"
+)
+
+// CouponStatus is the status of a coupon.
+type CouponStatus string
+
+const (
+	CouponAvailable CouponStatus = "available"
+	// CouponUsed is a coupon used by an order, given back if it is cancelled.
+	CouponUsed CouponStatus = "used"
+)
+
+// Coupon is a coupon of a user discounting the items of an order, unlike
+// the cash coupons of the members service spent on payment.
+type Coupon struct {
+	ID     int64
+	Code   string
+	UserID int64
+	Title  string
+	Kind   CouponKind
+	Amount int64
+	// Percent is the percent a CouponPercentOff takes off, at most MaxDiscount
+	// when set.
+	Percent     int32
+	MaxDiscount int64
+	// MinAmount is the least the items in scope must cost.
+	MinAmount      int64
+	Scope          Scope
+	ExclusiveGroup string
+	StartAt        time.Time
+	ExpireAt       time.Time
+	Status         CouponStatus
+	// OrderNo is the parent order that used the coupon.
+	OrderNo   string
+	CreatedAt time.Time
+}
+
+func (c *Coupon) validate() error {
+	if c.UserID <= 0 || c.Title == "" || !c.ExpireAt.After(c.StartAt) || c.MinAmount < 0 || c.MaxDiscount < 0 {
+		return ErrInvalidCoupon
+	}
+	switch c.Kind {
+	case CouponAmountOff:
+		if c.Amount <= 0 {
+			return ErrInvalidCoupon
+		}
+	case CouponPercentOff:
+		if c.Percent < 1 || c.Percent > 99 {
+			return ErrInvalidCoupon
+		}
+	case CouponFreeShipping:
+	default:
+		return ErrInvalidCoupon
+	}
+	return nil
+}
+
+// CouponRepo is a Coupon repo.
+type CouponRepo interface {
+	Save(context.Context, *Coupon) (*Coupon, error)
+	FindByCodes(ctx context.Context, codes []string) ([]*Coupon, error)
+	// ListByUser lists the coupons of a user, soonest to expire first, of
+	// every status when status is empty.
+	ListByUser(ctx context.Context, userID int64, status CouponStatus) ([]*Coupon, error)
+	// Use marks available coupons of a user used by an order, all or none,
+	// or returns ErrCouponUnavailable.
+	Use(ctx context.Context, userID int64, codes []string, orderNo string) error
+	// Release makes the coupons used by an order available again.
+	Release(ctx context.Context, orderNo string) error
+}
+
+// FreightRepo prices the freight of items with the shipping templates of
+// their merchants.
+type FreightRepo interface {
+	// Quote returns the freight of the items of each merchant shipped to a
+	// province, by merchant, with the default rules of the templates for
+	// none. It returns ErrRegionNotDelivered for items not shipped there.
+	Quote(ctx context.Context, province string, items []*PriceItem) (map[int64]int64, error)
+}
+
+// PriceRequest asks for items to be priced for a user.
+type PriceRequest struct {
+	// UserID is 0 for a guest, who cannot use coupons.
+	UserID      int64
+	MemberLevel int32
+	Items       []*PriceItem
+	CouponCodes []string
+	// Address is where the items are shipped, nil for a cart priced before
+	// it is known.
+	Address *Address
+}
+
+// PromotionUsecase is a Promotion usecase, pricing items against member
+// prices, the promotions running and coupons.
+type PromotionUsecase struct {
+	promotions   PromotionRepo
+	memberPrices MemberPriceRepo
+	coupons      CouponRepo
+	freight      FreightRepo
+	log          *log.Helper
+}
+
+// NewPromotionUsecase new a Promotion usecase.
+func NewPromotionUsecase(promotions PromotionRepo, memberPrices MemberPriceRepo, coupons CouponRepo, freight FreightRepo, logger log.Logger) *PromotionUsecase {
+	return &PromotionUsecase{
+		promotions:   promotions,
+		memberPrices: memberPrices,
+		coupons:      coupons,
+		freight:      freight,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// CreatePromotion creates a promotion, enabled.
+func (uc *PromotionUsecase) CreatePromotion(ctx context.Context, p *Promotion) (*Promotion, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	p.Enabled = true
+	p, err := uc.promotions.Save(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("CreatePromotion: %d %s %s", p.ID, p.Kind, p.Name)
+	return p, nil
+}
+
+// ListPromotions lists promotions, newest first, only those running now
+// when active.
+func (uc *PromotionUsecase) ListPromotions(ctx context.Context, active bool, page, pageSize int) ([]*Promotion, int64, error) {
+	page, pageSize = pagination(page, pageSize)
+	var now time.Time
+	if active {
+		now = time.Now()
+	}
+	return uc.promotions.List(ctx, now, page, pageSize)
+}
+
+// SetPromotionEnabled enables or disables a promotion.
+func (uc *PromotionUsecase) SetPromotionEnabled(ctx context.Context, id int64, enabled bool) (*Promotion, error) {
+	return uc.promotions.SetEnabled(ctx, id, enabled)
+}
+
+// SetMemberPrices sets the prices of SKUs by id for members of a level and
+// above, removing those of 0.
+func (uc *PromotionUsecase) SetMemberPrices(ctx context.Context, level int32, prices map[int64]int64) error {
+	if level <= 0 {
+		return ErrInvalidPromotion
+	}
+	for skuID, p := range prices {
+		if skuID <= 0 || p < 0 {
+			return ErrInvalidPromotion
+		}
+	}
+	return uc.memberPrices.Set(ctx, level, prices)
+}
+
+// IssueCoupon issues a coupon to a user.
+func (uc *PromotionUsecase) IssueCoupon(ctx context.Context, c *Coupon) (*Coupon, error) {
+	if c.StartAt.IsZero() {
+		c.StartAt = time.Now()
+	}
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+	c.Code = NewCouponCode()
+	c.Status = CouponAvailable
+	c, err := uc.coupons.Save(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("IssueCoupon: %s %s to user %d", c.Code, c.Kind, c.UserID)
+	return c, nil
+}
+
+// ListCoupons lists the coupons of a user, soonest to expire first.
+func (uc *PromotionUsecase) ListCoupons(ctx context.Context, userID int64, status CouponStatus) ([]*Coupon, error) {
+	return uc.coupons.ListByUser(ctx, userID, status)
+}
+
+// Price prices items against the member prices of the level, the promotions
+// running and the coupons asked for, which must all be usable by the user,
+// with the freight of their merchants to the address. Coupons that end up
+// giving nothing are left out of the result.
+func (uc *PromotionUsecase) Price(ctx context.Context, req *PriceRequest) (*PriceResult, error) {
+	now := time.Now()
+	coupons, err := uc.usableCoupons(ctx, req.UserID, req.CouponCodes, now)
+	if err != nil {
+		return nil, err
+	}
+	promotions, err := uc.promotions.ListActive(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	memberPrices := map[int64]int64{}
+	if req.MemberLevel > 0 && len(req.Items) > 0 {
+		ids := make([]int64, 0, len(req.Items))
+		for _, it := range req.Items {
+			ids = append(ids, it.SkuID)
+		}
+		if memberPrices, err = uc.memberPrices.Lowest(ctx, req.MemberLevel, ids); err != nil {
+			return nil, err
+		}
+	}
+	offers := make([]*offer, 0, len(promotions)+len(coupons))
+	for _, p := range promotions {
+		offers = append(offers, promotionOffer(p))
+	}
+	for _, c := range coupons {
+		offers = append(offers, couponOffer(c))
+	}
+	fees, err := uc.quote(ctx, req.Address, req.Items)
+	if err != nil {
+		return nil, err
+	}
+	return price(req.Items, memberPrices, offers, fees), nil
+}
+
+// PriceFlashSale prices an item bought in a flash sale. The flash sale takes
+// no other offer, its price coming off what the shop sells the item for as
+// a discount unless the shop sells it for less. The freight is that of the
+// item to the address.
+func (uc *PromotionUsecase) PriceFlashSale(ctx context.Context, item *PriceItem, f *FlashSale, addr *Address) (*PriceResult, error) {
+	fees, err := uc.quote(ctx, addr, []*PriceItem{item})
+	if err != nil {
+		return nil, err
+	}
+	r := price([]*PriceItem{item}, nil, nil, fees)
+	if item.Price <= f.Price {
+		return r, nil
+	}
+	d := &Discount{
+		Kind:   DiscountFlashSale,
+		Source: "flash_sale:" + strconv.FormatInt(f.ID, 10),
+		Title:  "flash sale",
+		Amount: (item.Price - f.Price) * int64(item.Quantity),
+	}
+	r.Lines[0].discount(d)
+	r.DiscountAmount += d.Amount
+	r.PayAmount -= d.Amount
+	return r, nil
+}
+
+// quote returns the freight of items to addr by merchant.
+func (uc *PromotionUsecase) quote(ctx context.Context, addr *Address, items []*PriceItem) (map[int64]int64, error) {
+	if len(items) == 0 {
+		return nil, nil
+	}
+	var province string
+	if addr != nil {
+		province = addr.Province
+	}
+	return uc.freight.Quote(ctx, province, items)
+}
+
+// usableCoupons returns the coupons of codes, in order, failing unless each
+// is available to the user now.
+func (uc *PromotionUsecase) usableCoupons(ctx context.Context, userID int64, codes []string, now time.Time) ([]*Coupon, error) {
+	codes = dedupe(codes)
+	if len(codes) == 0 {
+		return nil, nil
+	}
+	if userID <= 0 {
+		return nil, ErrCouponUnavailable
+	}
+	found, err := uc.coupons.FindByCodes(ctx, codes)
+	if err != nil {
+		return nil, err
+	}
+	byCode := make(map[string]*Coupon, len(found))
+	for _, c := range found {
+		byCode[c.Code] = c
+	}
+	coupons := make([]*Coupon, 0, len(codes))
+	for _, code := range codes {
+		c, ok := byCode[code]
+		if !ok || c.UserID != userID || c.Status != CouponAvailable || now.Before(c.StartAt) || !now.Before(c.ExpireAt) {
+			return nil, ErrCouponUnavailable.WithMetadata(map[string]string{"code": code})
+		}
+		coupons = append(coupons, c)
+	}
+	return coupons, nil
+}
+
+// UseCoupons marks the coupons of an order used, all or none.
+func (uc *PromotionUsecase) UseCoupons(ctx context.Context, userID int64, codes []string, orderNo string) error {
+	if len(codes) == 0 {
+		return nil
+	}
+	return uc.coupons.Use(ctx, userID, codes, orderNo)
+}
+
+// ReleaseCoupons gives back the coupons used by an order.
+func (uc *PromotionUsecase) ReleaseCoupons(ctx context.Context, orderNo string) error {
+	return uc.coupons.Release(ctx, orderNo)
+}
+
+// NewCouponCode returns a coupon code hard to guess.
+func NewCouponCode() string {
+	n, err := rand.Int(rand.Reader, big.NewInt(1e12))
+	if err != nil {
+		panic(err)
+	}
+	return fmt.Sprintf("C%s%012d", time.Now().Format("060102"), n.Int64())
+}
+
+// dedupe returns ids without the empty and repeated ones, in order.
+func dedupe(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			out = append(out, id)
+		}
+	}
+	return out
+}
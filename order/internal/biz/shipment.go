@@ -0,0 +1,267 @@
+package biz
+
+import (
+	"context"
+	stderrors "errors"
+	"sort"
+	"time"
+
+	v1 "github.com/go-kratos/kratos-layout/order/api/order/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrRegionNotDelivered is returned when pricing items to an address
+	// their shipping templates do not deliver to.
+	ErrRegionNotDelivered = errors.BadRequest(v1.ErrorReason_REGION_NOT_DELIVERED.String(), "region not delivered")
+	// ErrInvalidShipment is returned for a parcel without tracking number or
+	// of a carrier not supported.
+	ErrInvalidShipment = errors.BadRequest(v1.ErrorReason_INVALID_SHIPMENT.String(), "invalid shipment")
+	// ErrShipmentExists is returned when saving a parcel an order is shipped
+	// with already.
+	ErrShipmentExists = errors.Conflict(v1.ErrorReason_SHIPMENT_EXISTS.String(), "shipment exists")
+)
+
+// ErrShipmentChanged is returned by the Update of a repo when the shipment
+// changed since it was read.
+var ErrShipmentChanged = stderrors.New("shipment changed concurrently")
+
+const (
+	// trackingWindow is how long after they are shipped parcels not
+	// delivered are tracked.
+	trackingWindow = 30 * 24 * time.Hour
+	// trackingBatch is how many parcels one round of tracking asks the
+	// carriers for.
+	trackingBatch       = 200
+	maxCarrierLength    = 32
+	maxTrackingNoLength = 64
+)
+
+// ShipmentStatus is the status of a parcel, that of its latest tracking
+// event.
+type ShipmentStatus string
+
+const (
+	// ShipmentShipped is a parcel handed to the carrier, not tracked yet.
+	ShipmentShipped    ShipmentStatus = "shipped"
+	ShipmentInTransit  ShipmentStatus = "in_transit"
+	ShipmentDelivering ShipmentStatus = "delivering"
+	ShipmentDelivered  ShipmentStatus = "delivered"
+	// ShipmentException is a parcel held up, returned or lost, tracked on
+	// until delivered.
+	ShipmentException ShipmentStatus = "exception"
+)
+
+// TrackingEvent is a step of a parcel reported by its carrier.
+type TrackingEvent struct {
+	Status      ShipmentStatus
+	Location    string
+	Description string
+	OccurredAt  time.Time
+}
+
+// Shipment is a parcel an order is shipped in, an order being shipped in
+// one or more.
+type Shipment struct {
+	ID         int64
+	OrderNo    string
+	MerchantID int64
+	Carrier    string
+	TrackingNo string
+	Status     ShipmentStatus
+	// Events are the tracking events of the parcel, oldest first.
+	Events []*TrackingEvent
+	// CheckedAt is when the carrier was last asked for tracking events.
+	CheckedAt   time.Time
+	DeliveredAt time.Time
+	Version     int64
+	CreatedAt   time.Time
+}
+
+func (s *Shipment) validate() error {
+	// The carrier and the tracking number name files and paths of the
+	// carriers, they take letters, digits, '-' and '_' only.
+	if !validCode(s.Carrier, maxCarrierLength) || !validCode(s.TrackingNo, maxTrackingNoLength) {
+		return ErrInvalidShipment
+	}
+	return nil
+}
+
+func validCode(s string, maxLen int) bool {
+	if s == "" || len(s) > maxLen {
+		return false
+	}
+	for _, c := range s {
+		if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '-' || c == '_') {
+			return false
+		}
+	}
+	return true
+}
+
+// latest is when the latest tracking event of the parcel occurred.
+func (s *Shipment) latest() time.Time {
+	if len(s.Events) == 0 {
+		return time.Time{}
+	}
+	return s.Events[len(s.Events)-1].OccurredAt
+}
+
+// ShipmentRepo is a shipment repo.
+type ShipmentRepo interface {
+	// Save saves a parcel, or returns ErrShipmentExists for one of the same
+	// carrier and tracking number shipping the order.
+	Save(ctx context.Context, s *Shipment) (*Shipment, error)
+	// Update saves the status and times of a parcel at its version and
+	// bumps it, or returns ErrShipmentChanged.
+	Update(ctx context.Context, s *Shipment) error
+	// AddEvents appends tracking events to a parcel.
+	AddEvents(ctx context.Context, shipmentID int64, events []*TrackingEvent) error
+	// ListByOrder lists the parcels of an order with their events, first
+	// shipped first.
+	ListByOrder(ctx context.Context, orderNo string) ([]*Shipment, error)
+	// ListToTrack lists up to limit parcels not delivered shipped after
+	// shippedAfter and checked before checkedBefore, least recently
+	// checked first.
+	ListToTrack(ctx context.Context, shippedAfter, checkedBefore time.Time, limit int) ([]*Shipment, error)
+}
+
+// CarrierAdapter asks the carriers for the tracking events of parcels.
+type CarrierAdapter interface {
+	// Supports reports whether orders may be shipped with a carrier.
+	Supports(carrier string) bool
+	// Track returns the tracking events of a parcel known to its carrier,
+	// none for a parcel it has not seen yet.
+	Track(ctx context.Context, carrier, trackingNo string) ([]*TrackingEvent, error)
+}
+
+// ShipmentUsecase is a shipment usecase. Orders are shipped in parcels of
+// carriers, whose tracking events are taken in as the carriers are polled.
+type ShipmentUsecase struct {
+	shipments ShipmentRepo
+	orders    *OrderUsecase
+	carriers  CarrierAdapter
+	log       *log.Helper
+}
+
+// NewShipmentUsecase new a shipment usecase.
+func NewShipmentUsecase(shipments ShipmentRepo, orders *OrderUsecase, carriers CarrierAdapter, logger log.Logger) *ShipmentUsecase {
+	return &ShipmentUsecase{
+		shipments: shipments,
+		orders:    orders,
+		carriers:  carriers,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// ShipOrder marks a paid order as shipped in a parcel of a carrier. A
+// shipped order gets another parcel, shipping a parcel again changes
+// nothing.
+func (uc *ShipmentUsecase) ShipOrder(ctx context.Context, orderNo, carrier, trackingNo string) (*Order, error) {
+	s := &Shipment{OrderNo: orderNo, Carrier: carrier, TrackingNo: trackingNo, Status: ShipmentShipped}
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+	if !uc.carriers.Supports(carrier) {
+		return nil, ErrInvalidShipment
+	}
+	o, err := uc.orders.repo.FindByOrderNo(ctx, orderNo)
+	if err != nil {
+		return nil, err
+	}
+	s.MerchantID = o.MerchantID
+	if o.Status == StatusShipped {
+		if _, err := uc.shipments.Save(ctx, s); err != nil && !errors.Is(err, ErrShipmentExists) {
+			return nil, err
+		}
+		return o, nil
+	}
+	o.ShippedAt = time.Now()
+	err = uc.orders.states.Transit(ctx, o, StatusShipped, "shipped", func(ctx context.Context) error {
+		_, err := uc.shipments.Save(ctx, s)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("ShipOrder: %s by %s %s", o.OrderNo, carrier, trackingNo)
+	return o, nil
+}
+
+// ListShipments lists the parcels of an order, first shipped first.
+func (uc *ShipmentUsecase) ListShipments(ctx context.Context, orderNo string) ([]*Shipment, error) {
+	if _, err := uc.orders.repo.FindByOrderNo(ctx, orderNo); err != nil {
+		return nil, err
+	}
+	return uc.shipments.ListByOrder(ctx, orderNo)
+}
+
+// SyncShipments asks the carriers for the tracking events of a batch of
+// parcels not delivered and not checked since checkedBefore, and returns
+// how many it checked. A parcel the carrier fails for counts as checked,
+// to be asked for again once checkedBefore passes it.
+func (uc *ShipmentUsecase) SyncShipments(ctx context.Context, checkedBefore time.Time) (int, error) {
+	now := time.Now()
+	ss, err := uc.shipments.ListToTrack(ctx, now.Add(-trackingWindow), checkedBefore, trackingBatch)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, s := range ss {
+		if err := uc.track(ctx, s, now); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+// track takes in the tracking events of a parcel newer than those it has,
+// its status becoming that of the latest. A parcel tracked concurrently is
+// left to the other.
+func (uc *ShipmentUsecase) track(ctx context.Context, s *Shipment, now time.Time) error {
+	events, err := uc.carriers.Track(ctx, s.Carrier, s.TrackingNo)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		uc.log.WithContext(ctx).Warnf("Track %s %s of order %s: %v", s.Carrier, s.TrackingNo, s.OrderNo, err)
+	}
+	latest := s.latest()
+	var fresh []*TrackingEvent
+	for _, e := range events {
+		if e.OccurredAt.After(latest) {
+			fresh = append(fresh, e)
+		}
+	}
+	sort.SliceStable(fresh, func(i, j int) bool { return fresh[i].OccurredAt.Before(fresh[j].OccurredAt) })
+	for _, e := range fresh {
+		s.Status = e.Status
+		if e.Status == ShipmentDelivered && s.DeliveredAt.IsZero() {
+			s.DeliveredAt = e.OccurredAt
+		}
+	}
+	s.CheckedAt = now
+	err = uc.orders.states.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := uc.shipments.Update(ctx, s); err != nil {
+			return err
+		}
+		if len(fresh) == 0 {
+			return nil
+		}
+		return uc.shipments.AddEvents(ctx, s.ID, fresh)
+	})
+	if errors.Is(err, ErrShipmentChanged) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	s.Events = append(s.Events, fresh...)
+	if len(fresh) > 0 {
+		uc.log.WithContext(ctx).Infof("Track %s %s of order %s: %s", s.Carrier, s.TrackingNo, s.OrderNo, s.Status)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package biz
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+
+	v1 "github.com/go-kratos/kratos-layout/order/api/order/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ErrOrderConflict is returned when an order changed since it was read.
+var ErrOrderConflict = errors.Conflict(v1.ErrorReason_ORDER_VERSION_CONFLICT.String(), "order changed concurrently")
+
+// IllegalTransition is returned for a move the order state machine does not
+// allow from the status an order is in.
+func IllegalTransition(from, to Status) error {
+	switch {
+	case from == StatusCancelled && to != StatusRefunding:
+		return ErrOrderCancelled
+	case from != StatusPending && to == StatusPaid:
+		return ErrOrderPaid
+	}
+	return errors.Conflict(v1.ErrorReason_ILLEGAL_ORDER_TRANSITION.String(), "illegal order transition").
+		WithMetadata(map[string]string{"from": string(from), "to": string(to)})
+}
+
+// transitions are the moves the order state machine allows:
+//
+//	PENDING_PAYMENT → PAID → SHIPPED → COMPLETED
+//	       │           └───────┴──────────┴─→ REFUNDING → CANCELLED
+//	       └─────────────────────────────────────────────→ CANCELLED ─→ REFUNDING
+//
+// A refunding order falls back to where it was when refunded in part. A
+// cancelled order is refunded when its payment arrives after all.
+var transitions = map[Status][]Status{
+	StatusPending:   {StatusPaid, StatusCancelled},
+	StatusPaid:      {StatusShipped, StatusRefunding},
+	StatusShipped:   {StatusCompleted, StatusRefunding},
+	StatusCompleted: {StatusRefunding},
+	StatusRefunding: {StatusPaid, StatusShipped, StatusCompleted, StatusCancelled},
+	StatusCancelled: {StatusRefunding},
+}
+
+// CanTransit reports whether an order may move from one status to another.
+func CanTransit(from, to Status) bool {
+	for _, s := range transitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Transition is a move of an order recorded in its audit trail.
+type Transition struct {
+	ID      int64
+	OrderNo string
+	From    Status
+	To      Status
+	// Event is what caused the move, such as "payment_succeeded".
+	Event string
+	// Version is the version of the order after the move.
+	Version   int64
+	CreatedAt time.Time
+}
+
+// TransitionRepo is the audit trail of order transitions.
+type TransitionRepo interface {
+	Add(context.Context, *Transition) error
+	ListByOrderNo(ctx context.Context, orderNo string) ([]*Transition, error)
+}
+
+// StateMachine moves orders between statuses. Every move is guarded by the
+// allowed transitions, saved with optimistic locking on the order version,
+// and recorded in the audit trail in the same transaction, so that commands
+// and payment events racing on an order cannot overwrite each other.
+type StateMachine struct {
+	repo        OrderRepo
+	transitions TransitionRepo
+	tx          Transaction
+	log         *log.Helper
+}
+
+// NewStateMachine new an order StateMachine.
+func NewStateMachine(repo OrderRepo, transitions TransitionRepo, tx Transaction, logger log.Logger) *StateMachine {
+	return &StateMachine{repo: repo, transitions: transitions, tx: tx, log: log.NewHelper(logger)}
+}
+
+// Transit moves o to status to for event, saving the other fields changed
+// on o with it. fn, if not nil, runs in the same transaction. It returns
+// ErrOrderConflict when o is stale, and leaves o's status and version as
+// they were on failure.
+func (m *StateMachine) Transit(ctx context.Context, o *Order, to Status, event string, fn func(ctx context.Context) error) error {
+	from, version := o.Status, o.Version
+	if !CanTransit(from, to) {
+		return IllegalTransition(from, to)
+	}
+	err := m.tx.InTx(ctx, func(ctx context.Context) error {
+		o.Status = to
+		if err := m.repo.Update(ctx, o); err != nil {
+			return err
+		}
+		if err := m.transitions.Add(ctx, &Transition{
+			OrderNo: o.OrderNo,
+			From:    from,
+			To:      to,
+			Event:   event,
+			Version: o.Version,
+		}); err != nil {
+			return err
+		}
+		if fn != nil {
+			return fn(ctx)
+		}
+		return nil
+	})
+	if err != nil {
+		o.Status, o.Version = from, version
+		if stderrors.Is(err, ErrOrderChanged) {
+			return ErrOrderConflict
+		}
+		return err
+	}
+	m.log.WithContext(ctx).Infof("Order %s: %s -> %s on %s", o.OrderNo, from, to, event)
+	return nil
+}
@@ -0,0 +1,142 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// TopicPayTimeout is the delay queue topic of the cancellations of unpaid
+// orders, its tasks are parent order numbers.
+const TopicPayTimeout = "order_pay_timeout"
+
+const (
+	// defaultPayTimeout is how long an order waits for payment when
+	// OrderPolicy leaves it unset.
+	defaultPayTimeout = 30 * time.Minute
+	// expireAttempts bounds the attempts to cancel an order racing with
+	// its payment.
+	expireAttempts = 3
+	// pendingBatch is how many pending orders one page of a reschedule reads.
+	pendingBatch = 500
+)
+
+// OrderPolicy is how orders are handled.
+type OrderPolicy struct {
+	// PayTimeout is how long an order waits for payment before it is cancelled.
+	PayTimeout time.Duration
+}
+
+// DelayQueue runs tasks once they are due, at least once each.
+type DelayQueue interface {
+	// Schedule schedules task id of topic for at, moving it when scheduled
+	// already.
+	Schedule(ctx context.Context, topic, id string, at time.Time) error
+	// Subscribe calls handle for each due task of topic until ctx is done.
+	// A task handle fails for is retried later.
+	Subscribe(ctx context.Context, topic string, handle func(ctx context.Context, id string) error) error
+}
+
+// OnCancelled registers fn to be called once an order is cancelled, to give
+// back what it held. It may be called again for the same order and must be
+// idempotent. Register listeners while wiring, before any order is served.
+func (uc *OrderUsecase) OnCancelled(fn func(context.Context, *Order)) {
+	uc.cancelled = append(uc.cancelled, fn)
+}
+
+func (uc *OrderUsecase) notifyCancelled(ctx context.Context, o *Order) {
+	for _, fn := range uc.cancelled {
+		fn(ctx, o)
+	}
+}
+
+// OnPaid registers fn to be called once the orders of a parent order are
+// paid. The payment event is delivered again while fn fails, so it may be
+// called again for the same parent order and must be idempotent. Register
+// listeners while wiring, before any order is served.
+func (uc *OrderUsecase) OnPaid(fn func(context.Context, *ParentOrder) error) {
+	uc.paidListeners = append(uc.paidListeners, fn)
+}
+
+func (uc *OrderUsecase) notifyPaid(ctx context.Context, p *ParentOrder) error {
+	for _, fn := range uc.paidListeners {
+		if err := fn(ctx, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnRefunded registers fn to be called once a refund of an order is added
+// to it. The event of the refund is delivered again while fn fails, so it
+// may be called again for the same refund and must be idempotent. Register
+// listeners while wiring, before any order is served.
+func (uc *OrderUsecase) OnRefunded(fn func(context.Context, *Order, *OrderRefund) error) {
+	uc.refundListeners = append(uc.refundListeners, fn)
+}
+
+func (uc *OrderUsecase) notifyRefunded(ctx context.Context, o *Order, r *OrderRefund) error {
+	for _, fn := range uc.refundListeners {
+		if err := fn(ctx, o, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Expire cancels the orders of a parent order still unpaid once it expired.
+// A payment landing at the same moment wins: the cancellation finds the
+// orders paid and gives up, or the payment finds them cancelled and is
+// refunded.
+func (uc *OrderUsecase) Expire(ctx context.Context, parentNo string) error {
+	for attempt := 1; ; attempt++ {
+		p, err := uc.repo.FindParent(ctx, parentNo)
+		if errors.Is(err, ErrParentOrderNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if len(p.pending()) == 0 {
+			return nil
+		}
+		if time.Now().Before(p.ExpireAt) {
+			return uc.queue.Schedule(ctx, TopicPayTimeout, p.ParentNo, p.ExpireAt)
+		}
+		err = uc.cancelParent(ctx, p, "payment timeout", "pay_timeout")
+		if errors.Is(err, ErrOrderConflict) && attempt < expireAttempts {
+			continue
+		}
+		return err
+	}
+}
+
+// ReschedulePending schedules the cancellation of the parent order of every
+// pending order again, for queues that lose their tasks on restart.
+func (uc *OrderUsecase) ReschedulePending(ctx context.Context) (int, error) {
+	n := 0
+	var afterID int64
+	// The orders of a parent order are saved together, so they come in a row.
+	var scheduled string
+	for {
+		orders, err := uc.repo.ListPending(ctx, afterID, pendingBatch)
+		if err != nil {
+			return n, err
+		}
+		for _, o := range orders {
+			afterID = o.ID
+			if o.ParentNo == scheduled {
+				continue
+			}
+			if err := uc.queue.Schedule(ctx, TopicPayTimeout, o.ParentNo, o.ExpireAt); err != nil {
+				return n, err
+			}
+			scheduled = o.ParentNo
+			n++
+		}
+		if len(orders) < pendingBatch {
+			return n, nil
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AfterSale is the after_sales table.
+type AfterSale struct {
+	ID               int64           `gorm:"primaryKey"`
+	TicketNo         string          `gorm:"size:64;uniqueIndex"`
+	OrderNo          string          `gorm:"size:64;index"`
+	UserID           int64           `gorm:"index"`
+	MerchantID       int64           `gorm:"index"`
+	Kind             string          `gorm:"size:16"`
+	Status           string          `gorm:"size:16;index"`
+	Items            []AfterSaleItem `gorm:"foreignKey:TicketNo;references:TicketNo"`
+	RefundAmount     int64
+	Reason           string   `gorm:"size:255"`
+	Description      string   `gorm:"size:1024"`
+	Evidence         []string `gorm:"serializer:json;type:text"`
+	MerchantNote     string   `gorm:"size:1024"`
+	ReturnCarrier    string   `gorm:"size:64"`
+	ReturnTrackingNo string   `gorm:"size:64"`
+	ReshipCarrier    string   `gorm:"size:64"`
+	ReshipTrackingNo string   `gorm:"size:64"`
+	RefundNo         string   `gorm:"size:64"`
+	Deadline         *time.Time
+	Version          int64
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	ReviewedAt       *time.Time
+	ReturnedAt       *time.Time
+	ReceivedAt       *time.Time
+	CompletedAt      *time.Time
+}
+
+// AfterSaleItem is the after_sale_items table.
+type AfterSaleItem struct {
+	ID       int64  `gorm:"primaryKey"`
+	TicketNo string `gorm:"size:64;index"`
+	SkuID    int64
+	Quantity int32
+	Amount   int64
+}
+
+// NewAfterSalePolicy returns the configured after-sale policy.
+func NewAfterSalePolicy(c *conf.Order) *biz.AfterSalePolicy {
+	a := c.GetAfterSale()
+	return &biz.AfterSalePolicy{
+		Window:         a.GetWindow().AsDuration(),
+		ReviewTimeout:  a.GetReviewTimeout().AsDuration(),
+		ReturnTimeout:  a.GetReturnTimeout().AsDuration(),
+		ReceiveTimeout: a.GetReceiveTimeout().AsDuration(),
+	}
+}
+
+type afterSaleRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewAfterSaleRepo .
+func NewAfterSaleRepo(data *Data, logger log.Logger) biz.AfterSaleRepo {
+	return &afterSaleRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *afterSaleRepo) Save(ctx context.Context, a *biz.AfterSale) (*biz.AfterSale, error) {
+	po := toAfterSalePO(a)
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return nil, err
+	}
+	return toAfterSale(po), nil
+}
+
+func (r *afterSaleRepo) Update(ctx context.Context, a *biz.AfterSale) error {
+	po := toAfterSalePO(a)
+	po.Version++
+	res := r.data.DB(ctx).Model(po).Where("version = ?", a.Version).
+		Select("status", "merchant_note", "return_carrier", "return_tracking_no", "reship_carrier",
+			"reship_tracking_no", "refund_no", "deadline", "version", "updated_at",
+			"reviewed_at", "returned_at", "received_at", "completed_at").
+		Omit(clause.Associations).Updates(po)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return biz.ErrAfterSaleConflict
+	}
+	a.Version = po.Version
+	return nil
+}
+
+func (r *afterSaleRepo) FindByTicketNo(ctx context.Context, ticketNo string) (*biz.AfterSale, error) {
+	var po AfterSale
+	err := r.data.DB(ctx).Preload("Items").Where("ticket_no = ?", ticketNo).First(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrAfterSaleNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toAfterSale(&po), nil
+}
+
+func (r *afterSaleRepo) ListByOrderNo(ctx context.Context, orderNo string) ([]*biz.AfterSale, error) {
+	var pos []*AfterSale
+	if err := r.data.DB(ctx).Preload("Items").Where("order_no = ?", orderNo).Order("id").Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	rv := make([]*biz.AfterSale, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toAfterSale(po))
+	}
+	return rv, nil
+}
+
+func (r *afterSaleRepo) List(ctx context.Context, filter *biz.AfterSaleFilter, page, pageSize int) ([]*biz.AfterSale, int64, error) {
+	db := r.data.DB(ctx).Model(&AfterSale{})
+	if filter.UserID != 0 {
+		db = db.Where("user_id = ?", filter.UserID)
+	}
+	if filter.MerchantID != 0 {
+		db = db.Where("merchant_id = ?", filter.MerchantID)
+	}
+	if filter.OrderNo != "" {
+		db = db.Where("order_no = ?", filter.OrderNo)
+	}
+	if filter.Status != "" {
+		db = db.Where("status = ?", string(filter.Status))
+	}
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var pos []*AfterSale
+	if err := db.Preload("Items").Order("id DESC").Offset((page - 1) * pageSize).Limit(pageSize).Find(&pos).Error; err != nil {
+		return nil, 0, err
+	}
+	rv := make([]*biz.AfterSale, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toAfterSale(po))
+	}
+	return rv, total, nil
+}
+
+func (r *afterSaleRepo) ListWithDeadline(ctx context.Context, afterID int64, limit int) ([]*biz.AfterSale, error) {
+	var pos []*AfterSale
+	err := r.data.DB(ctx).Where("deadline IS NOT NULL AND id > ?", afterID).
+		Order("id").Limit(limit).Find(&pos).Error
+	if err != nil {
+		return nil, err
+	}
+	rv := make([]*biz.AfterSale, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toAfterSale(po))
+	}
+	return rv, nil
+}
+
+func toAfterSalePO(a *biz.AfterSale) *AfterSale {
+	po := &AfterSale{
+		ID:               a.ID,
+		TicketNo:         a.TicketNo,
+		OrderNo:          a.OrderNo,
+		UserID:           a.UserID,
+		MerchantID:       a.MerchantID,
+		Kind:             string(a.Kind),
+		Status:           string(a.Status),
+		RefundAmount:     a.RefundAmount,
+		Reason:           a.Reason,
+		Description:      a.Description,
+		Evidence:         a.Evidence,
+		MerchantNote:     a.MerchantNote,
+		ReturnCarrier:    a.ReturnCarrier,
+		ReturnTrackingNo: a.ReturnTrackingNo,
+		ReshipCarrier:    a.ReshipCarrier,
+		ReshipTrackingNo: a.ReshipTrackingNo,
+		RefundNo:         a.RefundNo,
+		Deadline:         timePtr(a.Deadline),
+		Version:          a.Version,
+		CreatedAt:        a.CreatedAt,
+		ReviewedAt:       timePtr(a.ReviewedAt),
+		ReturnedAt:       timePtr(a.ReturnedAt),
+		ReceivedAt:       timePtr(a.ReceivedAt),
+		CompletedAt:      timePtr(a.CompletedAt),
+	}
+	for _, it := range a.Items {
+		po.Items = append(po.Items, AfterSaleItem{
+			TicketNo: a.TicketNo,
+			SkuID:    it.SkuID,
+			Quantity: it.Quantity,
+			Amount:   it.Amount,
+		})
+	}
+	return po
+}
+
+func toAfterSale(po *AfterSale) *biz.AfterSale {
+	a := &biz.AfterSale{
+		ID:               po.ID,
+		TicketNo:         po.TicketNo,
+		OrderNo:          po.OrderNo,
+		UserID:           po.UserID,
+		MerchantID:       po.MerchantID,
+		Kind:             biz.AfterSaleKind(po.Kind),
+		Status:           biz.AfterSaleStatus(po.Status),
+		RefundAmount:     po.RefundAmount,
+		Reason:           po.Reason,
+		Description:      po.Description,
+		Evidence:         po.Evidence,
+		MerchantNote:     po.MerchantNote,
+		ReturnCarrier:    po.ReturnCarrier,
+		ReturnTrackingNo: po.ReturnTrackingNo,
+		ReshipCarrier:    po.ReshipCarrier,
+		ReshipTrackingNo: po.ReshipTrackingNo,
+		RefundNo:         po.RefundNo,
+		Deadline:         timeValue(po.Deadline),
+		Version:          po.Version,
+		CreatedAt:        po.CreatedAt,
+		ReviewedAt:       timeValue(po.ReviewedAt),
+		ReturnedAt:       timeValue(po.ReturnedAt),
+		ReceivedAt:       timeValue(po.ReceivedAt),
+		CompletedAt:      timeValue(po.CompletedAt),
+	}
+	for _, it := range po.Items {
+		a.Items = append(a.Items, &biz.AfterSaleItem{
+			SkuID:    it.SkuID,
+			Quantity: it.Quantity,
+			Amount:   it.Amount,
+		})
+	}
+	return a
+}
@@ -0,0 +1,224 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// defaultGuestCartTTL is how long a guest cart is kept after its last
+	// change by default.
+	defaultGuestCartTTL = 7 * 24 * time.Hour
+	// cartKeyPrefix prefixes the hash of each cart.
+	cartKeyPrefix = "order:cart:"
+	// cartAttempts bounds the attempts to change a cart changing meanwhile.
+	cartAttempts = 5
+)
+
+// errCartBusy is returned when a cart kept changing under a change.
+var errCartBusy = errors.New("cart changed concurrently, try again")
+
+// NewCartRepo returns the cart repo of the configured kind.
+func NewCartRepo(c *conf.Data, data *Data, logger log.Logger) (biz.CartRepo, error) {
+	ttl := c.GetCart().GetGuestTtl().AsDuration()
+	if ttl <= 0 {
+		ttl = defaultGuestCartTTL
+	}
+	switch kind := c.GetCart().GetKind(); kind {
+	case "", "redis":
+		return &cartRepo{data: data, guestTTL: ttl, log: log.NewHelper(logger)}, nil
+	case "memory":
+		return NewMemoryCartRepo(), nil
+	default:
+		return nil, fmt.Errorf("unknown cart repo %q", kind)
+	}
+}
+
+// cartRepo keeps each cart in a hash of its items by SKU id, changed
+// optimistically under WATCH.
+type cartRepo struct {
+	data     *Data
+	guestTTL time.Duration
+	log      *log.Helper
+}
+
+// cartValue is a cart item as kept in its hash field.
+type cartValue struct {
+	Quantity   int32 `json:"q"`
+	Selected   bool  `json:"s"`
+	AddedPrice int64 `json:"p"`
+	AddedAt    int64 `json:"t"`
+}
+
+func cartKey(owner biz.CartOwner) string {
+	return cartKeyPrefix + owner.Key()
+}
+
+func (r *cartRepo) Items(ctx context.Context, owner biz.CartOwner) ([]*biz.CartItem, error) {
+	return r.items(ctx, r.data.rdb, cartKey(owner))
+}
+
+func (r *cartRepo) items(ctx context.Context, c redis.Cmdable, key string) ([]*biz.CartItem, error) {
+	fields, err := c.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*biz.CartItem, 0, len(fields))
+	for f, v := range fields {
+		skuID, err := strconv.ParseInt(f, 10, 64)
+		if err != nil {
+			r.log.WithContext(ctx).Warnf("cart %s: skipping field %q", key, f)
+			continue
+		}
+		var cv cartValue
+		if err := json.Unmarshal([]byte(v), &cv); err != nil {
+			r.log.WithContext(ctx).Warnf("cart %s: skipping sku %d: %v", key, skuID, err)
+			continue
+		}
+		items = append(items, &biz.CartItem{
+			SkuID:      skuID,
+			Quantity:   cv.Quantity,
+			Selected:   cv.Selected,
+			AddedPrice: cv.AddedPrice,
+			AddedAt:    time.UnixMilli(cv.AddedAt),
+		})
+	}
+	return items, nil
+}
+
+// replace queues the writes replacing the cart of owner with items.
+func (r *cartRepo) replace(ctx context.Context, pipe redis.Pipeliner, owner biz.CartOwner, items []*biz.CartItem) error {
+	key := cartKey(owner)
+	pipe.Del(ctx, key)
+	if len(items) == 0 {
+		return nil
+	}
+	values := make([]interface{}, 0, 2*len(items))
+	for _, it := range items {
+		v, err := json.Marshal(&cartValue{
+			Quantity:   it.Quantity,
+			Selected:   it.Selected,
+			AddedPrice: it.AddedPrice,
+			AddedAt:    it.AddedAt.UnixMilli(),
+		})
+		if err != nil {
+			return err
+		}
+		values = append(values, strconv.FormatInt(it.SkuID, 10), v)
+	}
+	pipe.HSet(ctx, key, values...)
+	if owner.IsGuest() {
+		pipe.Expire(ctx, key, r.guestTTL)
+	}
+	return nil
+}
+
+// watch runs fn under WATCH of keys, again while they change meanwhile.
+func (r *cartRepo) watch(ctx context.Context, fn func(tx *redis.Tx) error, keys ...string) error {
+	for i := 0; i < cartAttempts; i++ {
+		err := r.data.rdb.Watch(ctx, fn, keys...)
+		if !errors.Is(err, redis.TxFailedErr) {
+			return err
+		}
+	}
+	return errCartBusy
+}
+
+func (r *cartRepo) Update(ctx context.Context, owner biz.CartOwner, fn func([]*biz.CartItem) ([]*biz.CartItem, error)) error {
+	key := cartKey(owner)
+	return r.watch(ctx, func(tx *redis.Tx) error {
+		items, err := r.items(ctx, tx, key)
+		if err != nil {
+			return err
+		}
+		if items, err = fn(items); err != nil {
+			return err
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			return r.replace(ctx, pipe, owner, items)
+		})
+		return err
+	}, key)
+}
+
+func (r *cartRepo) Merge(ctx context.Context, from, to biz.CartOwner, fn func(from, to []*biz.CartItem) []*biz.CartItem) error {
+	fromKey, toKey := cartKey(from), cartKey(to)
+	return r.watch(ctx, func(tx *redis.Tx) error {
+		fromItems, err := r.items(ctx, tx, fromKey)
+		if err != nil || len(fromItems) == 0 {
+			return err
+		}
+		toItems, err := r.items(ctx, tx, toKey)
+		if err != nil {
+			return err
+		}
+		items := fn(fromItems, toItems)
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Del(ctx, fromKey)
+			return r.replace(ctx, pipe, to, items)
+		})
+		return err
+	}, fromKey, toKey)
+}
+
+// memoryCartRepo keeps carts in process, for tests. Guest carts never expire.
+type memoryCartRepo struct {
+	mu    sync.Mutex
+	carts map[string][]*biz.CartItem
+}
+
+// NewMemoryCartRepo .
+func NewMemoryCartRepo() biz.CartRepo {
+	return &memoryCartRepo{carts: make(map[string][]*biz.CartItem)}
+}
+
+func (r *memoryCartRepo) Items(_ context.Context, owner biz.CartOwner) ([]*biz.CartItem, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return copyCartItems(r.carts[owner.Key()]), nil
+}
+
+func (r *memoryCartRepo) Update(_ context.Context, owner biz.CartOwner, fn func([]*biz.CartItem) ([]*biz.CartItem, error)) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	items, err := fn(copyCartItems(r.carts[owner.Key()]))
+	if err != nil {
+		return err
+	}
+	r.carts[owner.Key()] = copyCartItems(items)
+	return nil
+}
+
+func (r *memoryCartRepo) Merge(_ context.Context, from, to biz.CartOwner, fn func(from, to []*biz.CartItem) []*biz.CartItem) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	fromItems := r.carts[from.Key()]
+	if len(fromItems) == 0 {
+		return nil
+	}
+	items := fn(copyCartItems(fromItems), copyCartItems(r.carts[to.Key()]))
+	r.carts[to.Key()] = copyCartItems(items)
+	delete(r.carts, from.Key())
+	return nil
+}
+
+// copyCartItems copies items, so that callers never share them with the repo.
+func copyCartItems(items []*biz.CartItem) []*biz.CartItem {
+	cp := make([]*biz.CartItem, 0, len(items))
+	for _, it := range items {
+		c := *it
+		cp = append(cp, &c)
+	}
+	return cp
+}
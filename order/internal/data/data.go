@@ -1,24 +1,122 @@
 package data
 
 import (
+	"context"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
 	"github.com/go-kratos/kratos-layout/internal/conf"
+	"github.com/go-kratos/kratos-layout/pkg/saga"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewGreeterRepo)
+var ProviderSet = wire.NewSet(
+	NewData,
+	NewTransaction,
+	NewGreeterRepo,
+	NewOrderRepo,
+	NewTransitionRepo,
+	NewPaymentClient,
+	NewPaymentRepo,
+	NewInventoryClient,
+	NewStockRepo,
+	NewEventSubscriber,
+	NewDelayQueue,
+	NewOrderPolicy,
+	NewCartRepo,
+	NewCatalogClient,
+	NewSkuRepo,
+	NewPromotionRepo,
+	NewMemberPriceRepo,
+	NewCouponRepo,
+	NewAfterSaleRepo,
+	NewAfterSalePolicy,
+	NewSagaOrchestrator,
+	NewInvoiceRepo,
+	NewInvoiceProvider,
+	NewFlashSalePolicy,
+	NewFlashSaleClient,
+	NewFlashSaleRepo,
+	NewFlashSaleStore,
+	NewFreightClient,
+	NewFreightRepo,
+	NewShipmentRepo,
+	NewCarrierAdapter,
+)
 
 // Data .
 type Data struct {
-	// TODO wrapped database client
+	db  *gorm.DB
+	rdb *redis.Client
 }
 
+type contextTxKey struct{}
+
 // NewData .
 func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
+	db, err := gorm.Open(mysql.Open(c.Database.Source), &gorm.Config{TranslateError: true})
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := db.AutoMigrate(
+		&ParentOrder{},
+		&Order{},
+		&OrderItem{},
+		&OrderRefund{},
+		&Transition{},
+		&OrderDiscount{},
+		&Promotion{},
+		&MemberPrice{},
+		&Coupon{},
+		&AfterSale{},
+		&AfterSaleItem{},
+		&saga.Record{},
+		&InvoiceTitle{},
+		&Invoice{},
+		&InvoiceOrder{},
+		&Shipment{},
+		&TrackingEvent{},
+	); err != nil {
+		return nil, nil, err
+	}
+	rdb := redis.NewClient(&redis.Options{
+		Network:      c.Redis.Network,
+		Addr:         c.Redis.Addr,
+		ReadTimeout:  c.Redis.ReadTimeout.AsDuration(),
+		WriteTimeout: c.Redis.WriteTimeout.AsDuration(),
+	})
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
+		_ = rdb.Close()
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	}
+	return &Data{db: db, rdb: rdb}, cleanup, nil
+}
+
+// NewTransaction .
+func NewTransaction(d *Data) biz.Transaction {
+	return d
+}
+
+// InTx runs fn in a transaction, repos called with the ctx passed to fn join
+// it. Called within a transaction it nests as a savepoint.
+func (d *Data) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return d.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, contextTxKey{}, tx))
+	})
+}
+
+// DB returns the transaction carried by ctx, or the plain handle outside one.
+func (d *Data) DB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value(contextTxKey{}).(*gorm.DB); ok {
+		return tx
 	}
-	return &Data{}, cleanup, nil
+	return d.db.WithContext(ctx)
 }
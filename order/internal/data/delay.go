@@ -0,0 +1,244 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// defaultDelayTick is how often due tasks are looked for by default.
+	defaultDelayTick = time.Second
+	// delayRetry is how long a task handle failed for waits to be retried.
+	delayRetry = 30 * time.Second
+	// delayBatch is how many due tasks one redis round claims at most.
+	delayBatch = 100
+	// delayKeyPrefix prefixes the sorted set of each topic.
+	delayKeyPrefix = "order:delay:"
+	// wheelSlots is the number of slots of the timing wheel.
+	wheelSlots = 3600
+)
+
+// NewOrderPolicy .
+func NewOrderPolicy(c *conf.Order) *biz.OrderPolicy {
+	return &biz.OrderPolicy{
+		PayTimeout: c.GetPayTimeout().AsDuration(),
+	}
+}
+
+// NewDelayQueue returns the delay queue of the configured kind.
+func NewDelayQueue(c *conf.Data, data *Data, logger log.Logger) (biz.DelayQueue, func(), error) {
+	tick := c.GetDelayQueue().GetTick().AsDuration()
+	if tick <= 0 {
+		tick = defaultDelayTick
+	}
+	switch kind := c.GetDelayQueue().GetKind(); kind {
+	case "", "wheel":
+		w := newTimingWheel(tick, wheelSlots, logger)
+		return w, w.stop, nil
+	case "redis":
+		return &redisDelayQueue{data: data, tick: tick, log: log.NewHelper(logger)}, func() {}, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown delay queue %q", kind)
+	}
+}
+
+// timingWheel is an in-process hashed timing wheel. A task sits in the slot
+// its due tick falls in, with the rounds of the wheel left before it is due.
+// Tasks are lost when the process stops.
+type timingWheel struct {
+	tick  time.Duration
+	mu    sync.Mutex
+	slots []map[string]*wheelTask
+	// tasks indexes the scheduled tasks by topic and id.
+	tasks    map[string]*wheelTask
+	pos      int
+	handlers map[string]func(ctx context.Context, id string) error
+	done     chan struct{}
+	log      *log.Helper
+}
+
+type wheelTask struct {
+	topic  string
+	id     string
+	slot   int
+	rounds int
+}
+
+func newTimingWheel(tick time.Duration, slots int, logger log.Logger) *timingWheel {
+	w := &timingWheel{
+		tick:     tick,
+		slots:    make([]map[string]*wheelTask, slots),
+		tasks:    make(map[string]*wheelTask),
+		handlers: make(map[string]func(ctx context.Context, id string) error),
+		done:     make(chan struct{}),
+		log:      log.NewHelper(logger),
+	}
+	for i := range w.slots {
+		w.slots[i] = make(map[string]*wheelTask)
+	}
+	go w.run()
+	return w
+}
+
+func (w *timingWheel) Schedule(_ context.Context, topic, id string, at time.Time) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	key := topic + ":" + id
+	if t, ok := w.tasks[key]; ok {
+		delete(w.slots[t.slot], key)
+	}
+	ticks := int((time.Until(at) + w.tick - 1) / w.tick)
+	if ticks < 1 {
+		ticks = 1
+	}
+	t := &wheelTask{
+		topic:  topic,
+		id:     id,
+		slot:   (w.pos + ticks) % len(w.slots),
+		rounds: (ticks - 1) / len(w.slots),
+	}
+	w.slots[t.slot][key] = t
+	w.tasks[key] = t
+	return nil
+}
+
+func (w *timingWheel) Subscribe(ctx context.Context, topic string, handle func(ctx context.Context, id string) error) error {
+	w.mu.Lock()
+	w.handlers[topic] = handle
+	w.mu.Unlock()
+	<-ctx.Done()
+	w.mu.Lock()
+	delete(w.handlers, topic)
+	w.mu.Unlock()
+	return nil
+}
+
+func (w *timingWheel) run() {
+	ticker := time.NewTicker(w.tick)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			w.advance()
+		case <-w.done:
+			return
+		}
+	}
+}
+
+// advance moves the wheel one tick and hands the tasks due to their
+// handlers, rescheduling those that fail or have none yet.
+func (w *timingWheel) advance() {
+	w.mu.Lock()
+	w.pos = (w.pos + 1) % len(w.slots)
+	var due []*wheelTask
+	for key, t := range w.slots[w.pos] {
+		if t.rounds > 0 {
+			t.rounds--
+			continue
+		}
+		delete(w.slots[w.pos], key)
+		delete(w.tasks, key)
+		due = append(due, t)
+	}
+	handlers := make(map[string]func(ctx context.Context, id string) error, len(w.handlers))
+	for topic, h := range w.handlers {
+		handlers[topic] = h
+	}
+	w.mu.Unlock()
+	if len(due) == 0 {
+		return
+	}
+	go func() {
+		ctx := context.Background()
+		for _, t := range due {
+			h, ok := handlers[t.topic]
+			if ok {
+				err := h(ctx, t.id)
+				if err == nil {
+					continue
+				}
+				w.log.Errorf("delayed task %s %s: %v", t.topic, t.id, err)
+			}
+			_ = w.Schedule(ctx, t.topic, t.id, time.Now().Add(delayRetry))
+		}
+	}()
+}
+
+func (w *timingWheel) stop() {
+	close(w.done)
+}
+
+// claimScript takes the tasks due of a sorted set and pushes them back by
+// the retry delay, so that a task whose handler dies is retried then.
+var claimScript = redis.NewScript(`
+local ids = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[3])
+for _, id in ipairs(ids) do
+  redis.call('ZADD', KEYS[1], ARGV[2], id)
+end
+return ids
+`)
+
+// doneScript removes a task handled, unless it was scheduled again meanwhile.
+var doneScript = redis.NewScript(`
+if redis.call('ZSCORE', KEYS[1], ARGV[1]) == ARGV[2] then
+  return redis.call('ZREM', KEYS[1], ARGV[1])
+end
+return 0
+`)
+
+// redisDelayQueue keeps the tasks of each topic in a sorted set scored by
+// when they are due, shared by every instance.
+type redisDelayQueue struct {
+	data *Data
+	tick time.Duration
+	log  *log.Helper
+}
+
+func (q *redisDelayQueue) Schedule(ctx context.Context, topic, id string, at time.Time) error {
+	return q.data.rdb.ZAdd(ctx, delayKeyPrefix+topic, redis.Z{Score: float64(at.UnixMilli()), Member: id}).Err()
+}
+
+func (q *redisDelayQueue) Subscribe(ctx context.Context, topic string, handle func(ctx context.Context, id string) error) error {
+	key := delayKeyPrefix + topic
+	ticker := time.NewTicker(q.tick)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil
+		}
+		for ctx.Err() == nil {
+			now := time.Now()
+			ids, err := claimScript.Run(ctx, q.data.rdb, []string{key},
+				now.UnixMilli(), now.Add(delayRetry).UnixMilli(), delayBatch).StringSlice()
+			if err != nil {
+				q.log.Errorf("claim delayed tasks %s: %v", topic, err)
+				break
+			}
+			for _, id := range ids {
+				if err := handle(ctx, id); err != nil {
+					q.log.Errorf("delayed task %s %s: %v", topic, id, err)
+					continue
+				}
+				claimed := strconv.FormatInt(now.Add(delayRetry).UnixMilli(), 10)
+				if err := doneScript.Run(ctx, q.data.rdb, []string{key}, id, claimed).Err(); err != nil {
+					q.log.Errorf("remove delayed task %s %s: %v", topic, id, err)
+				}
+			}
+			if len(ids) < delayBatch {
+				break
+			}
+		}
+	}
+}
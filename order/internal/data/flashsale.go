@@ -0,0 +1,466 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/conf"
+	shopv1 "github.com/go-kratos/kratos-layout/shop/api/shop/v1"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// flashSaleKeyPrefix prefixes the flash sale keys, its hash tag keeping
+	// them in one slot for the scripts to touch them together.
+	flashSaleKeyPrefix = "order:{flashsale}:"
+	// flashSaleQueueKey lists the attempts admitted to place.
+	flashSaleQueueKey = flashSaleKeyPrefix + "queue"
+	// attemptSeqKey numbers the attempts admitted.
+	attemptSeqKey = flashSaleKeyPrefix + "attempt:seq"
+	// flashSalePlacingKey lists the attempts being placed.
+	flashSalePlacingKey = flashSaleKeyPrefix + "queue:processing"
+	// attemptLease is how long an attempt taken stays with its taker
+	// unless renewed, before Requeue queues it again.
+	attemptLease = 30 * time.Second
+	// flashSaleBatch is how many flash sales one page of a listing reads.
+	flashSaleBatch = 100
+	// admitAttempts bounds the attempts at an attempt number not taken.
+	admitAttempts = 3
+)
+
+// NewFlashSalePolicy .
+func NewFlashSalePolicy(c *conf.Order) *biz.FlashSalePolicy {
+	f := c.GetFlashSale()
+	return &biz.FlashSalePolicy{
+		WarmAhead:  f.GetWarmAhead().AsDuration(),
+		Difficulty: f.GetDifficulty(),
+		Retention:  f.GetRetention().AsDuration(),
+	}
+}
+
+// NewFlashSaleClient .
+func NewFlashSaleClient(c *conf.Data) (shopv1.FlashSaleClient, func(), error) {
+	opts := []grpc.ClientOption{
+		grpc.WithEndpoint(c.Shop.Endpoint),
+		grpc.WithMiddleware(recovery.Recovery()),
+	}
+	if c.Shop.Timeout != nil {
+		opts = append(opts, grpc.WithTimeout(c.Shop.Timeout.AsDuration()))
+	}
+	conn, err := grpc.DialInsecure(context.Background(), opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return shopv1.NewFlashSaleClient(conn), func() { _ = conn.Close() }, nil
+}
+
+type flashSaleRepo struct {
+	client shopv1.FlashSaleClient
+	log    *log.Helper
+}
+
+// NewFlashSaleRepo .
+func NewFlashSaleRepo(client shopv1.FlashSaleClient, logger log.Logger) biz.FlashSaleRepo {
+	return &flashSaleRepo{
+		client: client,
+		log:    log.NewHelper(logger),
+	}
+}
+
+func (r *flashSaleRepo) GetFlashSale(ctx context.Context, id int64) (*biz.FlashSale, error) {
+	reply, err := r.client.GetFlashSale(ctx, &shopv1.GetFlashSaleRequest{Id: id})
+	if e := kerrors.FromError(err); e != nil && e.Reason == shopv1.ErrorReason_FLASH_SALE_NOT_FOUND.String() {
+		return nil, biz.ErrFlashSaleNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toFlashSale(reply), nil
+}
+
+func (r *flashSaleRepo) ListStarting(ctx context.Context, before time.Time) ([]*biz.FlashSale, error) {
+	var rv []*biz.FlashSale
+	for page := int32(1); ; page++ {
+		reply, err := r.client.ListFlashSales(ctx, &shopv1.ListFlashSalesRequest{
+			StartsBefore: timestamppb.New(before),
+			Page:         page,
+			PageSize:     flashSaleBatch,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range reply.FlashSales {
+			rv = append(rv, toFlashSale(f))
+		}
+		if len(reply.FlashSales) < flashSaleBatch {
+			return rv, nil
+		}
+	}
+}
+
+func toFlashSale(f *shopv1.FlashSaleInfo) *biz.FlashSale {
+	return &biz.FlashSale{
+		ID:           f.Id,
+		MerchantID:   f.MerchantId,
+		SkuID:        f.SkuId,
+		Price:        f.Price,
+		Stock:        f.Stock,
+		PerUserLimit: f.PerUserLimit,
+		StartAt:      f.StartAt.AsTime(),
+		EndAt:        f.EndAt.AsTime(),
+		Cancelled:    f.Status == shopv1.FlashSaleStatus_CANCELLED,
+	}
+}
+
+// warmFlashSaleScript loads a flash sale, and its stock unless it started
+// and took some already.
+//
+// KEYS: flash sale, stock. ARGV: SKU, limit, start, end, expiry, stock,
+// cancelled, now; times in unix milliseconds.
+var warmFlashSaleScript = redis.NewScript(`
+redis.call('HSET', KEYS[1], 'sku', ARGV[1], 'limit', ARGV[2], 'start', ARGV[3], 'end', ARGV[4], 'expire', ARGV[5], 'cancelled', ARGV[7])
+redis.call('PEXPIREAT', KEYS[1], ARGV[5])
+if tonumber(ARGV[8]) < tonumber(ARGV[3]) then
+  redis.call('SET', KEYS[2], ARGV[6])
+else
+  redis.call('SETNX', KEYS[2], ARGV[6])
+end
+redis.call('PEXPIREAT', KEYS[2], ARGV[5])
+return 1
+`)
+
+// challengeScript saves the challenge token of a buyer for a flash sale
+// loaded unless one is saved, and returns the one saved.
+//
+// KEYS: flash sale, challenge. ARGV: token.
+var challengeScript = redis.NewScript(`
+local expire = redis.call('HGET', KEYS[1], 'expire')
+if not expire then
+  return false
+end
+redis.call('HSETNX', KEYS[2], 'token', ARGV[1])
+redis.call('PEXPIREAT', KEYS[2], expire)
+return redis.call('HGET', KEYS[2], 'token')
+`)
+
+// admitScript admits an attempt with a challenge token: it takes its
+// quantity off the stock of the flash sale and the limit of the buyer,
+// marks the token used by it, saves it and queues it. A token used returns
+// the attempt it admitted; an attempt number saved already returns taken,
+// leaving the attempt saved under it alone.
+//
+// KEYS: flash sale, stock, bought by buyer, challenge, attempt, queue.
+// ARGV: now in unix milliseconds, buyer, quantity, token, attempt number,
+// attempt.
+var admitScript = redis.NewScript(`
+local sale = redis.call('HMGET', KEYS[1], 'limit', 'start', 'end', 'expire', 'cancelled')
+if not sale[1] or sale[5] == '1' then
+  return {'not_found'}
+end
+local now = tonumber(ARGV[1])
+if now < tonumber(sale[2]) then
+  return {'not_started'}
+end
+if now >= tonumber(sale[3]) then
+  return {'ended'}
+end
+local challenge = redis.call('HMGET', KEYS[4], 'token', 'attempt')
+if challenge[1] ~= ARGV[4] then
+  return {'challenge'}
+end
+if challenge[2] then
+  return {'admitted', challenge[2]}
+end
+if redis.call('EXISTS', KEYS[5]) == 1 then
+  return {'taken'}
+end
+local quantity = tonumber(ARGV[3])
+if tonumber(redis.call('HGET', KEYS[3], ARGV[2]) or '0') + quantity > tonumber(sale[1]) then
+  return {'limit'}
+end
+if tonumber(redis.call('GET', KEYS[2]) or '0') < quantity then
+  return {'sold_out'}
+end
+redis.call('DECRBY', KEYS[2], quantity)
+redis.call('HINCRBY', KEYS[3], ARGV[2], quantity)
+redis.call('PEXPIREAT', KEYS[3], sale[4])
+redis.call('HSET', KEYS[4], 'attempt', ARGV[5])
+redis.call('HSET', KEYS[5], 'data', ARGV[6], 'status', 'queued')
+redis.call('PEXPIREAT', KEYS[5], sale[4])
+redis.call('RPUSH', KEYS[6], ARGV[5])
+return {'admitted', ARGV[5]}
+`)
+
+// settleScript records the outcome of an attempt queued, giving back the
+// quantity of a failed one and freeing its challenge token for the buyer to
+// join again with, and takes it off the list being placed.
+//
+// KEYS: attempt, stock, bought by buyer, list being placed, challenge.
+// ARGV: attempt number, status, parent order, failure reason, buyer,
+// quantity.
+var settleScript = redis.NewScript(`
+if redis.call('HGET', KEYS[1], 'status') == 'queued' then
+  redis.call('HSET', KEYS[1], 'status', ARGV[2], 'parent_no', ARGV[3], 'reason', ARGV[4])
+  if ARGV[2] == 'failed' and redis.call('EXISTS', KEYS[2]) == 1 then
+    redis.call('INCRBY', KEYS[2], ARGV[6])
+    redis.call('HINCRBY', KEYS[3], ARGV[5], -tonumber(ARGV[6]))
+  end
+  if ARGV[2] == 'failed' and redis.call('HGET', KEYS[5], 'attempt') == ARGV[1] then
+    redis.call('HDEL', KEYS[5], 'attempt')
+  end
+end
+redis.call('LREM', KEYS[4], 0, ARGV[1])
+return 1
+`)
+
+// returnScript gives back the quantity of a succeeded attempt once.
+//
+// KEYS: attempt, stock, bought by buyer. ARGV: buyer, quantity.
+var returnScript = redis.NewScript(`
+if redis.call('HGET', KEYS[1], 'status') ~= 'succeeded' or redis.call('HSETNX', KEYS[1], 'returned', '1') == 0 then
+  return 0
+end
+if redis.call('EXISTS', KEYS[2]) == 1 then
+  redis.call('INCRBY', KEYS[2], ARGV[2])
+  redis.call('HINCRBY', KEYS[3], ARGV[1], -tonumber(ARGV[2]))
+end
+return 1
+`)
+
+// leaseScript sets the lease of an attempt unless it expired.
+//
+// KEYS: attempt. ARGV: lease end in unix milliseconds.
+var leaseScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+  return 0
+end
+redis.call('HSET', KEYS[1], 'lease', ARGV[1])
+return 1
+`)
+
+// requeueScript queues again an attempt being placed whose lease ended. An
+// attempt without a lease, taken just now or by an instance that stopped
+// before leasing it, gets one, to be queued again once that ends too; one
+// expired is dropped.
+//
+// KEYS: list being placed, queue, attempt. ARGV: attempt number, now, lease
+// end in unix milliseconds.
+var requeueScript = redis.NewScript(`
+local lease = redis.call('HGET', KEYS[3], 'lease')
+if not lease then
+  if redis.call('EXISTS', KEYS[3]) == 0 then
+    redis.call('LREM', KEYS[1], 0, ARGV[1])
+  else
+    redis.call('HSET', KEYS[3], 'lease', ARGV[3])
+  end
+  return 0
+end
+if tonumber(lease) > tonumber(ARGV[2]) or redis.call('LREM', KEYS[1], 1, ARGV[1]) == 0 then
+  return 0
+end
+redis.call('HDEL', KEYS[3], 'lease')
+redis.call('LPUSH', KEYS[2], ARGV[1])
+return 1
+`)
+
+// admitErrors are the errors of the admissions turned down.
+var admitErrors = map[string]error{
+	"not_found":   biz.ErrFlashSaleNotFound,
+	"not_started": biz.ErrFlashSaleNotStarted,
+	"ended":       biz.ErrFlashSaleEnded,
+	"challenge":   biz.ErrInvalidChallenge,
+	"limit":       biz.ErrFlashSaleLimitExceeded,
+	"sold_out":    biz.ErrFlashSaleSoldOut,
+}
+
+// flashSaleStore keeps flash sales in redis, a hash for each with its stock
+// apart, the quantities each buyer bought in a hash, and each attempt in a
+// hash of its own with its fields as JSON and its outcome.
+type flashSaleStore struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewFlashSaleStore .
+func NewFlashSaleStore(data *Data, logger log.Logger) biz.FlashSaleStore {
+	return &flashSaleStore{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func flashSaleKey(kind string, id int64) string {
+	return flashSaleKeyPrefix + kind + ":" + strconv.FormatInt(id, 10)
+}
+
+func challengeKey(flashSaleID, userID int64) string {
+	return flashSaleKey("challenge", flashSaleID) + ":" + strconv.FormatInt(userID, 10)
+}
+
+func attemptKey(attemptNo string) string {
+	return flashSaleKeyPrefix + "attempt:" + attemptNo
+}
+
+func (s *flashSaleStore) Warm(ctx context.Context, f *biz.FlashSale, retention time.Duration) error {
+	cancelled := "0"
+	if f.Cancelled {
+		cancelled = "1"
+	}
+	return warmFlashSaleScript.Run(ctx, s.data.rdb,
+		[]string{flashSaleKey("sale", f.ID), flashSaleKey("stock", f.ID)},
+		f.SkuID, f.PerUserLimit, f.StartAt.UnixMilli(), f.EndAt.UnixMilli(), f.EndAt.Add(retention).UnixMilli(),
+		f.Stock, cancelled, time.Now().UnixMilli()).Err()
+}
+
+func (s *flashSaleStore) Challenge(ctx context.Context, flashSaleID, userID int64, token string) (string, error) {
+	saved, err := challengeScript.Run(ctx, s.data.rdb,
+		[]string{flashSaleKey("sale", flashSaleID), challengeKey(flashSaleID, userID)}, token).Text()
+	if errors.Is(err, redis.Nil) {
+		return "", biz.ErrFlashSaleNotFound
+	}
+	return saved, err
+}
+
+func (s *flashSaleStore) Admit(ctx context.Context, a *biz.FlashSaleAttempt, token string, now time.Time) (*biz.FlashSaleAttempt, error) {
+	for i := 0; i < admitAttempts; i++ {
+		seq, err := s.data.rdb.Incr(ctx, attemptSeqKey).Result()
+		if err != nil {
+			return nil, err
+		}
+		a.AttemptNo = fmt.Sprintf("F%s%06d", now.Format("20060102150405"), seq)
+		data, err := json.Marshal(a)
+		if err != nil {
+			return nil, err
+		}
+		keys := []string{
+			flashSaleKey("sale", a.FlashSaleID),
+			flashSaleKey("stock", a.FlashSaleID),
+			flashSaleKey("bought", a.FlashSaleID),
+			challengeKey(a.FlashSaleID, a.UserID),
+			attemptKey(a.AttemptNo),
+			flashSaleQueueKey,
+		}
+		vals, err := admitScript.Run(ctx, s.data.rdb, keys,
+			now.UnixMilli(), a.UserID, a.Quantity, token, a.AttemptNo, string(data)).StringSlice()
+		if err != nil {
+			return nil, err
+		}
+		if vals[0] == "taken" {
+			// The sequence started over, such as on a cache flushed.
+			s.log.WithContext(ctx).Warnf("Flash sale attempt %s: number taken", a.AttemptNo)
+			continue
+		}
+		if err, ok := admitErrors[vals[0]]; ok {
+			return nil, err
+		}
+		if vals[1] != a.AttemptNo {
+			return s.FindAttempt(ctx, vals[1])
+		}
+		return a, nil
+	}
+	return nil, fmt.Errorf("admit attempt of user %d: attempt numbers kept taken", a.UserID)
+}
+
+func (s *flashSaleStore) Next(ctx context.Context) (*biz.FlashSaleAttempt, error) {
+	no, err := s.data.rdb.BLMove(ctx, flashSaleQueueKey, flashSalePlacingKey, "LEFT", "RIGHT", time.Second).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Renew(ctx, no); err != nil {
+		// Left without a lease, Requeue gives it one and queues it again
+		// once that ends.
+		return nil, err
+	}
+	a, err := s.FindAttempt(ctx, no)
+	if errors.Is(err, biz.ErrFlashSaleAttemptNotFound) {
+		s.log.WithContext(ctx).Warnf("Flash sale attempt %s: expired before it was placed", no)
+		return nil, s.data.rdb.LRem(ctx, flashSalePlacingKey, 0, no).Err()
+	}
+	return a, err
+}
+
+func (s *flashSaleStore) Settle(ctx context.Context, a *biz.FlashSaleAttempt) error {
+	keys := []string{
+		attemptKey(a.AttemptNo),
+		flashSaleKey("stock", a.FlashSaleID),
+		flashSaleKey("bought", a.FlashSaleID),
+		flashSalePlacingKey,
+		challengeKey(a.FlashSaleID, a.UserID),
+	}
+	return settleScript.Run(ctx, s.data.rdb, keys,
+		a.AttemptNo, string(a.Status), a.ParentNo, a.FailureReason, a.UserID, a.Quantity).Err()
+}
+
+func (s *flashSaleStore) Return(ctx context.Context, attemptNo string) error {
+	a, err := s.FindAttempt(ctx, attemptNo)
+	if errors.Is(err, biz.ErrFlashSaleAttemptNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	keys := []string{
+		attemptKey(a.AttemptNo),
+		flashSaleKey("stock", a.FlashSaleID),
+		flashSaleKey("bought", a.FlashSaleID),
+	}
+	return returnScript.Run(ctx, s.data.rdb, keys, a.UserID, a.Quantity).Err()
+}
+
+func (s *flashSaleStore) Renew(ctx context.Context, attemptNo string) error {
+	return leaseScript.Run(ctx, s.data.rdb, []string{attemptKey(attemptNo)},
+		time.Now().Add(attemptLease).UnixMilli()).Err()
+}
+
+func (s *flashSaleStore) Requeue(ctx context.Context) (int, error) {
+	nos, err := s.data.rdb.LRange(ctx, flashSalePlacingKey, 0, -1).Result()
+	if err != nil {
+		return 0, err
+	}
+	now := time.Now()
+	n := 0
+	for _, no := range nos {
+		queued, err := requeueScript.Run(ctx, s.data.rdb,
+			[]string{flashSalePlacingKey, flashSaleQueueKey, attemptKey(no)},
+			no, now.UnixMilli(), now.Add(attemptLease).UnixMilli()).Int()
+		if err != nil {
+			return n, err
+		}
+		n += queued
+	}
+	return n, nil
+}
+
+func (s *flashSaleStore) FindAttempt(ctx context.Context, attemptNo string) (*biz.FlashSaleAttempt, error) {
+	vals, err := s.data.rdb.HMGet(ctx, attemptKey(attemptNo), "data", "status", "parent_no", "reason").Result()
+	if err != nil {
+		return nil, err
+	}
+	data, ok := vals[0].(string)
+	if !ok {
+		return nil, biz.ErrFlashSaleAttemptNotFound
+	}
+	var a biz.FlashSaleAttempt
+	if err := json.Unmarshal([]byte(data), &a); err != nil {
+		return nil, err
+	}
+	status, _ := vals[1].(string)
+	a.ParentNo, _ = vals[2].(string)
+	a.FailureReason, _ = vals[3].(string)
+	a.Status = biz.FlashSaleAttemptStatus(status)
+	return &a, nil
+}
@@ -0,0 +1,471 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// InvoiceTitle is the invoice_titles table.
+type InvoiceTitle struct {
+	ID          int64  `gorm:"primaryKey"`
+	UserID      int64  `gorm:"index"`
+	Kind        string `gorm:"size:16"`
+	Name        string `gorm:"size:128"`
+	TaxID       string `gorm:"size:32"`
+	Address     string `gorm:"size:255"`
+	Phone       string `gorm:"size:32"`
+	BankName    string `gorm:"size:128"`
+	BankAccount string `gorm:"size:64"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Invoice is the invoices table, with the title as it was when requested.
+type Invoice struct {
+	ID               int64          `gorm:"primaryKey"`
+	InvoiceNo        string         `gorm:"size:64;uniqueIndex"`
+	UserID           int64          `gorm:"index"`
+	MerchantID       int64          `gorm:"index"`
+	TitleKind        string         `gorm:"size:16"`
+	TitleName        string         `gorm:"size:128"`
+	TaxID            string         `gorm:"size:32"`
+	TitleAddress     string         `gorm:"size:255"`
+	TitlePhone       string         `gorm:"size:32"`
+	TitleBankName    string         `gorm:"size:128"`
+	TitleBankAccount string         `gorm:"size:64"`
+	Email            string         `gorm:"size:128"`
+	Orders           []InvoiceOrder `gorm:"foreignKey:InvoiceNo;references:InvoiceNo"`
+	Amount           int64
+	Status           string `gorm:"size:16;index"`
+	ReplacesNo       string `gorm:"size:64"`
+	ProviderNo       string `gorm:"size:64"`
+	FileURL          string `gorm:"size:512"`
+	FailReason       string `gorm:"size:255"`
+	Version          int64
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	IssuedAt         *time.Time
+	ReversedAt       *time.Time
+}
+
+// InvoiceOrder is the invoice_orders table.
+type InvoiceOrder struct {
+	ID        int64  `gorm:"primaryKey"`
+	InvoiceNo string `gorm:"size:64;index"`
+	OrderNo   string `gorm:"size:64;index"`
+	Amount    int64
+}
+
+// activeInvoiceStatuses are the statuses of the invoices an order has.
+var activeInvoiceStatuses = []string{string(biz.InvoiceIssuing), string(biz.InvoiceIssued)}
+
+type invoiceRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewInvoiceRepo .
+func NewInvoiceRepo(data *Data, logger log.Logger) biz.InvoiceRepo {
+	return &invoiceRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *invoiceRepo) SaveTitle(ctx context.Context, t *biz.InvoiceTitle) (*biz.InvoiceTitle, error) {
+	po := toInvoiceTitlePO(t)
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return nil, err
+	}
+	return toInvoiceTitle(po), nil
+}
+
+func (r *invoiceRepo) UpdateTitle(ctx context.Context, t *biz.InvoiceTitle) error {
+	po := toInvoiceTitlePO(t)
+	res := r.data.DB(ctx).Model(po).Where("user_id = ?", t.UserID).
+		Select("kind", "name", "tax_id", "address", "phone", "bank_name", "bank_account", "updated_at").
+		Updates(po)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return biz.ErrInvoiceTitleNotFound
+	}
+	return nil
+}
+
+func (r *invoiceRepo) DeleteTitle(ctx context.Context, userID, id int64) error {
+	res := r.data.DB(ctx).Where("id = ? AND user_id = ?", id, userID).Delete(&InvoiceTitle{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return biz.ErrInvoiceTitleNotFound
+	}
+	return nil
+}
+
+func (r *invoiceRepo) FindTitle(ctx context.Context, id int64) (*biz.InvoiceTitle, error) {
+	var po InvoiceTitle
+	err := r.data.DB(ctx).Where("id = ?", id).First(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrInvoiceTitleNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toInvoiceTitle(&po), nil
+}
+
+func (r *invoiceRepo) ListTitles(ctx context.Context, userID int64) ([]*biz.InvoiceTitle, error) {
+	var pos []*InvoiceTitle
+	if err := r.data.DB(ctx).Where("user_id = ?", userID).Order("id").Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	rv := make([]*biz.InvoiceTitle, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toInvoiceTitle(po))
+	}
+	return rv, nil
+}
+
+func (r *invoiceRepo) Save(ctx context.Context, inv *biz.Invoice) (*biz.Invoice, error) {
+	po := toInvoicePO(inv)
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return nil, err
+	}
+	return toInvoice(po), nil
+}
+
+func (r *invoiceRepo) Update(ctx context.Context, inv *biz.Invoice) error {
+	po := toInvoicePO(inv)
+	po.Version++
+	res := r.data.DB(ctx).Model(po).Where("version = ?", inv.Version).
+		Select("status", "provider_no", "file_url", "fail_reason", "version", "updated_at", "issued_at", "reversed_at").
+		Omit(clause.Associations).Updates(po)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return biz.ErrInvoiceConflict
+	}
+	inv.Version = po.Version
+	return nil
+}
+
+func (r *invoiceRepo) FindByInvoiceNo(ctx context.Context, invoiceNo string) (*biz.Invoice, error) {
+	var po Invoice
+	err := r.data.DB(ctx).Preload("Orders").Where("invoice_no = ?", invoiceNo).First(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrInvoiceNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toInvoice(&po), nil
+}
+
+func (r *invoiceRepo) FindActiveByOrderNo(ctx context.Context, orderNo string) (*biz.Invoice, error) {
+	db := r.data.DB(ctx)
+	var po Invoice
+	err := db.Preload("Orders").
+		Where("invoice_no IN (?)", db.Session(&gorm.Session{NewDB: true}).Model(&InvoiceOrder{}).Select("invoice_no").Where("order_no = ?", orderNo)).
+		Where("status IN ?", activeInvoiceStatuses).
+		Order("id DESC").First(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrInvoiceNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toInvoice(&po), nil
+}
+
+func (r *invoiceRepo) List(ctx context.Context, filter *biz.InvoiceFilter, page, pageSize int) ([]*biz.Invoice, int64, error) {
+	db := r.data.DB(ctx).Model(&Invoice{})
+	if filter.UserID != 0 {
+		db = db.Where("user_id = ?", filter.UserID)
+	}
+	if filter.MerchantID != 0 {
+		db = db.Where("merchant_id = ?", filter.MerchantID)
+	}
+	if filter.OrderNo != "" {
+		db = db.Where("invoice_no IN (?)", db.Session(&gorm.Session{NewDB: true}).Model(&InvoiceOrder{}).Select("invoice_no").Where("order_no = ?", filter.OrderNo))
+	}
+	if filter.Status != "" {
+		db = db.Where("status = ?", string(filter.Status))
+	}
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var pos []*Invoice
+	if err := db.Preload("Orders").Order("id DESC").Offset((page - 1) * pageSize).Limit(pageSize).Find(&pos).Error; err != nil {
+		return nil, 0, err
+	}
+	rv := make([]*biz.Invoice, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toInvoice(po))
+	}
+	return rv, total, nil
+}
+
+func (r *invoiceRepo) ListUnsettled(ctx context.Context, afterID int64, limit int) ([]*biz.Invoice, error) {
+	var pos []*Invoice
+	err := r.data.DB(ctx).
+		Where("status IN ? AND id > ?", []string{string(biz.InvoiceIssuing), string(biz.InvoiceReversing)}, afterID).
+		Order("id").Limit(limit).Find(&pos).Error
+	if err != nil {
+		return nil, err
+	}
+	rv := make([]*biz.Invoice, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toInvoice(po))
+	}
+	return rv, nil
+}
+
+func toInvoiceTitlePO(t *biz.InvoiceTitle) *InvoiceTitle {
+	return &InvoiceTitle{
+		ID:          t.ID,
+		UserID:      t.UserID,
+		Kind:        string(t.Kind),
+		Name:        t.Name,
+		TaxID:       t.TaxID,
+		Address:     t.Address,
+		Phone:       t.Phone,
+		BankName:    t.BankName,
+		BankAccount: t.BankAccount,
+		CreatedAt:   t.CreatedAt,
+	}
+}
+
+func toInvoiceTitle(po *InvoiceTitle) *biz.InvoiceTitle {
+	return &biz.InvoiceTitle{
+		ID:          po.ID,
+		UserID:      po.UserID,
+		Kind:        biz.InvoiceTitleKind(po.Kind),
+		Name:        po.Name,
+		TaxID:       po.TaxID,
+		Address:     po.Address,
+		Phone:       po.Phone,
+		BankName:    po.BankName,
+		BankAccount: po.BankAccount,
+		CreatedAt:   po.CreatedAt,
+		UpdatedAt:   po.UpdatedAt,
+	}
+}
+
+func toInvoicePO(inv *biz.Invoice) *Invoice {
+	po := &Invoice{
+		ID:         inv.ID,
+		InvoiceNo:  inv.InvoiceNo,
+		UserID:     inv.UserID,
+		MerchantID: inv.MerchantID,
+		Email:      inv.Email,
+		Amount:     inv.Amount,
+		Status:     string(inv.Status),
+		ReplacesNo: inv.ReplacesNo,
+		ProviderNo: inv.ProviderNo,
+		FileURL:    inv.FileURL,
+		FailReason: inv.FailReason,
+		Version:    inv.Version,
+		CreatedAt:  inv.CreatedAt,
+		IssuedAt:   timePtr(inv.IssuedAt),
+		ReversedAt: timePtr(inv.ReversedAt),
+	}
+	if t := inv.Title; t != nil {
+		po.TitleKind = string(t.Kind)
+		po.TitleName = t.Name
+		po.TaxID = t.TaxID
+		po.TitleAddress = t.Address
+		po.TitlePhone = t.Phone
+		po.TitleBankName = t.BankName
+		po.TitleBankAccount = t.BankAccount
+	}
+	for _, o := range inv.Orders {
+		po.Orders = append(po.Orders, InvoiceOrder{
+			InvoiceNo: inv.InvoiceNo,
+			OrderNo:   o.OrderNo,
+			Amount:    o.Amount,
+		})
+	}
+	return po
+}
+
+func toInvoice(po *Invoice) *biz.Invoice {
+	inv := &biz.Invoice{
+		ID:         po.ID,
+		InvoiceNo:  po.InvoiceNo,
+		UserID:     po.UserID,
+		MerchantID: po.MerchantID,
+		Title: &biz.InvoiceTitle{
+			UserID:      po.UserID,
+			Kind:        biz.InvoiceTitleKind(po.TitleKind),
+			Name:        po.TitleName,
+			TaxID:       po.TaxID,
+			Address:     po.TitleAddress,
+			Phone:       po.TitlePhone,
+			BankName:    po.TitleBankName,
+			BankAccount: po.TitleBankAccount,
+		},
+		Email:      po.Email,
+		Amount:     po.Amount,
+		Status:     biz.InvoiceStatus(po.Status),
+		ReplacesNo: po.ReplacesNo,
+		ProviderNo: po.ProviderNo,
+		FileURL:    po.FileURL,
+		FailReason: po.FailReason,
+		Version:    po.Version,
+		CreatedAt:  po.CreatedAt,
+		IssuedAt:   timeValue(po.IssuedAt),
+		ReversedAt: timeValue(po.ReversedAt),
+	}
+	for _, o := range po.Orders {
+		inv.Orders = append(inv.Orders, &biz.InvoiceOrder{
+			OrderNo: o.OrderNo,
+			Amount:  o.Amount,
+		})
+	}
+	return inv
+}
+
+// NewInvoiceProvider returns the invoice provider of the configured kind.
+func NewInvoiceProvider(c *conf.Data, logger log.Logger) (biz.InvoiceProvider, error) {
+	switch kind := c.GetInvoice().GetKind(); kind {
+	case "", "file":
+		dir := c.GetInvoice().GetDir()
+		if dir == "" {
+			dir = filepath.Join(os.TempDir(), "invoices")
+		}
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+		return &fileInvoiceProvider{dir: dir, log: log.NewHelper(logger)}, nil
+	default:
+		return nil, fmt.Errorf("unknown invoice provider %q", kind)
+	}
+}
+
+// fileInvoiceProvider stands in for the tax provider: it issues an invoice
+// as a JSON file named after its number, and reverses it with a second file
+// next to it.
+type fileInvoiceProvider struct {
+	dir string
+	log *log.Helper
+}
+
+// fileInvoice is the content of an invoice file.
+type fileInvoice struct {
+	ProviderNo string             `json:"provider_no"`
+	InvoiceNo  string             `json:"invoice_no"`
+	MerchantID int64              `json:"merchant_id"`
+	Title      fileInvoiceTitle   `json:"title"`
+	Orders     []fileInvoiceOrder `json:"orders"`
+	Amount     int64              `json:"amount"`
+	IssuedAt   time.Time          `json:"issued_at"`
+}
+
+type fileInvoiceTitle struct {
+	Kind        string `json:"kind"`
+	Name        string `json:"name"`
+	TaxID       string `json:"tax_id,omitempty"`
+	Address     string `json:"address,omitempty"`
+	Phone       string `json:"phone,omitempty"`
+	BankName    string `json:"bank_name,omitempty"`
+	BankAccount string `json:"bank_account,omitempty"`
+}
+
+type fileInvoiceOrder struct {
+	OrderNo string `json:"order_no"`
+	Amount  int64  `json:"amount"`
+}
+
+// fileReversal is the content of the file reversing an invoice.
+type fileReversal struct {
+	InvoiceNo  string    `json:"invoice_no"`
+	ReversedAt time.Time `json:"reversed_at"`
+}
+
+func (p *fileInvoiceProvider) Issue(ctx context.Context, inv *biz.Invoice) (*biz.IssuedInvoice, error) {
+	path := filepath.Join(p.dir, inv.InvoiceNo+".json")
+	if _, err := os.Stat(p.reversalPath(inv)); err == nil {
+		return nil, fmt.Errorf("%w: %s reversed", biz.ErrInvoiceRejected, inv.InvoiceNo)
+	}
+	var f fileInvoice
+	if b, err := os.ReadFile(path); err == nil {
+		if err := json.Unmarshal(b, &f); err != nil {
+			return nil, err
+		}
+	} else {
+		f = fileInvoice{
+			ProviderNo: "F" + inv.InvoiceNo[1:],
+			InvoiceNo:  inv.InvoiceNo,
+			MerchantID: inv.MerchantID,
+			Title: fileInvoiceTitle{
+				Kind:        string(inv.Title.Kind),
+				Name:        inv.Title.Name,
+				TaxID:       inv.Title.TaxID,
+				Address:     inv.Title.Address,
+				Phone:       inv.Title.Phone,
+				BankName:    inv.Title.BankName,
+				BankAccount: inv.Title.BankAccount,
+			},
+			Amount:   inv.Amount,
+			IssuedAt: time.Now(),
+		}
+		for _, o := range inv.Orders {
+			f.Orders = append(f.Orders, fileInvoiceOrder{OrderNo: o.OrderNo, Amount: o.Amount})
+		}
+		if err := writeJSON(path, &f); err != nil {
+			return nil, err
+		}
+		p.log.WithContext(ctx).Infof("Invoice %s: issued to %s", inv.InvoiceNo, path)
+	}
+	return &biz.IssuedInvoice{
+		ProviderNo: f.ProviderNo,
+		FileURL:    "file://" + path,
+		IssuedAt:   f.IssuedAt,
+	}, nil
+}
+
+func (p *fileInvoiceProvider) Reverse(ctx context.Context, inv *biz.Invoice) error {
+	path := p.reversalPath(inv)
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	if err := writeJSON(path, &fileReversal{InvoiceNo: inv.InvoiceNo, ReversedAt: time.Now()}); err != nil {
+		return err
+	}
+	p.log.WithContext(ctx).Infof("Invoice %s: reversed to %s", inv.InvoiceNo, path)
+	return nil
+}
+
+func (p *fileInvoiceProvider) reversalPath(inv *biz.Invoice) string {
+	return filepath.Join(p.dir, inv.InvoiceNo+".red.json")
+}
+
+// writeJSON writes v to path at once, through a temporary file renamed.
+func writeJSON(path string, v any) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, b, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
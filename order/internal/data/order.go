@@ -0,0 +1,398 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Order is the orders table.
+type Order struct {
+	ID             int64       `gorm:"primaryKey"`
+	OrderNo        string      `gorm:"size:64;uniqueIndex"`
+	ParentNo       string      `gorm:"size:64;index"`
+	UserID         int64       `gorm:"index:idx_orders_user,priority:1"`
+	MerchantID     int64       `gorm:"index"`
+	Status         string      `gorm:"size:16;index"`
+	Items          []OrderItem `gorm:"foreignKey:OrderNo;references:OrderNo"`
+	ItemsAmount    int64
+	ShippingFee    int64
+	DiscountAmount int64
+	PayAmount      int64
+	// Discounts are those of the items and, without a SKU, of the shipping fee.
+	Discounts      []OrderDiscount `gorm:"foreignKey:OrderNo;references:OrderNo"`
+	Address        Address         `gorm:"embedded;embeddedPrefix:address_"`
+	Remark         string          `gorm:"size:255"`
+	ExpireAt       time.Time
+	TradeNo        string `gorm:"size:64"`
+	RefundedAmount int64
+	CancelReason   string `gorm:"size:255"`
+	Version        int64
+	CreatedAt      time.Time `gorm:"index:idx_orders_user,priority:2"`
+	UpdatedAt      time.Time
+	PaidAt         *time.Time
+	ShippedAt      *time.Time
+	CompletedAt    *time.Time
+	CancelledAt    *time.Time
+}
+
+// ParentOrder is the parent_orders table, the checkouts split into orders.
+type ParentOrder struct {
+	ID             int64  `gorm:"primaryKey"`
+	ParentNo       string `gorm:"size:64;uniqueIndex"`
+	UserID         int64  `gorm:"index"`
+	ItemsAmount    int64
+	ShippingFee    int64
+	DiscountAmount int64
+	PayAmount      int64
+	CouponCodes    []string `gorm:"serializer:json;type:text"`
+	PayNo          string   `gorm:"size:64"`
+	ExpireAt       time.Time
+	Orders         []Order `gorm:"foreignKey:ParentNo;references:ParentNo"`
+	CreatedAt      time.Time
+}
+
+// Address is the delivery address embedded in orders.
+type Address struct {
+	Name     string `gorm:"size:64"`
+	Phone    string `gorm:"size:32"`
+	Province string `gorm:"size:64"`
+	City     string `gorm:"size:64"`
+	District string `gorm:"size:64"`
+	Detail   string `gorm:"size:255"`
+}
+
+// OrderItem is the order_items table.
+type OrderItem struct {
+	ID             int64  `gorm:"primaryKey"`
+	OrderNo        string `gorm:"size:64;index"`
+	SkuID          int64
+	Title          string `gorm:"size:128"`
+	Price          int64
+	Quantity       int32
+	Amount         int64
+	DiscountAmount int64
+	PayAmount      int64
+}
+
+// OrderDiscount is the order_discounts table, the price breakdown of orders.
+type OrderDiscount struct {
+	ID      int64  `gorm:"primaryKey"`
+	OrderNo string `gorm:"size:64;index"`
+	// SkuID is 0 for a discount of the shipping fee.
+	SkuID  int64
+	Kind   string `gorm:"size:16"`
+	Source string `gorm:"size:64"`
+	Title  string `gorm:"size:128"`
+	Amount int64
+}
+
+// OrderRefund is the order_refunds table, the refunds added to orders.
+type OrderRefund struct {
+	ID        int64  `gorm:"primaryKey"`
+	OrderNo   string `gorm:"size:64;index"`
+	RefundNo  string `gorm:"size:64;uniqueIndex"`
+	TradeNo   string `gorm:"size:64"`
+	Amount    int64
+	CreatedAt time.Time
+}
+
+type orderRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewOrderRepo .
+func NewOrderRepo(data *Data, logger log.Logger) biz.OrderRepo {
+	return &orderRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *orderRepo) SaveParent(ctx context.Context, p *biz.ParentOrder) (*biz.ParentOrder, error) {
+	po := &ParentOrder{
+		ParentNo:       p.ParentNo,
+		UserID:         p.UserID,
+		ItemsAmount:    p.ItemsAmount,
+		ShippingFee:    p.ShippingFee,
+		DiscountAmount: p.DiscountAmount,
+		PayAmount:      p.PayAmount,
+		CouponCodes:    p.CouponCodes,
+		ExpireAt:       p.ExpireAt,
+	}
+	for _, o := range p.Orders {
+		po.Orders = append(po.Orders, *toOrderPO(o))
+	}
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return nil, err
+	}
+	return toParentOrder(po), nil
+}
+
+func (r *orderRepo) FindParent(ctx context.Context, parentNo string) (*biz.ParentOrder, error) {
+	var po ParentOrder
+	err := r.data.DB(ctx).
+		Preload("Orders", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+		Preload("Orders.Items").Preload("Orders.Discounts").
+		Where("parent_no = ?", parentNo).First(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrParentOrderNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toParentOrder(&po), nil
+}
+
+func (r *orderRepo) SetPayNo(ctx context.Context, parentNo, payNo string) error {
+	return r.data.DB(ctx).Model(&ParentOrder{}).Where("parent_no = ?", parentNo).Update("pay_no", payNo).Error
+}
+
+func (r *orderRepo) Update(ctx context.Context, o *biz.Order) error {
+	po := toOrderPO(o)
+	po.Version++
+	res := r.data.DB(ctx).Model(po).Where("version = ?", o.Version).
+		Select("status", "trade_no", "refunded_amount", "cancel_reason", "version",
+			"paid_at", "shipped_at", "completed_at", "cancelled_at", "updated_at").
+		Omit(clause.Associations).Updates(po)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return biz.ErrOrderChanged
+	}
+	o.Version = po.Version
+	return nil
+}
+
+func (r *orderRepo) FindByOrderNo(ctx context.Context, orderNo string) (*biz.Order, error) {
+	var po Order
+	err := r.data.DB(ctx).Preload("Items").Preload("Discounts").Where("order_no = ?", orderNo).First(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrOrderNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toOrder(&po), nil
+}
+
+func (r *orderRepo) List(ctx context.Context, filter *biz.OrderFilter, page, pageSize int) ([]*biz.Order, int64, error) {
+	db := r.data.DB(ctx).Model(&Order{})
+	if filter.UserID != 0 {
+		db = db.Where("user_id = ?", filter.UserID)
+	}
+	if filter.ParentNo != "" {
+		db = db.Where("parent_no = ?", filter.ParentNo)
+	}
+	if filter.Status != "" {
+		db = db.Where("status = ?", string(filter.Status))
+	}
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var pos []*Order
+	if err := db.Preload("Items").Preload("Discounts").Order("id DESC").Offset((page - 1) * pageSize).Limit(pageSize).Find(&pos).Error; err != nil {
+		return nil, 0, err
+	}
+	rv := make([]*biz.Order, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toOrder(po))
+	}
+	return rv, total, nil
+}
+
+func (r *orderRepo) ListPending(ctx context.Context, afterID int64, limit int) ([]*biz.Order, error) {
+	var pos []*Order
+	err := r.data.DB(ctx).Where("status = ? AND id > ?", string(biz.StatusPending), afterID).
+		Order("id").Limit(limit).Find(&pos).Error
+	if err != nil {
+		return nil, err
+	}
+	rv := make([]*biz.Order, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toOrder(po))
+	}
+	return rv, nil
+}
+
+func (r *orderRepo) AddRefund(ctx context.Context, f *biz.OrderRefund) (bool, error) {
+	po := &OrderRefund{OrderNo: f.OrderNo, RefundNo: f.RefundNo, TradeNo: f.TradeNo, Amount: f.Amount}
+	err := r.data.DB(ctx).Create(po).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	f.ID, f.CreatedAt = po.ID, po.CreatedAt
+	return true, nil
+}
+
+func toOrderPO(o *biz.Order) *Order {
+	po := &Order{
+		ID:             o.ID,
+		OrderNo:        o.OrderNo,
+		ParentNo:       o.ParentNo,
+		UserID:         o.UserID,
+		MerchantID:     o.MerchantID,
+		Status:         string(o.Status),
+		ItemsAmount:    o.ItemsAmount,
+		ShippingFee:    o.ShippingFee,
+		DiscountAmount: o.DiscountAmount,
+		PayAmount:      o.PayAmount,
+		Remark:         o.Remark,
+		ExpireAt:       o.ExpireAt,
+		TradeNo:        o.TradeNo,
+		RefundedAmount: o.RefundedAmount,
+		CancelReason:   o.CancelReason,
+		Version:        o.Version,
+		CreatedAt:      o.CreatedAt,
+		PaidAt:         timePtr(o.PaidAt),
+		ShippedAt:      timePtr(o.ShippedAt),
+		CompletedAt:    timePtr(o.CompletedAt),
+		CancelledAt:    timePtr(o.CancelledAt),
+	}
+	if a := o.Address; a != nil {
+		po.Address = Address{
+			Name:     a.Name,
+			Phone:    a.Phone,
+			Province: a.Province,
+			City:     a.City,
+			District: a.District,
+			Detail:   a.Detail,
+		}
+	}
+	for _, it := range o.Items {
+		po.Items = append(po.Items, OrderItem{
+			ID:             it.ID,
+			OrderNo:        o.OrderNo,
+			SkuID:          it.SkuID,
+			Title:          it.Title,
+			Price:          it.Price,
+			Quantity:       it.Quantity,
+			Amount:         it.Amount,
+			DiscountAmount: it.DiscountAmount,
+			PayAmount:      it.PayAmount,
+		})
+		for _, d := range it.Discounts {
+			po.Discounts = append(po.Discounts, toOrderDiscountPO(o.OrderNo, it.SkuID, d))
+		}
+	}
+	for _, d := range o.ShippingDiscounts {
+		po.Discounts = append(po.Discounts, toOrderDiscountPO(o.OrderNo, 0, d))
+	}
+	return po
+}
+
+func toOrderDiscountPO(orderNo string, skuID int64, d *biz.Discount) OrderDiscount {
+	return OrderDiscount{
+		OrderNo: orderNo,
+		SkuID:   skuID,
+		Kind:    string(d.Kind),
+		Source:  d.Source,
+		Title:   d.Title,
+		Amount:  d.Amount,
+	}
+}
+
+func toOrder(po *Order) *biz.Order {
+	o := &biz.Order{
+		ID:             po.ID,
+		OrderNo:        po.OrderNo,
+		ParentNo:       po.ParentNo,
+		UserID:         po.UserID,
+		MerchantID:     po.MerchantID,
+		Status:         biz.Status(po.Status),
+		ItemsAmount:    po.ItemsAmount,
+		ShippingFee:    po.ShippingFee,
+		DiscountAmount: po.DiscountAmount,
+		PayAmount:      po.PayAmount,
+		Address: &biz.Address{
+			Name:     po.Address.Name,
+			Phone:    po.Address.Phone,
+			Province: po.Address.Province,
+			City:     po.Address.City,
+			District: po.Address.District,
+			Detail:   po.Address.Detail,
+		},
+		Remark:         po.Remark,
+		ExpireAt:       po.ExpireAt,
+		TradeNo:        po.TradeNo,
+		RefundedAmount: po.RefundedAmount,
+		CancelReason:   po.CancelReason,
+		Version:        po.Version,
+		CreatedAt:      po.CreatedAt,
+		PaidAt:         timeValue(po.PaidAt),
+		ShippedAt:      timeValue(po.ShippedAt),
+		CompletedAt:    timeValue(po.CompletedAt),
+		CancelledAt:    timeValue(po.CancelledAt),
+	}
+	for _, it := range po.Items {
+		o.Items = append(o.Items, &biz.OrderItem{
+			ID:             it.ID,
+			SkuID:          it.SkuID,
+			Title:          it.Title,
+			Price:          it.Price,
+			Quantity:       it.Quantity,
+			Amount:         it.Amount,
+			DiscountAmount: it.DiscountAmount,
+			PayAmount:      it.PayAmount,
+		})
+	}
+	for _, d := range po.Discounts {
+		bd := &biz.Discount{Kind: biz.DiscountKind(d.Kind), Source: d.Source, Title: d.Title, Amount: d.Amount}
+		if d.SkuID == 0 {
+			o.ShippingDiscounts = append(o.ShippingDiscounts, bd)
+			continue
+		}
+		for _, it := range o.Items {
+			if it.SkuID == d.SkuID {
+				it.Discounts = append(it.Discounts, bd)
+			}
+		}
+	}
+	return o
+}
+
+func toParentOrder(po *ParentOrder) *biz.ParentOrder {
+	p := &biz.ParentOrder{
+		ID:             po.ID,
+		ParentNo:       po.ParentNo,
+		UserID:         po.UserID,
+		ItemsAmount:    po.ItemsAmount,
+		ShippingFee:    po.ShippingFee,
+		DiscountAmount: po.DiscountAmount,
+		PayAmount:      po.PayAmount,
+		CouponCodes:    po.CouponCodes,
+		PayNo:          po.PayNo,
+		ExpireAt:       po.ExpireAt,
+		CreatedAt:      po.CreatedAt,
+	}
+	for i := range po.Orders {
+		p.Orders = append(p.Orders, toOrder(&po.Orders[i]))
+	}
+	return p
+}
+
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func timeValue(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
@@ -0,0 +1,78 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/conf"
+	paymentv1 "github.com/go-kratos/kratos-layout/payment/api/payment/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+)
+
+// NewPaymentClient .
+func NewPaymentClient(c *conf.Data) (paymentv1.PaymentClient, func(), error) {
+	opts := []grpc.ClientOption{
+		grpc.WithEndpoint(c.Payment.Endpoint),
+		grpc.WithMiddleware(recovery.Recovery()),
+	}
+	if c.Payment.Timeout != nil {
+		opts = append(opts, grpc.WithTimeout(c.Payment.Timeout.AsDuration()))
+	}
+	conn, err := grpc.DialInsecure(context.Background(), opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return paymentv1.NewPaymentClient(conn), func() { _ = conn.Close() }, nil
+}
+
+type paymentRepo struct {
+	client paymentv1.PaymentClient
+	log    *log.Helper
+}
+
+// NewPaymentRepo .
+func NewPaymentRepo(client paymentv1.PaymentClient, logger log.Logger) biz.PaymentRepo {
+	return &paymentRepo{
+		client: client,
+		log:    log.NewHelper(logger),
+	}
+}
+
+func (r *paymentRepo) Pay(ctx context.Context, p *biz.ParentOrder, pay *biz.Payment) (string, error) {
+	reply, err := r.client.CreateCombinedPayment(ctx, &paymentv1.CreateCombinedPaymentRequest{
+		BizNo:   p.ParentNo,
+		UserId:  p.UserID,
+		Subject: fmt.Sprintf("Order %s", p.ParentNo),
+		Amount:  p.PayAmount,
+		Points:  pay.Points,
+		Balance: pay.Balance,
+		Channel: pay.Channel,
+	})
+	if errors.Reason(err) == paymentv1.ErrorReason_PAYMENT_ALREADY_PAID.String() {
+		return "", biz.ErrOrderPaid
+	}
+	if err != nil {
+		return "", err
+	}
+	return reply.PayNo, nil
+}
+
+func (r *paymentRepo) CancelPay(ctx context.Context, payNo string) error {
+	_, err := r.client.CancelCombinedPayment(ctx, &paymentv1.CancelCombinedPaymentRequest{PayNo: payNo})
+	return err
+}
+
+func (r *paymentRepo) Refund(ctx context.Context, tradeNo, refundNo string, amount int64, reason string) error {
+	_, err := r.client.CreateRefund(ctx, &paymentv1.CreateRefundRequest{
+		TradeNo:  tradeNo,
+		RefundNo: refundNo,
+		Amount:   amount,
+		Reason:   reason,
+	})
+	return err
+}
@@ -0,0 +1,332 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Promotion is the promotions table.
+type Promotion struct {
+	ID             int64      `gorm:"primaryKey"`
+	Name           string     `gorm:"size:128"`
+	Kind           string     `gorm:"size:16"`
+	Scope          biz.Scope  `gorm:"serializer:json;type:text"`
+	Tiers          []biz.Tier `gorm:"serializer:json;type:text"`
+	Repeat         bool
+	MaxReduction   int64
+	Percent        int32
+	MinAmount      int64
+	MinQuantity    int32
+	ExclusiveGroup string    `gorm:"size:64"`
+	StartAt        time.Time `gorm:"index:idx_promotions_active,priority:2"`
+	EndAt          time.Time `gorm:"index:idx_promotions_active,priority:3"`
+	Enabled        bool      `gorm:"index:idx_promotions_active,priority:1"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// MemberPrice is the member_prices table, a price of a SKU for members of a
+// level and above.
+type MemberPrice struct {
+	ID        int64 `gorm:"primaryKey"`
+	SkuID     int64 `gorm:"uniqueIndex:idx_member_prices_sku_level,priority:1"`
+	Level     int32 `gorm:"uniqueIndex:idx_member_prices_sku_level,priority:2"`
+	Price     int64
+	UpdatedAt time.Time
+}
+
+// Coupon is the coupons table.
+type Coupon struct {
+	ID             int64  `gorm:"primaryKey"`
+	Code           string `gorm:"size:32;uniqueIndex"`
+	UserID         int64  `gorm:"index:idx_coupons_user,priority:1"`
+	Title          string `gorm:"size:128"`
+	Kind           string `gorm:"size:16"`
+	Amount         int64
+	Percent        int32
+	MaxDiscount    int64
+	MinAmount      int64
+	Scope          biz.Scope `gorm:"serializer:json;type:text"`
+	ExclusiveGroup string    `gorm:"size:64"`
+	StartAt        time.Time
+	ExpireAt       time.Time `gorm:"index:idx_coupons_user,priority:2"`
+	Status         string    `gorm:"size:16"`
+	OrderNo        string    `gorm:"size:64;index"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type promotionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewPromotionRepo .
+func NewPromotionRepo(data *Data, logger log.Logger) biz.PromotionRepo {
+	return &promotionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *promotionRepo) Save(ctx context.Context, p *biz.Promotion) (*biz.Promotion, error) {
+	po := &Promotion{
+		Name:           p.Name,
+		Kind:           string(p.Kind),
+		Scope:          p.Scope,
+		Tiers:          p.Tiers,
+		Repeat:         p.Repeat,
+		MaxReduction:   p.MaxReduction,
+		Percent:        p.Percent,
+		MinAmount:      p.MinAmount,
+		MinQuantity:    p.MinQuantity,
+		ExclusiveGroup: p.ExclusiveGroup,
+		StartAt:        p.StartAt,
+		EndAt:          p.EndAt,
+		Enabled:        p.Enabled,
+	}
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return nil, err
+	}
+	return toPromotion(po), nil
+}
+
+func (r *promotionRepo) SetEnabled(ctx context.Context, id int64, enabled bool) (*biz.Promotion, error) {
+	var po Promotion
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&po, id).Error; err != nil {
+			return err
+		}
+		po.Enabled = enabled
+		return tx.Model(&po).Update("enabled", enabled).Error
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrPromotionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toPromotion(&po), nil
+}
+
+func (r *promotionRepo) List(ctx context.Context, now time.Time, page, pageSize int) ([]*biz.Promotion, int64, error) {
+	db := r.data.DB(ctx).Model(&Promotion{})
+	if !now.IsZero() {
+		db = db.Where("enabled = ? AND start_at <= ? AND end_at > ?", true, now, now)
+	}
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var pos []Promotion
+	if err := db.Order("id DESC").Offset((page - 1) * pageSize).Limit(pageSize).Find(&pos).Error; err != nil {
+		return nil, 0, err
+	}
+	ps := make([]*biz.Promotion, 0, len(pos))
+	for i := range pos {
+		ps = append(ps, toPromotion(&pos[i]))
+	}
+	return ps, total, nil
+}
+
+func (r *promotionRepo) ListActive(ctx context.Context, now time.Time) ([]*biz.Promotion, error) {
+	var pos []Promotion
+	err := r.data.DB(ctx).Where("enabled = ? AND start_at <= ? AND end_at > ?", true, now, now).
+		Order("id").Find(&pos).Error
+	if err != nil {
+		return nil, err
+	}
+	ps := make([]*biz.Promotion, 0, len(pos))
+	for i := range pos {
+		ps = append(ps, toPromotion(&pos[i]))
+	}
+	return ps, nil
+}
+
+func toPromotion(po *Promotion) *biz.Promotion {
+	return &biz.Promotion{
+		ID:             po.ID,
+		Name:           po.Name,
+		Kind:           biz.PromotionKind(po.Kind),
+		Scope:          po.Scope,
+		Tiers:          po.Tiers,
+		Repeat:         po.Repeat,
+		MaxReduction:   po.MaxReduction,
+		Percent:        po.Percent,
+		MinAmount:      po.MinAmount,
+		MinQuantity:    po.MinQuantity,
+		ExclusiveGroup: po.ExclusiveGroup,
+		StartAt:        po.StartAt,
+		EndAt:          po.EndAt,
+		Enabled:        po.Enabled,
+		CreatedAt:      po.CreatedAt,
+	}
+}
+
+type memberPriceRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewMemberPriceRepo .
+func NewMemberPriceRepo(data *Data, logger log.Logger) biz.MemberPriceRepo {
+	return &memberPriceRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *memberPriceRepo) Set(ctx context.Context, level int32, prices map[int64]int64) error {
+	var set []MemberPrice
+	var removed []int64
+	for skuID, p := range prices {
+		if p == 0 {
+			removed = append(removed, skuID)
+			continue
+		}
+		set = append(set, MemberPrice{SkuID: skuID, Level: level, Price: p})
+	}
+	return r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(removed) > 0 {
+			if err := tx.Where("level = ? AND sku_id IN ?", level, removed).Delete(&MemberPrice{}).Error; err != nil {
+				return err
+			}
+		}
+		if len(set) == 0 {
+			return nil
+		}
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "sku_id"}, {Name: "level"}},
+			DoUpdates: clause.AssignmentColumns([]string{"price", "updated_at"}),
+		}).Create(&set).Error
+	})
+}
+
+func (r *memberPriceRepo) Lowest(ctx context.Context, level int32, skuIDs []int64) (map[int64]int64, error) {
+	var rows []struct {
+		SkuID int64
+		Price int64
+	}
+	err := r.data.DB(ctx).Model(&MemberPrice{}).Select("sku_id, MIN(price) AS price").
+		Where("level <= ? AND sku_id IN ?", level, skuIDs).Group("sku_id").Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	prices := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		prices[row.SkuID] = row.Price
+	}
+	return prices, nil
+}
+
+type couponRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewCouponRepo .
+func NewCouponRepo(data *Data, logger log.Logger) biz.CouponRepo {
+	return &couponRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *couponRepo) Save(ctx context.Context, c *biz.Coupon) (*biz.Coupon, error) {
+	po := &Coupon{
+		Code:           c.Code,
+		UserID:         c.UserID,
+		Title:          c.Title,
+		Kind:           string(c.Kind),
+		Amount:         c.Amount,
+		Percent:        c.Percent,
+		MaxDiscount:    c.MaxDiscount,
+		MinAmount:      c.MinAmount,
+		Scope:          c.Scope,
+		ExclusiveGroup: c.ExclusiveGroup,
+		StartAt:        c.StartAt,
+		ExpireAt:       c.ExpireAt,
+		Status:         string(c.Status),
+	}
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return nil, err
+	}
+	return toCoupon(po), nil
+}
+
+func (r *couponRepo) FindByCodes(ctx context.Context, codes []string) ([]*biz.Coupon, error) {
+	var pos []Coupon
+	if err := r.data.DB(ctx).Where("code IN ?", codes).Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	cs := make([]*biz.Coupon, 0, len(pos))
+	for i := range pos {
+		cs = append(cs, toCoupon(&pos[i]))
+	}
+	return cs, nil
+}
+
+func (r *couponRepo) ListByUser(ctx context.Context, userID int64, status biz.CouponStatus) ([]*biz.Coupon, error) {
+	db := r.data.DB(ctx).Where("user_id = ?", userID)
+	if status != "" {
+		db = db.Where("status = ?", status)
+	}
+	var pos []Coupon
+	if err := db.Order("expire_at").Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	cs := make([]*biz.Coupon, 0, len(pos))
+	for i := range pos {
+		cs = append(cs, toCoupon(&pos[i]))
+	}
+	return cs, nil
+}
+
+func (r *couponRepo) Use(ctx context.Context, userID int64, codes []string, orderNo string) error {
+	return r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&Coupon{}).
+			Where("code IN ? AND user_id = ? AND status = ?", codes, userID, biz.CouponAvailable).
+			Updates(map[string]interface{}{"status": biz.CouponUsed, "order_no": orderNo})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected != int64(len(codes)) {
+			return biz.ErrCouponUnavailable
+		}
+		return nil
+	})
+}
+
+func (r *couponRepo) Release(ctx context.Context, orderNo string) error {
+	return r.data.DB(ctx).Model(&Coupon{}).
+		Where("order_no = ? AND status = ?", orderNo, biz.CouponUsed).
+		Updates(map[string]interface{}{"status": biz.CouponAvailable, "order_no": ""}).Error
+}
+
+func toCoupon(po *Coupon) *biz.Coupon {
+	return &biz.Coupon{
+		ID:             po.ID,
+		Code:           po.Code,
+		UserID:         po.UserID,
+		Title:          po.Title,
+		Kind:           biz.CouponKind(po.Kind),
+		Amount:         po.Amount,
+		Percent:        po.Percent,
+		MaxDiscount:    po.MaxDiscount,
+		MinAmount:      po.MinAmount,
+		Scope:          po.Scope,
+		ExclusiveGroup: po.ExclusiveGroup,
+		StartAt:        po.StartAt,
+		ExpireAt:       po.ExpireAt,
+		Status:         biz.CouponStatus(po.Status),
+		OrderNo:        po.OrderNo,
+		CreatedAt:      po.CreatedAt,
+	}
+}
@@ -0,0 +1,20 @@
+package data
+
+import (
+	"github.com/go-kratos/kratos-layout/internal/conf"
+	"github.com/go-kratos/kratos-layout/pkg/saga"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// NewSagaOrchestrator returns the saga orchestrator of the service, its sagas
+// saved to the sagas table.
+func NewSagaOrchestrator(d *Data, c *conf.Order, logger log.Logger) *saga.Orchestrator {
+	s := c.GetSaga()
+	return saga.NewOrchestrator(saga.NewGormStore(d.DB),
+		saga.WithAttempts(int(s.GetAttempts())),
+		saga.WithBackoff(s.GetBackoff().AsDuration(), s.GetMaxBackoff().AsDuration()),
+		saga.WithLease(s.GetLease().AsDuration()),
+		saga.WithLogger(logger),
+	)
+}
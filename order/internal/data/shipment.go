@@ -0,0 +1,248 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// Shipment is the shipments table, a row per parcel an order is shipped in.
+type Shipment struct {
+	ID          int64           `gorm:"primaryKey"`
+	OrderNo     string          `gorm:"size:64;uniqueIndex:idx_shipments_parcel,priority:1"`
+	MerchantID  int64           `gorm:"index"`
+	Carrier     string          `gorm:"size:32;uniqueIndex:idx_shipments_parcel,priority:2"`
+	TrackingNo  string          `gorm:"size:64;uniqueIndex:idx_shipments_parcel,priority:3"`
+	Status      string          `gorm:"size:16;index:idx_shipments_tracking,priority:1"`
+	Events      []TrackingEvent `gorm:"foreignKey:ShipmentID"`
+	CheckedAt   *time.Time      `gorm:"index:idx_shipments_tracking,priority:2"`
+	DeliveredAt *time.Time
+	Version     int64
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// TrackingEvent is the tracking_events table.
+type TrackingEvent struct {
+	ID          int64  `gorm:"primaryKey"`
+	ShipmentID  int64  `gorm:"index"`
+	Status      string `gorm:"size:16"`
+	Location    string `gorm:"size:128"`
+	Description string `gorm:"size:255"`
+	OccurredAt  time.Time
+}
+
+type shipmentRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewShipmentRepo .
+func NewShipmentRepo(data *Data, logger log.Logger) biz.ShipmentRepo {
+	return &shipmentRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *shipmentRepo) Save(ctx context.Context, s *biz.Shipment) (*biz.Shipment, error) {
+	po := toShipmentPO(s)
+	err := r.data.DB(ctx).Create(po).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return nil, biz.ErrShipmentExists
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toShipment(po), nil
+}
+
+func (r *shipmentRepo) Update(ctx context.Context, s *biz.Shipment) error {
+	po := toShipmentPO(s)
+	po.Version++
+	res := r.data.DB(ctx).Model(po).Where("version = ?", s.Version).
+		Select("status", "checked_at", "delivered_at", "version", "updated_at").
+		Updates(po)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return biz.ErrShipmentChanged
+	}
+	s.Version = po.Version
+	return nil
+}
+
+func (r *shipmentRepo) AddEvents(ctx context.Context, shipmentID int64, events []*biz.TrackingEvent) error {
+	pos := make([]*TrackingEvent, 0, len(events))
+	for _, e := range events {
+		pos = append(pos, &TrackingEvent{
+			ShipmentID:  shipmentID,
+			Status:      string(e.Status),
+			Location:    e.Location,
+			Description: e.Description,
+			OccurredAt:  e.OccurredAt,
+		})
+	}
+	return r.data.DB(ctx).Create(pos).Error
+}
+
+func (r *shipmentRepo) ListByOrder(ctx context.Context, orderNo string) ([]*biz.Shipment, error) {
+	var pos []*Shipment
+	err := r.data.DB(ctx).Preload("Events", orderEvents).
+		Where("order_no = ?", orderNo).Order("id").Find(&pos).Error
+	if err != nil {
+		return nil, err
+	}
+	rv := make([]*biz.Shipment, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toShipment(po))
+	}
+	return rv, nil
+}
+
+func (r *shipmentRepo) ListToTrack(ctx context.Context, shippedAfter, checkedBefore time.Time, limit int) ([]*biz.Shipment, error) {
+	var pos []*Shipment
+	err := r.data.DB(ctx).Preload("Events", orderEvents).
+		Where("status <> ? AND created_at > ?", string(biz.ShipmentDelivered), shippedAfter).
+		Where("checked_at IS NULL OR checked_at < ?", checkedBefore).
+		Order("checked_at").Limit(limit).Find(&pos).Error
+	if err != nil {
+		return nil, err
+	}
+	rv := make([]*biz.Shipment, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toShipment(po))
+	}
+	return rv, nil
+}
+
+// orderEvents preloads the tracking events of parcels oldest first.
+func orderEvents(db *gorm.DB) *gorm.DB {
+	return db.Order("occurred_at, id")
+}
+
+func toShipmentPO(s *biz.Shipment) *Shipment {
+	return &Shipment{
+		ID:          s.ID,
+		OrderNo:     s.OrderNo,
+		MerchantID:  s.MerchantID,
+		Carrier:     s.Carrier,
+		TrackingNo:  s.TrackingNo,
+		Status:      string(s.Status),
+		CheckedAt:   timePtr(s.CheckedAt),
+		DeliveredAt: timePtr(s.DeliveredAt),
+		Version:     s.Version,
+		CreatedAt:   s.CreatedAt,
+	}
+}
+
+func toShipment(po *Shipment) *biz.Shipment {
+	s := &biz.Shipment{
+		ID:          po.ID,
+		OrderNo:     po.OrderNo,
+		MerchantID:  po.MerchantID,
+		Carrier:     po.Carrier,
+		TrackingNo:  po.TrackingNo,
+		Status:      biz.ShipmentStatus(po.Status),
+		Events:      make([]*biz.TrackingEvent, 0, len(po.Events)),
+		CheckedAt:   timeValue(po.CheckedAt),
+		DeliveredAt: timeValue(po.DeliveredAt),
+		Version:     po.Version,
+		CreatedAt:   po.CreatedAt,
+	}
+	for _, e := range po.Events {
+		s.Events = append(s.Events, &biz.TrackingEvent{
+			Status:      biz.ShipmentStatus(e.Status),
+			Location:    e.Location,
+			Description: e.Description,
+			OccurredAt:  e.OccurredAt,
+		})
+	}
+	return s
+}
+
+// NewCarrierAdapter returns the carrier adapter of the configured kind.
+func NewCarrierAdapter(c *conf.Data, logger log.Logger) (biz.CarrierAdapter, error) {
+	switch kind := c.GetLogistics().GetKind(); kind {
+	case "", "file":
+		dir := c.GetLogistics().GetDir()
+		if dir == "" {
+			dir = filepath.Join(os.TempDir(), "logistics")
+		}
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+		return &fileCarrierAdapter{dir: dir, carriers: c.GetLogistics().GetCarriers(), log: log.NewHelper(logger)}, nil
+	default:
+		return nil, fmt.Errorf("unknown carrier adapter %q", kind)
+	}
+}
+
+// fileCarrierAdapter stands in for the carriers: the tracking events of a
+// parcel are a JSON array in the file dir/<carrier>/<tracking_no>.json,
+// written by hand or by a test, and a parcel without a file has none.
+type fileCarrierAdapter struct {
+	dir      string
+	carriers []string
+	log      *log.Helper
+}
+
+// fileTrackingEvent is an event of a tracking file.
+type fileTrackingEvent struct {
+	Status      string    `json:"status"`
+	Location    string    `json:"location,omitempty"`
+	Description string    `json:"description,omitempty"`
+	Time        time.Time `json:"time"`
+}
+
+// fileTrackingStatuses are the statuses of a tracking file.
+var fileTrackingStatuses = map[string]biz.ShipmentStatus{
+	"in_transit": biz.ShipmentInTransit,
+	"delivering": biz.ShipmentDelivering,
+	"delivered":  biz.ShipmentDelivered,
+	"exception":  biz.ShipmentException,
+}
+
+func (a *fileCarrierAdapter) Supports(carrier string) bool {
+	return len(a.carriers) == 0 || slices.Contains(a.carriers, carrier)
+}
+
+func (a *fileCarrierAdapter) Track(ctx context.Context, carrier, trackingNo string) ([]*biz.TrackingEvent, error) {
+	b, err := os.ReadFile(filepath.Join(a.dir, carrier, trackingNo+".json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var fes []fileTrackingEvent
+	if err := json.Unmarshal(b, &fes); err != nil {
+		return nil, fmt.Errorf("tracking file of %s %s: %w", carrier, trackingNo, err)
+	}
+	events := make([]*biz.TrackingEvent, 0, len(fes))
+	for _, fe := range fes {
+		status, ok := fileTrackingStatuses[fe.Status]
+		if !ok {
+			return nil, fmt.Errorf("tracking file of %s %s: unknown status %q", carrier, trackingNo, fe.Status)
+		}
+		events = append(events, &biz.TrackingEvent{
+			Status:      status,
+			Location:    fe.Location,
+			Description: fe.Description,
+			OccurredAt:  fe.Time,
+		})
+	}
+	return events, nil
+}
@@ -0,0 +1,167 @@
+package data
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/conf"
+	shopv1 "github.com/go-kratos/kratos-layout/shop/api/shop/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+)
+
+// NewCatalogClient .
+func NewCatalogClient(c *conf.Data) (shopv1.CatalogClient, func(), error) {
+	opts := []grpc.ClientOption{
+		grpc.WithEndpoint(c.Shop.Endpoint),
+		grpc.WithMiddleware(recovery.Recovery()),
+	}
+	if c.Shop.Timeout != nil {
+		opts = append(opts, grpc.WithTimeout(c.Shop.Timeout.AsDuration()))
+	}
+	conn, err := grpc.DialInsecure(context.Background(), opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return shopv1.NewCatalogClient(conn), func() { _ = conn.Close() }, nil
+}
+
+type skuRepo struct {
+	client shopv1.CatalogClient
+	log    *log.Helper
+}
+
+// NewSkuRepo .
+func NewSkuRepo(client shopv1.CatalogClient, logger log.Logger) biz.SkuRepo {
+	return &skuRepo{
+		client: client,
+		log:    log.NewHelper(logger),
+	}
+}
+
+func (r *skuRepo) GetSkus(ctx context.Context, ids []int64) (map[int64]*biz.Sku, error) {
+	reply, err := r.client.BatchGetSkus(ctx, &shopv1.BatchGetSkusRequest{Ids: ids})
+	if err != nil {
+		return nil, err
+	}
+	skus := make(map[int64]*biz.Sku, len(reply.Skus))
+	for _, s := range reply.Skus {
+		skus[s.Id] = &biz.Sku{
+			ID:         s.Id,
+			SpuID:      s.SpuId,
+			MerchantID: s.MerchantId,
+			Title:      s.Title,
+			Image:      s.Image,
+			Price:      s.Price,
+			Stock:      s.Stock,
+			OnSale:     s.OnSale,
+		}
+	}
+	return skus, nil
+}
+
+// NewInventoryClient .
+func NewInventoryClient(c *conf.Data) (shopv1.InventoryClient, func(), error) {
+	opts := []grpc.ClientOption{
+		grpc.WithEndpoint(c.Shop.Endpoint),
+		grpc.WithMiddleware(recovery.Recovery()),
+	}
+	if c.Shop.Timeout != nil {
+		opts = append(opts, grpc.WithTimeout(c.Shop.Timeout.AsDuration()))
+	}
+	conn, err := grpc.DialInsecure(context.Background(), opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return shopv1.NewInventoryClient(conn), func() { _ = conn.Close() }, nil
+}
+
+type stockRepo struct {
+	client shopv1.InventoryClient
+	log    *log.Helper
+}
+
+// NewStockRepo .
+func NewStockRepo(client shopv1.InventoryClient, logger log.Logger) biz.StockRepo {
+	return &stockRepo{
+		client: client,
+		log:    log.NewHelper(logger),
+	}
+}
+
+func (r *stockRepo) Reserve(ctx context.Context, reservationNo string, items []*biz.OrderItem) error {
+	req := &shopv1.ReserveStockRequest{ReservationNo: reservationNo}
+	for _, it := range items {
+		req.Items = append(req.Items, &shopv1.StockItem{SkuId: it.SkuID, Quantity: int64(it.Quantity)})
+	}
+	_, err := r.client.ReserveStock(ctx, req)
+	if e := errors.FromError(err); e != nil && e.Reason == shopv1.ErrorReason_INSUFFICIENT_STOCK.String() {
+		return biz.ErrSkuUnavailable.WithMetadata(e.Metadata)
+	}
+	return err
+}
+
+func (r *stockRepo) Confirm(ctx context.Context, reservationNo string) error {
+	_, err := r.client.ConfirmStock(ctx, &shopv1.ConfirmStockRequest{ReservationNo: reservationNo})
+	return err
+}
+
+func (r *stockRepo) Release(ctx context.Context, reservationNo string) error {
+	_, err := r.client.ReleaseStock(ctx, &shopv1.ReleaseStockRequest{ReservationNo: reservationNo})
+	return err
+}
+
+// NewFreightClient .
+func NewFreightClient(c *conf.Data) (shopv1.FreightClient, func(), error) {
+	opts := []grpc.ClientOption{
+		grpc.WithEndpoint(c.Shop.Endpoint),
+		grpc.WithMiddleware(recovery.Recovery()),
+	}
+	if c.Shop.Timeout != nil {
+		opts = append(opts, grpc.WithTimeout(c.Shop.Timeout.AsDuration()))
+	}
+	conn, err := grpc.DialInsecure(context.Background(), opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return shopv1.NewFreightClient(conn), func() { _ = conn.Close() }, nil
+}
+
+type freightRepo struct {
+	client shopv1.FreightClient
+	log    *log.Helper
+}
+
+// NewFreightRepo .
+func NewFreightRepo(client shopv1.FreightClient, logger log.Logger) biz.FreightRepo {
+	return &freightRepo{
+		client: client,
+		log:    log.NewHelper(logger),
+	}
+}
+
+func (r *freightRepo) Quote(ctx context.Context, province string, items []*biz.PriceItem) (map[int64]int64, error) {
+	req := &shopv1.CalculateFreightRequest{Province: province}
+	for _, it := range items {
+		req.Items = append(req.Items, &shopv1.FreightItem{
+			SkuId:    it.SkuID,
+			Quantity: int64(it.Quantity),
+			Amount:   it.Price * int64(it.Quantity),
+		})
+	}
+	reply, err := r.client.CalculateFreight(ctx, req)
+	if e := errors.FromError(err); e != nil && e.Reason == shopv1.ErrorReason_REGION_NOT_DELIVERED.String() {
+		return nil, biz.ErrRegionNotDelivered.WithMetadata(e.Metadata)
+	}
+	if err != nil {
+		return nil, err
+	}
+	fees := make(map[int64]int64, len(reply.Freights))
+	for _, f := range reply.Freights {
+		fees[f.MerchantId] = f.Fee
+	}
+	return fees, nil
+}
@@ -0,0 +1,75 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Transition is the order_transitions table, the audit trail of order
+// statuses. An order has one transition per version.
+type Transition struct {
+	ID        int64  `gorm:"primaryKey"`
+	OrderNo   string `gorm:"size:64;uniqueIndex:idx_order_transitions_version,priority:1"`
+	From      string `gorm:"size:32"`
+	To        string `gorm:"size:32"`
+	Event     string `gorm:"size:32"`
+	Version   int64  `gorm:"uniqueIndex:idx_order_transitions_version,priority:2"`
+	CreatedAt time.Time
+}
+
+// TableName .
+func (Transition) TableName() string {
+	return "order_transitions"
+}
+
+type transitionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewTransitionRepo .
+func NewTransitionRepo(data *Data, logger log.Logger) biz.TransitionRepo {
+	return &transitionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *transitionRepo) Add(ctx context.Context, t *biz.Transition) error {
+	po := &Transition{
+		OrderNo: t.OrderNo,
+		From:    string(t.From),
+		To:      string(t.To),
+		Event:   t.Event,
+		Version: t.Version,
+	}
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return err
+	}
+	t.ID, t.CreatedAt = po.ID, po.CreatedAt
+	return nil
+}
+
+func (r *transitionRepo) ListByOrderNo(ctx context.Context, orderNo string) ([]*biz.Transition, error) {
+	var pos []Transition
+	if err := r.data.DB(ctx).Where("order_no = ?", orderNo).Order("version").Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	ts := make([]*biz.Transition, 0, len(pos))
+	for _, po := range pos {
+		ts = append(ts, &biz.Transition{
+			ID:        po.ID,
+			OrderNo:   po.OrderNo,
+			From:      biz.Status(po.From),
+			To:        biz.Status(po.To),
+			Event:     po.Event,
+			Version:   po.Version,
+			CreatedAt: po.CreatedAt,
+		})
+	}
+	return ts, nil
+}
@@ -0,0 +1,93 @@
+package server
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ConsumerServer feeds the payment events to the orders, cancels the orders
+// left unpaid once they expire, moves the after-sale tickets on at their
+// deadlines, and issues and reverses the invoices requested.
+type ConsumerServer struct {
+	subscriber biz.EventSubscriber
+	queue      biz.DelayQueue
+	orders     *biz.OrderUsecase
+	afterSales *biz.AfterSaleUsecase
+	invoices   *biz.InvoiceUsecase
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
+	log        *log.Helper
+}
+
+// NewConsumerServer new a consumer server.
+func NewConsumerServer(subscriber biz.EventSubscriber, queue biz.DelayQueue, orders *biz.OrderUsecase, afterSales *biz.AfterSaleUsecase, invoices *biz.InvoiceUsecase, logger log.Logger) *ConsumerServer {
+	return &ConsumerServer{subscriber: subscriber, queue: queue, orders: orders, afterSales: afterSales, invoices: invoices, log: log.NewHelper(logger)}
+}
+
+// Start implements transport.Server.
+func (s *ConsumerServer) Start(context.Context) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.wg.Add(4)
+	go func() {
+		defer s.wg.Done()
+		if err := s.subscriber.Subscribe(ctx, s.orders.HandlePaymentEvent); err != nil {
+			s.log.Errorf("subscribe payment events: %v", err)
+		}
+	}()
+	go func() {
+		defer s.wg.Done()
+		n, err := s.orders.ReschedulePending(ctx)
+		if err != nil {
+			s.log.Errorf("reschedule pending orders: %v", err)
+		}
+		s.log.Infof("rescheduled %d pending orders", n)
+		if err := s.queue.Subscribe(ctx, biz.TopicPayTimeout, s.orders.Expire); err != nil {
+			s.log.Errorf("subscribe %s: %v", biz.TopicPayTimeout, err)
+		}
+	}()
+	go func() {
+		defer s.wg.Done()
+		n, err := s.afterSales.RescheduleDeadlines(ctx)
+		if err != nil {
+			s.log.Errorf("reschedule after-sale deadlines: %v", err)
+		}
+		s.log.Infof("rescheduled %d after-sale deadlines", n)
+		if err := s.queue.Subscribe(ctx, biz.TopicAfterSaleTimeout, s.afterSales.ExpireAfterSale); err != nil {
+			s.log.Errorf("subscribe %s: %v", biz.TopicAfterSaleTimeout, err)
+		}
+	}()
+	go func() {
+		defer s.wg.Done()
+		n, err := s.invoices.RescheduleInvoices(ctx)
+		if err != nil {
+			s.log.Errorf("reschedule invoices: %v", err)
+		}
+		s.log.Infof("rescheduled %d invoices", n)
+		if err := s.queue.Subscribe(ctx, biz.TopicInvoice, s.invoices.SettleInvoice); err != nil {
+			s.log.Errorf("subscribe %s: %v", biz.TopicInvoice, err)
+		}
+	}()
+	return nil
+}
+
+// Stop implements transport.Server, it waits for the event in hand.
+func (s *ConsumerServer) Stop(ctx context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// FlashSaleServer warms the flash sales about to start and places the
+// orders of the attempts admitted, with a number of workers taking them
+// from the queue. It requeues the attempts left by instances that stopped
+// as it warms.
+type FlashSaleServer struct {
+	uc           *biz.FlashSaleUsecase
+	workers      int
+	warmInterval time.Duration
+	cancel       context.CancelFunc
+	wg           sync.WaitGroup
+	log          *log.Helper
+}
+
+// NewFlashSaleServer new a flash sale server.
+func NewFlashSaleServer(uc *biz.FlashSaleUsecase, c *conf.Order, logger log.Logger) *FlashSaleServer {
+	workers := int(c.GetFlashSale().GetWorkers())
+	if workers <= 0 {
+		workers = 8
+	}
+	warmInterval := c.GetFlashSale().GetWarmInterval().AsDuration()
+	if warmInterval <= 0 {
+		warmInterval = 30 * time.Second
+	}
+	return &FlashSaleServer{uc: uc, workers: workers, warmInterval: warmInterval, log: log.NewHelper(logger)}
+}
+
+// Start implements transport.Server.
+func (s *FlashSaleServer) Start(context.Context) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.wg.Add(s.workers + 1)
+	for i := 0; i < s.workers; i++ {
+		go func() {
+			defer s.wg.Done()
+			s.work(ctx)
+		}()
+	}
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.warmInterval)
+		defer ticker.Stop()
+		for {
+			n, err := s.uc.Warm(ctx)
+			if err != nil && ctx.Err() == nil {
+				s.log.Errorf("warm flash sales: %v", err)
+			}
+			if n > 0 {
+				s.log.Debugf("warmed %d flash sales", n)
+			}
+			n, err = s.uc.Requeue(ctx)
+			if err != nil && ctx.Err() == nil {
+				s.log.Errorf("requeue flash sale attempts: %v", err)
+			}
+			if n > 0 {
+				s.log.Infof("requeued %d flash sale attempts", n)
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// work places the attempts queued until ctx is done, trying an attempt
+// again a second after an error; one left when it stops is requeued once
+// its lease ends.
+func (s *FlashSaleServer) work(ctx context.Context) {
+	for ctx.Err() == nil {
+		a, err := s.uc.Next(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				s.log.Errorf("take flash sale attempt: %v", err)
+				sleep(ctx, time.Second)
+			}
+			continue
+		}
+		if a == nil {
+			continue
+		}
+		for ctx.Err() == nil {
+			err := s.uc.Place(ctx, a)
+			if err == nil {
+				break
+			}
+			s.log.Errorf("place flash sale attempt %s: %v", a.AttemptNo, err)
+			sleep(ctx, time.Second)
+		}
+	}
+}
+
+// Stop implements transport.Server, it waits for the attempts in hand.
+func (s *FlashSaleServer) Stop(ctx context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+	return nil
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+	case <-ctx.Done():
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/go-kratos/kratos-layout/bff/api/helloworld/v1"
 	"github.com/go-kratos/kratos-layout/internal/conf"
 	"github.com/go-kratos/kratos-layout/internal/service"
+	orderv1 "github.com/go-kratos/kratos-layout/order/api/order/v1"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
@@ -11,7 +12,7 @@ import (
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, order *service.OrderService, cart *service.CartService, promotion *service.PromotionService, afterSale *service.AfterSaleService, invoice *service.InvoiceService, flashSale *service.FlashSaleService, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
@@ -28,5 +29,11 @@ func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, logger log.L
 	}
 	srv := grpc.NewServer(opts...)
 	v1.RegisterGreeterServer(srv, greeter)
+	orderv1.RegisterOrderServer(srv, order)
+	orderv1.RegisterCartServer(srv, cart)
+	orderv1.RegisterPromotionServer(srv, promotion)
+	orderv1.RegisterAfterSaleServer(srv, afterSale)
+	orderv1.RegisterInvoiceServer(srv, invoice)
+	orderv1.RegisterFlashSaleServer(srv, flashSale)
 	return srv
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/go-kratos/kratos-layout/bff/api/helloworld/v1"
 	"github.com/go-kratos/kratos-layout/internal/conf"
 	"github.com/go-kratos/kratos-layout/internal/service"
+	orderv1 "github.com/go-kratos/kratos-layout/order/api/order/v1"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
@@ -11,7 +12,7 @@ import (
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, order *service.OrderService, cart *service.CartService, promotion *service.PromotionService, afterSale *service.AfterSaleService, invoice *service.InvoiceService, flashSale *service.FlashSaleService, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
@@ -28,5 +29,11 @@ func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, logger log.L
 	}
 	srv := http.NewServer(opts...)
 	v1.RegisterGreeterHTTPServer(srv, greeter)
+	orderv1.RegisterOrderHTTPServer(srv, order)
+	orderv1.RegisterCartHTTPServer(srv, cart)
+	orderv1.RegisterPromotionHTTPServer(srv, promotion)
+	orderv1.RegisterAfterSaleHTTPServer(srv, afterSale)
+	orderv1.RegisterInvoiceHTTPServer(srv, invoice)
+	orderv1.RegisterFlashSaleHTTPServer(srv, flashSale)
 	return srv
 }
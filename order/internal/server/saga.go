@@ -0,0 +1,14 @@
+package server
+
+import (
+	"github.com/go-kratos/kratos-layout/internal/conf"
+	"github.com/go-kratos/kratos-layout/pkg/saga"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// NewSagaRunner new a runner taking up the sagas cut short, such as by a
+// crash, on start and then periodically.
+func NewSagaRunner(o *saga.Orchestrator, c *conf.Order, logger log.Logger) *saga.Runner {
+	return saga.NewRunner(o, c.GetSaga().GetRecoverInterval().AsDuration(), logger)
+}
@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// TrackingServer polls the carriers for the tracking events of the parcels
+// not delivered, each parcel once per poll interval.
+type TrackingServer struct {
+	uc           *biz.ShipmentUsecase
+	pollInterval time.Duration
+	cancel       context.CancelFunc
+	wg           sync.WaitGroup
+	log          *log.Helper
+}
+
+// NewTrackingServer new a tracking server.
+func NewTrackingServer(uc *biz.ShipmentUsecase, c *conf.Order, logger log.Logger) *TrackingServer {
+	pollInterval := c.GetTracking().GetPollInterval().AsDuration()
+	if pollInterval <= 0 {
+		pollInterval = 10 * time.Minute
+	}
+	return &TrackingServer{uc: uc, pollInterval: pollInterval, log: log.NewHelper(logger)}
+}
+
+// Start implements transport.Server.
+func (s *TrackingServer) Start(context.Context) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		for ctx.Err() == nil {
+			n, err := s.uc.SyncShipments(ctx, time.Now().Add(-s.pollInterval))
+			if err != nil && ctx.Err() == nil {
+				s.log.Errorf("sync shipments: %v", err)
+			}
+			if n > 0 {
+				s.log.Debugf("tracked %d shipments", n)
+			}
+			// Parcels checked leave the batch, so that a round checking
+			// some may leave others behind, taken up at once.
+			if err != nil || n == 0 {
+				sleep(ctx, min(s.pollInterval, time.Minute))
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop implements transport.Server, it waits for the parcel in hand.
+func (s *TrackingServer) Stop(ctx context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+	return nil
+}
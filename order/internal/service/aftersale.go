@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/go-kratos/kratos-layout/order/api/order/v1"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AfterSaleService is an after-sale service.
+type AfterSaleService struct {
+	v1.UnimplementedAfterSaleServer
+
+	uc *biz.AfterSaleUsecase
+}
+
+// NewAfterSaleService new an after-sale service.
+func NewAfterSaleService(uc *biz.AfterSaleUsecase) *AfterSaleService {
+	return &AfterSaleService{uc: uc}
+}
+
+// CreateAfterSale implements v1.AfterSaleServer.
+func (s *AfterSaleService) CreateAfterSale(ctx context.Context, in *v1.CreateAfterSaleRequest) (*v1.AfterSaleInfo, error) {
+	a := &biz.AfterSale{
+		OrderNo:     in.OrderNo,
+		Kind:        afterSaleKinds[in.Kind],
+		Reason:      in.Reason,
+		Description: in.Description,
+		Evidence:    in.Evidence,
+	}
+	for _, it := range in.Items {
+		a.Items = append(a.Items, &biz.AfterSaleItem{SkuID: it.SkuId, Quantity: it.Quantity})
+	}
+	a, err := s.uc.CreateAfterSale(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+	return toAfterSaleProto(a), nil
+}
+
+// GetAfterSale implements v1.AfterSaleServer.
+func (s *AfterSaleService) GetAfterSale(ctx context.Context, in *v1.GetAfterSaleRequest) (*v1.AfterSaleInfo, error) {
+	a, err := s.uc.GetAfterSale(ctx, in.TicketNo)
+	if err != nil {
+		return nil, err
+	}
+	return toAfterSaleProto(a), nil
+}
+
+// ListAfterSales implements v1.AfterSaleServer.
+func (s *AfterSaleService) ListAfterSales(ctx context.Context, in *v1.ListAfterSalesRequest) (*v1.ListAfterSalesReply, error) {
+	as, total, err := s.uc.ListAfterSales(ctx, &biz.AfterSaleFilter{
+		UserID:     in.UserId,
+		MerchantID: in.MerchantId,
+		OrderNo:    in.OrderNo,
+		Status:     afterSaleStatuses[in.Status],
+	}, int(in.Page), int(in.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListAfterSalesReply{Total: total}
+	for _, a := range as {
+		reply.AfterSales = append(reply.AfterSales, toAfterSaleProto(a))
+	}
+	return reply, nil
+}
+
+// CancelAfterSale implements v1.AfterSaleServer.
+func (s *AfterSaleService) CancelAfterSale(ctx context.Context, in *v1.CancelAfterSaleRequest) (*v1.AfterSaleInfo, error) {
+	a, err := s.uc.CancelAfterSale(ctx, in.TicketNo)
+	if err != nil {
+		return nil, err
+	}
+	return toAfterSaleProto(a), nil
+}
+
+// ShipReturn implements v1.AfterSaleServer.
+func (s *AfterSaleService) ShipReturn(ctx context.Context, in *v1.ShipReturnRequest) (*v1.AfterSaleInfo, error) {
+	a, err := s.uc.ShipReturn(ctx, in.TicketNo, in.Carrier, in.TrackingNo)
+	if err != nil {
+		return nil, err
+	}
+	return toAfterSaleProto(a), nil
+}
+
+// ApproveAfterSale implements v1.AfterSaleServer.
+func (s *AfterSaleService) ApproveAfterSale(ctx context.Context, in *v1.ApproveAfterSaleRequest) (*v1.AfterSaleInfo, error) {
+	a, err := s.uc.ApproveAfterSale(ctx, in.TicketNo, in.Note)
+	if err != nil {
+		return nil, err
+	}
+	return toAfterSaleProto(a), nil
+}
+
+// RejectAfterSale implements v1.AfterSaleServer.
+func (s *AfterSaleService) RejectAfterSale(ctx context.Context, in *v1.RejectAfterSaleRequest) (*v1.AfterSaleInfo, error) {
+	a, err := s.uc.RejectAfterSale(ctx, in.TicketNo, in.Note)
+	if err != nil {
+		return nil, err
+	}
+	return toAfterSaleProto(a), nil
+}
+
+// ReceiveReturn implements v1.AfterSaleServer.
+func (s *AfterSaleService) ReceiveReturn(ctx context.Context, in *v1.ReceiveReturnRequest) (*v1.AfterSaleInfo, error) {
+	a, err := s.uc.ReceiveReturn(ctx, in.TicketNo)
+	if err != nil {
+		return nil, err
+	}
+	return toAfterSaleProto(a), nil
+}
+
+// ShipReplacement implements v1.AfterSaleServer.
+func (s *AfterSaleService) ShipReplacement(ctx context.Context, in *v1.ShipReplacementRequest) (*v1.AfterSaleInfo, error) {
+	a, err := s.uc.ShipReplacement(ctx, in.TicketNo, in.Carrier, in.TrackingNo)
+	if err != nil {
+		return nil, err
+	}
+	return toAfterSaleProto(a), nil
+}
+
+var afterSaleKinds = map[v1.AfterSaleKind]biz.AfterSaleKind{
+	v1.AfterSaleKind_REFUND_ONLY:   biz.AfterSaleRefundOnly,
+	v1.AfterSaleKind_RETURN_REFUND: biz.AfterSaleReturnRefund,
+	v1.AfterSaleKind_EXCHANGE:      biz.AfterSaleExchange,
+}
+
+var afterSaleStatuses = map[v1.AfterSaleStatus]biz.AfterSaleStatus{
+	v1.AfterSaleStatus_AFTER_SALE_PENDING_REVIEW:  biz.AfterSalePending,
+	v1.AfterSaleStatus_AFTER_SALE_AWAITING_RETURN: biz.AfterSaleAwaitingReturn,
+	v1.AfterSaleStatus_AFTER_SALE_RETURNING:       biz.AfterSaleReturning,
+	v1.AfterSaleStatus_AFTER_SALE_REFUNDING:       biz.AfterSaleRefunding,
+	v1.AfterSaleStatus_AFTER_SALE_RESHIPPING:      biz.AfterSaleReshipping,
+	v1.AfterSaleStatus_AFTER_SALE_COMPLETED:       biz.AfterSaleCompleted,
+	v1.AfterSaleStatus_AFTER_SALE_REJECTED:        biz.AfterSaleRejected,
+	v1.AfterSaleStatus_AFTER_SALE_CLOSED:          biz.AfterSaleClosed,
+}
+
+func toAfterSaleProto(a *biz.AfterSale) *v1.AfterSaleInfo {
+	pb := &v1.AfterSaleInfo{
+		TicketNo:         a.TicketNo,
+		OrderNo:          a.OrderNo,
+		UserId:           a.UserID,
+		MerchantId:       a.MerchantID,
+		RefundAmount:     a.RefundAmount,
+		Reason:           a.Reason,
+		Description:      a.Description,
+		Evidence:         a.Evidence,
+		MerchantNote:     a.MerchantNote,
+		ReturnCarrier:    a.ReturnCarrier,
+		ReturnTrackingNo: a.ReturnTrackingNo,
+		ReshipCarrier:    a.ReshipCarrier,
+		ReshipTrackingNo: a.ReshipTrackingNo,
+		RefundNo:         a.RefundNo,
+		Deadline:         toTimestamp(a.Deadline),
+		Version:          a.Version,
+		CreatedAt:        timestamppb.New(a.CreatedAt),
+		ReviewedAt:       toTimestamp(a.ReviewedAt),
+		ReturnedAt:       toTimestamp(a.ReturnedAt),
+		ReceivedAt:       toTimestamp(a.ReceivedAt),
+		CompletedAt:      toTimestamp(a.CompletedAt),
+	}
+	for k, v := range afterSaleKinds {
+		if v == a.Kind {
+			pb.Kind = k
+		}
+	}
+	for k, v := range afterSaleStatuses {
+		if v == a.Status {
+			pb.Status = k
+		}
+	}
+	for _, it := range a.Items {
+		pb.Items = append(pb.Items, &v1.AfterSaleItem{SkuId: it.SkuID, Quantity: it.Quantity, Amount: it.Amount})
+	}
+	return pb
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/go-kratos/kratos-layout/order/api/order/v1"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+)
+
+// CartService is a cart service.
+type CartService struct {
+	v1.UnimplementedCartServer
+
+	uc *biz.CartUsecase
+}
+
+// NewCartService new a cart service.
+func NewCartService(uc *biz.CartUsecase) *CartService {
+	return &CartService{uc: uc}
+}
+
+// GetCart implements v1.CartServer.
+func (s *CartService) GetCart(ctx context.Context, in *v1.GetCartRequest) (*v1.CartInfo, error) {
+	c, err := s.uc.GetCart(ctx, toCartOwner(in.Owner), in.CouponCodes)
+	if err != nil {
+		return nil, err
+	}
+	return toCartProto(c), nil
+}
+
+// AddCartItem implements v1.CartServer.
+func (s *CartService) AddCartItem(ctx context.Context, in *v1.AddCartItemRequest) (*v1.CartInfo, error) {
+	c, err := s.uc.AddItem(ctx, toCartOwner(in.Owner), in.SkuId, in.Quantity)
+	if err != nil {
+		return nil, err
+	}
+	return toCartProto(c), nil
+}
+
+// UpdateCartItem implements v1.CartServer.
+func (s *CartService) UpdateCartItem(ctx context.Context, in *v1.UpdateCartItemRequest) (*v1.CartInfo, error) {
+	c, err := s.uc.UpdateItem(ctx, toCartOwner(in.Owner), in.SkuId, in.Quantity)
+	if err != nil {
+		return nil, err
+	}
+	return toCartProto(c), nil
+}
+
+// RemoveCartItems implements v1.CartServer.
+func (s *CartService) RemoveCartItems(ctx context.Context, in *v1.RemoveCartItemsRequest) (*v1.CartInfo, error) {
+	c, err := s.uc.RemoveItems(ctx, toCartOwner(in.Owner), in.SkuIds)
+	if err != nil {
+		return nil, err
+	}
+	return toCartProto(c), nil
+}
+
+// SelectCartItems implements v1.CartServer.
+func (s *CartService) SelectCartItems(ctx context.Context, in *v1.SelectCartItemsRequest) (*v1.CartInfo, error) {
+	c, err := s.uc.SelectItems(ctx, toCartOwner(in.Owner), in.SkuIds, in.Selected)
+	if err != nil {
+		return nil, err
+	}
+	return toCartProto(c), nil
+}
+
+// MergeCart implements v1.CartServer.
+func (s *CartService) MergeCart(ctx context.Context, in *v1.MergeCartRequest) (*v1.CartInfo, error) {
+	c, err := s.uc.MergeCart(ctx, in.UserId, in.MemberLevel, in.GuestId)
+	if err != nil {
+		return nil, err
+	}
+	return toCartProto(c), nil
+}
+
+var cartLineStatuses = map[biz.CartLineStatus]v1.CartLineStatus{
+	biz.CartLineAvailable:         v1.CartLineStatus_AVAILABLE,
+	biz.CartLineInsufficientStock: v1.CartLineStatus_INSUFFICIENT_STOCK,
+	biz.CartLineOutOfStock:        v1.CartLineStatus_OUT_OF_STOCK,
+	biz.CartLineDelisted:          v1.CartLineStatus_DELISTED,
+}
+
+func toCartOwner(o *v1.CartOwner) biz.CartOwner {
+	return biz.CartOwner{UserID: o.GetUserId(), GuestID: o.GetGuestId(), MemberLevel: o.GetMemberLevel()}
+}
+
+func toCartProto(c *biz.Cart) *v1.CartInfo {
+	pb := &v1.CartInfo{
+		SelectedQuantity:  c.SelectedQuantity,
+		SelectedAmount:    c.SelectedAmount,
+		ShippingFee:       c.ShippingFee,
+		DiscountAmount:    c.DiscountAmount,
+		PayAmount:         c.PayAmount,
+		ShippingDiscounts: toDiscountsProto(c.ShippingDiscounts),
+		CouponCodes:       c.CouponCodes,
+	}
+	for _, l := range c.Lines {
+		pb.Lines = append(pb.Lines, &v1.CartLine{
+			SkuId:          l.SkuID,
+			SpuId:          l.SpuID,
+			MerchantId:     l.MerchantID,
+			Title:          l.Title,
+			Image:          l.Image,
+			Price:          l.Price,
+			AddedPrice:     l.AddedPrice,
+			Quantity:       l.Quantity,
+			Amount:         l.Amount,
+			Selected:       l.Selected,
+			Status:         cartLineStatuses[l.Status],
+			Stock:          l.Stock,
+			DiscountAmount: l.DiscountAmount,
+			PayAmount:      l.PayAmount,
+			Discounts:      toDiscountsProto(l.Discounts),
+		})
+	}
+	return pb
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/go-kratos/kratos-layout/order/api/order/v1"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FlashSaleService is a flash sale service.
+type FlashSaleService struct {
+	v1.UnimplementedFlashSaleServer
+
+	uc *biz.FlashSaleUsecase
+}
+
+// NewFlashSaleService new a flash sale service.
+func NewFlashSaleService(uc *biz.FlashSaleUsecase) *FlashSaleService {
+	return &FlashSaleService{uc: uc}
+}
+
+// GetFlashSaleChallenge implements v1.FlashSaleServer.
+func (s *FlashSaleService) GetFlashSaleChallenge(ctx context.Context, in *v1.GetFlashSaleChallengeRequest) (*v1.FlashSaleChallenge, error) {
+	c, err := s.uc.GetChallenge(ctx, in.FlashSaleId, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.FlashSaleChallenge{
+		Token:      c.Token,
+		Difficulty: int32(c.Difficulty),
+		ExpireAt:   timestamppb.New(c.ExpireAt),
+	}, nil
+}
+
+// JoinFlashSale implements v1.FlashSaleServer.
+func (s *FlashSaleService) JoinFlashSale(ctx context.Context, in *v1.JoinFlashSaleRequest) (*v1.FlashSaleAttemptInfo, error) {
+	a := &biz.FlashSaleAttempt{
+		FlashSaleID: in.FlashSaleId,
+		UserID:      in.UserId,
+		Quantity:    in.Quantity,
+		Remark:      in.Remark,
+	}
+	if addr := in.Address; addr != nil {
+		a.Address = &biz.Address{
+			Name:     addr.Name,
+			Phone:    addr.Phone,
+			Province: addr.Province,
+			City:     addr.City,
+			District: addr.District,
+			Detail:   addr.Detail,
+		}
+	}
+	a, err := s.uc.JoinFlashSale(ctx, a, in.Token, in.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	return toFlashSaleAttemptProto(a), nil
+}
+
+// GetFlashSaleAttempt implements v1.FlashSaleServer.
+func (s *FlashSaleService) GetFlashSaleAttempt(ctx context.Context, in *v1.GetFlashSaleAttemptRequest) (*v1.FlashSaleAttemptInfo, error) {
+	a, err := s.uc.GetAttempt(ctx, in.AttemptNo)
+	if err != nil {
+		return nil, err
+	}
+	return toFlashSaleAttemptProto(a), nil
+}
+
+var flashSaleAttemptStatuses = map[biz.FlashSaleAttemptStatus]v1.FlashSaleAttemptStatus{
+	biz.AttemptQueued:    v1.FlashSaleAttemptStatus_QUEUED,
+	biz.AttemptSucceeded: v1.FlashSaleAttemptStatus_SUCCEEDED,
+	biz.AttemptFailed:    v1.FlashSaleAttemptStatus_FAILED,
+}
+
+func toFlashSaleAttemptProto(a *biz.FlashSaleAttempt) *v1.FlashSaleAttemptInfo {
+	return &v1.FlashSaleAttemptInfo{
+		AttemptNo:     a.AttemptNo,
+		FlashSaleId:   a.FlashSaleID,
+		UserId:        a.UserID,
+		Quantity:      a.Quantity,
+		Status:        flashSaleAttemptStatuses[a.Status],
+		ParentNo:      a.ParentNo,
+		FailureReason: a.FailureReason,
+		CreatedAt:     toTimestamp(a.CreatedAt),
+	}
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/go-kratos/kratos-layout/order/api/order/v1"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// InvoiceService is an invoice service.
+type InvoiceService struct {
+	v1.UnimplementedInvoiceServer
+
+	uc *biz.InvoiceUsecase
+}
+
+// NewInvoiceService new an invoice service.
+func NewInvoiceService(uc *biz.InvoiceUsecase) *InvoiceService {
+	return &InvoiceService{uc: uc}
+}
+
+// CreateInvoiceTitle implements v1.InvoiceServer.
+func (s *InvoiceService) CreateInvoiceTitle(ctx context.Context, in *v1.CreateInvoiceTitleRequest) (*v1.InvoiceTitleInfo, error) {
+	t, err := s.uc.CreateInvoiceTitle(ctx, &biz.InvoiceTitle{
+		UserID:      in.UserId,
+		Kind:        invoiceTitleKinds[in.Kind],
+		Name:        in.Name,
+		TaxID:       in.TaxId,
+		Address:     in.Address,
+		Phone:       in.Phone,
+		BankName:    in.BankName,
+		BankAccount: in.BankAccount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toInvoiceTitleProto(t), nil
+}
+
+// UpdateInvoiceTitle implements v1.InvoiceServer.
+func (s *InvoiceService) UpdateInvoiceTitle(ctx context.Context, in *v1.UpdateInvoiceTitleRequest) (*v1.InvoiceTitleInfo, error) {
+	t, err := s.uc.UpdateInvoiceTitle(ctx, &biz.InvoiceTitle{
+		ID:          in.Id,
+		UserID:      in.UserId,
+		Kind:        invoiceTitleKinds[in.Kind],
+		Name:        in.Name,
+		TaxID:       in.TaxId,
+		Address:     in.Address,
+		Phone:       in.Phone,
+		BankName:    in.BankName,
+		BankAccount: in.BankAccount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toInvoiceTitleProto(t), nil
+}
+
+// DeleteInvoiceTitle implements v1.InvoiceServer.
+func (s *InvoiceService) DeleteInvoiceTitle(ctx context.Context, in *v1.DeleteInvoiceTitleRequest) (*v1.DeleteInvoiceTitleReply, error) {
+	if err := s.uc.DeleteInvoiceTitle(ctx, in.UserId, in.Id); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteInvoiceTitleReply{}, nil
+}
+
+// ListInvoiceTitles implements v1.InvoiceServer.
+func (s *InvoiceService) ListInvoiceTitles(ctx context.Context, in *v1.ListInvoiceTitlesRequest) (*v1.ListInvoiceTitlesReply, error) {
+	ts, err := s.uc.ListInvoiceTitles(ctx, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListInvoiceTitlesReply{}
+	for _, t := range ts {
+		reply.Titles = append(reply.Titles, toInvoiceTitleProto(t))
+	}
+	return reply, nil
+}
+
+// RequestInvoice implements v1.InvoiceServer.
+func (s *InvoiceService) RequestInvoice(ctx context.Context, in *v1.RequestInvoiceRequest) (*v1.InvoiceInfo, error) {
+	inv, err := s.uc.RequestInvoice(ctx, in.UserId, in.TitleId, in.OrderNos, in.Email)
+	if err != nil {
+		return nil, err
+	}
+	return toInvoiceProto(inv), nil
+}
+
+// GetInvoice implements v1.InvoiceServer.
+func (s *InvoiceService) GetInvoice(ctx context.Context, in *v1.GetInvoiceRequest) (*v1.InvoiceInfo, error) {
+	inv, err := s.uc.GetInvoice(ctx, in.InvoiceNo)
+	if err != nil {
+		return nil, err
+	}
+	return toInvoiceProto(inv), nil
+}
+
+// ListInvoices implements v1.InvoiceServer.
+func (s *InvoiceService) ListInvoices(ctx context.Context, in *v1.ListInvoicesRequest) (*v1.ListInvoicesReply, error) {
+	invs, total, err := s.uc.ListInvoices(ctx, &biz.InvoiceFilter{
+		UserID:     in.UserId,
+		MerchantID: in.MerchantId,
+		OrderNo:    in.OrderNo,
+		Status:     invoiceStatuses[in.Status],
+	}, int(in.Page), int(in.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListInvoicesReply{Total: total}
+	for _, inv := range invs {
+		reply.Invoices = append(reply.Invoices, toInvoiceProto(inv))
+	}
+	return reply, nil
+}
+
+var invoiceTitleKinds = map[v1.InvoiceTitleKind]biz.InvoiceTitleKind{
+	v1.InvoiceTitleKind_PERSONAL: biz.InvoicePersonal,
+	v1.InvoiceTitleKind_COMPANY:  biz.InvoiceCompany,
+}
+
+var invoiceStatuses = map[v1.InvoiceStatus]biz.InvoiceStatus{
+	v1.InvoiceStatus_INVOICE_ISSUING:   biz.InvoiceIssuing,
+	v1.InvoiceStatus_INVOICE_ISSUED:    biz.InvoiceIssued,
+	v1.InvoiceStatus_INVOICE_FAILED:    biz.InvoiceFailed,
+	v1.InvoiceStatus_INVOICE_REVERSING: biz.InvoiceReversing,
+	v1.InvoiceStatus_INVOICE_REVERSED:  biz.InvoiceReversed,
+}
+
+func toInvoiceTitleProto(t *biz.InvoiceTitle) *v1.InvoiceTitleInfo {
+	pb := &v1.InvoiceTitleInfo{
+		Id:          t.ID,
+		UserId:      t.UserID,
+		Name:        t.Name,
+		TaxId:       t.TaxID,
+		Address:     t.Address,
+		Phone:       t.Phone,
+		BankName:    t.BankName,
+		BankAccount: t.BankAccount,
+		CreatedAt:   toTimestamp(t.CreatedAt),
+		UpdatedAt:   toTimestamp(t.UpdatedAt),
+	}
+	for k, v := range invoiceTitleKinds {
+		if v == t.Kind {
+			pb.Kind = k
+		}
+	}
+	return pb
+}
+
+func toInvoiceProto(inv *biz.Invoice) *v1.InvoiceInfo {
+	pb := &v1.InvoiceInfo{
+		InvoiceNo:  inv.InvoiceNo,
+		UserId:     inv.UserID,
+		MerchantId: inv.MerchantID,
+		Email:      inv.Email,
+		Amount:     inv.Amount,
+		ReplacesNo: inv.ReplacesNo,
+		ProviderNo: inv.ProviderNo,
+		FileUrl:    inv.FileURL,
+		FailReason: inv.FailReason,
+		CreatedAt:  timestamppb.New(inv.CreatedAt),
+		IssuedAt:   toTimestamp(inv.IssuedAt),
+		ReversedAt: toTimestamp(inv.ReversedAt),
+	}
+	if inv.Title != nil {
+		pb.Title = toInvoiceTitleProto(inv.Title)
+	}
+	for k, v := range invoiceStatuses {
+		if v == inv.Status {
+			pb.Status = k
+		}
+	}
+	for _, o := range inv.Orders {
+		pb.Orders = append(pb.Orders, &v1.InvoiceOrder{OrderNo: o.OrderNo, Amount: o.Amount})
+	}
+	return pb
+}
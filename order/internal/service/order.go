@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/go-kratos/kratos-layout/order/api/order/v1"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// OrderService is an order service.
+type OrderService struct {
+	v1.UnimplementedOrderServer
+
+	uc        *biz.OrderUsecase
+	shipments *biz.ShipmentUsecase
+}
+
+// NewOrderService new an order service.
+func NewOrderService(uc *biz.OrderUsecase, shipments *biz.ShipmentUsecase) *OrderService {
+	return &OrderService{uc: uc, shipments: shipments}
+}
+
+// CreateOrder implements v1.OrderServer.
+func (s *OrderService) CreateOrder(ctx context.Context, in *v1.CreateOrderRequest) (*v1.ParentOrderInfo, error) {
+	o := &biz.Order{
+		UserID: in.UserId,
+		Remark: in.Remark,
+	}
+	for _, it := range in.Items {
+		o.Items = append(o.Items, &biz.OrderItem{
+			SkuID:    it.SkuId,
+			Quantity: it.Quantity,
+		})
+	}
+	if a := in.Address; a != nil {
+		o.Address = &biz.Address{
+			Name:     a.Name,
+			Phone:    a.Phone,
+			Province: a.Province,
+			City:     a.City,
+			District: a.District,
+			Detail:   a.Detail,
+		}
+	}
+	var pay *biz.Payment
+	if in.Payment != nil {
+		pay = &biz.Payment{
+			Points:  in.Payment.Points,
+			Balance: in.Payment.Balance,
+			Channel: in.Payment.Channel,
+		}
+	}
+	p, err := s.uc.CreateOrder(ctx, o, in.MemberLevel, in.CouponCodes, pay)
+	if err != nil {
+		return nil, err
+	}
+	return toParentOrderProto(p), nil
+}
+
+// GetParentOrder implements v1.OrderServer.
+func (s *OrderService) GetParentOrder(ctx context.Context, in *v1.GetParentOrderRequest) (*v1.ParentOrderInfo, error) {
+	p, err := s.uc.GetParentOrder(ctx, in.ParentNo)
+	if err != nil {
+		return nil, err
+	}
+	return toParentOrderProto(p), nil
+}
+
+// GetOrder implements v1.OrderServer.
+func (s *OrderService) GetOrder(ctx context.Context, in *v1.GetOrderRequest) (*v1.OrderInfo, error) {
+	o, err := s.uc.GetOrder(ctx, in.OrderNo)
+	if err != nil {
+		return nil, err
+	}
+	return toOrderProto(o), nil
+}
+
+// ListOrders implements v1.OrderServer.
+func (s *OrderService) ListOrders(ctx context.Context, in *v1.ListOrdersRequest) (*v1.ListOrdersReply, error) {
+	os, total, err := s.uc.ListOrders(ctx, &biz.OrderFilter{
+		UserID:   in.UserId,
+		ParentNo: in.ParentNo,
+		Status:   orderStatuses[in.Status],
+	}, int(in.Page), int(in.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListOrdersReply{Total: total}
+	for _, o := range os {
+		reply.Orders = append(reply.Orders, toOrderProto(o))
+	}
+	return reply, nil
+}
+
+// CancelOrder implements v1.OrderServer.
+func (s *OrderService) CancelOrder(ctx context.Context, in *v1.CancelOrderRequest) (*v1.OrderInfo, error) {
+	o, err := s.uc.CancelOrder(ctx, in.OrderNo, in.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return toOrderProto(o), nil
+}
+
+// ShipOrder implements v1.OrderServer.
+func (s *OrderService) ShipOrder(ctx context.Context, in *v1.ShipOrderRequest) (*v1.OrderInfo, error) {
+	o, err := s.shipments.ShipOrder(ctx, in.OrderNo, in.Carrier, in.TrackingNo)
+	if err != nil {
+		return nil, err
+	}
+	return toOrderProto(o), nil
+}
+
+// ListShipments implements v1.OrderServer.
+func (s *OrderService) ListShipments(ctx context.Context, in *v1.ListShipmentsRequest) (*v1.ListShipmentsReply, error) {
+	ss, err := s.shipments.ListShipments(ctx, in.OrderNo)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListShipmentsReply{Shipments: make([]*v1.ShipmentInfo, 0, len(ss))}
+	for _, sh := range ss {
+		reply.Shipments = append(reply.Shipments, toShipmentProto(sh))
+	}
+	return reply, nil
+}
+
+// CompleteOrder implements v1.OrderServer.
+func (s *OrderService) CompleteOrder(ctx context.Context, in *v1.CompleteOrderRequest) (*v1.OrderInfo, error) {
+	o, err := s.uc.CompleteOrder(ctx, in.OrderNo)
+	if err != nil {
+		return nil, err
+	}
+	return toOrderProto(o), nil
+}
+
+var orderStatuses = map[v1.OrderStatus]biz.Status{
+	v1.OrderStatus_PENDING_PAYMENT: biz.StatusPending,
+	v1.OrderStatus_PAID:            biz.StatusPaid,
+	v1.OrderStatus_SHIPPED:         biz.StatusShipped,
+	v1.OrderStatus_COMPLETED:       biz.StatusCompleted,
+	v1.OrderStatus_CANCELLED:       biz.StatusCancelled,
+	v1.OrderStatus_REFUNDING:       biz.StatusRefunding,
+}
+
+func toOrderProto(o *biz.Order) *v1.OrderInfo {
+	pb := &v1.OrderInfo{
+		OrderNo:           o.OrderNo,
+		ParentNo:          o.ParentNo,
+		UserId:            o.UserID,
+		MerchantId:        o.MerchantID,
+		ItemsAmount:       o.ItemsAmount,
+		ShippingFee:       o.ShippingFee,
+		DiscountAmount:    o.DiscountAmount,
+		PayAmount:         o.PayAmount,
+		Remark:            o.Remark,
+		TradeNo:           o.TradeNo,
+		RefundedAmount:    o.RefundedAmount,
+		CancelReason:      o.CancelReason,
+		Version:           o.Version,
+		CreatedAt:         timestamppb.New(o.CreatedAt),
+		PaidAt:            toTimestamp(o.PaidAt),
+		ShippedAt:         toTimestamp(o.ShippedAt),
+		CompletedAt:       toTimestamp(o.CompletedAt),
+		CancelledAt:       toTimestamp(o.CancelledAt),
+		ExpireAt:          toTimestamp(o.ExpireAt),
+		ShippingDiscounts: toDiscountsProto(o.ShippingDiscounts),
+	}
+	for k, v := range orderStatuses {
+		if v == o.Status {
+			pb.Status = k
+		}
+	}
+	for _, it := range o.Items {
+		pb.Items = append(pb.Items, &v1.OrderItem{
+			SkuId:          it.SkuID,
+			Title:          it.Title,
+			Price:          it.Price,
+			Quantity:       it.Quantity,
+			Amount:         it.Amount,
+			DiscountAmount: it.DiscountAmount,
+			PayAmount:      it.PayAmount,
+			Discounts:      toDiscountsProto(it.Discounts),
+		})
+	}
+	if a := o.Address; a != nil {
+		pb.Address = &v1.Address{
+			Name:     a.Name,
+			Phone:    a.Phone,
+			Province: a.Province,
+			City:     a.City,
+			District: a.District,
+			Detail:   a.Detail,
+		}
+	}
+	return pb
+}
+
+var shipmentStatuses = map[biz.ShipmentStatus]v1.ShipmentStatus{
+	biz.ShipmentShipped:    v1.ShipmentStatus_SHIPMENT_STATUS_SHIPPED,
+	biz.ShipmentInTransit:  v1.ShipmentStatus_SHIPMENT_STATUS_IN_TRANSIT,
+	biz.ShipmentDelivering: v1.ShipmentStatus_SHIPMENT_STATUS_DELIVERING,
+	biz.ShipmentDelivered:  v1.ShipmentStatus_SHIPMENT_STATUS_DELIVERED,
+	biz.ShipmentException:  v1.ShipmentStatus_SHIPMENT_STATUS_EXCEPTION,
+}
+
+func toShipmentProto(s *biz.Shipment) *v1.ShipmentInfo {
+	pb := &v1.ShipmentInfo{
+		Id:          s.ID,
+		OrderNo:     s.OrderNo,
+		MerchantId:  s.MerchantID,
+		Carrier:     s.Carrier,
+		TrackingNo:  s.TrackingNo,
+		Status:      shipmentStatuses[s.Status],
+		Events:      make([]*v1.TrackingEvent, 0, len(s.Events)),
+		CreatedAt:   timestamppb.New(s.CreatedAt),
+		CheckedAt:   toTimestamp(s.CheckedAt),
+		DeliveredAt: toTimestamp(s.DeliveredAt),
+	}
+	for _, e := range s.Events {
+		pb.Events = append(pb.Events, &v1.TrackingEvent{
+			Status:      shipmentStatuses[e.Status],
+			Location:    e.Location,
+			Description: e.Description,
+			OccurredAt:  timestamppb.New(e.OccurredAt),
+		})
+	}
+	return pb
+}
+
+func toParentOrderProto(p *biz.ParentOrder) *v1.ParentOrderInfo {
+	pb := &v1.ParentOrderInfo{
+		ParentNo:       p.ParentNo,
+		UserId:         p.UserID,
+		ItemsAmount:    p.ItemsAmount,
+		ShippingFee:    p.ShippingFee,
+		DiscountAmount: p.DiscountAmount,
+		PayAmount:      p.PayAmount,
+		CouponCodes:    p.CouponCodes,
+		PayNo:          p.PayNo,
+		ExpireAt:       toTimestamp(p.ExpireAt),
+		CreatedAt:      timestamppb.New(p.CreatedAt),
+	}
+	for _, o := range p.Orders {
+		pb.Orders = append(pb.Orders, toOrderProto(o))
+	}
+	return pb
+}
+
+// toTimestamp converts t, leaving a zero time unset.
+func toTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/go-kratos/kratos-layout/order/api/order/v1"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// PromotionService is a promotion back office service.
+type PromotionService struct {
+	v1.UnimplementedPromotionServer
+
+	uc *biz.PromotionUsecase
+}
+
+// NewPromotionService new a promotion service.
+func NewPromotionService(uc *biz.PromotionUsecase) *PromotionService {
+	return &PromotionService{uc: uc}
+}
+
+// CreatePromotion implements v1.PromotionServer.
+func (s *PromotionService) CreatePromotion(ctx context.Context, in *v1.CreatePromotionRequest) (*v1.PromotionInfo, error) {
+	pb := in.GetPromotion()
+	p := &biz.Promotion{
+		Name:           pb.GetName(),
+		Kind:           promotionKinds[pb.GetKind()],
+		Scope:          toScope(pb.GetScope()),
+		Repeat:         pb.GetRepeat(),
+		MaxReduction:   pb.GetMaxReduction(),
+		Percent:        pb.GetPercent(),
+		MinAmount:      pb.GetMinAmount(),
+		MinQuantity:    pb.GetMinQuantity(),
+		ExclusiveGroup: pb.GetExclusiveGroup(),
+		StartAt:        pb.GetStartAt().AsTime(),
+		EndAt:          pb.GetEndAt().AsTime(),
+	}
+	for _, t := range pb.GetTiers() {
+		p.Tiers = append(p.Tiers, biz.Tier{Threshold: t.Threshold, Reduction: t.Reduction})
+	}
+	p, err := s.uc.CreatePromotion(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	return toPromotionProto(p), nil
+}
+
+// ListPromotions implements v1.PromotionServer.
+func (s *PromotionService) ListPromotions(ctx context.Context, in *v1.ListPromotionsRequest) (*v1.ListPromotionsReply, error) {
+	ps, total, err := s.uc.ListPromotions(ctx, in.Active, int(in.Page), int(in.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListPromotionsReply{Total: total}
+	for _, p := range ps {
+		reply.Promotions = append(reply.Promotions, toPromotionProto(p))
+	}
+	return reply, nil
+}
+
+// SetPromotionEnabled implements v1.PromotionServer.
+func (s *PromotionService) SetPromotionEnabled(ctx context.Context, in *v1.SetPromotionEnabledRequest) (*v1.PromotionInfo, error) {
+	p, err := s.uc.SetPromotionEnabled(ctx, in.Id, in.Enabled)
+	if err != nil {
+		return nil, err
+	}
+	return toPromotionProto(p), nil
+}
+
+// SetMemberPrices implements v1.PromotionServer.
+func (s *PromotionService) SetMemberPrices(ctx context.Context, in *v1.SetMemberPricesRequest) (*v1.SetMemberPricesReply, error) {
+	prices := make(map[int64]int64, len(in.Prices))
+	for _, p := range in.Prices {
+		prices[p.SkuId] = p.Price
+	}
+	if err := s.uc.SetMemberPrices(ctx, in.Level, prices); err != nil {
+		return nil, err
+	}
+	return &v1.SetMemberPricesReply{}, nil
+}
+
+// IssueCoupon implements v1.PromotionServer.
+func (s *PromotionService) IssueCoupon(ctx context.Context, in *v1.IssueCouponRequest) (*v1.CouponInfo, error) {
+	pb := in.GetCoupon()
+	c := &biz.Coupon{
+		UserID:         pb.GetUserId(),
+		Title:          pb.GetTitle(),
+		Kind:           couponKinds[pb.GetKind()],
+		Amount:         pb.GetAmount(),
+		Percent:        pb.GetPercent(),
+		MaxDiscount:    pb.GetMaxDiscount(),
+		MinAmount:      pb.GetMinAmount(),
+		Scope:          toScope(pb.GetScope()),
+		ExclusiveGroup: pb.GetExclusiveGroup(),
+		ExpireAt:       pb.GetExpireAt().AsTime(),
+	}
+	if pb.GetStartAt() != nil {
+		c.StartAt = pb.GetStartAt().AsTime()
+	}
+	c, err := s.uc.IssueCoupon(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	return toCouponProto(c), nil
+}
+
+// ListCoupons implements v1.PromotionServer.
+func (s *PromotionService) ListCoupons(ctx context.Context, in *v1.ListCouponsRequest) (*v1.ListCouponsReply, error) {
+	cs, err := s.uc.ListCoupons(ctx, in.UserId, couponStatuses[in.Status])
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListCouponsReply{}
+	for _, c := range cs {
+		reply.Coupons = append(reply.Coupons, toCouponProto(c))
+	}
+	return reply, nil
+}
+
+var promotionKinds = map[v1.PromotionKind]biz.PromotionKind{
+	v1.PromotionKind_FULL_REDUCTION: biz.PromotionFullReduction,
+	v1.PromotionKind_PERCENTAGE:     biz.PromotionPercentage,
+	v1.PromotionKind_FREE_SHIPPING:  biz.PromotionFreeShipping,
+}
+
+var couponKinds = map[v1.CouponKind]biz.CouponKind{
+	v1.CouponKind_AMOUNT_OFF:           biz.CouponAmountOff,
+	v1.CouponKind_PERCENT_OFF:          biz.CouponPercentOff,
+	v1.CouponKind_FREE_SHIPPING_COUPON: biz.CouponFreeShipping,
+}
+
+var couponStatuses = map[v1.CouponStatus]biz.CouponStatus{
+	v1.CouponStatus_COUPON_AVAILABLE: biz.CouponAvailable,
+	v1.CouponStatus_COUPON_USED:      biz.CouponUsed,
+}
+
+var discountKinds = map[biz.DiscountKind]v1.DiscountKind{
+	biz.DiscountMemberPrice:   v1.DiscountKind_MEMBER_PRICE,
+	biz.DiscountPercentage:    v1.DiscountKind_PERCENTAGE_DISCOUNT,
+	biz.DiscountFullReduction: v1.DiscountKind_FULL_REDUCTION_DISCOUNT,
+	biz.DiscountCoupon:        v1.DiscountKind_COUPON,
+	biz.DiscountFreeShipping:  v1.DiscountKind_FREE_SHIPPING_DISCOUNT,
+}
+
+func toScope(pb *v1.Scope) biz.Scope {
+	return biz.Scope{SkuIDs: pb.GetSkuIds(), SpuIDs: pb.GetSpuIds(), MerchantIDs: pb.GetMerchantIds()}
+}
+
+func toScopeProto(s biz.Scope) *v1.Scope {
+	return &v1.Scope{SkuIds: s.SkuIDs, SpuIds: s.SpuIDs, MerchantIds: s.MerchantIDs}
+}
+
+func toPromotionProto(p *biz.Promotion) *v1.PromotionInfo {
+	pb := &v1.PromotionInfo{
+		Id:             p.ID,
+		Name:           p.Name,
+		Scope:          toScopeProto(p.Scope),
+		Repeat:         p.Repeat,
+		MaxReduction:   p.MaxReduction,
+		Percent:        p.Percent,
+		MinAmount:      p.MinAmount,
+		MinQuantity:    p.MinQuantity,
+		ExclusiveGroup: p.ExclusiveGroup,
+		StartAt:        timestamppb.New(p.StartAt),
+		EndAt:          timestamppb.New(p.EndAt),
+		Enabled:        p.Enabled,
+		CreatedAt:      timestamppb.New(p.CreatedAt),
+	}
+	for k, v := range promotionKinds {
+		if v == p.Kind {
+			pb.Kind = k
+		}
+	}
+	for _, t := range p.Tiers {
+		pb.Tiers = append(pb.Tiers, &v1.Tier{Threshold: t.Threshold, Reduction: t.Reduction})
+	}
+	return pb
+}
+
+func toCouponProto(c *biz.Coupon) *v1.CouponInfo {
+	pb := &v1.CouponInfo{
+		Id:             c.ID,
+		Code:           c.Code,
+		UserId:         c.UserID,
+		Title:          c.Title,
+		Amount:         c.Amount,
+		Percent:        c.Percent,
+		MaxDiscount:    c.MaxDiscount,
+		MinAmount:      c.MinAmount,
+		Scope:          toScopeProto(c.Scope),
+		ExclusiveGroup: c.ExclusiveGroup,
+		StartAt:        timestamppb.New(c.StartAt),
+		ExpireAt:       timestamppb.New(c.ExpireAt),
+		OrderNo:        c.OrderNo,
+	}
+	for k, v := range couponKinds {
+		if v == c.Kind {
+			pb.Kind = k
+		}
+	}
+	for k, v := range couponStatuses {
+		if v == c.Status {
+			pb.Status = k
+		}
+	}
+	return pb
+}
+
+func toDiscountsProto(ds []*biz.Discount) []*v1.Discount {
+	var pbs []*v1.Discount
+	for _, d := range ds {
+		pbs = append(pbs, &v1.Discount{
+			Kind:   discountKinds[d.Kind],
+			Source: d.Source,
+			Title:  d.Title,
+			Amount: d.Amount,
+		})
+	}
+	return pbs
+}
@@ -0,0 +1,277 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.3
+// source: payment/v1/error_reason.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ErrorReason int32
+
+const (
+	ErrorReason_PAYMENT_UNSPECIFIED                  ErrorReason = 0
+	ErrorReason_PAYMENT_NOT_FOUND                    ErrorReason = 1
+	ErrorReason_INVALID_TIME_RANGE                   ErrorReason = 2
+	ErrorReason_INVALID_AMOUNT                       ErrorReason = 3
+	ErrorReason_CHANNEL_NOT_SUPPORTED                ErrorReason = 4
+	ErrorReason_CHANNEL_ERROR                        ErrorReason = 5
+	ErrorReason_PAYMENT_ALREADY_PAID                 ErrorReason = 6
+	ErrorReason_PAYMENT_ALREADY_CLOSED               ErrorReason = 7
+	ErrorReason_CHANNEL_NOT_SIMULATED                ErrorReason = 8
+	ErrorReason_INVALID_NOTIFICATION                 ErrorReason = 9
+	ErrorReason_COMBINED_PAYMENT_NOT_FOUND           ErrorReason = 10
+	ErrorReason_COMBINED_PAYMENT_NOT_CANCELLABLE     ErrorReason = 11
+	ErrorReason_REFUND_NOT_FOUND                     ErrorReason = 12
+	ErrorReason_REFUND_EXCEEDS_PAID                  ErrorReason = 13
+	ErrorReason_PAYMENT_NOT_REFUNDABLE               ErrorReason = 14
+	ErrorReason_INVALID_REFUND                       ErrorReason = 15
+	ErrorReason_ILLEGAL_PAYMENT_TRANSITION           ErrorReason = 16
+	ErrorReason_PAYMENT_VERSION_CONFLICT             ErrorReason = 17
+	ErrorReason_PAYMENT_ALREADY_FAILED               ErrorReason = 18
+	ErrorReason_PAYMENT_FULLY_REFUNDED               ErrorReason = 19
+	ErrorReason_INVALID_DAY                          ErrorReason = 20
+	ErrorReason_STATEMENT_NOT_SUPPORTED              ErrorReason = 21
+	ErrorReason_INVALID_STATEMENT                    ErrorReason = 22
+	ErrorReason_STATEMENT_ALREADY_IMPORTED           ErrorReason = 23
+	ErrorReason_STATEMENT_NOT_FOUND                  ErrorReason = 24
+	ErrorReason_STATEMENT_EXCEPTION_NOT_FOUND        ErrorReason = 25
+	ErrorReason_STATEMENT_EXCEPTION_ALREADY_RESOLVED ErrorReason = 26
+	// The risk engine refused the payment.
+	ErrorReason_PAYMENT_RISK_DENIED ErrorReason = 27
+	// The payer must be verified, see VerifyChallenge, then the payment
+	// retried with the decision_id of the error metadata as
+	// risk.challenge_id.
+	ErrorReason_PAYMENT_RISK_CHALLENGE ErrorReason = 28
+	// Another payment of the same business is being created, retry shortly.
+	ErrorReason_PAYMENT_IN_PROGRESS ErrorReason = 29
+	// No challenge of the user to verify, or it expired or was used.
+	ErrorReason_RISK_CHALLENGE_NOT_FOUND ErrorReason = 30
+)
+
+// Enum value maps for ErrorReason.
+var (
+	ErrorReason_name = map[int32]string{
+		0:  "PAYMENT_UNSPECIFIED",
+		1:  "PAYMENT_NOT_FOUND",
+		2:  "INVALID_TIME_RANGE",
+		3:  "INVALID_AMOUNT",
+		4:  "CHANNEL_NOT_SUPPORTED",
+		5:  "CHANNEL_ERROR",
+		6:  "PAYMENT_ALREADY_PAID",
+		7:  "PAYMENT_ALREADY_CLOSED",
+		8:  "CHANNEL_NOT_SIMULATED",
+		9:  "INVALID_NOTIFICATION",
+		10: "COMBINED_PAYMENT_NOT_FOUND",
+		11: "COMBINED_PAYMENT_NOT_CANCELLABLE",
+		12: "REFUND_NOT_FOUND",
+		13: "REFUND_EXCEEDS_PAID",
+		14: "PAYMENT_NOT_REFUNDABLE",
+		15: "INVALID_REFUND",
+		16: "ILLEGAL_PAYMENT_TRANSITION",
+		17: "PAYMENT_VERSION_CONFLICT",
+		18: "PAYMENT_ALREADY_FAILED",
+		19: "PAYMENT_FULLY_REFUNDED",
+		20: "INVALID_DAY",
+		21: "STATEMENT_NOT_SUPPORTED",
+		22: "INVALID_STATEMENT",
+		23: "STATEMENT_ALREADY_IMPORTED",
+		24: "STATEMENT_NOT_FOUND",
+		25: "STATEMENT_EXCEPTION_NOT_FOUND",
+		26: "STATEMENT_EXCEPTION_ALREADY_RESOLVED",
+		27: "PAYMENT_RISK_DENIED",
+		28: "PAYMENT_RISK_CHALLENGE",
+		29: "PAYMENT_IN_PROGRESS",
+		30: "RISK_CHALLENGE_NOT_FOUND",
+	}
+	ErrorReason_value = map[string]int32{
+		"PAYMENT_UNSPECIFIED":                  0,
+		"PAYMENT_NOT_FOUND":                    1,
+		"INVALID_TIME_RANGE":                   2,
+		"INVALID_AMOUNT":                       3,
+		"CHANNEL_NOT_SUPPORTED":                4,
+		"CHANNEL_ERROR":                        5,
+		"PAYMENT_ALREADY_PAID":                 6,
+		"PAYMENT_ALREADY_CLOSED":               7,
+		"CHANNEL_NOT_SIMULATED":                8,
+		"INVALID_NOTIFICATION":                 9,
+		"COMBINED_PAYMENT_NOT_FOUND":           10,
+		"COMBINED_PAYMENT_NOT_CANCELLABLE":     11,
+		"REFUND_NOT_FOUND":                     12,
+		"REFUND_EXCEEDS_PAID":                  13,
+		"PAYMENT_NOT_REFUNDABLE":               14,
+		"INVALID_REFUND":                       15,
+		"ILLEGAL_PAYMENT_TRANSITION":           16,
+		"PAYMENT_VERSION_CONFLICT":             17,
+		"PAYMENT_ALREADY_FAILED":               18,
+		"PAYMENT_FULLY_REFUNDED":               19,
+		"INVALID_DAY":                          20,
+		"STATEMENT_NOT_SUPPORTED":              21,
+		"INVALID_STATEMENT":                    22,
+		"STATEMENT_ALREADY_IMPORTED":           23,
+		"STATEMENT_NOT_FOUND":                  24,
+		"STATEMENT_EXCEPTION_NOT_FOUND":        25,
+		"STATEMENT_EXCEPTION_ALREADY_RESOLVED": 26,
+		"PAYMENT_RISK_DENIED":                  27,
+		"PAYMENT_RISK_CHALLENGE":               28,
+		"PAYMENT_IN_PROGRESS":                  29,
+		"RISK_CHALLENGE_NOT_FOUND":             30,
+	}
+)
+
+func (x ErrorReason) Enum() *ErrorReason {
+	p := new(ErrorReason)
+	*p = x
+	return p
+}
+
+func (x ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_error_reason_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorReason) Type() protoreflect.EnumType {
+	return &file_payment_v1_error_reason_proto_enumTypes[0]
+}
+
+func (x ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorReason.Descriptor instead.
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_error_reason_proto_rawDescGZIP(), []int{0}
+}
+
+var File_payment_v1_error_reason_proto protoreflect.FileDescriptor
+
+var file_payment_v1_error_reason_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2a, 0xd4, 0x06, 0x0a, 0x0b,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x50,
+	0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47,
+	0x45, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41,
+	0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x48, 0x41, 0x4e, 0x4e,
+	0x45, 0x4c, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44,
+	0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x50, 0x41, 0x49, 0x44, 0x10, 0x06, 0x12,
+	0x1a, 0x0a, 0x16, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41,
+	0x44, 0x59, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x07, 0x12, 0x19, 0x0a, 0x15, 0x43,
+	0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x49, 0x4d, 0x55, 0x4c,
+	0x41, 0x54, 0x45, 0x44, 0x10, 0x08, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x09,
+	0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x4d, 0x42, 0x49, 0x4e, 0x45, 0x44, 0x5f, 0x50, 0x41, 0x59,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x0a,
+	0x12, 0x24, 0x0a, 0x20, 0x43, 0x4f, 0x4d, 0x42, 0x49, 0x4e, 0x45, 0x44, 0x5f, 0x50, 0x41, 0x59,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c,
+	0x41, 0x42, 0x4c, 0x45, 0x10, 0x0b, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x0c, 0x12, 0x17, 0x0a, 0x13,
+	0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x53, 0x5f, 0x50,
+	0x41, 0x49, 0x44, 0x10, 0x0d, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x41, 0x42, 0x4c, 0x45, 0x10,
+	0x0e, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x46,
+	0x55, 0x4e, 0x44, 0x10, 0x0f, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4c, 0x4c, 0x45, 0x47, 0x41, 0x4c,
+	0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x10, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43,
+	0x54, 0x10, 0x11, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41,
+	0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x12, 0x12,
+	0x1a, 0x0a, 0x16, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x59,
+	0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x13, 0x12, 0x0f, 0x0a, 0x0b, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x14, 0x12, 0x1b, 0x0a, 0x17,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x55,
+	0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x15, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x16,
+	0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x54, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x4c,
+	0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x17,
+	0x12, 0x17, 0x0a, 0x13, 0x53, 0x54, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x18, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x50, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x19, 0x12, 0x28, 0x0a, 0x24,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x50, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x4f,
+	0x4c, 0x56, 0x45, 0x44, 0x10, 0x1a, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x52, 0x49, 0x53, 0x4b, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x1b, 0x12,
+	0x1a, 0x0a, 0x16, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x49, 0x53, 0x4b, 0x5f,
+	0x43, 0x48, 0x41, 0x4c, 0x4c, 0x45, 0x4e, 0x47, 0x45, 0x10, 0x1c, 0x12, 0x17, 0x0a, 0x13, 0x50,
+	0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45,
+	0x53, 0x53, 0x10, 0x1d, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x49, 0x53, 0x4b, 0x5f, 0x43, 0x48, 0x41,
+	0x4c, 0x4c, 0x45, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x1e, 0x42, 0x5b, 0x0a, 0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67,
+	0x6f, 0x2d, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2d,
+	0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31,
+	0xa2, 0x02, 0x0c, 0x41, 0x50, 0x49, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_payment_v1_error_reason_proto_rawDescOnce sync.Once
+	file_payment_v1_error_reason_proto_rawDescData = file_payment_v1_error_reason_proto_rawDesc
+)
+
+func file_payment_v1_error_reason_proto_rawDescGZIP() []byte {
+	file_payment_v1_error_reason_proto_rawDescOnce.Do(func() {
+		file_payment_v1_error_reason_proto_rawDescData = protoimpl.X.CompressGZIP(file_payment_v1_error_reason_proto_rawDescData)
+	})
+	return file_payment_v1_error_reason_proto_rawDescData
+}
+
+var file_payment_v1_error_reason_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_payment_v1_error_reason_proto_goTypes = []interface{}{
+	(ErrorReason)(0), // 0: payment.v1.ErrorReason
+}
+var file_payment_v1_error_reason_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_payment_v1_error_reason_proto_init() }
+func file_payment_v1_error_reason_proto_init() {
+	if File_payment_v1_error_reason_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_payment_v1_error_reason_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_payment_v1_error_reason_proto_goTypes,
+		DependencyIndexes: file_payment_v1_error_reason_proto_depIdxs,
+		EnumInfos:         file_payment_v1_error_reason_proto_enumTypes,
+	}.Build()
+	File_payment_v1_error_reason_proto = out.File
+	file_payment_v1_error_reason_proto_rawDesc = nil
+	file_payment_v1_error_reason_proto_goTypes = nil
+	file_payment_v1_error_reason_proto_depIdxs = nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/go-kratos/kratos-layout/internal/server"
 	"github.com/go-kratos/kratos-layout/internal/service"
 	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/config"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
@@ -23,7 +24,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, configConfig config.Config, logger log.Logger) (*kratos.App, func(), error) {
 	dataData, cleanup, err := data.NewData(confData, logger)
 	if err != nil {
 		return nil, nil, err
@@ -31,10 +32,67 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 	greeterRepo := data.NewGreeterRepo(dataData, logger)
 	greeterUsecase := biz.NewGreeterUsecase(greeterRepo, logger)
 	greeterService := service.NewGreeterService(greeterUsecase)
-	grpcServer := server.NewGRPCServer(confServer, greeterService, logger)
-	httpServer := server.NewHTTPServer(confServer, greeterService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	paymentRepo := data.NewPaymentRepo(dataData, logger)
+	transitionRepo := data.NewTransitionRepo(dataData, logger)
+	transaction := data.NewTransaction(dataData)
+	stateMachine := biz.NewStateMachine(paymentRepo, transitionRepo, transaction, logger)
+	notificationRepo := data.NewNotificationRepo(dataData, logger)
+	eventRepo := data.NewEventRepo(dataData, logger)
+	statsRepo := data.NewStatsRepo(dataData, logger)
+	riskRuleSource, err := data.NewRiskRuleSource(configConfig, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	velocityRepo := data.NewVelocityRepo(dataData, logger)
+	userRepo := data.NewUserRepo(logger)
+	riskDecisionRepo := data.NewRiskDecisionRepo(dataData, logger)
+	riskUsecase := biz.NewRiskUsecase(riskRuleSource, velocityRepo, userRepo, riskDecisionRepo, logger)
+	channels, err := data.NewChannels(confData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	paymentUsecase := biz.NewPaymentUsecase(paymentRepo, stateMachine, notificationRepo, eventRepo, statsRepo, riskUsecase, transaction, channels, logger)
+	combinedRepo := data.NewCombinedRepo(dataData, logger)
+	benefitsClient, cleanup2, err := data.NewMembersClient(confData)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	couponRepo := data.NewCouponRepo(benefitsClient, logger)
+	pointsRepo := data.NewPointsRepo(benefitsClient, logger)
+	balanceClient, cleanup3, err := data.NewWalletClient(confData)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	balanceRepo := data.NewBalanceRepo(balanceClient, logger)
+	combinedUsecase := biz.NewCombinedUsecase(combinedRepo, couponRepo, pointsRepo, balanceRepo, paymentUsecase, eventRepo, statsRepo, riskUsecase, transaction, logger)
+	refundRepo := data.NewRefundRepo(dataData, logger)
+	refundUsecase := biz.NewRefundUsecase(refundRepo, paymentRepo, stateMachine, combinedRepo, couponRepo, pointsRepo, balanceRepo, channels, eventRepo, statsRepo, transaction, logger)
+	paymentService := service.NewPaymentService(paymentUsecase, combinedUsecase, refundUsecase, riskUsecase)
+	statementRepo := data.NewStatementRepo(dataData, logger)
+	statementUsecase := biz.NewStatementUsecase(statementRepo, paymentRepo, channels, transaction, logger)
+	reportUsecase := biz.NewReportUsecase(statsRepo, logger)
+	paymentAdminService := service.NewPaymentAdminService(statementUsecase, reportUsecase, riskUsecase)
+	grpcServer := server.NewGRPCServer(confServer, greeterService, paymentService, paymentAdminService, logger)
+	notifyService := service.NewNotifyService(paymentUsecase, logger)
+	httpServer := server.NewHTTPServer(confServer, greeterService, paymentService, notifyService, paymentAdminService, logger)
+	eventBus := data.NewEventBus(dataData)
+	eventUsecase := biz.NewEventUsecase(eventRepo, eventBus, logger)
+	cronServer, err := server.NewCronServer(confServer, paymentUsecase, combinedUsecase, refundUsecase, eventUsecase, logger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	app := newApp(logger, grpcServer, httpServer, cronServer)
 	return app, func() {
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
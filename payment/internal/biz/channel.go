@@ -0,0 +1,135 @@
+package biz
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	v1 "github.com/go-kratos/kratos-layout/payment/api/payment/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+var (
+	// ErrChannelNotSupported is returned for a channel that is not configured.
+	ErrChannelNotSupported = errors.BadRequest(v1.ErrorReason_CHANNEL_NOT_SUPPORTED.String(), "channel not supported")
+	// ErrChannelNotSimulated is returned when simulating a payment of a real channel.
+	ErrChannelNotSimulated = errors.BadRequest(v1.ErrorReason_CHANNEL_NOT_SIMULATED.String(), "channel is not simulated")
+)
+
+// InvalidNotification is returned for a notification that fails verification.
+func InvalidNotification(channel string, err error) error {
+	return errors.BadRequest(v1.ErrorReason_INVALID_NOTIFICATION.String(), channel+": "+err.Error()).WithCause(err)
+}
+
+// ChannelError is returned when a channel rejects or fails a call.
+func ChannelError(channel string, err error) error {
+	return errors.ServiceUnavailable(v1.ErrorReason_CHANNEL_ERROR.String(), channel+": "+err.Error()).WithCause(err)
+}
+
+const (
+	ChannelAlipay = "alipay"
+	ChannelWechat = "wechat"
+)
+
+// ChannelTradeState is the state of a trade as the channel sees it.
+type ChannelTradeState string
+
+const (
+	ChannelTradePending ChannelTradeState = "pending"
+	ChannelTradePaid    ChannelTradeState = "paid"
+	ChannelTradeClosed  ChannelTradeState = "closed"
+)
+
+// ChannelRefundState is the state of a refund as the channel sees it.
+type ChannelRefundState string
+
+const (
+	ChannelRefundProcessing ChannelRefundState = "processing"
+	ChannelRefundSucceeded  ChannelRefundState = "succeeded"
+	ChannelRefundFailed     ChannelRefundState = "failed"
+)
+
+// ChannelOrder is what we ask a channel to collect.
+type ChannelOrder struct {
+	TradeNo  string
+	Subject  string
+	Amount   int64
+	ExpireAt time.Time
+}
+
+// ChannelIntent is how the payer completes a trade, such as a QR code URL.
+type ChannelIntent struct {
+	PayURL string
+}
+
+// ChannelTrade is a trade as queried from a channel.
+type ChannelTrade struct {
+	TradeNo        string
+	ChannelTradeNo string
+	State          ChannelTradeState
+	Amount         int64
+	PaidAt         time.Time
+}
+
+// ChannelRefund is a refund we ask a channel to pay back.
+type ChannelRefund struct {
+	TradeNo     string
+	RefundNo    string
+	Amount      int64
+	TotalAmount int64
+	Reason      string
+}
+
+// ChannelRefundResult is a refund as the channel reports it.
+type ChannelRefundResult struct {
+	RefundNo        string
+	ChannelRefundNo string
+	State           ChannelRefundState
+}
+
+// NotifyRequest is a notification as a channel posted it to us.
+type NotifyRequest struct {
+	Header http.Header
+	Body   []byte
+}
+
+// ChannelNotify is a verified channel notification.
+type ChannelNotify struct {
+	// ID identifies the notification; a channel resends it with the same ID.
+	ID string
+	// Trade is the trade the notification reports, nil for other events.
+	Trade *ChannelTrade
+}
+
+// Channel is a payment channel such as Alipay or WeChat Pay.
+type Channel interface {
+	Name() string
+	Create(context.Context, *ChannelOrder) (*ChannelIntent, error)
+	Query(ctx context.Context, tradeNo string) (*ChannelTrade, error)
+	Close(ctx context.Context, tradeNo string) error
+	Refund(context.Context, *ChannelRefund) (*ChannelRefundResult, error)
+	// QueryRefund reports how a refund the channel accepted went.
+	QueryRefund(ctx context.Context, tradeNo, refundNo string) (*ChannelRefundResult, error)
+	// ParseNotify verifies the signature of a notification and decodes it.
+	ParseNotify(context.Context, *NotifyRequest) (*ChannelNotify, error)
+}
+
+// Simulator is a channel that settles trades on command instead of a payer.
+type Simulator interface {
+	Channel
+	// Simulate pays a pending trade.
+	Simulate(ctx context.Context, tradeNo string) (*ChannelTrade, error)
+}
+
+// Channels are the configured channels by name.
+type Channels map[string]Channel
+
+// Get returns the channel with the name.
+func (cs Channels) Get(name string) (Channel, error) {
+	c, ok := cs[name]
+	if !ok {
+		return nil, ErrChannelNotSupported
+	}
+	return c, nil
+}
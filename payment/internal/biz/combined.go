@@ -0,0 +1,538 @@
+package biz
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+
+	v1 "github.com/go-kratos/kratos-layout/payment/api/payment/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	// ErrCombinedNotFound is combined payment not found.
+	ErrCombinedNotFound = errors.NotFound(v1.ErrorReason_COMBINED_PAYMENT_NOT_FOUND.String(), "combined payment not found")
+	// ErrCombinedNotCancellable is returned when cancelling a combined payment
+	// that is paid in full, or still holding its parts.
+	ErrCombinedNotCancellable = errors.Conflict(v1.ErrorReason_COMBINED_PAYMENT_NOT_CANCELLABLE.String(), "combined payment not cancellable")
+)
+
+// combinedGrace is how long a combined payment may stay in an intermediate
+// status before recovery takes it over.
+const combinedGrace = time.Minute
+
+// combinedBatch is how many combined payments one recovery round handles at most.
+const combinedBatch = 100
+
+// CombinedStatus is the status of a combined payment.
+type CombinedStatus string
+
+const (
+	// CombinedTrying holds the coupon, points and balance.
+	CombinedTrying CombinedStatus = "trying"
+	// CombinedPaying waits for the remainder to be paid through the channel.
+	CombinedPaying CombinedStatus = "paying"
+	// CombinedConfirming spends what was held once paid in full.
+	CombinedConfirming CombinedStatus = "confirming"
+	CombinedSucceeded  CombinedStatus = "succeeded"
+	// CombinedCancelling releases what was held after a failure.
+	CombinedCancelling CombinedStatus = "cancelling"
+	CombinedCancelled  CombinedStatus = "cancelled"
+)
+
+// Method is how part of a payment is paid.
+type Method string
+
+const (
+	MethodCoupon  Method = "coupon"
+	MethodPoints  Method = "points"
+	MethodBalance Method = "balance"
+	MethodChannel Method = "channel"
+)
+
+// Split is the part of a payment paid by one method.
+type Split struct {
+	Method Method
+	Amount int64
+}
+
+// CombinedPayment pays an order with a coupon, points and balance, and the
+// remainder through a channel. Its parts are held first and only spent once
+// the whole amount is covered, so that a failure anywhere releases them all.
+//
+// Points and BalanceAmount are the most to spend when created, and what was
+// held afterwards.
+type CombinedPayment struct {
+	ID            int64
+	PayNo         string
+	BizNo         string
+	UserID        int64
+	MerchantID    int64
+	Subject       string
+	Amount        int64
+	CouponID      int64
+	CouponAmount  int64
+	Points        int64
+	PointsAmount  int64
+	BalanceAmount int64
+	Channel       string
+	ChannelAmount int64
+	TradeNo       string
+	Status        CombinedStatus
+	FailReason    string
+	PaidAt        time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+
+	// Payment is the channel payment of the remainder, if loaded.
+	Payment *Payment
+}
+
+// Splits is how the amount is paid, leaving out unused methods.
+func (c *CombinedPayment) Splits() []Split {
+	var splits []Split
+	for _, s := range []Split{
+		{MethodCoupon, c.CouponAmount},
+		{MethodPoints, c.PointsAmount},
+		{MethodBalance, c.BalanceAmount},
+		{MethodChannel, c.ChannelAmount},
+	} {
+		if s.Amount > 0 {
+			splits = append(splits, s)
+		}
+	}
+	return splits
+}
+
+// CombinedRepo is a CombinedPayment repo.
+type CombinedRepo interface {
+	Save(context.Context, *CombinedPayment) (*CombinedPayment, error)
+	// Update saves a combined payment if it is still in status from,
+	// otherwise it returns ErrPaymentChanged.
+	Update(ctx context.Context, c *CombinedPayment, from CombinedStatus) error
+	FindByPayNo(ctx context.Context, payNo string) (*CombinedPayment, error)
+	// Lock finds a combined payment and locks it for the transaction carried by ctx.
+	Lock(ctx context.Context, payNo string) (*CombinedPayment, error)
+	// FindLatestByBiz finds the latest combined payment of an order.
+	FindLatestByBiz(ctx context.Context, bizNo string) (*CombinedPayment, error)
+	// ListStale lists the combined payments in status updated before a time.
+	ListStale(ctx context.Context, status CombinedStatus, before time.Time, limit int) ([]*CombinedPayment, error)
+}
+
+// CouponRepo reserves coupons in the members service.
+type CouponRepo interface {
+	// Reserve reserves a coupon against an order amount, returning the discount.
+	Reserve(ctx context.Context, reservationNo string, couponID, userID, orderAmount int64, bizNo string) (int64, error)
+	Redeem(ctx context.Context, reservationNo string) error
+	Release(ctx context.Context, reservationNo string) error
+	// Return makes a redeemed coupon available again.
+	Return(ctx context.Context, reservationNo string) error
+}
+
+// PointsRepo holds points in the members service.
+type PointsRepo interface {
+	// Hold holds up to points worth at most maxAmount, returning the points
+	// held and their worth.
+	Hold(ctx context.Context, holdNo string, userID, points, maxAmount int64, bizNo string) (int64, int64, error)
+	Capture(ctx context.Context, holdNo string) error
+	Release(ctx context.Context, holdNo string) error
+	// Refund credits back captured points worth amount.
+	Refund(ctx context.Context, refundNo, holdNo string, amount int64) error
+}
+
+// BalanceRepo holds balances in the wallet service.
+type BalanceRepo interface {
+	Hold(ctx context.Context, holdNo string, userID, amount int64, bizNo string) error
+	Capture(ctx context.Context, holdNo string) error
+	Release(ctx context.Context, holdNo string) error
+	// Refund credits back part of a captured amount.
+	Refund(ctx context.Context, refundNo, holdNo string, amount int64) error
+}
+
+// CombinedUsecase is a CombinedPayment usecase. Every part is held under the
+// pay number, so holding, spending and releasing can all be retried.
+type CombinedUsecase struct {
+	repo     CombinedRepo
+	coupons  CouponRepo
+	points   PointsRepo
+	balances BalanceRepo
+	payments *PaymentUsecase
+	events   EventRepo
+	stats    StatsRepo
+	risk     *RiskUsecase
+	tx       Transaction
+	log      *log.Helper
+}
+
+// NewCombinedUsecase new a CombinedPayment usecase.
+func NewCombinedUsecase(repo CombinedRepo, coupons CouponRepo, points PointsRepo, balances BalanceRepo, payments *PaymentUsecase, events EventRepo, stats StatsRepo, risk *RiskUsecase, tx Transaction, logger log.Logger) *CombinedUsecase {
+	uc := &CombinedUsecase{
+		repo:     repo,
+		coupons:  coupons,
+		points:   points,
+		balances: balances,
+		payments: payments,
+		events:   events,
+		stats:    stats,
+		risk:     risk,
+		tx:       tx,
+		log:      log.NewHelper(logger),
+	}
+	payments.OnSettled(uc.settle)
+	return uc
+}
+
+// CreateCombined holds the coupon, points and balance of an order and opens a
+// channel payment for the remainder, or completes at once when nothing
+// remains. Asking again for an order reuses its combined payment in flight,
+// or returns ErrPaymentInProgress while another is being created.
+func (uc *CombinedUsecase) CreateCombined(ctx context.Context, c *CombinedPayment, rc *RiskContext) (*CombinedPayment, error) {
+	if c.Amount <= 0 || c.CouponID < 0 || c.Points < 0 || c.BalanceAmount < 0 {
+		return nil, ErrInvalidAmount
+	}
+	// Concurrent checkouts of an order would each find no combined payment
+	// in flight, hold its parts twice and open two channel payments.
+	unlock, ok, err := uc.payments.repo.LockBiz(ctx, PurposeCombined, c.BizNo, createLockTTL)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrPaymentInProgress
+	}
+	defer unlock()
+	prev, err := uc.repo.FindLatestByBiz(ctx, c.BizNo)
+	switch {
+	case errors.Is(err, ErrCombinedNotFound):
+	case err != nil:
+		return nil, err
+	case prev.Status == CombinedSucceeded:
+		return nil, ErrPaymentPaid
+	case prev.Status != CombinedCancelled && prev.Status != CombinedCancelling:
+		return uc.load(ctx, prev)
+	}
+	if err := uc.risk.Check(ctx, c.UserID, c.BizNo, c.Amount, rc); err != nil {
+		return nil, err
+	}
+
+	c.PayNo = NewTradeNo("C")
+	c.Status = CombinedTrying
+	if c, err = uc.repo.Save(ctx, c); err != nil {
+		return nil, err
+	}
+	if err := uc.try(ctx, c); err != nil {
+		uc.cancel(ctx, c, CombinedTrying, err.Error())
+		return nil, err
+	}
+	if c.Status == CombinedConfirming {
+		uc.confirm(ctx, c)
+	}
+	return uc.load(ctx, c)
+}
+
+// try holds each part in turn, each against what the previous ones left.
+func (uc *CombinedUsecase) try(ctx context.Context, c *CombinedPayment) error {
+	remaining := c.Amount
+	if c.CouponID > 0 {
+		amount, err := uc.coupons.Reserve(ctx, c.PayNo, c.CouponID, c.UserID, c.Amount, c.BizNo)
+		if err != nil {
+			return err
+		}
+		c.CouponAmount = amount
+		remaining -= amount
+	}
+	if c.Points > 0 && remaining > 0 {
+		points, amount, err := uc.points.Hold(ctx, c.PayNo, c.UserID, c.Points, remaining, c.BizNo)
+		if err != nil {
+			return err
+		}
+		c.Points, c.PointsAmount = points, amount
+		remaining -= amount
+	} else {
+		c.Points = 0
+	}
+	if c.BalanceAmount > remaining {
+		c.BalanceAmount = remaining
+	}
+	if c.BalanceAmount > 0 {
+		if err := uc.balances.Hold(ctx, c.PayNo, c.UserID, c.BalanceAmount, c.BizNo); err != nil {
+			return err
+		}
+		remaining -= c.BalanceAmount
+	}
+	c.ChannelAmount = remaining
+	c.Status = CombinedConfirming
+	if remaining > 0 {
+		p, err := uc.payments.CreatePayment(ctx, &Payment{
+			BizNo:      c.PayNo,
+			UserID:     c.UserID,
+			MerchantID: c.MerchantID,
+			Purpose:    PurposeCombined,
+			Subject:    c.Subject,
+			Amount:     remaining,
+			Channel:    c.Channel,
+		}, nil)
+		if err != nil {
+			return err
+		}
+		c.TradeNo = p.TradeNo
+		c.Status = CombinedPaying
+	}
+	if err := uc.repo.Update(ctx, c, CombinedTrying); err != nil {
+		return err
+	}
+	uc.log.WithContext(ctx).Infof("CreateCombined: %s for %s, %d of %d left to %s",
+		c.PayNo, c.BizNo, c.ChannelAmount, c.Amount, c.Channel)
+	return nil
+}
+
+// GetCombined returns a combined payment, syncing its channel part while paying.
+func (uc *CombinedUsecase) GetCombined(ctx context.Context, payNo string) (*CombinedPayment, error) {
+	c, err := uc.repo.FindByPayNo(ctx, payNo)
+	if err != nil {
+		return nil, err
+	}
+	if c.Status == CombinedPaying {
+		p, err := uc.payments.GetPayment(ctx, c.TradeNo)
+		if err != nil {
+			return nil, err
+		}
+		uc.settle(ctx, p)
+		if c, err = uc.repo.FindByPayNo(ctx, payNo); err != nil {
+			return nil, err
+		}
+	}
+	return uc.load(ctx, c)
+}
+
+// CancelCombined gives up a combined payment whose remainder is unpaid,
+// closing its channel payment and releasing its parts.
+func (uc *CombinedUsecase) CancelCombined(ctx context.Context, payNo string) (*CombinedPayment, error) {
+	c, err := uc.repo.FindByPayNo(ctx, payNo)
+	if err != nil {
+		return nil, err
+	}
+	switch c.Status {
+	case CombinedCancelled:
+		return uc.load(ctx, c)
+	case CombinedCancelling:
+		uc.cancel(ctx, c, CombinedCancelling, c.FailReason)
+	case CombinedPaying:
+		if _, err := uc.payments.ClosePayment(ctx, c.TradeNo); err != nil {
+			return nil, err
+		}
+		if c, err = uc.repo.FindByPayNo(ctx, payNo); err != nil {
+			return nil, err
+		}
+		if c.Status == CombinedPaying {
+			// The channel payment was closed before, recover it here.
+			uc.cancel(ctx, c, CombinedPaying, "cancelled")
+		}
+	default:
+		return nil, ErrCombinedNotCancellable
+	}
+	return uc.GetCombined(ctx, payNo)
+}
+
+// settle moves a combined payment on once its channel part is paid or closed.
+func (uc *CombinedUsecase) settle(ctx context.Context, p *Payment) {
+	if p.Purpose != PurposeCombined {
+		return
+	}
+	c, err := uc.repo.FindByPayNo(ctx, p.BizNo)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("settle %s: %v", p.TradeNo, err)
+		return
+	}
+	if c.Status != CombinedPaying {
+		if c.Status == CombinedCancelled && p.Status.Paid() {
+			uc.log.WithContext(ctx).Warnf("Combined payment %s was paid by %s after it was cancelled", c.PayNo, p.TradeNo)
+		}
+		return
+	}
+	switch p.Status {
+	case StatusPaid:
+		c.Status = CombinedConfirming
+		c.PaidAt = p.PaidAt
+		if err := uc.repo.Update(ctx, c, CombinedPaying); err != nil {
+			if !stderrors.Is(err, ErrPaymentChanged) {
+				uc.log.WithContext(ctx).Errorf("settle %s: %v", c.PayNo, err)
+			}
+			return
+		}
+		c.Payment = p
+		uc.confirm(ctx, c)
+	case StatusClosed, StatusFailed:
+		uc.cancel(ctx, c, CombinedPaying, "channel payment "+string(p.Status))
+	}
+}
+
+// confirm spends the held parts of a confirming combined payment and
+// publishes PaymentSucceeded for the order. Left confirming when a part
+// fails, for recovery to retry.
+func (uc *CombinedUsecase) confirm(ctx context.Context, c *CombinedPayment) {
+	var err error
+	if c.CouponID > 0 {
+		err = uc.coupons.Redeem(ctx, c.PayNo)
+	}
+	if err == nil && c.PointsAmount > 0 {
+		err = uc.points.Capture(ctx, c.PayNo)
+	}
+	if err == nil && c.BalanceAmount > 0 {
+		err = uc.balances.Capture(ctx, c.PayNo)
+	}
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("confirm %s: %v", c.PayNo, err)
+		return
+	}
+	if c.PaidAt.IsZero() {
+		c.PaidAt = time.Now()
+	}
+	if c.Payment == nil && c.TradeNo != "" {
+		if c.Payment, err = uc.payments.repo.FindByTradeNo(ctx, c.TradeNo); err != nil {
+			uc.log.WithContext(ctx).Errorf("confirm %s: %v", c.PayNo, err)
+			return
+		}
+	}
+	c.Status = CombinedSucceeded
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.Update(ctx, c, CombinedConfirming); err != nil {
+			return err
+		}
+		e, err := newCombinedSucceeded(c)
+		if err != nil {
+			return err
+		}
+		if err := uc.events.Add(ctx, e); err != nil {
+			return err
+		}
+		return uc.stats.Add(ctx, paidStats(c.PaidAt, c.MerchantID, c.Channel, c.Splits()))
+	})
+	if err != nil {
+		if !stderrors.Is(err, ErrPaymentChanged) {
+			uc.log.WithContext(ctx).Errorf("confirm %s: %v", c.PayNo, err)
+		}
+		return
+	}
+	uc.log.WithContext(ctx).Infof("Combined payment %s succeeded", c.PayNo)
+}
+
+// cancel releases every part a combined payment may hold. A part never held
+// is released too, so that a hold still on its way is refused on arrival.
+// Left cancelling when a part fails, for recovery to retry.
+func (uc *CombinedUsecase) cancel(ctx context.Context, c *CombinedPayment, from CombinedStatus, reason string) {
+	if from != CombinedCancelling {
+		c.Status = CombinedCancelling
+		c.FailReason = reason
+		if err := uc.repo.Update(ctx, c, from); err != nil {
+			if !stderrors.Is(err, ErrPaymentChanged) {
+				uc.log.WithContext(ctx).Errorf("cancel %s: %v", c.PayNo, err)
+			}
+			return
+		}
+	}
+	var err error
+	if c.TradeNo != "" && from != CombinedPaying {
+		// Failed after opening the channel payment, which must not be paid now.
+		_, err = uc.payments.ClosePayment(ctx, c.TradeNo)
+	}
+	if err == nil && c.CouponID > 0 {
+		err = uc.coupons.Release(ctx, c.PayNo)
+	}
+	if err == nil && c.Points > 0 {
+		err = uc.points.Release(ctx, c.PayNo)
+	}
+	if err == nil && c.BalanceAmount > 0 {
+		err = uc.balances.Release(ctx, c.PayNo)
+	}
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("cancel %s: %v", c.PayNo, err)
+		return
+	}
+	c.Status = CombinedCancelled
+	if err := uc.repo.Update(ctx, c, CombinedCancelling); err != nil {
+		if !stderrors.Is(err, ErrPaymentChanged) {
+			uc.log.WithContext(ctx).Errorf("cancel %s: %v", c.PayNo, err)
+		}
+		return
+	}
+	uc.log.WithContext(ctx).Infof("Combined payment %s cancelled: %s", c.PayNo, reason)
+}
+
+// Recover finishes the combined payments a crash or a failed call left in an
+// intermediate status: abandoned tries are cancelled, paying ones are synced
+// with their channel payment, and confirming or cancelling ones retried.
+func (uc *CombinedUsecase) Recover(ctx context.Context) (int, error) {
+	before := time.Now().Add(-combinedGrace)
+	n := 0
+	for _, status := range []CombinedStatus{CombinedTrying, CombinedPaying, CombinedConfirming, CombinedCancelling} {
+		cs, err := uc.repo.ListStale(ctx, status, before, combinedBatch)
+		if err != nil {
+			return n, err
+		}
+		for _, c := range cs {
+			switch c.Status {
+			case CombinedTrying:
+				uc.cancel(ctx, c, CombinedTrying, "abandoned")
+			case CombinedPaying:
+				p, err := uc.payments.repo.FindByTradeNo(ctx, c.TradeNo)
+				if err != nil {
+					uc.log.WithContext(ctx).Errorf("Recover: %s: %v", c.PayNo, err)
+					continue
+				}
+				// Pending ones are left to PollPending.
+				uc.settle(ctx, p)
+			case CombinedConfirming:
+				uc.confirm(ctx, c)
+			case CombinedCancelling:
+				uc.cancel(ctx, c, CombinedCancelling, c.FailReason)
+			}
+			n++
+		}
+	}
+	return n, nil
+}
+
+// load attaches the channel payment of a combined payment.
+func (uc *CombinedUsecase) load(ctx context.Context, c *CombinedPayment) (*CombinedPayment, error) {
+	if c.TradeNo == "" || c.Payment != nil {
+		return c, nil
+	}
+	p, err := uc.payments.repo.FindByTradeNo(ctx, c.TradeNo)
+	if err != nil {
+		return nil, err
+	}
+	c.Payment = p
+	return c, nil
+}
+
+var methods = map[Method]v1.PaymentMethod{
+	MethodCoupon:  v1.PaymentMethod_METHOD_COUPON,
+	MethodPoints:  v1.PaymentMethod_METHOD_POINTS,
+	MethodBalance: v1.PaymentMethod_METHOD_BALANCE,
+	MethodChannel: v1.PaymentMethod_METHOD_CHANNEL,
+}
+
+// newCombinedSucceeded builds the v1.PaymentSucceeded event of an order paid
+// in full by a combined payment.
+func newCombinedSucceeded(c *CombinedPayment) (*Event, error) {
+	m := &v1.PaymentSucceeded{
+		TradeNo:    c.PayNo,
+		BizNo:      c.BizNo,
+		UserId:     c.UserID,
+		MerchantId: c.MerchantID,
+		Purpose:    v1.Purpose_PURPOSE_ORDER,
+		Amount:     c.Amount,
+		PaidAt:     timestamppb.New(c.PaidAt),
+	}
+	if c.Payment != nil {
+		m.Channel = c.Payment.Channel
+		m.ChannelTradeNo = c.Payment.ChannelTradeNo
+	}
+	for _, s := range c.Splits() {
+		m.Splits = append(m.Splits, &v1.PaymentSplit{Method: methods[s.Method], Amount: s.Amount})
+	}
+	return paymentSucceededEvent(m)
+}
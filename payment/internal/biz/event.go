@@ -0,0 +1,115 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/go-kratos/kratos-layout/payment/api/payment/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// EventPaymentSucceeded is the type of a v1.PaymentSucceeded event.
+const EventPaymentSucceeded = "payment.succeeded"
+
+// relayBatch is how many events one relay round publishes at most.
+const relayBatch = 100
+
+// Event is an event in the outbox, written in the transaction that caused it
+// and published afterwards so it is never lost nor published for a rollback.
+type Event struct {
+	ID          int64
+	Type        string
+	Key         string
+	Payload     []byte
+	CreatedAt   time.Time
+	PublishedAt time.Time
+}
+
+// EventRepo is the event outbox.
+type EventRepo interface {
+	// Add writes an event, joining the transaction carried by ctx.
+	Add(context.Context, *Event) error
+	ListUnpublished(ctx context.Context, limit int) ([]*Event, error)
+	MarkPublished(ctx context.Context, ids []int64) error
+}
+
+// EventBus delivers events to the other services.
+type EventBus interface {
+	Publish(context.Context, *Event) error
+}
+
+// EventUsecase relays the outbox to the event bus.
+type EventUsecase struct {
+	repo EventRepo
+	bus  EventBus
+	log  *log.Helper
+}
+
+// NewEventUsecase new an Event usecase.
+func NewEventUsecase(repo EventRepo, bus EventBus, logger log.Logger) *EventUsecase {
+	return &EventUsecase{repo: repo, bus: bus, log: log.NewHelper(logger)}
+}
+
+// Relay publishes the unpublished events in order, stopping at the first
+// failure so that events of a trade are never delivered out of order.
+// Consumers may see an event twice and must dedupe by its key.
+func (uc *EventUsecase) Relay(ctx context.Context) (int, error) {
+	n := 0
+	for {
+		events, err := uc.repo.ListUnpublished(ctx, relayBatch)
+		if err != nil {
+			return n, err
+		}
+		if len(events) == 0 {
+			return n, nil
+		}
+		ids := make([]int64, 0, len(events))
+		for _, e := range events {
+			if err := uc.bus.Publish(ctx, e); err != nil {
+				if len(ids) > 0 {
+					_ = uc.repo.MarkPublished(ctx, ids)
+				}
+				return n + len(ids), err
+			}
+			ids = append(ids, e.ID)
+		}
+		if err := uc.repo.MarkPublished(ctx, ids); err != nil {
+			return n, err
+		}
+		n += len(ids)
+		if len(events) < relayBatch {
+			return n, nil
+		}
+	}
+}
+
+// newPaymentSucceeded builds the v1.PaymentSucceeded event of a paid payment.
+func newPaymentSucceeded(p *Payment) (*Event, error) {
+	purpose := v1.Purpose_PURPOSE_ORDER
+	if p.Purpose == PurposeTopUp {
+		purpose = v1.Purpose_PURPOSE_TOP_UP
+	}
+	return paymentSucceededEvent(&v1.PaymentSucceeded{
+		TradeNo:        p.TradeNo,
+		BizNo:          p.BizNo,
+		UserId:         p.UserID,
+		MerchantId:     p.MerchantID,
+		Purpose:        purpose,
+		Amount:         p.Amount,
+		Channel:        p.Channel,
+		ChannelTradeNo: p.ChannelTradeNo,
+		PaidAt:         timestamppb.New(p.PaidAt),
+		Splits:         []*v1.PaymentSplit{{Method: v1.PaymentMethod_METHOD_CHANNEL, Amount: p.Amount}},
+	})
+}
+
+func paymentSucceededEvent(m *v1.PaymentSucceeded) (*Event, error) {
+	payload, err := protojson.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return &Event{Type: EventPaymentSucceeded, Key: m.TradeNo, Payload: payload}, nil
+}
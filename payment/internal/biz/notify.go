@@ -0,0 +1,102 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrNotificationSeen is returned when recording a notification twice.
+var ErrNotificationSeen = errors.New("notification already processed")
+
+// pollGrace is how long a pending payment waits for its notification before
+// it is polled.
+const pollGrace = time.Minute
+
+// pollBatch is how many pending payments one poll round syncs at most.
+const pollBatch = 200
+
+// Notification is a channel notification that has been processed.
+type Notification struct {
+	ID        int64
+	Channel   string
+	NotifyID  string
+	TradeNo   string
+	CreatedAt time.Time
+}
+
+// NotificationRepo records processed notifications.
+type NotificationRepo interface {
+	// Save records a notification, or returns ErrNotificationSeen.
+	Save(context.Context, *Notification) error
+}
+
+// HandleNotify verifies a notification posted by a channel and applies the
+// trade it reports. A notification already processed is acknowledged again
+// without effect, since channels resend until they get an acknowledgement.
+func (uc *PaymentUsecase) HandleNotify(ctx context.Context, channelName string, req *NotifyRequest) error {
+	channel, err := uc.channels.Get(channelName)
+	if err != nil {
+		return err
+	}
+	n, err := channel.ParseNotify(ctx, req)
+	if err != nil {
+		return err
+	}
+	if n.Trade == nil {
+		uc.log.WithContext(ctx).Infof("HandleNotify: ignored %s notification %s", channelName, n.ID)
+		return nil
+	}
+	var applied *Payment
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		err := uc.notifications.Save(ctx, &Notification{Channel: channelName, NotifyID: n.ID, TradeNo: n.Trade.TradeNo})
+		if errors.Is(err, ErrNotificationSeen) {
+			uc.log.WithContext(ctx).Infof("HandleNotify: duplicate %s notification %s", channelName, n.ID)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		p, err := uc.repo.FindByTradeNo(ctx, n.Trade.TradeNo)
+		if err != nil {
+			return err
+		}
+		if p.Channel != channelName {
+			return InvalidNotification(channelName, fmt.Errorf("trade %s belongs to %s", p.TradeNo, p.Channel))
+		}
+		applied, err = uc.apply(ctx, p, n.Trade)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if applied != nil {
+		uc.settle(ctx, applied)
+	}
+	return nil
+}
+
+// PollPending syncs the pending payments whose notification has not arrived
+// in time with their channels, and closes those that expired unpaid.
+func (uc *PaymentUsecase) PollPending(ctx context.Context) (int, error) {
+	ps, err := uc.repo.ListPending(ctx, time.Now().Add(-pollGrace), pollBatch)
+	if err != nil {
+		return 0, err
+	}
+	synced := 0
+	for _, pending := range ps {
+		p, err := uc.GetPayment(ctx, pending.TradeNo)
+		if err == nil && p.Status.Pending() && time.Now().After(p.ExpireAt) {
+			p, err = uc.ClosePayment(ctx, p.TradeNo)
+		}
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("PollPending: %s: %v", pending.TradeNo, err)
+			continue
+		}
+		if !p.Status.Pending() {
+			synced++
+		}
+	}
+	return synced, nil
+}
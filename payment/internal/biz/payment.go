@@ -0,0 +1,449 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	stderrors "errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	v1 "github.com/go-kratos/kratos-layout/payment/api/payment/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrPaymentNotFound is payment not found.
+	ErrPaymentNotFound = errors.NotFound(v1.ErrorReason_PAYMENT_NOT_FOUND.String(), "payment not found")
+	// ErrInvalidTimeRange is returned when a query range ends before it
+	// starts, or spans more than a report allows.
+	ErrInvalidTimeRange = errors.BadRequest(v1.ErrorReason_INVALID_TIME_RANGE.String(), "invalid time range")
+	// ErrInvalidAmount is returned for a payment of a non-positive amount.
+	ErrInvalidAmount = errors.BadRequest(v1.ErrorReason_INVALID_AMOUNT.String(), "invalid amount")
+	// ErrPaymentPaid is returned when paying for something already paid.
+	ErrPaymentPaid = errors.Conflict(v1.ErrorReason_PAYMENT_ALREADY_PAID.String(), "payment already paid")
+	// ErrPaymentInProgress is returned when creating a payment for a
+	// business another payment is being created for.
+	ErrPaymentInProgress = errors.Conflict(v1.ErrorReason_PAYMENT_IN_PROGRESS.String(), "payment in progress")
+	// ErrPaymentClosed is returned when acting on a closed payment.
+	ErrPaymentClosed = errors.Conflict(v1.ErrorReason_PAYMENT_ALREADY_CLOSED.String(), "payment already closed")
+)
+
+// ErrPaymentChanged is returned by the Update of a repo when the record
+// changed since it was read.
+var ErrPaymentChanged = stderrors.New("payment changed concurrently")
+
+// paymentTTL is how long a payer has to complete a payment.
+const paymentTTL = 30 * time.Minute
+
+// createLockTTL bounds how long creating a payment holds the lock of its
+// business, the channel calls of one being well within it.
+const createLockTTL = time.Minute
+
+// Purpose is what a payment is paying for.
+type Purpose string
+
+const (
+	PurposeOrder Purpose = "order"
+	PurposeTopUp Purpose = "top_up"
+	// PurposeCombined is the channel part of a combined payment.
+	PurposeCombined Purpose = "combined"
+)
+
+// Status is the status of a payment.
+type Status string
+
+const (
+	StatusCreated Status = "created"
+	// StatusPaying has its trade open at the channel, waiting for the payer.
+	StatusPaying Status = "paying"
+	StatusPaid   Status = "paid"
+	// StatusFailed could not open its trade at the channel.
+	StatusFailed            Status = "failed"
+	StatusClosed            Status = "closed"
+	StatusRefunding         Status = "refunding"
+	StatusPartiallyRefunded Status = "partially_refunded"
+	StatusRefunded          Status = "refunded"
+)
+
+// Pending reports whether a payment may still be paid.
+func (s Status) Pending() bool {
+	return s == StatusCreated || s == StatusPaying
+}
+
+// Paid reports whether a payment was paid, whatever was refunded since.
+func (s Status) Paid() bool {
+	switch s {
+	case StatusPaid, StatusRefunding, StatusPartiallyRefunded, StatusRefunded:
+		return true
+	}
+	return false
+}
+
+// Payment is a payment model.
+type Payment struct {
+	ID      int64
+	TradeNo string
+	BizNo   string
+	UserID  int64
+	// MerchantID is the merchant paid, zero for the platform itself.
+	MerchantID     int64
+	Purpose        Purpose
+	Subject        string
+	Amount         int64
+	Channel        string
+	ChannelTradeNo string
+	PayURL         string
+	Status         Status
+	// Version is bumped by every transition, for optimistic locking.
+	Version   int64
+	ExpireAt  time.Time
+	PaidAt    time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// SettlementSummary is the total of settled payments in a range.
+type SettlementSummary struct {
+	Amount int64
+	Count  int64
+}
+
+// PaymentRepo is a Payment repo.
+type PaymentRepo interface {
+	Save(context.Context, *Payment) (*Payment, error)
+	// Update saves a payment and bumps its version if that is still
+	// p.Version, otherwise it returns ErrPaymentChanged.
+	Update(ctx context.Context, p *Payment) error
+	FindByTradeNo(context.Context, string) (*Payment, error)
+	// Lock finds a payment and locks it for the transaction carried by ctx.
+	Lock(ctx context.Context, tradeNo string) (*Payment, error)
+	// LockBiz takes the lock of a business number payments are created
+	// under, ok is false when it is already held.
+	LockBiz(ctx context.Context, purpose Purpose, bizNo string, ttl time.Duration) (unlock func(), ok bool, err error)
+	// FindLatestByBiz finds the latest payment made for a business number.
+	FindLatestByBiz(ctx context.Context, purpose Purpose, bizNo string) (*Payment, error)
+	SumSettled(ctx context.Context, purpose Purpose, start, end time.Time) (*SettlementSummary, error)
+	// ListSettled lists the payments of a purpose settled within [start, end)
+	// in trade number order, after afterTradeNo.
+	ListSettled(ctx context.Context, purpose Purpose, start, end time.Time, afterTradeNo string, limit int) ([]*Payment, error)
+	// ListPending lists the pending payments created before a time, oldest first.
+	ListPending(ctx context.Context, before time.Time, limit int) ([]*Payment, error)
+	FindByChannelTradeNo(ctx context.Context, channel, channelTradeNo string) (*Payment, error)
+	// ListPaidByChannel lists the payments through a channel paid within
+	// [start, end), whatever was refunded since.
+	ListPaidByChannel(ctx context.Context, channel string, start, end time.Time) ([]*Payment, error)
+}
+
+// PaymentUsecase is a Payment usecase.
+type PaymentUsecase struct {
+	repo          PaymentRepo
+	states        *StateMachine
+	notifications NotificationRepo
+	events        EventRepo
+	stats         StatsRepo
+	risk          *RiskUsecase
+	tx            Transaction
+	channels      Channels
+	settled       []func(context.Context, *Payment)
+	log           *log.Helper
+}
+
+// NewPaymentUsecase new a Payment usecase.
+func NewPaymentUsecase(repo PaymentRepo, states *StateMachine, notifications NotificationRepo, events EventRepo, stats StatsRepo, risk *RiskUsecase, tx Transaction, channels Channels, logger log.Logger) *PaymentUsecase {
+	return &PaymentUsecase{
+		repo:          repo,
+		states:        states,
+		notifications: notifications,
+		events:        events,
+		stats:         stats,
+		risk:          risk,
+		tx:            tx,
+		channels:      channels,
+		log:           log.NewHelper(logger),
+	}
+}
+
+// OnSettled registers fn to be called once a payment is paid or closed.
+// It may be called again for the same payment and must be idempotent.
+// Register listeners while wiring, before any payment is served.
+func (uc *PaymentUsecase) OnSettled(fn func(context.Context, *Payment)) {
+	uc.settled = append(uc.settled, fn)
+}
+
+// NewTradeNo returns a trade number that sorts by creation time.
+func NewTradeNo(prefix string) string {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		panic(err)
+	}
+	return fmt.Sprintf("%s%s%06d", prefix, time.Now().Format("20060102150405"), n.Int64())
+}
+
+// CreatePayment opens a payment intent with a channel. Asking again for the
+// same business number reuses the pending payment instead of charging twice.
+// New payments are checked by the risk engine first, except the channel
+// parts of combined payments, checked as a whole.
+func (uc *PaymentUsecase) CreatePayment(ctx context.Context, p *Payment, rc *RiskContext) (*Payment, error) {
+	if p.Amount <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	channel, err := uc.channels.Get(p.Channel)
+	if err != nil {
+		return nil, err
+	}
+	// Concurrent requests for a business would each find no payment pending
+	// and open a trade of their own.
+	unlock, ok, err := uc.repo.LockBiz(ctx, p.Purpose, p.BizNo, createLockTTL)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrPaymentInProgress
+	}
+	defer unlock()
+	prev, err := uc.repo.FindLatestByBiz(ctx, p.Purpose, p.BizNo)
+	switch {
+	case errors.Is(err, ErrPaymentNotFound):
+	case err != nil:
+		return nil, err
+	case prev.Status.Paid():
+		return nil, ErrPaymentPaid
+	case prev.Status.Pending():
+		if prev.Status == StatusPaying && prev.Channel == p.Channel && prev.Amount == p.Amount && time.Now().Before(prev.ExpireAt) {
+			return prev, nil
+		}
+		if _, err := uc.ClosePayment(ctx, prev.TradeNo); err != nil {
+			return nil, err
+		}
+	}
+	if p.Purpose != PurposeCombined {
+		if err := uc.risk.Check(ctx, p.UserID, p.BizNo, p.Amount, rc); err != nil {
+			return nil, err
+		}
+	}
+
+	p.TradeNo = NewTradeNo("P")
+	p.Status = StatusCreated
+	p.ExpireAt = time.Now().Add(paymentTTL)
+	// Saved before the channel opens the trade, so that no trade goes unrecorded.
+	if p, err = uc.repo.Save(ctx, p); err != nil {
+		return nil, err
+	}
+	intent, err := channel.Create(ctx, &ChannelOrder{
+		TradeNo:  p.TradeNo,
+		Subject:  p.Subject,
+		Amount:   p.Amount,
+		ExpireAt: p.ExpireAt,
+	})
+	if err != nil {
+		if terr := uc.states.Transit(ctx, p, StatusFailed, "create_failed", nil); terr != nil {
+			uc.log.WithContext(ctx).Errorf("CreatePayment: %s: %v", p.TradeNo, terr)
+		}
+		return nil, err
+	}
+	p.PayURL = intent.PayURL
+	if err := uc.states.Transit(ctx, p, StatusPaying, "trade_opened", nil); err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("CreatePayment: %s for %s %s via %s", p.TradeNo, p.Purpose, p.BizNo, p.Channel)
+	return p, nil
+}
+
+// GetPayment returns a payment, syncing it with the channel while pending.
+func (uc *PaymentUsecase) GetPayment(ctx context.Context, tradeNo string) (*Payment, error) {
+	p, err := uc.repo.FindByTradeNo(ctx, tradeNo)
+	if err != nil {
+		return nil, err
+	}
+	if !p.Status.Pending() {
+		return p, nil
+	}
+	channel, err := uc.channels.Get(p.Channel)
+	if err != nil {
+		return nil, err
+	}
+	trade, err := channel.Query(ctx, p.TradeNo)
+	if err != nil {
+		return nil, err
+	}
+	return uc.sync(ctx, p, trade)
+}
+
+// ClosePayment closes a pending payment so it can no longer be paid.
+func (uc *PaymentUsecase) ClosePayment(ctx context.Context, tradeNo string) (*Payment, error) {
+	p, err := uc.repo.FindByTradeNo(ctx, tradeNo)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case p.Status == StatusClosed || p.Status == StatusFailed:
+		return p, nil
+	case !p.Status.Pending():
+		return nil, IllegalTransition(p.Status, StatusClosed)
+	}
+	channel, err := uc.channels.Get(p.Channel)
+	if err != nil {
+		return nil, err
+	}
+	if err := channel.Close(ctx, p.TradeNo); err != nil {
+		return nil, err
+	}
+	if err := uc.states.Transit(ctx, p, StatusClosed, "closed", nil); err != nil {
+		if !errors.Is(err, ErrPaymentConflict) {
+			return nil, err
+		}
+		// Paid meanwhile; the channel refuses to close a paid trade, so
+		// this only happens when its notification won the race.
+		if p, err = uc.repo.FindByTradeNo(ctx, tradeNo); err != nil {
+			return nil, err
+		}
+		if p.Status != StatusClosed {
+			return nil, IllegalTransition(p.Status, StatusClosed)
+		}
+		return p, nil
+	}
+	uc.log.WithContext(ctx).Infof("ClosePayment: %s", p.TradeNo)
+	uc.settle(ctx, p)
+	return p, nil
+}
+
+// SimulatePay pays a pending payment of a simulated channel.
+func (uc *PaymentUsecase) SimulatePay(ctx context.Context, tradeNo string) (*Payment, error) {
+	p, err := uc.repo.FindByTradeNo(ctx, tradeNo)
+	if err != nil {
+		return nil, err
+	}
+	channel, err := uc.channels.Get(p.Channel)
+	if err != nil {
+		return nil, err
+	}
+	if !p.Status.Pending() && !p.Status.Paid() {
+		return nil, IllegalTransition(p.Status, StatusPaid)
+	}
+	sim, ok := channel.(Simulator)
+	if !ok {
+		return nil, ErrChannelNotSimulated
+	}
+	trade, err := sim.Simulate(ctx, p.TradeNo)
+	if err != nil {
+		return nil, err
+	}
+	return uc.sync(ctx, p, trade)
+}
+
+// sync applies the trade the channel reports and tells the listeners when
+// that settles the payment.
+func (uc *PaymentUsecase) sync(ctx context.Context, p *Payment, trade *ChannelTrade) (*Payment, error) {
+	p, err := uc.apply(ctx, p, trade)
+	if err != nil {
+		return nil, err
+	}
+	uc.settle(ctx, p)
+	return p, nil
+}
+
+// settle calls the OnSettled listeners for a paid or closed payment. It runs
+// outside any transaction, as listeners call other services.
+func (uc *PaymentUsecase) settle(ctx context.Context, p *Payment) {
+	if p.Status.Pending() {
+		return
+	}
+	for _, fn := range uc.settled {
+		fn(ctx, p)
+	}
+}
+
+// apply moves a pending payment to the state the channel reports, and
+// publishes PaymentSucceeded and counts the payment with the move to paid. The channel part of a
+// combined payment publishes nothing, the combined payment does once whole.
+func (uc *PaymentUsecase) apply(ctx context.Context, p *Payment, trade *ChannelTrade) (*Payment, error) {
+	if !p.Status.Pending() {
+		if !p.Status.Paid() && trade.State == ChannelTradePaid {
+			uc.log.WithContext(ctx).Warnf("Payment %s was paid after it was %s", p.TradeNo, p.Status)
+		}
+		return p, nil
+	}
+	var to Status
+	var event string
+	switch trade.State {
+	case ChannelTradePaid:
+		if trade.Amount != p.Amount {
+			return nil, ChannelError(p.Channel, fmt.Errorf("trade %s paid %d, expected %d", p.TradeNo, trade.Amount, p.Amount))
+		}
+		to, event = StatusPaid, "channel_paid"
+	case ChannelTradeClosed:
+		to, event = StatusClosed, "channel_closed"
+	default:
+		return p, nil
+	}
+	if p.Status == StatusCreated {
+		// The channel opened the trade but the move to paying was lost.
+		if err := uc.states.Transit(ctx, p, StatusPaying, "trade_found", nil); err != nil {
+			return uc.reload(ctx, p, err)
+		}
+	}
+	if to == StatusPaid {
+		p.ChannelTradeNo = trade.ChannelTradeNo
+		p.PaidAt = trade.PaidAt
+		if p.PaidAt.IsZero() {
+			p.PaidAt = time.Now()
+		}
+	}
+	err := uc.states.Transit(ctx, p, to, event, func(ctx context.Context) error {
+		if to != StatusPaid || p.Purpose == PurposeCombined {
+			return nil
+		}
+		e, err := newPaymentSucceeded(p)
+		if err != nil {
+			return err
+		}
+		if err := uc.events.Add(ctx, e); err != nil {
+			return err
+		}
+		return uc.stats.Add(ctx, paidStats(p.PaidAt, p.MerchantID, p.Channel, []Split{{MethodChannel, p.Amount}}))
+	})
+	if err != nil {
+		return uc.reload(ctx, p, err)
+	}
+	return p, nil
+}
+
+// reload returns a payment as the winner of a race left it when err says a
+// transition of p lost that race.
+func (uc *PaymentUsecase) reload(ctx context.Context, p *Payment, err error) (*Payment, error) {
+	if errors.Is(err, ErrPaymentConflict) {
+		return uc.repo.FindByTradeNo(ctx, p.TradeNo)
+	}
+	return nil, err
+}
+
+// ListTransitions returns the status transitions of a payment, oldest first.
+func (uc *PaymentUsecase) ListTransitions(ctx context.Context, tradeNo string) ([]*Transition, error) {
+	return uc.states.History(ctx, tradeNo)
+}
+
+// GetSettlementSummary sums the payments of a purpose paid within [start, end).
+func (uc *PaymentUsecase) GetSettlementSummary(ctx context.Context, purpose Purpose, start, end time.Time) (*SettlementSummary, error) {
+	if !end.After(start) {
+		return nil, ErrInvalidTimeRange
+	}
+	return uc.repo.SumSettled(ctx, purpose, start, end)
+}
+
+// ListSettledPayments lists the payments of a purpose paid within
+// [start, end), a page of trade numbers after afterTradeNo.
+func (uc *PaymentUsecase) ListSettledPayments(ctx context.Context, purpose Purpose, start, end time.Time, afterTradeNo string, pageSize int) ([]*Payment, error) {
+	if !end.After(start) {
+		return nil, ErrInvalidTimeRange
+	}
+	if pageSize <= 0 {
+		pageSize = 100
+	}
+	if pageSize > 1000 {
+		pageSize = 1000
+	}
+	return uc.repo.ListSettled(ctx, purpose, start, end, afterTradeNo, pageSize)
+}
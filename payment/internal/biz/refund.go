@@ -0,0 +1,530 @@
+package biz
+
+import (
+	"context"
+	stderrors "errors"
+	"math/big"
+	"time"
+
+	v1 "github.com/go-kratos/kratos-layout/payment/api/payment/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	// ErrRefundNotFound is refund not found.
+	ErrRefundNotFound = errors.NotFound(v1.ErrorReason_REFUND_NOT_FOUND.String(), "refund not found")
+	// ErrRefundExceedsPaid is returned when refunds would add up to more than was paid.
+	ErrRefundExceedsPaid = errors.BadRequest(v1.ErrorReason_REFUND_EXCEEDS_PAID.String(), "refund exceeds paid amount")
+	// ErrNotRefundable is returned when refunding a payment that is not paid,
+	// a top-up, or the channel part of a combined payment.
+	ErrNotRefundable = errors.Conflict(v1.ErrorReason_PAYMENT_NOT_REFUNDABLE.String(), "payment not refundable")
+	// ErrInvalidRefund is returned for a refund without a number, or one
+	// reusing the number of a different refund.
+	ErrInvalidRefund = errors.BadRequest(v1.ErrorReason_INVALID_REFUND.String(), "invalid refund")
+)
+
+// EventRefundCompleted is the type of a v1.RefundCompleted event.
+const EventRefundCompleted = "refund.completed"
+
+// refundGrace is how long a refund may stay processing before it is followed up.
+const refundGrace = time.Minute
+
+// refundBatch is how many refunds one follow-up round handles at most.
+const refundBatch = 100
+
+// RefundStatus is the status of a refund.
+type RefundStatus string
+
+const (
+	RefundProcessing RefundStatus = "processing"
+	RefundSucceeded  RefundStatus = "succeeded"
+	// RefundFailed is a refund the channel refused, nothing was paid back.
+	RefundFailed RefundStatus = "failed"
+)
+
+// Refund pays back part of a payment, split across the instruments it was
+// paid with in proportion to what each paid. The coupon part is not paid
+// back; the coupon is returned by the refund that completes its part.
+type Refund struct {
+	ID             int64
+	RefundNo       string
+	TradeNo        string
+	BizNo          string
+	UserID         int64
+	MerchantID     int64
+	Amount         int64
+	Reason         string
+	CouponAmount   int64
+	PointsAmount   int64
+	BalanceAmount  int64
+	ChannelAmount  int64
+	CouponReturned bool
+	Channel        string
+	// PaymentTradeNo is the trade number of the channel payment refunded.
+	PaymentTradeNo    string
+	ChannelRefundNo   string
+	ChannelRefundedAt time.Time
+	Status            RefundStatus
+	FailReason        string
+	CompletedAt       time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// Splits is how the amount is refunded, leaving out unused methods.
+func (r *Refund) Splits() []Split {
+	var splits []Split
+	for _, s := range []Split{
+		{MethodCoupon, r.CouponAmount},
+		{MethodPoints, r.PointsAmount},
+		{MethodBalance, r.BalanceAmount},
+		{MethodChannel, r.ChannelAmount},
+	} {
+		if s.Amount > 0 {
+			splits = append(splits, s)
+		}
+	}
+	return splits
+}
+
+// RefundRepo is a Refund repo.
+type RefundRepo interface {
+	Create(context.Context, *Refund) error
+	// Update saves a refund if it is still in status from, otherwise it
+	// returns ErrPaymentChanged.
+	Update(ctx context.Context, r *Refund, from RefundStatus) error
+	FindByRefundNo(ctx context.Context, refundNo string) (*Refund, error)
+	ListByTradeNo(ctx context.Context, tradeNo string) ([]*Refund, error)
+	// ListByPaymentTradeNo lists the refunds of the channel payment tradeNo.
+	ListByPaymentTradeNo(ctx context.Context, tradeNo string) ([]*Refund, error)
+	// ListProcessing lists the refunds still processing created before a time.
+	ListProcessing(ctx context.Context, before time.Time, limit int) ([]*Refund, error)
+}
+
+// refundSource is a paid payment or a succeeded combined payment as seen by refunds.
+type refundSource struct {
+	tradeNo        string
+	bizNo          string
+	userID         int64
+	merchantID     int64
+	channel        string
+	paymentTradeNo string
+	// payment is the locked channel payment of an order payment.
+	payment *Payment
+	// paid is what the coupon, points, balance and channel paid, in order.
+	paid [4]int64
+}
+
+// RefundUsecase is a Refund usecase.
+type RefundUsecase struct {
+	repo     RefundRepo
+	payments PaymentRepo
+	states   *StateMachine
+	combined CombinedRepo
+	coupons  CouponRepo
+	points   PointsRepo
+	balances BalanceRepo
+	channels Channels
+	events   EventRepo
+	stats    StatsRepo
+	tx       Transaction
+	log      *log.Helper
+}
+
+// NewRefundUsecase new a Refund usecase.
+func NewRefundUsecase(repo RefundRepo, payments PaymentRepo, states *StateMachine, combined CombinedRepo, coupons CouponRepo, points PointsRepo, balances BalanceRepo, channels Channels, events EventRepo, stats StatsRepo, tx Transaction, logger log.Logger) *RefundUsecase {
+	return &RefundUsecase{
+		repo:     repo,
+		payments: payments,
+		states:   states,
+		combined: combined,
+		coupons:  coupons,
+		points:   points,
+		balances: balances,
+		channels: channels,
+		events:   events,
+		stats:    stats,
+		tx:       tx,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// CreateRefund refunds r.Amount of the payment r.TradeNo, which may be
+// refunded several times up to what was paid. Asking again with the same
+// refund number returns the refund made the first time.
+func (uc *RefundUsecase) CreateRefund(ctx context.Context, r *Refund) (*Refund, error) {
+	if r.RefundNo == "" {
+		return nil, ErrInvalidRefund
+	}
+	if r.Amount <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	var rv *Refund
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		prev, err := uc.repo.FindByRefundNo(ctx, r.RefundNo)
+		switch {
+		case errors.Is(err, ErrRefundNotFound):
+		case err != nil:
+			return err
+		case prev.TradeNo != r.TradeNo || prev.Amount != r.Amount:
+			return ErrInvalidRefund
+		default:
+			rv = prev
+			return nil
+		}
+		// Locking the payment serializes its refunds.
+		src, err := uc.lockSource(ctx, r.TradeNo)
+		if err != nil {
+			return err
+		}
+		refunds, err := uc.repo.ListByTradeNo(ctx, r.TradeNo)
+		if err != nil {
+			return err
+		}
+		left := src.paid
+		var total int64
+		for _, prev := range refunds {
+			if prev.Status == RefundFailed {
+				continue
+			}
+			left[0] -= prev.CouponAmount
+			left[1] -= prev.PointsAmount
+			left[2] -= prev.BalanceAmount
+			left[3] -= prev.ChannelAmount
+		}
+		for _, l := range left {
+			total += l
+		}
+		if r.Amount > total {
+			return ErrRefundExceedsPaid
+		}
+		share := allocate(r.Amount, left)
+		r.BizNo = src.bizNo
+		r.UserID = src.userID
+		r.MerchantID = src.merchantID
+		r.CouponAmount, r.PointsAmount, r.BalanceAmount, r.ChannelAmount = share[0], share[1], share[2], share[3]
+		r.CouponReturned = share[0] > 0 && share[0] == left[0]
+		r.Channel = src.channel
+		r.PaymentTradeNo = src.paymentTradeNo
+		r.Status = RefundProcessing
+		if err := uc.repo.Create(ctx, r); err != nil {
+			return err
+		}
+		rv = r
+		if r.ChannelAmount == 0 {
+			return nil
+		}
+		p := src.payment
+		if p == nil {
+			if p, err = uc.payments.Lock(ctx, r.PaymentTradeNo); err != nil {
+				return err
+			}
+		}
+		if p.Status == StatusRefunding {
+			return nil
+		}
+		return uc.states.Transit(ctx, p, StatusRefunding, "refund_requested", nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rv.Status == RefundProcessing {
+		uc.log.WithContext(ctx).Infof("CreateRefund: %s of %d for %s", rv.RefundNo, rv.Amount, rv.TradeNo)
+		uc.process(ctx, rv)
+	}
+	return rv, nil
+}
+
+// GetRefund returns a refund.
+func (uc *RefundUsecase) GetRefund(ctx context.Context, refundNo string) (*Refund, error) {
+	return uc.repo.FindByRefundNo(ctx, refundNo)
+}
+
+// ListRefunds lists the refunds of a payment and the total not failed.
+func (uc *RefundUsecase) ListRefunds(ctx context.Context, tradeNo string) ([]*Refund, int64, error) {
+	refunds, err := uc.repo.ListByTradeNo(ctx, tradeNo)
+	if err != nil {
+		return nil, 0, err
+	}
+	var refunded int64
+	for _, r := range refunds {
+		if r.Status != RefundFailed {
+			refunded += r.Amount
+		}
+	}
+	return refunds, refunded, nil
+}
+
+// Sync follows up the refunds still processing: channel refunds not settled
+// yet are queried, and instruments that failed are retried.
+func (uc *RefundUsecase) Sync(ctx context.Context) (int, error) {
+	refunds, err := uc.repo.ListProcessing(ctx, time.Now().Add(-refundGrace), refundBatch)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, r := range refunds {
+		uc.process(ctx, r)
+		if r.Status != RefundProcessing {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// lockSource locks the payment or combined payment a refund is made against.
+func (uc *RefundUsecase) lockSource(ctx context.Context, tradeNo string) (*refundSource, error) {
+	p, err := uc.payments.Lock(ctx, tradeNo)
+	if err == nil {
+		switch {
+		case p.Purpose != PurposeOrder:
+			return nil, ErrNotRefundable
+		case p.Status == StatusRefunded:
+			return nil, ErrPaymentRefunded
+		case !p.Status.Paid():
+			return nil, ErrNotRefundable
+		}
+		return &refundSource{
+			tradeNo:        p.TradeNo,
+			bizNo:          p.BizNo,
+			userID:         p.UserID,
+			merchantID:     p.MerchantID,
+			channel:        p.Channel,
+			paymentTradeNo: p.TradeNo,
+			payment:        p,
+			paid:           [4]int64{0, 0, 0, p.Amount},
+		}, nil
+	}
+	if !errors.Is(err, ErrPaymentNotFound) {
+		return nil, err
+	}
+	c, err := uc.combined.Lock(ctx, tradeNo)
+	if errors.Is(err, ErrCombinedNotFound) {
+		return nil, ErrPaymentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if c.Status != CombinedSucceeded {
+		return nil, ErrNotRefundable
+	}
+	return &refundSource{
+		tradeNo:        c.PayNo,
+		bizNo:          c.BizNo,
+		userID:         c.UserID,
+		merchantID:     c.MerchantID,
+		channel:        c.Channel,
+		paymentTradeNo: c.TradeNo,
+		paid:           [4]int64{c.CouponAmount, c.PointsAmount, c.BalanceAmount, c.ChannelAmount},
+	}, nil
+}
+
+// allocate splits amount across methods in proportion to what each has left
+// to refund, so that successive partial refunds add up to exactly what each
+// paid. Cents lost to rounding go to the channel first, then balance, points
+// and coupon, so money is paid back before benefits.
+func allocate(amount int64, left [4]int64) [4]int64 {
+	var share [4]int64
+	var total, allocated int64
+	for _, l := range left {
+		total += l
+	}
+	if total == 0 {
+		return share
+	}
+	// amount * l may not fit in an int64, the share it is divided down to does.
+	bigAmount, bigTotal := big.NewInt(amount), big.NewInt(total)
+	for i, l := range left {
+		share[i] = new(big.Int).Quo(new(big.Int).Mul(bigAmount, big.NewInt(l)), bigTotal).Int64()
+		allocated += share[i]
+	}
+	for i := len(left) - 1; i >= 0 && allocated < amount; i-- {
+		extra := left[i] - share[i]
+		if extra > amount-allocated {
+			extra = amount - allocated
+		}
+		share[i] += extra
+		allocated += extra
+	}
+	return share
+}
+
+// process pays a refund back. The channel goes first, as the only part that
+// can fail for good; the instruments are only refunded once it succeeded,
+// and are retried until they are. Everything is idempotent on the refund
+// number, so a refund left processing is simply processed again.
+func (uc *RefundUsecase) process(ctx context.Context, r *Refund) {
+	if r.ChannelAmount > 0 && r.ChannelRefundedAt.IsZero() {
+		done, err := uc.refundChannel(ctx, r)
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("refund %s: %v", r.RefundNo, err)
+			return
+		}
+		if !done {
+			return
+		}
+	}
+	var err error
+	if r.BalanceAmount > 0 {
+		err = uc.balances.Refund(ctx, r.RefundNo, r.TradeNo, r.BalanceAmount)
+	}
+	if err == nil && r.PointsAmount > 0 {
+		err = uc.points.Refund(ctx, r.RefundNo, r.TradeNo, r.PointsAmount)
+	}
+	if err == nil && r.CouponReturned {
+		err = uc.coupons.Return(ctx, r.TradeNo)
+	}
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("refund %s: %v", r.RefundNo, err)
+		return
+	}
+	r.Status = RefundSucceeded
+	r.CompletedAt = time.Now()
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.Update(ctx, r, RefundProcessing); err != nil {
+			return err
+		}
+		e, err := newRefundCompleted(r)
+		if err != nil {
+			return err
+		}
+		if err := uc.events.Add(ctx, e); err != nil {
+			return err
+		}
+		return uc.stats.Add(ctx, refundedStats(r.CompletedAt, r.MerchantID, r.Channel, r.Splits()))
+	})
+	if err != nil {
+		r.Status = RefundProcessing
+		if !stderrors.Is(err, ErrPaymentChanged) {
+			uc.log.WithContext(ctx).Errorf("refund %s: %v", r.RefundNo, err)
+		}
+		return
+	}
+	uc.log.WithContext(ctx).Infof("Refund %s succeeded", r.RefundNo)
+}
+
+// refundChannel asks the channel to refund its part, or how that went when
+// it was asked before, and reports whether the channel refunded it.
+func (uc *RefundUsecase) refundChannel(ctx context.Context, r *Refund) (bool, error) {
+	channel, err := uc.channels.Get(r.Channel)
+	if err != nil {
+		return false, err
+	}
+	var res *ChannelRefundResult
+	if r.ChannelRefundNo == "" {
+		p, err := uc.payments.FindByTradeNo(ctx, r.PaymentTradeNo)
+		if err != nil {
+			return false, err
+		}
+		res, err = channel.Refund(ctx, &ChannelRefund{
+			TradeNo:     r.PaymentTradeNo,
+			RefundNo:    r.RefundNo,
+			Amount:      r.ChannelAmount,
+			TotalAmount: p.Amount,
+			Reason:      r.Reason,
+		})
+		if err != nil {
+			return false, err
+		}
+	} else {
+		res, err = channel.QueryRefund(ctx, r.PaymentTradeNo, r.RefundNo)
+		if err != nil {
+			return false, err
+		}
+	}
+	if res.ChannelRefundNo != "" {
+		r.ChannelRefundNo = res.ChannelRefundNo
+	}
+	switch res.State {
+	case ChannelRefundSucceeded:
+		r.ChannelRefundedAt = time.Now()
+	case ChannelRefundFailed:
+		r.Status = RefundFailed
+		r.FailReason = "channel refused the refund"
+		r.CompletedAt = time.Now()
+	}
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.Update(ctx, r, RefundProcessing); err != nil {
+			return err
+		}
+		if res.State == ChannelRefundProcessing {
+			return nil
+		}
+		return uc.settlePayment(ctx, r)
+	})
+	if err != nil {
+		return false, err
+	}
+	if r.Status == RefundFailed {
+		uc.log.WithContext(ctx).Warnf("Refund %s failed at %s", r.RefundNo, r.Channel)
+		return false, nil
+	}
+	return res.State == ChannelRefundSucceeded, nil
+}
+
+// settlePayment moves the channel payment of r to the status its refunds
+// add up to, once the channel refunded or refused the part of r.
+func (uc *RefundUsecase) settlePayment(ctx context.Context, r *Refund) error {
+	p, err := uc.payments.Lock(ctx, r.PaymentTradeNo)
+	if err != nil {
+		return err
+	}
+	refunds, err := uc.repo.ListByPaymentTradeNo(ctx, p.TradeNo)
+	if err != nil {
+		return err
+	}
+	var refunded int64
+	pending := false
+	for _, prev := range refunds {
+		switch {
+		case prev.ChannelAmount == 0 || prev.Status == RefundFailed:
+		case prev.ChannelRefundedAt.IsZero():
+			pending = true
+		default:
+			refunded += prev.ChannelAmount
+		}
+	}
+	to := StatusPaid
+	switch {
+	case pending:
+		to = StatusRefunding
+	case refunded == p.Amount:
+		to = StatusRefunded
+	case refunded > 0:
+		to = StatusPartiallyRefunded
+	}
+	if to == p.Status {
+		return nil
+	}
+	event := "refund_succeeded"
+	if r.Status == RefundFailed {
+		event = "refund_failed"
+	}
+	return uc.states.Transit(ctx, p, to, event, nil)
+}
+
+// newRefundCompleted builds the v1.RefundCompleted event of a refund.
+func newRefundCompleted(r *Refund) (*Event, error) {
+	m := &v1.RefundCompleted{
+		RefundNo:       r.RefundNo,
+		TradeNo:        r.TradeNo,
+		BizNo:          r.BizNo,
+		UserId:         r.UserID,
+		Amount:         r.Amount,
+		CouponReturned: r.CouponReturned,
+		Reason:         r.Reason,
+		CompletedAt:    timestamppb.New(r.CompletedAt),
+	}
+	for _, s := range r.Splits() {
+		m.Splits = append(m.Splits, &v1.PaymentSplit{Method: methods[s.Method], Amount: s.Amount})
+	}
+	payload, err := protojson.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return &Event{Type: EventRefundCompleted, Key: r.RefundNo, Payload: payload}, nil
+}
@@ -0,0 +1,75 @@
+package biz
+
+import (
+	"math"
+	"testing"
+)
+
+// large is a quarter of the largest int64, so that amount * left overflows.
+const large = math.MaxInt64 / 4
+
+func TestAllocate(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount int64
+		left   [4]int64
+		want   [4]int64
+	}{
+		{"nothing left", 100, [4]int64{}, [4]int64{}},
+		{"channel only", 70, [4]int64{0, 0, 0, 100}, [4]int64{0, 0, 0, 70}},
+		{"in proportion", 50, [4]int64{100, 0, 100, 200}, [4]int64{12, 0, 12, 26}},
+		{"whole", 400, [4]int64{100, 0, 100, 200}, [4]int64{100, 0, 100, 200}},
+		// 1/3 of a cent each, the remainder goes to the channel.
+		{"remainder to channel", 1, [4]int64{1, 1, 1, 1}, [4]int64{0, 0, 0, 1}},
+		// Then to balance, points and coupon once the channel is spent.
+		{"remainder to balance", 2, [4]int64{1, 1, 1, 1}, [4]int64{0, 0, 1, 1}},
+		{"remainder past spent channel", 2, [4]int64{1, 1, 1, 0}, [4]int64{0, 1, 1, 0}},
+		{"remainder to coupon last", 3, [4]int64{1, 1, 1, 0}, [4]int64{1, 1, 1, 0}},
+		{"large", large, [4]int64{large, 0, 0, large}, [4]int64{large / 2, 0, 0, large/2 + 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := allocate(tt.amount, tt.left); got != tt.want {
+				t.Errorf("allocate(%d, %v) = %v, want %v", tt.amount, tt.left, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAllocatePartialRefunds(t *testing.T) {
+	tests := []struct {
+		name    string
+		paid    [4]int64
+		refunds []int64
+	}{
+		{"even", [4]int64{100, 200, 300, 400}, []int64{250, 250, 250, 250}},
+		{"odd cents", [4]int64{333, 1, 17, 650}, []int64{1, 2, 3, 5, 7, 11, 13, 959}},
+		{"cent by cent", [4]int64{3, 2, 0, 5}, []int64{1, 1, 1, 1, 1, 1, 1, 1, 1, 1}},
+		{"channel only", [4]int64{0, 0, 0, 999}, []int64{333, 333, 333}},
+		{"large", [4]int64{large, 0, 1, large}, []int64{large, 1, large}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			left := tt.paid
+			var refunded [4]int64
+			for _, amount := range tt.refunds {
+				share := allocate(amount, left)
+				var sum int64
+				for i := range share {
+					if share[i] < 0 || share[i] > left[i] {
+						t.Fatalf("allocate(%d, %v) = %v, out of what is left", amount, left, share)
+					}
+					sum += share[i]
+					left[i] -= share[i]
+					refunded[i] += share[i]
+				}
+				if sum != amount {
+					t.Fatalf("allocate(%d, ...) = %v, sums to %d", amount, share, sum)
+				}
+			}
+			if refunded != tt.paid {
+				t.Errorf("refunded %v, paid %v", refunded, tt.paid)
+			}
+		})
+	}
+}
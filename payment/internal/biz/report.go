@@ -0,0 +1,130 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// maxReportDays bounds the range of a report.
+const maxReportDays = 366
+
+// PaymentStat is what a merchant took through one method on one day.
+type PaymentStat struct {
+	Day        time.Time
+	MerchantID int64
+	Method     Method
+	// Channel is the channel of MethodChannel, empty for the other methods.
+	Channel string
+	// Count is how many payments used the method.
+	Count int64
+	// Paid is the money collected through balance and channels.
+	Paid int64
+	// Discount is the worth of the coupons and points spent.
+	Discount int64
+	// Refunded is the paid and discount given back by refunds.
+	Refunded int64
+}
+
+// ReportFilter narrows a report to a range of days, zero fields match all.
+type ReportFilter struct {
+	// Start and End are the first and the last day reported.
+	Start      time.Time
+	End        time.Time
+	MerchantID int64
+	Method     Method
+}
+
+// StatsRepo keeps the running totals of payments.
+type StatsRepo interface {
+	// Add adds stats to the totals of their day, merchant, method and
+	// channel, joining the transaction carried by ctx.
+	Add(ctx context.Context, stats []*PaymentStat) error
+	// List lists the totals matching filter by day, merchant, method and channel.
+	List(ctx context.Context, filter *ReportFilter) ([]*PaymentStat, error)
+}
+
+// startOfDay returns the local midnight starting the day of t.
+func startOfDay(t time.Time) time.Time {
+	t = t.In(time.Local)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// paidStats are the stats of a payment of merchantID split as splits.
+// Coupons and points count as discount, balance and channel as paid.
+func paidStats(at time.Time, merchantID int64, channel string, splits []Split) []*PaymentStat {
+	stats := make([]*PaymentStat, 0, len(splits))
+	for _, s := range splits {
+		st := newStat(at, merchantID, channel, s.Method)
+		st.Count = 1
+		if s.Method == MethodCoupon || s.Method == MethodPoints {
+			st.Discount = s.Amount
+		} else {
+			st.Paid = s.Amount
+		}
+		stats = append(stats, st)
+	}
+	return stats
+}
+
+// refundedStats are the stats of a refund of merchantID split as splits.
+func refundedStats(at time.Time, merchantID int64, channel string, splits []Split) []*PaymentStat {
+	stats := make([]*PaymentStat, 0, len(splits))
+	for _, s := range splits {
+		st := newStat(at, merchantID, channel, s.Method)
+		st.Refunded = s.Amount
+		stats = append(stats, st)
+	}
+	return stats
+}
+
+func newStat(at time.Time, merchantID int64, channel string, m Method) *PaymentStat {
+	st := &PaymentStat{Day: startOfDay(at), MerchantID: merchantID, Method: m}
+	if m == MethodChannel {
+		st.Channel = channel
+	}
+	return st
+}
+
+// ReportUsecase reports the totals of payments.
+type ReportUsecase struct {
+	repo StatsRepo
+	log  *log.Helper
+}
+
+// NewReportUsecase new a report usecase.
+func NewReportUsecase(repo StatsRepo, logger log.Logger) *ReportUsecase {
+	return &ReportUsecase{repo: repo, log: log.NewHelper(logger)}
+}
+
+// GetReport returns the totals by day, merchant, method and channel over a
+// range of days, and the totals by method and channel over the whole range.
+func (uc *ReportUsecase) GetReport(ctx context.Context, filter *ReportFilter) ([]*PaymentStat, []*PaymentStat, error) {
+	if filter.End.Before(filter.Start) || filter.End.Sub(filter.Start) >= maxReportDays*24*time.Hour {
+		return nil, nil, ErrInvalidTimeRange
+	}
+	rows, err := uc.repo.List(ctx, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	type key struct {
+		method  Method
+		channel string
+	}
+	var totals []*PaymentStat
+	byKey := make(map[key]*PaymentStat)
+	for _, r := range rows {
+		t, ok := byKey[key{r.Method, r.Channel}]
+		if !ok {
+			t = &PaymentStat{Method: r.Method, Channel: r.Channel}
+			byKey[key{r.Method, r.Channel}] = t
+			totals = append(totals, t)
+		}
+		t.Count += r.Count
+		t.Paid += r.Paid
+		t.Discount += r.Discount
+		t.Refunded += r.Refunded
+	}
+	return rows, totals, nil
+}
@@ -0,0 +1,355 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/go-kratos/kratos-layout/payment/api/payment/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ErrChallengeNotFound is returned when verifying a decision that is not a
+// challenge of the user, or one expired or used already.
+var ErrChallengeNotFound = errors.NotFound(v1.ErrorReason_RISK_CHALLENGE_NOT_FOUND.String(), "risk challenge not found")
+
+// challengeTTL is how long after it was raised a challenge may be verified
+// and the payment retried with it.
+const challengeTTL = 10 * time.Minute
+
+// RiskDenied is returned for a payment the risk engine refused.
+func RiskDenied(d *RiskDecision) error {
+	return errors.Forbidden(v1.ErrorReason_PAYMENT_RISK_DENIED.String(), "payment denied by risk control").
+		WithMetadata(map[string]string{"decision_id": strconv.FormatInt(d.ID, 10)})
+}
+
+// RiskChallenged is returned for a payment to retry once the payer is verified.
+func RiskChallenged(d *RiskDecision) error {
+	return errors.Forbidden(v1.ErrorReason_PAYMENT_RISK_CHALLENGE.String(), "payer must be verified").
+		WithMetadata(map[string]string{"decision_id": strconv.FormatInt(d.ID, 10)})
+}
+
+// RiskAction is what the risk engine decides for a payment, from the
+// mildest to the most severe.
+type RiskAction string
+
+const (
+	RiskAllow     RiskAction = "allow"
+	RiskChallenge RiskAction = "challenge"
+	RiskDeny      RiskAction = "deny"
+)
+
+func (a RiskAction) severity() int {
+	switch a {
+	case RiskChallenge:
+		return 1
+	case RiskDeny:
+		return 2
+	}
+	return 0
+}
+
+// RuleKind is what a risk rule checks.
+type RuleKind string
+
+const (
+	// RuleBlacklist denies the listed users, devices or IPs.
+	RuleBlacklist RuleKind = "blacklist"
+	// RuleVelocity limits the payments of a user, device or IP in a window.
+	RuleVelocity RuleKind = "velocity"
+	// RuleAmount limits the amount of one payment.
+	RuleAmount RuleKind = "amount"
+	// RuleNewAccount limits the amount of one payment of a new account.
+	RuleNewAccount RuleKind = "new_account"
+)
+
+// Dimension is what blacklist and velocity rules key on.
+type Dimension string
+
+const (
+	DimensionUser   Dimension = "user"
+	DimensionDevice Dimension = "device"
+	DimensionIP     Dimension = "ip"
+)
+
+// RiskRule is a configured risk rule.
+type RiskRule struct {
+	Name       string
+	Kind       RuleKind
+	Action     RiskAction
+	Dimension  Dimension
+	Values     map[string]bool
+	Window     time.Duration
+	MaxCount   int64
+	MaxAmount  int64
+	AccountAge time.Duration
+}
+
+// RiskRules are the rules in force.
+type RiskRules struct {
+	Enabled bool
+	Rules   []*RiskRule
+}
+
+// Validate reports the first rule that cannot be applied.
+func (rs *RiskRules) Validate() error {
+	names := make(map[string]bool, len(rs.Rules))
+	for _, r := range rs.Rules {
+		if r.Name == "" || names[r.Name] {
+			return fmt.Errorf("rule %q: missing or duplicated name", r.Name)
+		}
+		names[r.Name] = true
+		if r.Action != RiskChallenge && r.Action != RiskDeny {
+			return fmt.Errorf("rule %q: invalid action %q", r.Name, r.Action)
+		}
+		switch r.Kind {
+		case RuleBlacklist, RuleVelocity:
+			if r.Dimension != DimensionUser && r.Dimension != DimensionDevice && r.Dimension != DimensionIP {
+				return fmt.Errorf("rule %q: invalid dimension %q", r.Name, r.Dimension)
+			}
+			if r.Kind == RuleVelocity && (r.Window <= 0 || r.MaxCount <= 0) {
+				return fmt.Errorf("rule %q: velocity needs a window and max_count", r.Name)
+			}
+		case RuleAmount:
+		case RuleNewAccount:
+			if r.AccountAge <= 0 {
+				return fmt.Errorf("rule %q: new_account needs an account_age", r.Name)
+			}
+		default:
+			return fmt.Errorf("rule %q: invalid kind %q", r.Name, r.Kind)
+		}
+	}
+	return nil
+}
+
+// RiskContext is what the risk engine knows of a payer besides the user.
+type RiskContext struct {
+	DeviceID string
+	// IP is the address the payment was asked from, as the transport saw
+	// it, never as the payer told.
+	IP string
+	// ChallengeID is the decision of a challenge the payer was verified for
+	// since.
+	ChallengeID int64
+}
+
+// RiskDecision is a decision of the risk engine, kept for review.
+type RiskDecision struct {
+	ID       int64
+	UserID   int64
+	DeviceID string
+	IP       string
+	BizNo    string
+	Amount   int64
+	Action   RiskAction
+	// Rules are the names of the rules that fired.
+	Rules     []string
+	Detail    string
+	CreatedAt time.Time
+	// VerifiedAt is when the payer of a challenge was verified, and
+	// ConsumedAt when a payment waived the challenge with it.
+	VerifiedAt time.Time
+	ConsumedAt time.Time
+}
+
+// RiskDecisionFilter narrows ListDecisions, zero fields match all.
+type RiskDecisionFilter struct {
+	UserID int64
+	Action RiskAction
+}
+
+// RiskRuleSource holds the rules in force, reloaded as the config changes.
+type RiskRuleSource interface {
+	Rules() *RiskRules
+}
+
+// VelocityRepo counts payments in sliding windows.
+type VelocityRepo interface {
+	// Hit records a payment under key and returns how many were recorded
+	// within the window, this one included.
+	Hit(ctx context.Context, key string, window time.Duration) (int64, error)
+}
+
+// UserRepo reads the accounts of payers in the user service.
+type UserRepo interface {
+	// CreatedAt returns when the account of a user was created, or zero
+	// when it is not known.
+	CreatedAt(ctx context.Context, userID int64) (time.Time, error)
+}
+
+// RiskDecisionRepo is a RiskDecision repo.
+type RiskDecisionRepo interface {
+	Create(context.Context, *RiskDecision) error
+	// Find finds a decision, or returns ErrChallengeNotFound.
+	Find(ctx context.Context, id int64) (*RiskDecision, error)
+	// Verify marks a challenge as verified unless it was already.
+	Verify(ctx context.Context, id int64) error
+	// Consume marks a verified challenge of a user raised after since for
+	// a payment of bizNo and amount as used, ok is false when there is none
+	// left to use.
+	Consume(ctx context.Context, id, userID int64, bizNo string, amount int64, since time.Time) (ok bool, err error)
+	List(ctx context.Context, filter *RiskDecisionFilter, page, pageSize int) ([]*RiskDecision, int64, error)
+}
+
+// RiskUsecase checks payments against the risk rules before they are charged.
+type RiskUsecase struct {
+	rules     RiskRuleSource
+	velocity  VelocityRepo
+	users     UserRepo
+	decisions RiskDecisionRepo
+	log       *log.Helper
+}
+
+// NewRiskUsecase new a risk usecase.
+func NewRiskUsecase(rules RiskRuleSource, velocity VelocityRepo, users UserRepo, decisions RiskDecisionRepo, logger log.Logger) *RiskUsecase {
+	return &RiskUsecase{rules: rules, velocity: velocity, users: users, decisions: decisions, log: log.NewHelper(logger)}
+}
+
+// Check evaluates every rule against a payment of amount by a user and
+// returns nil when it may go on, or the error of a challenge or a denial.
+// The most severe action of the rules fired wins; a challenge is waived
+// once for a payer presenting a recent challenge of theirs for the same
+// payment they were verified for. Every payment is counted by the velocity
+// rules, allowed or not, and every decision is recorded. A rule missing
+// what it checks, a device or address the payer left out or the age of an
+// account the user service does not know, challenges; velocity rules that
+// cannot be counted pass, so that an outage of their store does not stop
+// payments.
+func (uc *RiskUsecase) Check(ctx context.Context, userID int64, bizNo string, amount int64, rc *RiskContext) error {
+	rules := uc.rules.Rules()
+	if !rules.Enabled {
+		return nil
+	}
+	if rc == nil {
+		rc = &RiskContext{}
+	}
+	d := &RiskDecision{UserID: userID, DeviceID: rc.DeviceID, IP: rc.IP, BizNo: bizNo, Amount: amount, Action: RiskAllow}
+	var details []string
+	for _, r := range rules.Rules {
+		action, detail := uc.evaluate(ctx, r, d)
+		if action == RiskAllow {
+			continue
+		}
+		if action.severity() > d.Action.severity() {
+			d.Action = action
+		}
+		d.Rules = append(d.Rules, r.Name)
+		details = append(details, r.Name+": "+detail)
+	}
+	if d.Action == RiskChallenge && rc.ChallengeID != 0 && uc.passed(ctx, rc.ChallengeID, d) {
+		d.Action = RiskAllow
+		details = append(details, fmt.Sprintf("challenge %d passed", rc.ChallengeID))
+	}
+	d.Detail = strings.Join(details, "; ")
+	if err := uc.decisions.Create(ctx, d); err != nil {
+		return err
+	}
+	switch d.Action {
+	case RiskDeny:
+		uc.log.WithContext(ctx).Warnf("Risk: denied %s of user %d for %d: %s", bizNo, userID, amount, d.Detail)
+		return RiskDenied(d)
+	case RiskChallenge:
+		uc.log.WithContext(ctx).Infof("Risk: challenged %s of user %d for %d: %s", bizNo, userID, amount, d.Detail)
+		return RiskChallenged(d)
+	}
+	return nil
+}
+
+// evaluate returns the action r takes for the payment of d and why, or
+// RiskAllow when it does not fire.
+func (uc *RiskUsecase) evaluate(ctx context.Context, r *RiskRule, d *RiskDecision) (RiskAction, string) {
+	switch r.Kind {
+	case RuleBlacklist:
+		if v := dimensionValue(r.Dimension, d); v != "" && r.Values[v] {
+			return RiskDeny, fmt.Sprintf("%s %s blacklisted", r.Dimension, v)
+		}
+	case RuleVelocity:
+		v := dimensionValue(r.Dimension, d)
+		if v == "" {
+			return RiskChallenge, fmt.Sprintf("%s unknown", r.Dimension)
+		}
+		n, err := uc.velocity.Hit(ctx, r.Name+":"+v, r.Window)
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("Risk: rule %s: %v", r.Name, err)
+			return RiskAllow, ""
+		}
+		if n > r.MaxCount {
+			return r.Action, fmt.Sprintf("%d payments of %s %s within %s", n, r.Dimension, v, r.Window)
+		}
+	case RuleAmount:
+		if d.Amount > r.MaxAmount {
+			return r.Action, fmt.Sprintf("amount %d above %d", d.Amount, r.MaxAmount)
+		}
+	case RuleNewAccount:
+		if d.Amount <= r.MaxAmount {
+			return RiskAllow, ""
+		}
+		createdAt, err := uc.users.CreatedAt(ctx, d.UserID)
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("Risk: rule %s: %v", r.Name, err)
+		}
+		if createdAt.IsZero() {
+			return RiskChallenge, fmt.Sprintf("account of unknown age pays %d above %d", d.Amount, r.MaxAmount)
+		}
+		if age := time.Since(createdAt); age < r.AccountAge {
+			return r.Action, fmt.Sprintf("account created %s ago pays %d above %d", age.Round(time.Minute), d.Amount, r.MaxAmount)
+		}
+	}
+	return RiskAllow, ""
+}
+
+// passed reports whether id is a recent challenge of the payment of d its
+// user was verified for, using it up.
+func (uc *RiskUsecase) passed(ctx context.Context, id int64, d *RiskDecision) bool {
+	ok, err := uc.decisions.Consume(ctx, id, d.UserID, d.BizNo, d.Amount, time.Now().Add(-challengeTTL))
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("Risk: consume challenge %d: %v", id, err)
+		return false
+	}
+	return ok
+}
+
+// VerifyChallenge marks a recent challenge of a user as passed, once the
+// payer was verified. Verifying it again changes nothing.
+func (uc *RiskUsecase) VerifyChallenge(ctx context.Context, id, userID int64) error {
+	d, err := uc.decisions.Find(ctx, id)
+	if err != nil {
+		return err
+	}
+	if d.Action != RiskChallenge || d.UserID != userID || !d.ConsumedAt.IsZero() || time.Since(d.CreatedAt) >= challengeTTL {
+		return ErrChallengeNotFound
+	}
+	if !d.VerifiedAt.IsZero() {
+		return nil
+	}
+	if err := uc.decisions.Verify(ctx, id); err != nil {
+		return err
+	}
+	uc.log.WithContext(ctx).Infof("Risk: challenge %d of user %d verified", id, userID)
+	return nil
+}
+
+func dimensionValue(dim Dimension, d *RiskDecision) string {
+	switch dim {
+	case DimensionUser:
+		if d.UserID == 0 {
+			return ""
+		}
+		return strconv.FormatInt(d.UserID, 10)
+	case DimensionDevice:
+		return d.DeviceID
+	case DimensionIP:
+		return d.IP
+	}
+	return ""
+}
+
+// ListDecisions lists risk decisions, newest first.
+func (uc *RiskUsecase) ListDecisions(ctx context.Context, filter *RiskDecisionFilter, page, pageSize int) ([]*RiskDecision, int64, error) {
+	page, pageSize = pagination(page, pageSize)
+	return uc.decisions.List(ctx, filter, page, pageSize)
+}
@@ -0,0 +1,147 @@
+package biz
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+
+	v1 "github.com/go-kratos/kratos-layout/payment/api/payment/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrPaymentConflict is returned when a payment changed since it was read.
+	ErrPaymentConflict = errors.Conflict(v1.ErrorReason_PAYMENT_VERSION_CONFLICT.String(), "payment changed concurrently")
+	// ErrPaymentFailed is returned when acting on a payment that failed.
+	ErrPaymentFailed = errors.Conflict(v1.ErrorReason_PAYMENT_ALREADY_FAILED.String(), "payment failed")
+	// ErrPaymentRefunded is returned when refunding a payment refunded in full.
+	ErrPaymentRefunded = errors.Conflict(v1.ErrorReason_PAYMENT_FULLY_REFUNDED.String(), "payment fully refunded")
+)
+
+// IllegalTransition is returned for a move the payment state machine does not
+// allow from the status a payment is in.
+func IllegalTransition(from, to Status) error {
+	switch from {
+	case StatusPaid, StatusRefunding, StatusPartiallyRefunded:
+		if to == StatusClosed || to == StatusPaying || to == StatusFailed {
+			return ErrPaymentPaid
+		}
+	case StatusClosed:
+		return ErrPaymentClosed
+	case StatusFailed:
+		return ErrPaymentFailed
+	case StatusRefunded:
+		return ErrPaymentRefunded
+	}
+	return errors.Conflict(v1.ErrorReason_ILLEGAL_PAYMENT_TRANSITION.String(), "illegal payment transition").
+		WithMetadata(map[string]string{"from": string(from), "to": string(to)})
+}
+
+// transitions are the moves the payment state machine allows:
+//
+//	CREATED → PAYING → PAID → REFUNDING → PARTIALLY_REFUNDED → REFUNDING → …
+//	   │         │                 │                                ↓
+//	   └─→ FAILED / CLOSED ←───────┘ (unpaid only)              REFUNDED
+//
+// A refunding payment falls back to PAID or PARTIALLY_REFUNDED when its
+// refunds fail.
+var transitions = map[Status][]Status{
+	StatusCreated:           {StatusPaying, StatusFailed, StatusClosed},
+	StatusPaying:            {StatusPaid, StatusFailed, StatusClosed},
+	StatusPaid:              {StatusRefunding},
+	StatusRefunding:         {StatusPaid, StatusPartiallyRefunded, StatusRefunded},
+	StatusPartiallyRefunded: {StatusRefunding},
+}
+
+// CanTransit reports whether a payment may move from one status to another.
+func CanTransit(from, to Status) bool {
+	for _, s := range transitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Transition is a move of a payment recorded in its audit trail.
+type Transition struct {
+	ID      int64
+	TradeNo string
+	From    Status
+	To      Status
+	// Event is what caused the move, such as "channel_paid".
+	Event string
+	// Version is the version of the payment after the move.
+	Version   int64
+	CreatedAt time.Time
+}
+
+// TransitionRepo is the audit trail of payment transitions.
+type TransitionRepo interface {
+	Add(context.Context, *Transition) error
+	ListByTradeNo(ctx context.Context, tradeNo string) ([]*Transition, error)
+}
+
+// StateMachine moves payments between statuses. Every move is guarded by the
+// allowed transitions, saved with optimistic locking on the payment version,
+// and recorded in the audit trail in the same transaction, so that racing
+// notifications, polls and closes cannot overwrite each other.
+type StateMachine struct {
+	repo        PaymentRepo
+	transitions TransitionRepo
+	tx          Transaction
+	log         *log.Helper
+}
+
+// NewStateMachine new a payment StateMachine.
+func NewStateMachine(repo PaymentRepo, transitions TransitionRepo, tx Transaction, logger log.Logger) *StateMachine {
+	return &StateMachine{repo: repo, transitions: transitions, tx: tx, log: log.NewHelper(logger)}
+}
+
+// Transit moves p to status to for event, saving the other fields changed
+// on p with it. fn, if not nil, runs in the same transaction. It returns
+// ErrPaymentConflict when p is stale, and leaves p as it was on failure.
+func (m *StateMachine) Transit(ctx context.Context, p *Payment, to Status, event string, fn func(ctx context.Context) error) error {
+	from, version := p.Status, p.Version
+	if !CanTransit(from, to) {
+		return IllegalTransition(from, to)
+	}
+	err := m.tx.InTx(ctx, func(ctx context.Context) error {
+		p.Status = to
+		if err := m.repo.Update(ctx, p); err != nil {
+			return err
+		}
+		if err := m.transitions.Add(ctx, &Transition{
+			TradeNo: p.TradeNo,
+			From:    from,
+			To:      to,
+			Event:   event,
+			Version: p.Version,
+		}); err != nil {
+			return err
+		}
+		if fn != nil {
+			return fn(ctx)
+		}
+		return nil
+	})
+	if err != nil {
+		p.Status, p.Version = from, version
+		if stderrors.Is(err, ErrPaymentChanged) {
+			return ErrPaymentConflict
+		}
+		return err
+	}
+	m.log.WithContext(ctx).Infof("Payment %s: %s -> %s on %s", p.TradeNo, from, to, event)
+	return nil
+}
+
+// History returns the audit trail of a payment, oldest first.
+func (m *StateMachine) History(ctx context.Context, tradeNo string) ([]*Transition, error) {
+	if _, err := m.repo.FindByTradeNo(ctx, tradeNo); err != nil {
+		return nil, err
+	}
+	return m.transitions.ListByTradeNo(ctx, tradeNo)
+}
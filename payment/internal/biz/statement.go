@@ -0,0 +1,366 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	v1 "github.com/go-kratos/kratos-layout/payment/api/payment/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrInvalidDay is returned when a day is not formatted as 2006-01-02.
+	ErrInvalidDay = errors.BadRequest(v1.ErrorReason_INVALID_DAY.String(), "invalid day")
+	// ErrStatementNotSupported is returned for a channel without statement files.
+	ErrStatementNotSupported = errors.BadRequest(v1.ErrorReason_STATEMENT_NOT_SUPPORTED.String(), "channel has no statements")
+	// ErrStatementImported is returned when importing a day of a channel twice.
+	ErrStatementImported = errors.Conflict(v1.ErrorReason_STATEMENT_ALREADY_IMPORTED.String(), "statement already imported")
+	// ErrStatementNotFound is statement import not found.
+	ErrStatementNotFound = errors.NotFound(v1.ErrorReason_STATEMENT_NOT_FOUND.String(), "statement import not found")
+	// ErrExceptionNotFound is statement exception not found.
+	ErrExceptionNotFound = errors.NotFound(v1.ErrorReason_STATEMENT_EXCEPTION_NOT_FOUND.String(), "statement exception not found")
+	// ErrExceptionResolved is returned when resolving an exception twice.
+	ErrExceptionResolved = errors.Conflict(v1.ErrorReason_STATEMENT_EXCEPTION_ALREADY_RESOLVED.String(), "statement exception already resolved")
+)
+
+// InvalidStatement is returned for a statement file that cannot be read.
+func InvalidStatement(err error) error {
+	return errors.BadRequest(v1.ErrorReason_INVALID_STATEMENT.String(), err.Error()).WithCause(err)
+}
+
+// statementOperator is who resolves the exceptions a later statement settles.
+const statementOperator = "statement import"
+
+// DayLayout is the layout of the days statements and reports are kept by.
+const DayLayout = "2006-01-02"
+
+// ParseDay parses a 2006-01-02 day in local time.
+func ParseDay(s string) (time.Time, error) {
+	day, err := time.ParseInLocation(DayLayout, s, time.Local)
+	if err != nil {
+		return time.Time{}, ErrInvalidDay
+	}
+	return day, nil
+}
+
+// MatchResult is how a statement line compares with our payments.
+type MatchResult string
+
+const (
+	MatchMatched        MatchResult = "matched"
+	MatchAmountMismatch MatchResult = "amount_mismatch"
+	// MatchLong is a trade at the channel we have no paid payment for.
+	MatchLong MatchResult = "long"
+	// MatchShort is a paid payment missing from the channel statement.
+	MatchShort MatchResult = "short"
+)
+
+// ExceptionStatus is the status of a statement exception.
+type ExceptionStatus string
+
+const (
+	ExceptionOpen     ExceptionStatus = "open"
+	ExceptionResolved ExceptionStatus = "resolved"
+)
+
+// StatementEntry is a payment line of a channel statement file.
+type StatementEntry struct {
+	TradeNo        string
+	ChannelTradeNo string
+	Amount         int64
+}
+
+// StatementParser is a channel that publishes daily statement files.
+type StatementParser interface {
+	Channel
+	// ParseStatement reads the payment lines of a statement file, skipping
+	// refunds, headers and summaries.
+	ParseStatement(r io.Reader) ([]*StatementEntry, error)
+}
+
+// StatementImport is the import of the statement of a channel for a day.
+type StatementImport struct {
+	ID               int64
+	Channel          string
+	Day              time.Time
+	FileName         string
+	Lines            int64
+	Matched          int64
+	AmountMismatches int64
+	MissingLocally   int64
+	MissingAtChannel int64
+	CreatedAt        time.Time
+}
+
+// StatementLine is a statement line, or a paid payment missing from the
+// statement, and how it was matched.
+type StatementLine struct {
+	ID             int64
+	ImportID       int64
+	Result         MatchResult
+	TradeNo        string
+	ChannelTradeNo string
+	ChannelAmount  int64
+	LocalAmount    int64
+}
+
+// StatementException is a line that did not match, waiting for an operator.
+type StatementException struct {
+	ID             int64
+	ImportID       int64
+	Channel        string
+	Day            time.Time
+	Result         MatchResult
+	TradeNo        string
+	ChannelTradeNo string
+	ChannelAmount  int64
+	LocalAmount    int64
+	Detail         string
+	Status         ExceptionStatus
+	Resolution     string
+	ResolvedBy     string
+	CreatedAt      time.Time
+	ResolvedAt     time.Time
+}
+
+// ExceptionFilter narrows ListExceptions, zero fields match all.
+type ExceptionFilter struct {
+	Status   ExceptionStatus
+	Channel  string
+	ImportID int64
+}
+
+// StatementRepo is a StatementImport, StatementLine and StatementException repo.
+type StatementRepo interface {
+	// CreateImport saves an import, or returns ErrStatementImported when its
+	// day of its channel was imported before.
+	CreateImport(context.Context, *StatementImport) error
+	FindImport(ctx context.Context, id int64) (*StatementImport, error)
+	CreateLines(context.Context, []*StatementLine) error
+	ListLines(ctx context.Context, importID int64, result MatchResult, page, pageSize int) ([]*StatementLine, int64, error)
+	CreateExceptions(context.Context, []*StatementException) error
+	FindException(ctx context.Context, id int64) (*StatementException, error)
+	UpdateException(context.Context, *StatementException) error
+	// BookedTradeNos reports which of the payments a statement of the
+	// channel imported before booked, matched or of another amount.
+	BookedTradeNos(ctx context.Context, channel string, tradeNos []string) (map[string]bool, error)
+	// ResolveShortExceptions resolves the open short exceptions of the
+	// payments and returns how many it resolved.
+	ResolveShortExceptions(ctx context.Context, channel string, tradeNos []string, operator, resolution string, at time.Time) (int64, error)
+	ListExceptions(ctx context.Context, filter *ExceptionFilter, page, pageSize int) ([]*StatementException, int64, error)
+}
+
+// StatementUsecase reconciles channel statements against our payments.
+type StatementUsecase struct {
+	repo     StatementRepo
+	payments PaymentRepo
+	channels Channels
+	tx       Transaction
+	log      *log.Helper
+}
+
+// NewStatementUsecase new a statement usecase.
+func NewStatementUsecase(repo StatementRepo, payments PaymentRepo, channels Channels, tx Transaction, logger log.Logger) *StatementUsecase {
+	return &StatementUsecase{repo: repo, payments: payments, channels: channels, tx: tx, log: log.NewHelper(logger)}
+}
+
+// Import reads the statement of a channel for a day and matches its lines by
+// channel trade number against our payments: against those paid that day,
+// then against any paid payment, as a trade paid around midnight may be
+// booked on another day by either side. Lines that do not match, and
+// payments paid that day the statement lacks, raise exceptions. A payment
+// the statement of another day already booked is not short, and one this
+// statement books resolves the short exception another day raised.
+func (uc *StatementUsecase) Import(ctx context.Context, channel string, day time.Time, fileName string, r io.Reader) (*StatementImport, error) {
+	c, err := uc.channels.Get(channel)
+	if err != nil {
+		return nil, err
+	}
+	parser, ok := c.(StatementParser)
+	if !ok {
+		return nil, ErrStatementNotSupported
+	}
+	entries, err := parser.ParseStatement(r)
+	if err != nil {
+		return nil, InvalidStatement(err)
+	}
+	day = startOfDay(day)
+	paid, err := uc.payments.ListPaidByChannel(ctx, channel, day, day.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+	local := make(map[string]*Payment, len(paid))
+	for _, p := range paid {
+		local[p.ChannelTradeNo] = p
+	}
+
+	var resolved int64
+	imp := &StatementImport{Channel: channel, Day: day, FileName: fileName, Lines: int64(len(entries))}
+	lines := make([]*StatementLine, 0, len(entries))
+	seen := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		line := &StatementLine{TradeNo: e.TradeNo, ChannelTradeNo: e.ChannelTradeNo, ChannelAmount: e.Amount}
+		p, ok := local[e.ChannelTradeNo]
+		if !ok {
+			if p, err = uc.payments.FindByChannelTradeNo(ctx, channel, e.ChannelTradeNo); errors.Is(err, ErrPaymentNotFound) {
+				p, err = nil, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		switch {
+		case p == nil || !p.Status.Paid():
+			line.Result = MatchLong
+		case p.Amount != e.Amount:
+			line.Result, line.TradeNo, line.LocalAmount = MatchAmountMismatch, p.TradeNo, p.Amount
+		default:
+			line.Result, line.TradeNo, line.LocalAmount = MatchMatched, p.TradeNo, p.Amount
+		}
+		seen[e.ChannelTradeNo] = true
+		lines = append(lines, line)
+	}
+	var missing []string
+	for _, p := range paid {
+		if !seen[p.ChannelTradeNo] {
+			missing = append(missing, p.TradeNo)
+		}
+	}
+	bookedBefore, err := uc.repo.BookedTradeNos(ctx, channel, missing)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range paid {
+		if !seen[p.ChannelTradeNo] && !bookedBefore[p.TradeNo] {
+			lines = append(lines, &StatementLine{
+				Result:         MatchShort,
+				TradeNo:        p.TradeNo,
+				ChannelTradeNo: p.ChannelTradeNo,
+				LocalAmount:    p.Amount,
+			})
+		}
+	}
+
+	var (
+		exceptions []*StatementException
+		booked     []string
+	)
+	for _, l := range lines {
+		switch l.Result {
+		case MatchMatched:
+			imp.Matched++
+			booked = append(booked, l.TradeNo)
+			continue
+		case MatchAmountMismatch:
+			imp.AmountMismatches++
+			booked = append(booked, l.TradeNo)
+		case MatchLong:
+			imp.MissingLocally++
+		case MatchShort:
+			imp.MissingAtChannel++
+		}
+		exceptions = append(exceptions, &StatementException{
+			Channel:        channel,
+			Day:            day,
+			Result:         l.Result,
+			TradeNo:        l.TradeNo,
+			ChannelTradeNo: l.ChannelTradeNo,
+			ChannelAmount:  l.ChannelAmount,
+			LocalAmount:    l.LocalAmount,
+			Detail:         exceptionDetail(l),
+			Status:         ExceptionOpen,
+		})
+	}
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.CreateImport(ctx, imp); err != nil {
+			return err
+		}
+		for _, l := range lines {
+			l.ImportID = imp.ID
+		}
+		for _, e := range exceptions {
+			e.ImportID = imp.ID
+		}
+		if err := uc.repo.CreateLines(ctx, lines); err != nil {
+			return err
+		}
+		if err := uc.repo.CreateExceptions(ctx, exceptions); err != nil {
+			return err
+		}
+		resolved, err = uc.repo.ResolveShortExceptions(ctx, channel, booked, statementOperator,
+			fmt.Sprintf("booked by the statement of %s", day.Format(DayLayout)), time.Now())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("ImportStatement: %s %s, %d lines, %d matched, %d exceptions, %d short resolved",
+		channel, day.Format(DayLayout), imp.Lines, imp.Matched, len(exceptions), resolved)
+	return imp, nil
+}
+
+func exceptionDetail(l *StatementLine) string {
+	switch l.Result {
+	case MatchAmountMismatch:
+		return fmt.Sprintf("channel settled %d, payment %s is %d", l.ChannelAmount, l.TradeNo, l.LocalAmount)
+	case MatchLong:
+		return fmt.Sprintf("channel settled %d, no paid payment has trade %s", l.ChannelAmount, l.ChannelTradeNo)
+	default:
+		return fmt.Sprintf("payment %s paid %d, missing from the statement", l.TradeNo, l.LocalAmount)
+	}
+}
+
+// GetImport returns a statement import.
+func (uc *StatementUsecase) GetImport(ctx context.Context, id int64) (*StatementImport, error) {
+	return uc.repo.FindImport(ctx, id)
+}
+
+// ListLines lists the lines of an import, of every result for an empty one.
+func (uc *StatementUsecase) ListLines(ctx context.Context, importID int64, result MatchResult, page, pageSize int) ([]*StatementLine, int64, error) {
+	if _, err := uc.repo.FindImport(ctx, importID); err != nil {
+		return nil, 0, err
+	}
+	page, pageSize = pagination(page, pageSize)
+	return uc.repo.ListLines(ctx, importID, result, page, pageSize)
+}
+
+// ListExceptions lists statement exceptions, newest first.
+func (uc *StatementUsecase) ListExceptions(ctx context.Context, filter *ExceptionFilter, page, pageSize int) ([]*StatementException, int64, error) {
+	page, pageSize = pagination(page, pageSize)
+	return uc.repo.ListExceptions(ctx, filter, page, pageSize)
+}
+
+// ResolveException records how an operator settled a statement exception.
+func (uc *StatementUsecase) ResolveException(ctx context.Context, id int64, operator, resolution string) (*StatementException, error) {
+	e, err := uc.repo.FindException(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if e.Status == ExceptionResolved {
+		return nil, ErrExceptionResolved
+	}
+	e.Status = ExceptionResolved
+	e.ResolvedBy = operator
+	e.Resolution = resolution
+	e.ResolvedAt = time.Now()
+	if err := uc.repo.UpdateException(ctx, e); err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("ResolveException: %d by %s", id, operator)
+	return e, nil
+}
+
+// pagination defaults a page number and size to valid values.
+func pagination(page, pageSize int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	return page, pageSize
+}
@@ -0,0 +1,310 @@
+package data
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	alipayGateway    = "https://openapi.alipay.com/gateway.do"
+	alipayTimeLayout = "2006-01-02 15:04:05"
+	alipayCodeOK     = "10000"
+)
+
+// alipayLocation is the zone Alipay timestamps are in.
+var alipayLocation = time.FixedZone("CST", 8*3600)
+
+// alipayChannel talks to the Alipay open platform with RSA2 signatures.
+type alipayChannel struct {
+	appID     string
+	gateway   string
+	notifyURL string
+	key       *rsa.PrivateKey
+	alipayKey *rsa.PublicKey
+	client    *http.Client
+	log       *log.Helper
+}
+
+func newAlipayChannel(c *conf.Data_Alipay, notifyBase string, client *http.Client, logger log.Logger) (*alipayChannel, error) {
+	key, err := parsePrivateKey(c.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	alipayKey, err := parsePublicKey(c.AlipayPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	gateway := c.Gateway
+	if gateway == "" {
+		gateway = alipayGateway
+	}
+	return &alipayChannel{
+		appID:     c.AppId,
+		gateway:   gateway,
+		notifyURL: notifyURL(notifyBase, biz.ChannelAlipay),
+		key:       key,
+		alipayKey: alipayKey,
+		client:    client,
+		log:       log.NewHelper(logger),
+	}, nil
+}
+
+func (c *alipayChannel) Name() string {
+	return biz.ChannelAlipay
+}
+
+func (c *alipayChannel) Create(ctx context.Context, o *biz.ChannelOrder) (*biz.ChannelIntent, error) {
+	var resp struct {
+		QRCode string `json:"qr_code"`
+	}
+	err := c.call(ctx, "alipay.trade.precreate", map[string]string{
+		"out_trade_no": o.TradeNo,
+		"total_amount": formatYuan(o.Amount),
+		"subject":      o.Subject,
+		"time_expire":  o.ExpireAt.In(alipayLocation).Format(alipayTimeLayout),
+	}, &resp)
+	if err != nil {
+		return nil, biz.ChannelError(c.Name(), err)
+	}
+	return &biz.ChannelIntent{PayURL: resp.QRCode}, nil
+}
+
+func (c *alipayChannel) Query(ctx context.Context, tradeNo string) (*biz.ChannelTrade, error) {
+	var resp struct {
+		TradeNo     string `json:"trade_no"`
+		TradeStatus string `json:"trade_status"`
+		TotalAmount string `json:"total_amount"`
+		SendPayDate string `json:"send_pay_date"`
+	}
+	err := c.call(ctx, "alipay.trade.query", map[string]string{"out_trade_no": tradeNo}, &resp)
+	var aerr *alipayError
+	if errors.As(err, &aerr) && aerr.SubCode == "ACQ.TRADE_NOT_EXIST" {
+		// The trade only exists at Alipay once the payer scanned the code.
+		return &biz.ChannelTrade{TradeNo: tradeNo, State: biz.ChannelTradePending}, nil
+	}
+	if err != nil {
+		return nil, biz.ChannelError(c.Name(), err)
+	}
+	trade := &biz.ChannelTrade{TradeNo: tradeNo, ChannelTradeNo: resp.TradeNo}
+	if trade.Amount, err = parseYuan(resp.TotalAmount); err != nil {
+		return nil, biz.ChannelError(c.Name(), err)
+	}
+	switch resp.TradeStatus {
+	case "TRADE_SUCCESS", "TRADE_FINISHED":
+		trade.State = biz.ChannelTradePaid
+		trade.PaidAt, _ = time.ParseInLocation(alipayTimeLayout, resp.SendPayDate, alipayLocation)
+	case "TRADE_CLOSED":
+		trade.State = biz.ChannelTradeClosed
+	default:
+		trade.State = biz.ChannelTradePending
+	}
+	return trade, nil
+}
+
+func (c *alipayChannel) Close(ctx context.Context, tradeNo string) error {
+	err := c.call(ctx, "alipay.trade.close", map[string]string{"out_trade_no": tradeNo}, nil)
+	var aerr *alipayError
+	if errors.As(err, &aerr) && aerr.SubCode == "ACQ.TRADE_NOT_EXIST" {
+		// Never scanned, nothing to close at Alipay.
+		return nil
+	}
+	if err != nil {
+		return biz.ChannelError(c.Name(), err)
+	}
+	return nil
+}
+
+func (c *alipayChannel) Refund(ctx context.Context, r *biz.ChannelRefund) (*biz.ChannelRefundResult, error) {
+	var resp struct {
+		TradeNo    string `json:"trade_no"`
+		FundChange string `json:"fund_change"`
+	}
+	err := c.call(ctx, "alipay.trade.refund", map[string]string{
+		"out_trade_no":   r.TradeNo,
+		"out_request_no": r.RefundNo,
+		"refund_amount":  formatYuan(r.Amount),
+		"refund_reason":  r.Reason,
+	}, &resp)
+	if err != nil {
+		return nil, biz.ChannelError(c.Name(), err)
+	}
+	state := biz.ChannelRefundProcessing
+	if resp.FundChange == "Y" {
+		state = biz.ChannelRefundSucceeded
+	}
+	return &biz.ChannelRefundResult{RefundNo: r.RefundNo, ChannelRefundNo: resp.TradeNo, State: state}, nil
+}
+
+func (c *alipayChannel) QueryRefund(ctx context.Context, tradeNo, refundNo string) (*biz.ChannelRefundResult, error) {
+	var resp struct {
+		TradeNo      string `json:"trade_no"`
+		RefundAmount string `json:"refund_amount"`
+		RefundStatus string `json:"refund_status"`
+	}
+	err := c.call(ctx, "alipay.trade.fastpay.refund.query", map[string]string{
+		"out_trade_no":   tradeNo,
+		"out_request_no": refundNo,
+	}, &resp)
+	if err != nil {
+		return nil, biz.ChannelError(c.Name(), err)
+	}
+	res := &biz.ChannelRefundResult{RefundNo: refundNo, ChannelRefundNo: resp.TradeNo}
+	switch {
+	case resp.RefundStatus == "REFUND_SUCCESS", resp.RefundStatus == "" && resp.RefundAmount != "":
+		res.State = biz.ChannelRefundSucceeded
+	case resp.RefundAmount == "":
+		// Alipay refunds synchronously, one it does not know has failed.
+		res.State = biz.ChannelRefundFailed
+	default:
+		res.State = biz.ChannelRefundProcessing
+	}
+	return res, nil
+}
+
+func (c *alipayChannel) ParseNotify(_ context.Context, req *biz.NotifyRequest) (*biz.ChannelNotify, error) {
+	form, err := url.ParseQuery(string(req.Body))
+	if err != nil {
+		return nil, biz.InvalidNotification(c.Name(), err)
+	}
+	params := make(map[string]string, len(form))
+	for k := range form {
+		params[k] = form.Get(k)
+	}
+	// Unlike requests, notifications leave sign_type out of the signed content.
+	delete(params, "sign_type")
+	if err := verifySHA256WithRSA(c.alipayKey, []byte(alipaySignContent(params)), params["sign"]); err != nil {
+		return nil, biz.InvalidNotification(c.Name(), err)
+	}
+	if params["app_id"] != c.appID {
+		return nil, biz.InvalidNotification(c.Name(), fmt.Errorf("unexpected app_id %q", params["app_id"]))
+	}
+	trade := &biz.ChannelTrade{TradeNo: params["out_trade_no"], ChannelTradeNo: params["trade_no"]}
+	if trade.Amount, err = parseYuan(params["total_amount"]); err != nil {
+		return nil, biz.InvalidNotification(c.Name(), err)
+	}
+	switch params["trade_status"] {
+	case "TRADE_SUCCESS", "TRADE_FINISHED":
+		trade.State = biz.ChannelTradePaid
+		trade.PaidAt, _ = time.ParseInLocation(alipayTimeLayout, params["gmt_payment"], alipayLocation)
+	case "TRADE_CLOSED":
+		trade.State = biz.ChannelTradeClosed
+	default:
+		trade.State = biz.ChannelTradePending
+	}
+	return &biz.ChannelNotify{ID: params["notify_id"], Trade: trade}, nil
+}
+
+// alipayError is a business failure reported by Alipay.
+type alipayError struct {
+	Code    string `json:"code"`
+	Msg     string `json:"msg"`
+	SubCode string `json:"sub_code"`
+	SubMsg  string `json:"sub_msg"`
+}
+
+func (e *alipayError) Error() string {
+	return fmt.Sprintf("%s %s: %s %s", e.Code, e.Msg, e.SubCode, e.SubMsg)
+}
+
+// call invokes an Alipay method and decodes its verified response into out.
+func (c *alipayChannel) call(ctx context.Context, method string, bizContent map[string]string, out interface{}) error {
+	content, err := json.Marshal(bizContent)
+	if err != nil {
+		return err
+	}
+	params := map[string]string{
+		"app_id":      c.appID,
+		"method":      method,
+		"format":      "JSON",
+		"charset":     "utf-8",
+		"sign_type":   "RSA2",
+		"timestamp":   time.Now().In(alipayLocation).Format(alipayTimeLayout),
+		"version":     "1.0",
+		"notify_url":  c.notifyURL,
+		"biz_content": string(content),
+	}
+	sign, err := signSHA256WithRSA(c.key, []byte(alipaySignContent(params)))
+	if err != nil {
+		return err
+	}
+	form := url.Values{}
+	for k, v := range params {
+		form.Set(k, v)
+	}
+	form.Set("sign", sign)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.gateway, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded;charset=utf-8")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return err
+	}
+	node := envelope[strings.ReplaceAll(method, ".", "_")+"_response"]
+	// A response without a signature is not trusted, not even an error.
+	var sig string
+	if err := json.Unmarshal(envelope["sign"], &sig); err != nil || sig == "" {
+		return fmt.Errorf("verify %s response: missing sign", method)
+	}
+	// The signature covers the response node exactly as it was sent.
+	if err := verifySHA256WithRSA(c.alipayKey, node, sig); err != nil {
+		return fmt.Errorf("verify %s response: %w", method, err)
+	}
+	var aerr alipayError
+	if err := json.Unmarshal(node, &aerr); err != nil {
+		return err
+	}
+	if aerr.Code != alipayCodeOK {
+		return &aerr
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(node, out)
+}
+
+// alipaySignContent joins the non-empty params sorted by key.
+func alipaySignContent(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for k, v := range params {
+		if k == "sign" || v == "" {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteByte('&')
+		}
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(params[k])
+	}
+	return b.String()
+}
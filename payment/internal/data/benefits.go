@@ -0,0 +1,167 @@
+package data
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/conf"
+	membersv1 "github.com/go-kratos/kratos-layout/members/api/members/v1"
+	walletv1 "github.com/go-kratos/kratos-layout/wallet/api/wallet/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	ggrpc "google.golang.org/grpc"
+)
+
+func dial(c *conf.Data_Client) (*ggrpc.ClientConn, error) {
+	opts := []grpc.ClientOption{
+		grpc.WithEndpoint(c.Endpoint),
+		grpc.WithMiddleware(recovery.Recovery()),
+	}
+	if c.Timeout != nil {
+		opts = append(opts, grpc.WithTimeout(c.Timeout.AsDuration()))
+	}
+	return grpc.DialInsecure(context.Background(), opts...)
+}
+
+// NewMembersClient .
+func NewMembersClient(c *conf.Data) (membersv1.BenefitsClient, func(), error) {
+	conn, err := dial(c.Members)
+	if err != nil {
+		return nil, nil, err
+	}
+	return membersv1.NewBenefitsClient(conn), func() { _ = conn.Close() }, nil
+}
+
+// NewWalletClient .
+func NewWalletClient(c *conf.Data) (walletv1.BalanceClient, func(), error) {
+	conn, err := dial(c.Wallet)
+	if err != nil {
+		return nil, nil, err
+	}
+	return walletv1.NewBalanceClient(conn), func() { _ = conn.Close() }, nil
+}
+
+type couponRepo struct {
+	client membersv1.BenefitsClient
+	log    *log.Helper
+}
+
+// NewCouponRepo .
+func NewCouponRepo(client membersv1.BenefitsClient, logger log.Logger) biz.CouponRepo {
+	return &couponRepo{
+		client: client,
+		log:    log.NewHelper(logger),
+	}
+}
+
+func (r *couponRepo) Reserve(ctx context.Context, reservationNo string, couponID, userID, orderAmount int64, bizNo string) (int64, error) {
+	reply, err := r.client.ReserveCoupon(ctx, &membersv1.ReserveCouponRequest{
+		ReservationNo: reservationNo,
+		CouponId:      couponID,
+		UserId:        userID,
+		OrderAmount:   orderAmount,
+		BizNo:         bizNo,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return reply.Amount, nil
+}
+
+func (r *couponRepo) Redeem(ctx context.Context, reservationNo string) error {
+	_, err := r.client.RedeemCoupon(ctx, &membersv1.RedeemCouponRequest{ReservationNo: reservationNo})
+	return err
+}
+
+func (r *couponRepo) Release(ctx context.Context, reservationNo string) error {
+	_, err := r.client.ReleaseCoupon(ctx, &membersv1.ReleaseCouponRequest{ReservationNo: reservationNo})
+	return err
+}
+
+func (r *couponRepo) Return(ctx context.Context, reservationNo string) error {
+	_, err := r.client.ReturnCoupon(ctx, &membersv1.ReturnCouponRequest{ReservationNo: reservationNo})
+	return err
+}
+
+type pointsRepo struct {
+	client membersv1.BenefitsClient
+	log    *log.Helper
+}
+
+// NewPointsRepo .
+func NewPointsRepo(client membersv1.BenefitsClient, logger log.Logger) biz.PointsRepo {
+	return &pointsRepo{
+		client: client,
+		log:    log.NewHelper(logger),
+	}
+}
+
+func (r *pointsRepo) Hold(ctx context.Context, holdNo string, userID, points, maxAmount int64, bizNo string) (int64, int64, error) {
+	reply, err := r.client.HoldPoints(ctx, &membersv1.HoldPointsRequest{
+		HoldNo:    holdNo,
+		UserId:    userID,
+		Points:    points,
+		MaxAmount: maxAmount,
+		BizNo:     bizNo,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return reply.Points, reply.Amount, nil
+}
+
+func (r *pointsRepo) Capture(ctx context.Context, holdNo string) error {
+	_, err := r.client.CapturePoints(ctx, &membersv1.CapturePointsRequest{HoldNo: holdNo})
+	return err
+}
+
+func (r *pointsRepo) Release(ctx context.Context, holdNo string) error {
+	_, err := r.client.ReleasePoints(ctx, &membersv1.ReleasePointsRequest{HoldNo: holdNo})
+	return err
+}
+
+func (r *pointsRepo) Refund(ctx context.Context, refundNo, holdNo string, amount int64) error {
+	_, err := r.client.RefundPoints(ctx, &membersv1.RefundPointsRequest{RefundNo: refundNo, HoldNo: holdNo, Amount: amount})
+	return err
+}
+
+type balanceRepo struct {
+	client walletv1.BalanceClient
+	log    *log.Helper
+}
+
+// NewBalanceRepo .
+func NewBalanceRepo(client walletv1.BalanceClient, logger log.Logger) biz.BalanceRepo {
+	return &balanceRepo{
+		client: client,
+		log:    log.NewHelper(logger),
+	}
+}
+
+func (r *balanceRepo) Hold(ctx context.Context, holdNo string, userID, amount int64, bizNo string) error {
+	_, err := r.client.HoldBalance(ctx, &walletv1.HoldBalanceRequest{
+		HoldNo:  holdNo,
+		UserId:  userID,
+		Amount:  amount,
+		BizType: walletv1.BizType_ORDER,
+		BizNo:   bizNo,
+	})
+	return err
+}
+
+func (r *balanceRepo) Capture(ctx context.Context, holdNo string) error {
+	_, err := r.client.CaptureBalance(ctx, &walletv1.CaptureBalanceRequest{HoldNo: holdNo})
+	return err
+}
+
+func (r *balanceRepo) Release(ctx context.Context, holdNo string) error {
+	_, err := r.client.ReleaseBalance(ctx, &walletv1.ReleaseBalanceRequest{HoldNo: holdNo})
+	return err
+}
+
+func (r *balanceRepo) Refund(ctx context.Context, refundNo, holdNo string, amount int64) error {
+	_, err := r.client.RefundBalance(ctx, &walletv1.RefundBalanceRequest{RefundNo: refundNo, HoldNo: holdNo, Amount: amount})
+	return err
+}
@@ -0,0 +1,150 @@
+package data
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const defaultChannelTimeout = 5 * time.Second
+
+// NewChannels builds the configured payment channels, or simulators of
+// them when channels.simulate is set.
+func NewChannels(c *conf.Data, logger log.Logger) (biz.Channels, error) {
+	cc := c.Channels
+	if cc == nil {
+		return biz.Channels{}, nil
+	}
+	notifyBase := strings.TrimRight(cc.NotifyUrl, "/")
+	timeout := defaultChannelTimeout
+	if cc.Timeout != nil {
+		timeout = cc.Timeout.AsDuration()
+	}
+	client := &http.Client{Timeout: timeout}
+	if cc.Simulate {
+		return biz.Channels{
+			biz.ChannelAlipay: newSimulator(biz.ChannelAlipay, notifyBase, cc.SimulatorSecret, client, logger),
+			biz.ChannelWechat: newSimulator(biz.ChannelWechat, notifyBase, cc.SimulatorSecret, client, logger),
+		}, nil
+	}
+	channels := biz.Channels{}
+	if cc.Alipay != nil {
+		ch, err := newAlipayChannel(cc.Alipay, notifyBase, client, logger)
+		if err != nil {
+			return nil, fmt.Errorf("alipay: %w", err)
+		}
+		channels[biz.ChannelAlipay] = ch
+	}
+	if cc.Wechat != nil {
+		ch, err := newWechatChannel(cc.Wechat, notifyBase, client, logger)
+		if err != nil {
+			return nil, fmt.Errorf("wechat: %w", err)
+		}
+		channels[biz.ChannelWechat] = ch
+	}
+	return channels, nil
+}
+
+// notifyURL is where a channel posts its notifications.
+func notifyURL(base, channel string) string {
+	return base + "/v1/payments/notify/" + channel
+}
+
+func parsePrivateKey(s string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(s))
+	if block == nil {
+		return nil, errors.New("invalid PEM private key")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("private key is not RSA")
+	}
+	return rsaKey, nil
+}
+
+func parsePublicKey(s string) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode([]byte(s))
+	if block == nil {
+		return nil, errors.New("invalid PEM public key")
+	}
+	if block.Type == "CERTIFICATE" {
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		block.Bytes, _ = x509.MarshalPKIXPublicKey(cert.PublicKey)
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("public key is not RSA")
+	}
+	return rsaKey, nil
+}
+
+// signSHA256WithRSA signs msg as both Alipay RSA2 and WeChat Pay v3 expect.
+func signSHA256WithRSA(key *rsa.PrivateKey, msg []byte) (string, error) {
+	sum := sha256.Sum256(msg)
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, sum[:])
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(sig), nil
+}
+
+func verifySHA256WithRSA(key *rsa.PublicKey, msg []byte, sign string) error {
+	sig, err := base64.StdEncoding.DecodeString(sign)
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(msg)
+	return rsa.VerifyPKCS1v15(key, crypto.SHA256, sum[:], sig)
+}
+
+// formatYuan renders cents as the yuan string Alipay expects.
+func formatYuan(cents int64) string {
+	return fmt.Sprintf("%d.%02d", cents/100, cents%100)
+}
+
+// parseYuan parses a yuan string such as "12.3" into cents.
+func parseYuan(s string) (int64, error) {
+	yuan, frac, _ := strings.Cut(s, ".")
+	if len(frac) > 2 {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+	frac += strings.Repeat("0", 2-len(frac))
+	y, err := strconv.ParseInt(yuan, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+	f, err := strconv.ParseInt(frac, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+	return y*100 + f, nil
+}
@@ -0,0 +1,182 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CombinedPayment is the combined_payments table.
+type CombinedPayment struct {
+	ID            int64  `gorm:"primaryKey"`
+	PayNo         string `gorm:"size:64;uniqueIndex"`
+	BizNo         string `gorm:"size:64;index"`
+	UserID        int64  `gorm:"index"`
+	MerchantID    int64
+	Subject       string `gorm:"size:128"`
+	Amount        int64
+	CouponID      int64
+	CouponAmount  int64
+	Points        int64
+	PointsAmount  int64
+	BalanceAmount int64
+	Channel       string `gorm:"size:16"`
+	ChannelAmount int64
+	TradeNo       string `gorm:"size:64"`
+	Status        string `gorm:"size:16;index:idx_combined_payments_stale,priority:1"`
+	FailReason    string `gorm:"size:255"`
+	PaidAt        *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time `gorm:"index:idx_combined_payments_stale,priority:2"`
+}
+
+type combinedRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewCombinedRepo .
+func NewCombinedRepo(data *Data, logger log.Logger) biz.CombinedRepo {
+	return &combinedRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *combinedRepo) Save(ctx context.Context, c *biz.CombinedPayment) (*biz.CombinedPayment, error) {
+	po := toCombinedPO(c)
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return nil, err
+	}
+	return toCombined(po), nil
+}
+
+func (r *combinedRepo) Update(ctx context.Context, c *biz.CombinedPayment, from biz.CombinedStatus) error {
+	po := toCombinedPO(c)
+	res := r.data.DB(ctx).Model(po).Where("status = ?", string(from)).
+		Select("coupon_amount", "points", "points_amount", "balance_amount", "channel_amount",
+			"trade_no", "status", "fail_reason", "paid_at", "updated_at").
+		Updates(po)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return biz.ErrPaymentChanged
+	}
+	c.UpdatedAt = po.UpdatedAt
+	return nil
+}
+
+func (r *combinedRepo) FindByPayNo(ctx context.Context, payNo string) (*biz.CombinedPayment, error) {
+	var po CombinedPayment
+	err := r.data.DB(ctx).Where("pay_no = ?", payNo).First(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrCombinedNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toCombined(&po), nil
+}
+
+func (r *combinedRepo) Lock(ctx context.Context, payNo string) (*biz.CombinedPayment, error) {
+	var po CombinedPayment
+	err := r.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("pay_no = ?", payNo).First(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrCombinedNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toCombined(&po), nil
+}
+
+func (r *combinedRepo) FindLatestByBiz(ctx context.Context, bizNo string) (*biz.CombinedPayment, error) {
+	var po CombinedPayment
+	err := r.data.DB(ctx).Where("biz_no = ?", bizNo).Order("id DESC").First(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrCombinedNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toCombined(&po), nil
+}
+
+func (r *combinedRepo) ListStale(ctx context.Context, status biz.CombinedStatus, before time.Time, limit int) ([]*biz.CombinedPayment, error) {
+	var pos []CombinedPayment
+	err := r.data.DB(ctx).
+		Where("status = ? AND updated_at < ?", string(status), before).
+		Order("updated_at").Limit(limit).Find(&pos).Error
+	if err != nil {
+		return nil, err
+	}
+	cs := make([]*biz.CombinedPayment, 0, len(pos))
+	for i := range pos {
+		cs = append(cs, toCombined(&pos[i]))
+	}
+	return cs, nil
+}
+
+func toCombinedPO(c *biz.CombinedPayment) *CombinedPayment {
+	po := &CombinedPayment{
+		ID:            c.ID,
+		PayNo:         c.PayNo,
+		BizNo:         c.BizNo,
+		UserID:        c.UserID,
+		MerchantID:    c.MerchantID,
+		Subject:       c.Subject,
+		Amount:        c.Amount,
+		CouponID:      c.CouponID,
+		CouponAmount:  c.CouponAmount,
+		Points:        c.Points,
+		PointsAmount:  c.PointsAmount,
+		BalanceAmount: c.BalanceAmount,
+		Channel:       c.Channel,
+		ChannelAmount: c.ChannelAmount,
+		TradeNo:       c.TradeNo,
+		Status:        string(c.Status),
+		FailReason:    c.FailReason,
+		CreatedAt:     c.CreatedAt,
+		UpdatedAt:     c.UpdatedAt,
+	}
+	if !c.PaidAt.IsZero() {
+		po.PaidAt = &c.PaidAt
+	}
+	return po
+}
+
+func toCombined(po *CombinedPayment) *biz.CombinedPayment {
+	c := &biz.CombinedPayment{
+		ID:            po.ID,
+		PayNo:         po.PayNo,
+		BizNo:         po.BizNo,
+		UserID:        po.UserID,
+		MerchantID:    po.MerchantID,
+		Subject:       po.Subject,
+		Amount:        po.Amount,
+		CouponID:      po.CouponID,
+		CouponAmount:  po.CouponAmount,
+		Points:        po.Points,
+		PointsAmount:  po.PointsAmount,
+		BalanceAmount: po.BalanceAmount,
+		Channel:       po.Channel,
+		ChannelAmount: po.ChannelAmount,
+		TradeNo:       po.TradeNo,
+		Status:        biz.CombinedStatus(po.Status),
+		FailReason:    po.FailReason,
+		CreatedAt:     po.CreatedAt,
+		UpdatedAt:     po.UpdatedAt,
+	}
+	if po.PaidAt != nil {
+		c.PaidAt = *po.PaidAt
+	}
+	return c
+}
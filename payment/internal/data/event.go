@@ -0,0 +1,101 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+// eventStream is the Redis stream payment events are appended to.
+const eventStream = "payment:events"
+
+// eventStreamMaxLen bounds the stream, consumers are expected to keep up
+// well within it.
+const eventStreamMaxLen = 1000000
+
+// Event is the payment_events outbox table.
+type Event struct {
+	ID          int64  `gorm:"primaryKey"`
+	Type        string `gorm:"size:64"`
+	Key         string `gorm:"size:64"`
+	Payload     []byte
+	CreatedAt   time.Time
+	PublishedAt *time.Time `gorm:"index"`
+}
+
+// TableName .
+func (Event) TableName() string {
+	return "payment_events"
+}
+
+type eventRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewEventRepo .
+func NewEventRepo(data *Data, logger log.Logger) biz.EventRepo {
+	return &eventRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *eventRepo) Add(ctx context.Context, e *biz.Event) error {
+	po := &Event{Type: e.Type, Key: e.Key, Payload: e.Payload}
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return err
+	}
+	e.ID, e.CreatedAt = po.ID, po.CreatedAt
+	return nil
+}
+
+func (r *eventRepo) ListUnpublished(ctx context.Context, limit int) ([]*biz.Event, error) {
+	var pos []Event
+	err := r.data.DB(ctx).Where("published_at IS NULL").Order("id").Limit(limit).Find(&pos).Error
+	if err != nil {
+		return nil, err
+	}
+	es := make([]*biz.Event, 0, len(pos))
+	for _, po := range pos {
+		es = append(es, &biz.Event{
+			ID:        po.ID,
+			Type:      po.Type,
+			Key:       po.Key,
+			Payload:   po.Payload,
+			CreatedAt: po.CreatedAt,
+		})
+	}
+	return es, nil
+}
+
+func (r *eventRepo) MarkPublished(ctx context.Context, ids []int64) error {
+	return r.data.DB(ctx).Model(&Event{}).Where("id IN ?", ids).Update("published_at", time.Now()).Error
+}
+
+type eventBus struct {
+	data *Data
+}
+
+// NewEventBus .
+func NewEventBus(data *Data) biz.EventBus {
+	return &eventBus{data: data}
+}
+
+func (b *eventBus) Publish(ctx context.Context, e *biz.Event) error {
+	return b.data.rdb.XAdd(ctx, &redis.XAddArgs{
+		Stream: eventStream,
+		MaxLen: eventStreamMaxLen,
+		Approx: true,
+		Values: map[string]interface{}{
+			"id":      e.ID,
+			"type":    e.Type,
+			"key":     e.Key,
+			"payload": e.Payload,
+		},
+	}).Err()
+}
@@ -0,0 +1,57 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// Notification is the payment_notifications table, its unique key dedupes
+// the notifications channels resend.
+type Notification struct {
+	ID        int64  `gorm:"primaryKey"`
+	Channel   string `gorm:"size:16;uniqueIndex:idx_notifications_notify,priority:1"`
+	NotifyID  string `gorm:"size:128;uniqueIndex:idx_notifications_notify,priority:2"`
+	TradeNo   string `gorm:"size:64;index"`
+	CreatedAt time.Time
+}
+
+// TableName .
+func (Notification) TableName() string {
+	return "payment_notifications"
+}
+
+type notificationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewNotificationRepo .
+func NewNotificationRepo(data *Data, logger log.Logger) biz.NotificationRepo {
+	return &notificationRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *notificationRepo) Save(ctx context.Context, n *biz.Notification) error {
+	po := &Notification{Channel: n.Channel, NotifyID: n.NotifyID, TradeNo: n.TradeNo}
+	// Inserting in a savepoint keeps the caller's transaction usable when the
+	// key turns out to be taken.
+	err := r.data.InTx(ctx, func(ctx context.Context) error {
+		return r.data.DB(ctx).Create(po).Error
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return biz.ErrNotificationSeen
+	}
+	if err != nil {
+		return err
+	}
+	n.ID, n.CreatedAt = po.ID, po.CreatedAt
+	return nil
+}
@@ -0,0 +1,266 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Payment is the payments table.
+type Payment struct {
+	ID             int64  `gorm:"primaryKey"`
+	TradeNo        string `gorm:"size:64;uniqueIndex"`
+	BizNo          string `gorm:"size:64;index:idx_payments_biz,priority:2"`
+	UserID         int64  `gorm:"index"`
+	MerchantID     int64  `gorm:"index"`
+	Purpose        string `gorm:"size:16;index:idx_payments_biz,priority:1;index:idx_payments_settled,priority:1"`
+	Subject        string `gorm:"size:128"`
+	Amount         int64
+	Channel        string `gorm:"size:16;index:idx_payments_channel_trade,priority:1;index:idx_payments_channel_paid,priority:1"`
+	ChannelTradeNo string `gorm:"size:64;index:idx_payments_channel_trade,priority:2"`
+	PayURL         string `gorm:"size:512"`
+	Status         string `gorm:"size:16;index:idx_payments_settled,priority:2;index:idx_payments_pending,priority:1"`
+	Version        int64
+	ExpireAt       time.Time
+	PaidAt         *time.Time `gorm:"index:idx_payments_settled,priority:3;index:idx_payments_channel_paid,priority:2"`
+	CreatedAt      time.Time  `gorm:"index:idx_payments_pending,priority:2"`
+	UpdatedAt      time.Time
+}
+
+// paymentBizLockPrefix prefixes the keys of the locks payments of a
+// business are created under.
+const paymentBizLockPrefix = "payment:biz:lock:"
+
+// unlockScript deletes a lock only while it still carries the token of the
+// holder.
+var unlockScript = redis.NewScript(`if redis.call("GET", KEYS[1]) == ARGV[1] then return redis.call("DEL", KEYS[1]) end return 0`)
+
+// pendingStatuses and paidStatuses are the statuses of biz.Status.Pending
+// and biz.Status.Paid.
+var (
+	pendingStatuses = []string{string(biz.StatusCreated), string(biz.StatusPaying)}
+	paidStatuses    = []string{
+		string(biz.StatusPaid),
+		string(biz.StatusRefunding),
+		string(biz.StatusPartiallyRefunded),
+		string(biz.StatusRefunded),
+	}
+)
+
+type paymentRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewPaymentRepo .
+func NewPaymentRepo(data *Data, logger log.Logger) biz.PaymentRepo {
+	return &paymentRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *paymentRepo) Save(ctx context.Context, p *biz.Payment) (*biz.Payment, error) {
+	po := toPaymentPO(p)
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return nil, err
+	}
+	return toPayment(po), nil
+}
+
+func (r *paymentRepo) Update(ctx context.Context, p *biz.Payment) error {
+	po := toPaymentPO(p)
+	po.Version++
+	res := r.data.DB(ctx).Model(po).Where("version = ?", p.Version).
+		Select("channel_trade_no", "pay_url", "status", "version", "paid_at", "updated_at").Updates(po)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return biz.ErrPaymentChanged
+	}
+	p.Version = po.Version
+	return nil
+}
+
+func (r *paymentRepo) FindByTradeNo(ctx context.Context, tradeNo string) (*biz.Payment, error) {
+	var po Payment
+	err := r.data.DB(ctx).Where("trade_no = ?", tradeNo).First(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrPaymentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toPayment(&po), nil
+}
+
+func (r *paymentRepo) Lock(ctx context.Context, tradeNo string) (*biz.Payment, error) {
+	var po Payment
+	err := r.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("trade_no = ?", tradeNo).First(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrPaymentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toPayment(&po), nil
+}
+
+func (r *paymentRepo) LockBiz(ctx context.Context, purpose biz.Purpose, bizNo string, ttl time.Duration) (func(), bool, error) {
+	key := paymentBizLockPrefix + string(purpose) + ":" + bizNo
+	token := strconv.FormatInt(time.Now().UnixNano(), 10)
+	ok, err := r.data.rdb.SetNX(ctx, key, token, ttl).Result()
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	unlock := func() {
+		if err := unlockScript.Run(context.Background(), r.data.rdb, []string{key}, token).Err(); err != nil {
+			r.log.Errorf("release lock of %s %s: %v", purpose, bizNo, err)
+		}
+	}
+	return unlock, true, nil
+}
+
+func (r *paymentRepo) FindLatestByBiz(ctx context.Context, purpose biz.Purpose, bizNo string) (*biz.Payment, error) {
+	var po Payment
+	err := r.data.DB(ctx).
+		Where("purpose = ? AND biz_no = ?", string(purpose), bizNo).
+		Order("id DESC").First(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrPaymentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toPayment(&po), nil
+}
+
+func (r *paymentRepo) SumSettled(ctx context.Context, purpose biz.Purpose, start, end time.Time) (*biz.SettlementSummary, error) {
+	var s biz.SettlementSummary
+	err := r.data.DB(ctx).Model(&Payment{}).
+		Select("COALESCE(SUM(amount), 0) AS amount, COUNT(*) AS count").
+		Where("purpose = ? AND status IN ? AND paid_at >= ? AND paid_at < ?", string(purpose), paidStatuses, start, end).
+		Scan(&s).Error
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *paymentRepo) ListSettled(ctx context.Context, purpose biz.Purpose, start, end time.Time, afterTradeNo string, limit int) ([]*biz.Payment, error) {
+	var pos []Payment
+	err := r.data.DB(ctx).
+		Where("purpose = ? AND status IN ? AND paid_at >= ? AND paid_at < ? AND trade_no > ?",
+			string(purpose), paidStatuses, start, end, afterTradeNo).
+		Order("trade_no").Limit(limit).Find(&pos).Error
+	if err != nil {
+		return nil, err
+	}
+	ps := make([]*biz.Payment, 0, len(pos))
+	for i := range pos {
+		ps = append(ps, toPayment(&pos[i]))
+	}
+	return ps, nil
+}
+
+func (r *paymentRepo) ListPending(ctx context.Context, before time.Time, limit int) ([]*biz.Payment, error) {
+	var pos []Payment
+	err := r.data.DB(ctx).
+		Where("status IN ? AND created_at < ?", pendingStatuses, before).
+		Order("created_at").Limit(limit).Find(&pos).Error
+	if err != nil {
+		return nil, err
+	}
+	ps := make([]*biz.Payment, 0, len(pos))
+	for i := range pos {
+		ps = append(ps, toPayment(&pos[i]))
+	}
+	return ps, nil
+}
+
+func (r *paymentRepo) FindByChannelTradeNo(ctx context.Context, channel, channelTradeNo string) (*biz.Payment, error) {
+	var po Payment
+	err := r.data.DB(ctx).Where("channel = ? AND channel_trade_no = ?", channel, channelTradeNo).First(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrPaymentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toPayment(&po), nil
+}
+
+func (r *paymentRepo) ListPaidByChannel(ctx context.Context, channel string, start, end time.Time) ([]*biz.Payment, error) {
+	var pos []Payment
+	err := r.data.DB(ctx).
+		Where("channel = ? AND paid_at >= ? AND paid_at < ? AND status IN ?", channel, start, end, paidStatuses).
+		Order("paid_at").Find(&pos).Error
+	if err != nil {
+		return nil, err
+	}
+	ps := make([]*biz.Payment, 0, len(pos))
+	for i := range pos {
+		ps = append(ps, toPayment(&pos[i]))
+	}
+	return ps, nil
+}
+
+func toPaymentPO(p *biz.Payment) *Payment {
+	po := &Payment{
+		ID:             p.ID,
+		TradeNo:        p.TradeNo,
+		BizNo:          p.BizNo,
+		UserID:         p.UserID,
+		MerchantID:     p.MerchantID,
+		Purpose:        string(p.Purpose),
+		Subject:        p.Subject,
+		Amount:         p.Amount,
+		Channel:        p.Channel,
+		ChannelTradeNo: p.ChannelTradeNo,
+		PayURL:         p.PayURL,
+		Status:         string(p.Status),
+		Version:        p.Version,
+		ExpireAt:       p.ExpireAt,
+		CreatedAt:      p.CreatedAt,
+		UpdatedAt:      p.UpdatedAt,
+	}
+	if !p.PaidAt.IsZero() {
+		po.PaidAt = &p.PaidAt
+	}
+	return po
+}
+
+func toPayment(po *Payment) *biz.Payment {
+	p := &biz.Payment{
+		ID:             po.ID,
+		TradeNo:        po.TradeNo,
+		BizNo:          po.BizNo,
+		UserID:         po.UserID,
+		MerchantID:     po.MerchantID,
+		Purpose:        biz.Purpose(po.Purpose),
+		Subject:        po.Subject,
+		Amount:         po.Amount,
+		Channel:        po.Channel,
+		ChannelTradeNo: po.ChannelTradeNo,
+		PayURL:         po.PayURL,
+		Status:         biz.Status(po.Status),
+		Version:        po.Version,
+		ExpireAt:       po.ExpireAt,
+		CreatedAt:      po.CreatedAt,
+		UpdatedAt:      po.UpdatedAt,
+	}
+	if po.PaidAt != nil {
+		p.PaidAt = *po.PaidAt
+	}
+	return p
+}
@@ -0,0 +1,185 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// Refund is the refunds table.
+type Refund struct {
+	ID                int64  `gorm:"primaryKey"`
+	RefundNo          string `gorm:"size:64;uniqueIndex"`
+	TradeNo           string `gorm:"size:64;index"`
+	BizNo             string `gorm:"size:64"`
+	UserID            int64  `gorm:"index"`
+	MerchantID        int64
+	Amount            int64
+	Reason            string `gorm:"size:255"`
+	CouponAmount      int64
+	PointsAmount      int64
+	BalanceAmount     int64
+	ChannelAmount     int64
+	CouponReturned    bool
+	Channel           string `gorm:"size:16"`
+	PaymentTradeNo    string `gorm:"size:64;index"`
+	ChannelRefundNo   string `gorm:"size:64"`
+	ChannelRefundedAt *time.Time
+	Status            string `gorm:"size:16;index:idx_refunds_processing,priority:1"`
+	FailReason        string `gorm:"size:255"`
+	CompletedAt       *time.Time
+	CreatedAt         time.Time `gorm:"index:idx_refunds_processing,priority:2"`
+	UpdatedAt         time.Time
+}
+
+type refundRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewRefundRepo .
+func NewRefundRepo(data *Data, logger log.Logger) biz.RefundRepo {
+	return &refundRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *refundRepo) Create(ctx context.Context, rf *biz.Refund) error {
+	po := toRefundPO(rf)
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return err
+	}
+	rf.ID, rf.CreatedAt, rf.UpdatedAt = po.ID, po.CreatedAt, po.UpdatedAt
+	return nil
+}
+
+func (r *refundRepo) Update(ctx context.Context, rf *biz.Refund, from biz.RefundStatus) error {
+	po := toRefundPO(rf)
+	res := r.data.DB(ctx).Model(po).Where("status = ?", string(from)).
+		Select("channel_refund_no", "channel_refunded_at", "status", "fail_reason", "completed_at", "updated_at").
+		Updates(po)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return biz.ErrPaymentChanged
+	}
+	return nil
+}
+
+func (r *refundRepo) FindByRefundNo(ctx context.Context, refundNo string) (*biz.Refund, error) {
+	var po Refund
+	err := r.data.DB(ctx).Where("refund_no = ?", refundNo).First(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrRefundNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toRefund(&po), nil
+}
+
+func (r *refundRepo) ListByTradeNo(ctx context.Context, tradeNo string) ([]*biz.Refund, error) {
+	var pos []Refund
+	if err := r.data.DB(ctx).Where("trade_no = ?", tradeNo).Order("id").Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	return toRefunds(pos), nil
+}
+
+func (r *refundRepo) ListByPaymentTradeNo(ctx context.Context, tradeNo string) ([]*biz.Refund, error) {
+	var pos []Refund
+	if err := r.data.DB(ctx).Where("payment_trade_no = ?", tradeNo).Order("id").Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	return toRefunds(pos), nil
+}
+
+func (r *refundRepo) ListProcessing(ctx context.Context, before time.Time, limit int) ([]*biz.Refund, error) {
+	var pos []Refund
+	err := r.data.DB(ctx).
+		Where("status = ? AND created_at < ?", string(biz.RefundProcessing), before).
+		Order("created_at").Limit(limit).Find(&pos).Error
+	if err != nil {
+		return nil, err
+	}
+	return toRefunds(pos), nil
+}
+
+func toRefunds(pos []Refund) []*biz.Refund {
+	rs := make([]*biz.Refund, 0, len(pos))
+	for i := range pos {
+		rs = append(rs, toRefund(&pos[i]))
+	}
+	return rs
+}
+
+func toRefundPO(r *biz.Refund) *Refund {
+	po := &Refund{
+		ID:              r.ID,
+		RefundNo:        r.RefundNo,
+		TradeNo:         r.TradeNo,
+		BizNo:           r.BizNo,
+		UserID:          r.UserID,
+		MerchantID:      r.MerchantID,
+		Amount:          r.Amount,
+		Reason:          r.Reason,
+		CouponAmount:    r.CouponAmount,
+		PointsAmount:    r.PointsAmount,
+		BalanceAmount:   r.BalanceAmount,
+		ChannelAmount:   r.ChannelAmount,
+		CouponReturned:  r.CouponReturned,
+		Channel:         r.Channel,
+		PaymentTradeNo:  r.PaymentTradeNo,
+		ChannelRefundNo: r.ChannelRefundNo,
+		Status:          string(r.Status),
+		FailReason:      r.FailReason,
+		CreatedAt:       r.CreatedAt,
+		UpdatedAt:       r.UpdatedAt,
+	}
+	if !r.ChannelRefundedAt.IsZero() {
+		po.ChannelRefundedAt = &r.ChannelRefundedAt
+	}
+	if !r.CompletedAt.IsZero() {
+		po.CompletedAt = &r.CompletedAt
+	}
+	return po
+}
+
+func toRefund(po *Refund) *biz.Refund {
+	r := &biz.Refund{
+		ID:              po.ID,
+		RefundNo:        po.RefundNo,
+		TradeNo:         po.TradeNo,
+		BizNo:           po.BizNo,
+		UserID:          po.UserID,
+		MerchantID:      po.MerchantID,
+		Amount:          po.Amount,
+		Reason:          po.Reason,
+		CouponAmount:    po.CouponAmount,
+		PointsAmount:    po.PointsAmount,
+		BalanceAmount:   po.BalanceAmount,
+		ChannelAmount:   po.ChannelAmount,
+		CouponReturned:  po.CouponReturned,
+		Channel:         po.Channel,
+		PaymentTradeNo:  po.PaymentTradeNo,
+		ChannelRefundNo: po.ChannelRefundNo,
+		Status:          biz.RefundStatus(po.Status),
+		FailReason:      po.FailReason,
+		CreatedAt:       po.CreatedAt,
+		UpdatedAt:       po.UpdatedAt,
+	}
+	if po.ChannelRefundedAt != nil {
+		r.ChannelRefundedAt = *po.ChannelRefundedAt
+	}
+	if po.CompletedAt != nil {
+		r.CompletedAt = *po.CompletedAt
+	}
+	return r
+}
@@ -0,0 +1,268 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// riskConfigKey is the key of the risk rules in the config.
+const riskConfigKey = "risk"
+
+type riskRuleSource struct {
+	rules atomic.Value
+	log   *log.Helper
+}
+
+// NewRiskRuleSource loads the risk rules from the config and reloads them
+// whenever it changes. Rules that fail to load are logged and the previous
+// ones stay in force.
+func NewRiskRuleSource(c config.Config, logger log.Logger) (biz.RiskRuleSource, error) {
+	s := &riskRuleSource{log: log.NewHelper(logger)}
+	rules, err := loadRiskRules(c.Value(riskConfigKey))
+	if err != nil {
+		return nil, err
+	}
+	s.rules.Store(rules)
+	err = c.Watch(riskConfigKey, func(_ string, v config.Value) {
+		rules, err := loadRiskRules(v)
+		if err != nil {
+			s.log.Errorf("Risk: keeping the previous rules: %v", err)
+			return
+		}
+		s.rules.Store(rules)
+		s.log.Infof("Risk: reloaded %d rules, enabled: %t", len(rules.Rules), rules.Enabled)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *riskRuleSource) Rules() *biz.RiskRules {
+	return s.rules.Load().(*biz.RiskRules)
+}
+
+func loadRiskRules(v config.Value) (*biz.RiskRules, error) {
+	var rc conf.Risk
+	if err := v.Scan(&rc); err != nil {
+		// An absent section disables the engine.
+		if errors.Is(err, config.ErrNotFound) {
+			return &biz.RiskRules{}, nil
+		}
+		return nil, fmt.Errorf("risk: %w", err)
+	}
+	rules := &biz.RiskRules{Enabled: rc.Enabled}
+	for _, r := range rc.Rules {
+		rule := &biz.RiskRule{
+			Name:       r.Name,
+			Kind:       biz.RuleKind(r.Kind),
+			Action:     biz.RiskAction(r.Action),
+			Dimension:  biz.Dimension(r.Dimension),
+			Values:     make(map[string]bool, len(r.Values)),
+			Window:     r.Window.AsDuration(),
+			MaxCount:   r.MaxCount,
+			MaxAmount:  r.MaxAmount,
+			AccountAge: r.AccountAge.AsDuration(),
+		}
+		if rule.Kind == biz.RuleBlacklist {
+			rule.Action = biz.RiskDeny
+		}
+		for _, v := range r.Values {
+			rule.Values[v] = true
+		}
+		rules.Rules = append(rules.Rules, rule)
+	}
+	if err := rules.Validate(); err != nil {
+		return nil, fmt.Errorf("risk: %w", err)
+	}
+	return rules, nil
+}
+
+// velocityKeyPrefix prefixes the sorted sets velocity rules count in.
+const velocityKeyPrefix = "payment:risk:velocity:"
+
+// velocityScript trims the hits of a key to the window, adds one and counts
+// them, all at once so concurrent payments are all counted.
+var velocityScript = redis.NewScript(`
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', ARGV[1] - ARGV[2])
+redis.call('ZADD', KEYS[1], ARGV[1], ARGV[3])
+redis.call('PEXPIRE', KEYS[1], ARGV[2])
+return redis.call('ZCARD', KEYS[1])
+`)
+
+type velocityRepo struct {
+	data *Data
+	seq  atomic.Uint64
+	log  *log.Helper
+}
+
+// NewVelocityRepo .
+func NewVelocityRepo(data *Data, logger log.Logger) biz.VelocityRepo {
+	return &velocityRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *velocityRepo) Hit(ctx context.Context, key string, window time.Duration) (int64, error) {
+	now := time.Now()
+	// Hits of the same instant must stay apart to all be counted.
+	member := strconv.FormatInt(now.UnixNano(), 36) + ":" + strconv.FormatUint(r.seq.Add(1), 36)
+	return velocityScript.Run(ctx, r.data.rdb, []string{velocityKeyPrefix + key},
+		now.UnixMilli(), window.Milliseconds(), member).Int64()
+}
+
+// userRepo reads accounts from the user service. The user service has no
+// account API yet, so every account is unknown and new account rules
+// challenge the payments above their amount.
+type userRepo struct {
+	log *log.Helper
+}
+
+// NewUserRepo .
+func NewUserRepo(logger log.Logger) biz.UserRepo {
+	return &userRepo{log: log.NewHelper(logger)}
+}
+
+func (r *userRepo) CreatedAt(context.Context, int64) (time.Time, error) {
+	return time.Time{}, nil
+}
+
+// RiskDecision is the risk_decisions table.
+type RiskDecision struct {
+	ID         int64  `gorm:"primaryKey"`
+	UserID     int64  `gorm:"index"`
+	DeviceID   string `gorm:"size:64"`
+	IP         string `gorm:"size:64"`
+	BizNo      string `gorm:"size:64"`
+	Amount     int64
+	Action     string `gorm:"size:16;index"`
+	Rules      string `gorm:"type:text"`
+	Detail     string `gorm:"type:text"`
+	CreatedAt  time.Time
+	VerifiedAt *time.Time
+	ConsumedAt *time.Time
+}
+
+type riskDecisionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewRiskDecisionRepo .
+func NewRiskDecisionRepo(data *Data, logger log.Logger) biz.RiskDecisionRepo {
+	return &riskDecisionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *riskDecisionRepo) Create(ctx context.Context, d *biz.RiskDecision) error {
+	po := &RiskDecision{
+		UserID:   d.UserID,
+		DeviceID: d.DeviceID,
+		IP:       d.IP,
+		BizNo:    d.BizNo,
+		Amount:   d.Amount,
+		Action:   string(d.Action),
+		Rules:    strings.Join(d.Rules, ","),
+		Detail:   d.Detail,
+	}
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return err
+	}
+	d.ID, d.CreatedAt = po.ID, po.CreatedAt
+	return nil
+}
+
+func (r *riskDecisionRepo) Find(ctx context.Context, id int64) (*biz.RiskDecision, error) {
+	var po RiskDecision
+	err := r.data.DB(ctx).First(&po, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrChallengeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toRiskDecision(&po), nil
+}
+
+func (r *riskDecisionRepo) Verify(ctx context.Context, id int64) error {
+	return r.data.DB(ctx).Model(&RiskDecision{}).
+		Where("id = ? AND verified_at IS NULL", id).
+		Update("verified_at", time.Now()).Error
+}
+
+func (r *riskDecisionRepo) Consume(ctx context.Context, id, userID int64, bizNo string, amount int64, since time.Time) (bool, error) {
+	// A single conditional update, so that two payments presenting the
+	// challenge together cannot both use it.
+	res := r.data.DB(ctx).Model(&RiskDecision{}).
+		Where("id = ? AND user_id = ? AND action = ? AND created_at > ?", id, userID, string(biz.RiskChallenge), since).
+		Where("biz_no = ? AND amount = ?", bizNo, amount).
+		Where("verified_at IS NOT NULL AND consumed_at IS NULL").
+		Update("consumed_at", time.Now())
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+func (r *riskDecisionRepo) List(ctx context.Context, filter *biz.RiskDecisionFilter, page, pageSize int) ([]*biz.RiskDecision, int64, error) {
+	db := r.data.DB(ctx).Model(&RiskDecision{})
+	if filter.UserID != 0 {
+		db = db.Where("user_id = ?", filter.UserID)
+	}
+	if filter.Action != "" {
+		db = db.Where("action = ?", string(filter.Action))
+	}
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var pos []*RiskDecision
+	if err := db.Order("id DESC").Offset((page - 1) * pageSize).Limit(pageSize).Find(&pos).Error; err != nil {
+		return nil, 0, err
+	}
+	rv := make([]*biz.RiskDecision, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toRiskDecision(po))
+	}
+	return rv, total, nil
+}
+
+func toRiskDecision(po *RiskDecision) *biz.RiskDecision {
+	d := &biz.RiskDecision{
+		ID:        po.ID,
+		UserID:    po.UserID,
+		DeviceID:  po.DeviceID,
+		IP:        po.IP,
+		BizNo:     po.BizNo,
+		Amount:    po.Amount,
+		Action:    biz.RiskAction(po.Action),
+		Detail:    po.Detail,
+		CreatedAt: po.CreatedAt,
+	}
+	if po.VerifiedAt != nil {
+		d.VerifiedAt = *po.VerifiedAt
+	}
+	if po.ConsumedAt != nil {
+		d.ConsumedAt = *po.ConsumedAt
+	}
+	if po.Rules != "" {
+		d.Rules = strings.Split(po.Rules, ",")
+	}
+	return d
+}
@@ -0,0 +1,193 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// simulatorSignatureHeader carries the HMAC-SHA256 of a simulated notification.
+const simulatorSignatureHeader = "X-Simulator-Signature"
+
+// simulator is an in-memory channel for running the payment flow offline.
+// Trades are paid on command through Simulate, which also posts the signed
+// notification a real channel would; refunds succeed at once.
+type simulator struct {
+	name       string
+	notifyBase string
+	secret     []byte
+	client     *http.Client
+	log        *log.Helper
+
+	mu      sync.Mutex
+	trades  map[string]*biz.ChannelTrade
+	refunds map[string]*biz.ChannelRefundResult
+}
+
+// simulatorNotification is the body of a simulated notification.
+type simulatorNotification struct {
+	ID             string    `json:"id"`
+	TradeNo        string    `json:"trade_no"`
+	ChannelTradeNo string    `json:"channel_trade_no"`
+	Amount         int64     `json:"amount"`
+	PaidAt         time.Time `json:"paid_at"`
+}
+
+func newSimulator(name, notifyBase, secret string, client *http.Client, logger log.Logger) *simulator {
+	return &simulator{
+		name:       name,
+		notifyBase: notifyBase,
+		secret:     []byte(secret),
+		client:     client,
+		log:        log.NewHelper(logger),
+		trades:     make(map[string]*biz.ChannelTrade),
+		refunds:    make(map[string]*biz.ChannelRefundResult),
+	}
+}
+
+func (s *simulator) Name() string {
+	return s.name
+}
+
+func (s *simulator) Create(ctx context.Context, o *biz.ChannelOrder) (*biz.ChannelIntent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.trades[o.TradeNo] = &biz.ChannelTrade{TradeNo: o.TradeNo, State: biz.ChannelTradePending, Amount: o.Amount}
+	s.log.WithContext(ctx).Infof("simulated %s trade %s created", s.name, o.TradeNo)
+	return &biz.ChannelIntent{PayURL: s.notifyBase + "/v1/payments/" + o.TradeNo + "/simulate"}, nil
+}
+
+func (s *simulator) Query(_ context.Context, tradeNo string) (*biz.ChannelTrade, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.trades[tradeNo]
+	if !ok {
+		// Lost on restart; the payer never got to pay it here.
+		return &biz.ChannelTrade{TradeNo: tradeNo, State: biz.ChannelTradePending}, nil
+	}
+	cp := *t
+	return &cp, nil
+}
+
+func (s *simulator) Close(_ context.Context, tradeNo string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if t, ok := s.trades[tradeNo]; ok && t.State == biz.ChannelTradePending {
+		t.State = biz.ChannelTradeClosed
+	}
+	return nil
+}
+
+func (s *simulator) Refund(ctx context.Context, r *biz.ChannelRefund) (*biz.ChannelRefundResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if res, ok := s.refunds[r.RefundNo]; ok {
+		cp := *res
+		return &cp, nil
+	}
+	res := &biz.ChannelRefundResult{
+		RefundNo:        r.RefundNo,
+		ChannelRefundNo: biz.NewTradeNo("SR"),
+		State:           biz.ChannelRefundSucceeded,
+	}
+	s.refunds[r.RefundNo] = res
+	s.log.WithContext(ctx).Infof("simulated %s refund %s of %d for %s", s.name, r.RefundNo, r.Amount, r.TradeNo)
+	cp := *res
+	return &cp, nil
+}
+
+func (s *simulator) QueryRefund(_ context.Context, _, refundNo string) (*biz.ChannelRefundResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res, ok := s.refunds[refundNo]
+	if !ok {
+		// Lost on restart; simulated refunds always succeed.
+		return &biz.ChannelRefundResult{RefundNo: refundNo, State: biz.ChannelRefundSucceeded}, nil
+	}
+	cp := *res
+	return &cp, nil
+}
+
+func (s *simulator) Simulate(ctx context.Context, tradeNo string) (*biz.ChannelTrade, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.trades[tradeNo]
+	if !ok {
+		return nil, biz.ErrPaymentNotFound
+	}
+	if t.State == biz.ChannelTradePending {
+		t.State = biz.ChannelTradePaid
+		t.ChannelTradeNo = biz.NewTradeNo("S")
+		t.PaidAt = time.Now()
+		s.log.WithContext(ctx).Infof("simulated %s trade %s paid", s.name, tradeNo)
+		go s.notify(simulatorNotification{
+			ID:             biz.NewTradeNo("SN"),
+			TradeNo:        t.TradeNo,
+			ChannelTradeNo: t.ChannelTradeNo,
+			Amount:         t.Amount,
+			PaidAt:         t.PaidAt,
+		})
+	}
+	cp := *t
+	return &cp, nil
+}
+
+func (s *simulator) ParseNotify(_ context.Context, req *biz.NotifyRequest) (*biz.ChannelNotify, error) {
+	sign, err := hex.DecodeString(req.Header.Get(simulatorSignatureHeader))
+	if err != nil || !hmac.Equal(sign, s.sign(req.Body)) {
+		return nil, biz.InvalidNotification(s.name, errors.New("bad signature"))
+	}
+	var n simulatorNotification
+	if err := json.Unmarshal(req.Body, &n); err != nil {
+		return nil, biz.InvalidNotification(s.name, err)
+	}
+	return &biz.ChannelNotify{ID: n.ID, Trade: &biz.ChannelTrade{
+		TradeNo:        n.TradeNo,
+		ChannelTradeNo: n.ChannelTradeNo,
+		State:          biz.ChannelTradePaid,
+		Amount:         n.Amount,
+		PaidAt:         n.PaidAt,
+	}}, nil
+}
+
+func (s *simulator) sign(body []byte) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write(body)
+	return mac.Sum(nil)
+}
+
+// notify posts a notification to our own notify endpoint like a channel.
+func (s *simulator) notify(n simulatorNotification) {
+	body, err := json.Marshal(n)
+	if err != nil {
+		s.log.Errorf("simulated %s notification %s: %v", s.name, n.ID, err)
+		return
+	}
+	req, err := http.NewRequest(http.MethodPost, notifyURL(s.notifyBase, s.name), bytes.NewReader(body))
+	if err != nil {
+		s.log.Errorf("simulated %s notification %s: %v", s.name, n.ID, err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(simulatorSignatureHeader, hex.EncodeToString(s.sign(body)))
+	resp, err := s.client.Do(req)
+	if err != nil {
+		s.log.Errorf("simulated %s notification %s: %v", s.name, n.ID, err)
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		s.log.Errorf("simulated %s notification %s: %s", s.name, n.ID, resp.Status)
+	}
+}
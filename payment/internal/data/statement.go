@@ -0,0 +1,461 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"gorm.io/gorm"
+)
+
+// StatementImport is the statement_imports table.
+type StatementImport struct {
+	ID               int64     `gorm:"primaryKey"`
+	Channel          string    `gorm:"size:16;uniqueIndex:idx_statement_imports_day,priority:1"`
+	Day              time.Time `gorm:"type:date;uniqueIndex:idx_statement_imports_day,priority:2"`
+	FileName         string    `gorm:"size:255"`
+	Lines            int64
+	Matched          int64
+	AmountMismatches int64
+	MissingLocally   int64
+	MissingAtChannel int64
+	CreatedAt        time.Time
+}
+
+// StatementLine is the statement_lines table.
+type StatementLine struct {
+	ID             int64  `gorm:"primaryKey"`
+	ImportID       int64  `gorm:"index:idx_statement_lines_import,priority:1"`
+	Result         string `gorm:"size:16;index:idx_statement_lines_import,priority:2"`
+	TradeNo        string `gorm:"size:64;index"`
+	ChannelTradeNo string `gorm:"size:64"`
+	ChannelAmount  int64
+	LocalAmount    int64
+}
+
+// StatementException is the statement_exceptions table.
+type StatementException struct {
+	ID             int64     `gorm:"primaryKey"`
+	ImportID       int64     `gorm:"index"`
+	Channel        string    `gorm:"size:16;index"`
+	Day            time.Time `gorm:"type:date"`
+	Result         string    `gorm:"size:16"`
+	TradeNo        string    `gorm:"size:64;index"`
+	ChannelTradeNo string    `gorm:"size:64"`
+	ChannelAmount  int64
+	LocalAmount    int64
+	Detail         string `gorm:"size:1024"`
+	Status         string `gorm:"size:16;index"`
+	Resolution     string `gorm:"size:1024"`
+	ResolvedBy     string `gorm:"size:64"`
+	CreatedAt      time.Time
+	ResolvedAt     *time.Time
+}
+
+// statementBatch is how many rows one insert of lines or exceptions carries.
+const statementBatch = 500
+
+type statementRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewStatementRepo .
+func NewStatementRepo(data *Data, logger log.Logger) biz.StatementRepo {
+	return &statementRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *statementRepo) CreateImport(ctx context.Context, imp *biz.StatementImport) error {
+	var n int64
+	err := r.data.DB(ctx).Model(&StatementImport{}).
+		Where("channel = ? AND day = ?", imp.Channel, imp.Day).Count(&n).Error
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return biz.ErrStatementImported
+	}
+	po := &StatementImport{
+		Channel:          imp.Channel,
+		Day:              imp.Day,
+		FileName:         imp.FileName,
+		Lines:            imp.Lines,
+		Matched:          imp.Matched,
+		AmountMismatches: imp.AmountMismatches,
+		MissingLocally:   imp.MissingLocally,
+		MissingAtChannel: imp.MissingAtChannel,
+	}
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return biz.ErrStatementImported
+		}
+		return err
+	}
+	imp.ID, imp.CreatedAt = po.ID, po.CreatedAt
+	return nil
+}
+
+func (r *statementRepo) FindImport(ctx context.Context, id int64) (*biz.StatementImport, error) {
+	var po StatementImport
+	err := r.data.DB(ctx).First(&po, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrStatementNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &biz.StatementImport{
+		ID:               po.ID,
+		Channel:          po.Channel,
+		Day:              po.Day,
+		FileName:         po.FileName,
+		Lines:            po.Lines,
+		Matched:          po.Matched,
+		AmountMismatches: po.AmountMismatches,
+		MissingLocally:   po.MissingLocally,
+		MissingAtChannel: po.MissingAtChannel,
+		CreatedAt:        po.CreatedAt,
+	}, nil
+}
+
+func (r *statementRepo) CreateLines(ctx context.Context, lines []*biz.StatementLine) error {
+	if len(lines) == 0 {
+		return nil
+	}
+	pos := make([]*StatementLine, 0, len(lines))
+	for _, l := range lines {
+		pos = append(pos, &StatementLine{
+			ImportID:       l.ImportID,
+			Result:         string(l.Result),
+			TradeNo:        l.TradeNo,
+			ChannelTradeNo: l.ChannelTradeNo,
+			ChannelAmount:  l.ChannelAmount,
+			LocalAmount:    l.LocalAmount,
+		})
+	}
+	if err := r.data.DB(ctx).CreateInBatches(pos, statementBatch).Error; err != nil {
+		return err
+	}
+	for i, po := range pos {
+		lines[i].ID = po.ID
+	}
+	return nil
+}
+
+func (r *statementRepo) ListLines(ctx context.Context, importID int64, result biz.MatchResult, page, pageSize int) ([]*biz.StatementLine, int64, error) {
+	db := r.data.DB(ctx).Model(&StatementLine{}).Where("import_id = ?", importID)
+	if result != "" {
+		db = db.Where("result = ?", string(result))
+	}
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var pos []*StatementLine
+	if err := db.Order("id").Offset((page - 1) * pageSize).Limit(pageSize).Find(&pos).Error; err != nil {
+		return nil, 0, err
+	}
+	rv := make([]*biz.StatementLine, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, &biz.StatementLine{
+			ID:             po.ID,
+			ImportID:       po.ImportID,
+			Result:         biz.MatchResult(po.Result),
+			TradeNo:        po.TradeNo,
+			ChannelTradeNo: po.ChannelTradeNo,
+			ChannelAmount:  po.ChannelAmount,
+			LocalAmount:    po.LocalAmount,
+		})
+	}
+	return rv, total, nil
+}
+
+func (r *statementRepo) CreateExceptions(ctx context.Context, es []*biz.StatementException) error {
+	if len(es) == 0 {
+		return nil
+	}
+	pos := make([]*StatementException, 0, len(es))
+	for _, e := range es {
+		pos = append(pos, &StatementException{
+			ImportID:       e.ImportID,
+			Channel:        e.Channel,
+			Day:            e.Day,
+			Result:         string(e.Result),
+			TradeNo:        e.TradeNo,
+			ChannelTradeNo: e.ChannelTradeNo,
+			ChannelAmount:  e.ChannelAmount,
+			LocalAmount:    e.LocalAmount,
+			Detail:         e.Detail,
+			Status:         string(e.Status),
+		})
+	}
+	if err := r.data.DB(ctx).CreateInBatches(pos, statementBatch).Error; err != nil {
+		return err
+	}
+	for i, po := range pos {
+		es[i].ID, es[i].CreatedAt = po.ID, po.CreatedAt
+	}
+	return nil
+}
+
+func (r *statementRepo) FindException(ctx context.Context, id int64) (*biz.StatementException, error) {
+	var po StatementException
+	err := r.data.DB(ctx).First(&po, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrExceptionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toStatementException(&po), nil
+}
+
+func (r *statementRepo) UpdateException(ctx context.Context, e *biz.StatementException) error {
+	return r.data.DB(ctx).Model(&StatementException{ID: e.ID}).Updates(map[string]interface{}{
+		"status":      string(e.Status),
+		"resolution":  e.Resolution,
+		"resolved_by": e.ResolvedBy,
+		"resolved_at": e.ResolvedAt,
+	}).Error
+}
+
+func (r *statementRepo) BookedTradeNos(ctx context.Context, channel string, tradeNos []string) (map[string]bool, error) {
+	rv := make(map[string]bool)
+	for len(tradeNos) > 0 {
+		n := min(len(tradeNos), statementBatch)
+		var booked []string
+		err := r.data.DB(ctx).Model(&StatementLine{}).
+			Joins("JOIN statement_imports ON statement_imports.id = statement_lines.import_id").
+			Where("statement_imports.channel = ? AND statement_lines.result IN ? AND statement_lines.trade_no IN ?",
+				channel, []string{string(biz.MatchMatched), string(biz.MatchAmountMismatch)}, tradeNos[:n]).
+			Distinct().Pluck("statement_lines.trade_no", &booked).Error
+		if err != nil {
+			return nil, err
+		}
+		for _, tradeNo := range booked {
+			rv[tradeNo] = true
+		}
+		tradeNos = tradeNos[n:]
+	}
+	return rv, nil
+}
+
+func (r *statementRepo) ResolveShortExceptions(ctx context.Context, channel string, tradeNos []string, operator, resolution string, at time.Time) (int64, error) {
+	var resolved int64
+	for len(tradeNos) > 0 {
+		n := min(len(tradeNos), statementBatch)
+		res := r.data.DB(ctx).Model(&StatementException{}).
+			Where("channel = ? AND result = ? AND status = ? AND trade_no IN ?",
+				channel, string(biz.MatchShort), string(biz.ExceptionOpen), tradeNos[:n]).
+			Updates(map[string]interface{}{
+				"status":      string(biz.ExceptionResolved),
+				"resolution":  resolution,
+				"resolved_by": operator,
+				"resolved_at": at,
+			})
+		if res.Error != nil {
+			return 0, res.Error
+		}
+		resolved += res.RowsAffected
+		tradeNos = tradeNos[n:]
+	}
+	return resolved, nil
+}
+
+func (r *statementRepo) ListExceptions(ctx context.Context, filter *biz.ExceptionFilter, page, pageSize int) ([]*biz.StatementException, int64, error) {
+	db := r.data.DB(ctx).Model(&StatementException{})
+	if filter.Status != "" {
+		db = db.Where("status = ?", string(filter.Status))
+	}
+	if filter.Channel != "" {
+		db = db.Where("channel = ?", filter.Channel)
+	}
+	if filter.ImportID != 0 {
+		db = db.Where("import_id = ?", filter.ImportID)
+	}
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var pos []*StatementException
+	if err := db.Order("id DESC").Offset((page - 1) * pageSize).Limit(pageSize).Find(&pos).Error; err != nil {
+		return nil, 0, err
+	}
+	rv := make([]*biz.StatementException, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toStatementException(po))
+	}
+	return rv, total, nil
+}
+
+func toStatementException(po *StatementException) *biz.StatementException {
+	e := &biz.StatementException{
+		ID:             po.ID,
+		ImportID:       po.ImportID,
+		Channel:        po.Channel,
+		Day:            po.Day,
+		Result:         biz.MatchResult(po.Result),
+		TradeNo:        po.TradeNo,
+		ChannelTradeNo: po.ChannelTradeNo,
+		ChannelAmount:  po.ChannelAmount,
+		LocalAmount:    po.LocalAmount,
+		Detail:         po.Detail,
+		Status:         biz.ExceptionStatus(po.Status),
+		Resolution:     po.Resolution,
+		ResolvedBy:     po.ResolvedBy,
+		CreatedAt:      po.CreatedAt,
+	}
+	if po.ResolvedAt != nil {
+		e.ResolvedAt = *po.ResolvedAt
+	}
+	return e
+}
+
+// statementFormat names the statement columns of a channel by their header.
+type statementFormat struct {
+	tradeNo        string
+	channelTradeNo string
+	amount         string
+	// kind, if set, is the column telling payment lines, which hold payment.
+	kind    string
+	payment string
+	// cents is set when amounts are in cents rather than yuan.
+	cents bool
+}
+
+// readStatement reads the payment lines of a CSV statement. Lines before
+// the header, "#" comments and lines too short to hold the columns, such as
+// summaries, are skipped; cells are trimmed of blanks and of the backquote
+// WeChat Pay prefixes them with.
+func readStatement(r io.Reader, f statementFormat) ([]*biz.StatementEntry, error) {
+	cr := csv.NewReader(r)
+	cr.Comment = '#'
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+	var (
+		cols    map[string]int
+		width   int
+		entries []*biz.StatementEntry
+	)
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		for i := range rec {
+			rec[i] = strings.Trim(rec[i], " \t`")
+		}
+		if cols == nil {
+			cols = statementHeader(rec, f)
+			for _, i := range cols {
+				if i >= width {
+					width = i + 1
+				}
+			}
+			continue
+		}
+		if len(rec) < width {
+			continue
+		}
+		if f.kind != "" && rec[cols[f.kind]] != f.payment {
+			continue
+		}
+		e := &biz.StatementEntry{
+			TradeNo:        rec[cols[f.tradeNo]],
+			ChannelTradeNo: rec[cols[f.channelTradeNo]],
+		}
+		if e.ChannelTradeNo == "" {
+			continue
+		}
+		amount := rec[cols[f.amount]]
+		if f.cents {
+			e.Amount, err = strconv.ParseInt(amount, 10, 64)
+		} else {
+			e.Amount, err = parseYuan(amount)
+		}
+		if err != nil {
+			line, _ := cr.FieldPos(0)
+			return nil, fmt.Errorf("line %d: invalid amount %q", line, amount)
+		}
+		entries = append(entries, e)
+	}
+	if cols == nil {
+		return nil, fmt.Errorf("no header with column %q", f.channelTradeNo)
+	}
+	return entries, nil
+}
+
+// statementHeader indexes the columns of f if rec is the header, or
+// returns nil.
+func statementHeader(rec []string, f statementFormat) map[string]int {
+	cols := make(map[string]int)
+	for i, name := range rec {
+		cols[name] = i
+	}
+	for _, name := range []string{f.tradeNo, f.channelTradeNo, f.amount, f.kind} {
+		if _, ok := cols[name]; name != "" && !ok {
+			return nil
+		}
+	}
+	return cols
+}
+
+// ParseStatement reads the trade details of an Alipay bill, which it
+// serves GBK encoded; a file saved again as UTF-8 is read as is.
+func (c *alipayChannel) ParseStatement(r io.Reader) ([]*biz.StatementEntry, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if !utf8.Valid(b) {
+		if b, err = simplifiedchinese.GBK.NewDecoder().Bytes(b); err != nil {
+			return nil, err
+		}
+	}
+	return readStatement(bytes.NewReader(b), statementFormat{
+		tradeNo:        "商户订单号",
+		channelTradeNo: "支付宝交易号",
+		amount:         "订单金额（元）",
+		kind:           "业务类型",
+		payment:        "交易",
+	})
+}
+
+// ParseStatement reads a WeChat Pay trade bill.
+func (c *wechatChannel) ParseStatement(r io.Reader) ([]*biz.StatementEntry, error) {
+	return readStatement(r, statementFormat{
+		tradeNo:        "商户订单号",
+		channelTradeNo: "微信订单号",
+		amount:         "订单金额",
+		kind:           "交易状态",
+		payment:        "SUCCESS",
+	})
+}
+
+// ParseStatement reads a simulated statement, a CSV with the columns
+// trade_no, channel_trade_no and amount in cents.
+func (s *simulator) ParseStatement(r io.Reader) ([]*biz.StatementEntry, error) {
+	return readStatement(r, statementFormat{
+		tradeNo:        "trade_no",
+		channelTradeNo: "channel_trade_no",
+		amount:         "amount",
+		cents:          true,
+	})
+}
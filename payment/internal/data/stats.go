@@ -0,0 +1,95 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PaymentStat is the payment_stats table, one row of running totals per
+// day, merchant, method and channel.
+type PaymentStat struct {
+	ID         int64     `gorm:"primaryKey"`
+	Day        time.Time `gorm:"type:date;uniqueIndex:idx_payment_stats_key,priority:1"`
+	MerchantID int64     `gorm:"uniqueIndex:idx_payment_stats_key,priority:2"`
+	Method     string    `gorm:"size:16;uniqueIndex:idx_payment_stats_key,priority:3"`
+	Channel    string    `gorm:"size:16;uniqueIndex:idx_payment_stats_key,priority:4"`
+	Count      int64     `gorm:"column:payments"`
+	Paid       int64
+	Discount   int64
+	Refunded   int64
+	UpdatedAt  time.Time
+}
+
+type statsRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewStatsRepo .
+func NewStatsRepo(data *Data, logger log.Logger) biz.StatsRepo {
+	return &statsRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *statsRepo) Add(ctx context.Context, stats []*biz.PaymentStat) error {
+	for _, s := range stats {
+		err := r.data.DB(ctx).Clauses(clause.OnConflict{
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"payments":   gorm.Expr("payments + ?", s.Count),
+				"paid":       gorm.Expr("paid + ?", s.Paid),
+				"discount":   gorm.Expr("discount + ?", s.Discount),
+				"refunded":   gorm.Expr("refunded + ?", s.Refunded),
+				"updated_at": time.Now(),
+			}),
+		}).Create(&PaymentStat{
+			Day:        s.Day,
+			MerchantID: s.MerchantID,
+			Method:     string(s.Method),
+			Channel:    s.Channel,
+			Count:      s.Count,
+			Paid:       s.Paid,
+			Discount:   s.Discount,
+			Refunded:   s.Refunded,
+		}).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *statsRepo) List(ctx context.Context, filter *biz.ReportFilter) ([]*biz.PaymentStat, error) {
+	db := r.data.DB(ctx).Where("day >= ? AND day <= ?", filter.Start, filter.End)
+	if filter.MerchantID != 0 {
+		db = db.Where("merchant_id = ?", filter.MerchantID)
+	}
+	if filter.Method != "" {
+		db = db.Where("method = ?", string(filter.Method))
+	}
+	var pos []*PaymentStat
+	if err := db.Order("day, merchant_id, method, channel").Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	stats := make([]*biz.PaymentStat, 0, len(pos))
+	for _, po := range pos {
+		stats = append(stats, &biz.PaymentStat{
+			Day:        po.Day,
+			MerchantID: po.MerchantID,
+			Method:     biz.Method(po.Method),
+			Channel:    po.Channel,
+			Count:      po.Count,
+			Paid:       po.Paid,
+			Discount:   po.Discount,
+			Refunded:   po.Refunded,
+		})
+	}
+	return stats, nil
+}
@@ -0,0 +1,321 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const wechatGateway = "https://api.mch.weixin.qq.com"
+
+// wechatNotifySkew is how old a notification may be before it is taken for
+// a replay.
+const wechatNotifySkew = 5 * time.Minute
+
+// wechatChannel talks to WeChat Pay API v3.
+type wechatChannel struct {
+	appID       string
+	mchID       string
+	serialNo    string
+	apiV3Key    string
+	gateway     string
+	notifyURL   string
+	key         *rsa.PrivateKey
+	platformKey *rsa.PublicKey
+	client      *http.Client
+	log         *log.Helper
+}
+
+func newWechatChannel(c *conf.Data_Wechat, notifyBase string, client *http.Client, logger log.Logger) (*wechatChannel, error) {
+	key, err := parsePrivateKey(c.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	platformKey, err := parsePublicKey(c.PlatformPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	gateway := c.Gateway
+	if gateway == "" {
+		gateway = wechatGateway
+	}
+	return &wechatChannel{
+		appID:       c.AppId,
+		mchID:       c.MchId,
+		serialNo:    c.SerialNo,
+		apiV3Key:    c.ApiV3Key,
+		gateway:     gateway,
+		notifyURL:   notifyURL(notifyBase, biz.ChannelWechat),
+		key:         key,
+		platformKey: platformKey,
+		client:      client,
+		log:         log.NewHelper(logger),
+	}, nil
+}
+
+func (c *wechatChannel) Name() string {
+	return biz.ChannelWechat
+}
+
+func (c *wechatChannel) Create(ctx context.Context, o *biz.ChannelOrder) (*biz.ChannelIntent, error) {
+	req := map[string]interface{}{
+		"appid":        c.appID,
+		"mchid":        c.mchID,
+		"description":  o.Subject,
+		"out_trade_no": o.TradeNo,
+		"time_expire":  o.ExpireAt.Format(time.RFC3339),
+		"notify_url":   c.notifyURL,
+		"amount":       map[string]interface{}{"total": o.Amount, "currency": "CNY"},
+	}
+	var resp struct {
+		CodeURL string `json:"code_url"`
+	}
+	if err := c.call(ctx, http.MethodPost, "/v3/pay/transactions/native", req, &resp); err != nil {
+		return nil, biz.ChannelError(c.Name(), err)
+	}
+	return &biz.ChannelIntent{PayURL: resp.CodeURL}, nil
+}
+
+// wechatTransaction is a transaction as WeChat Pay reports it.
+type wechatTransaction struct {
+	OutTradeNo    string `json:"out_trade_no"`
+	TransactionID string `json:"transaction_id"`
+	TradeState    string `json:"trade_state"`
+	SuccessTime   string `json:"success_time"`
+	Amount        struct {
+		Total int64 `json:"total"`
+	} `json:"amount"`
+}
+
+func (t *wechatTransaction) toChannelTrade() *biz.ChannelTrade {
+	trade := &biz.ChannelTrade{
+		TradeNo:        t.OutTradeNo,
+		ChannelTradeNo: t.TransactionID,
+		Amount:         t.Amount.Total,
+	}
+	switch t.TradeState {
+	case "SUCCESS":
+		trade.State = biz.ChannelTradePaid
+		trade.PaidAt, _ = time.Parse(time.RFC3339, t.SuccessTime)
+	case "CLOSED", "REVOKED", "PAYERROR":
+		trade.State = biz.ChannelTradeClosed
+	default:
+		trade.State = biz.ChannelTradePending
+	}
+	return trade
+}
+
+func (c *wechatChannel) Query(ctx context.Context, tradeNo string) (*biz.ChannelTrade, error) {
+	var resp wechatTransaction
+	path := "/v3/pay/transactions/out-trade-no/" + tradeNo + "?mchid=" + c.mchID
+	err := c.call(ctx, http.MethodGet, path, nil, &resp)
+	var werr *wechatError
+	if errors.As(err, &werr) && werr.Code == "ORDER_NOT_EXIST" {
+		return &biz.ChannelTrade{TradeNo: tradeNo, State: biz.ChannelTradePending}, nil
+	}
+	if err != nil {
+		return nil, biz.ChannelError(c.Name(), err)
+	}
+	return resp.toChannelTrade(), nil
+}
+
+func (c *wechatChannel) Close(ctx context.Context, tradeNo string) error {
+	path := "/v3/pay/transactions/out-trade-no/" + tradeNo + "/close"
+	if err := c.call(ctx, http.MethodPost, path, map[string]string{"mchid": c.mchID}, nil); err != nil {
+		return biz.ChannelError(c.Name(), err)
+	}
+	return nil
+}
+
+func (c *wechatChannel) Refund(ctx context.Context, r *biz.ChannelRefund) (*biz.ChannelRefundResult, error) {
+	req := map[string]interface{}{
+		"out_trade_no":  r.TradeNo,
+		"out_refund_no": r.RefundNo,
+		"reason":        r.Reason,
+		"notify_url":    c.notifyURL,
+		"amount":        map[string]interface{}{"refund": r.Amount, "total": r.TotalAmount, "currency": "CNY"},
+	}
+	var resp struct {
+		RefundID string `json:"refund_id"`
+		Status   string `json:"status"`
+	}
+	if err := c.call(ctx, http.MethodPost, "/v3/refund/domestic/refunds", req, &resp); err != nil {
+		return nil, biz.ChannelError(c.Name(), err)
+	}
+	return &biz.ChannelRefundResult{RefundNo: r.RefundNo, ChannelRefundNo: resp.RefundID, State: wechatRefundState(resp.Status)}, nil
+}
+
+func (c *wechatChannel) QueryRefund(ctx context.Context, _, refundNo string) (*biz.ChannelRefundResult, error) {
+	var resp struct {
+		RefundID string `json:"refund_id"`
+		Status   string `json:"status"`
+	}
+	if err := c.call(ctx, http.MethodGet, "/v3/refund/domestic/refunds/"+refundNo, nil, &resp); err != nil {
+		return nil, biz.ChannelError(c.Name(), err)
+	}
+	return &biz.ChannelRefundResult{RefundNo: refundNo, ChannelRefundNo: resp.RefundID, State: wechatRefundState(resp.Status)}, nil
+}
+
+func wechatRefundState(status string) biz.ChannelRefundState {
+	switch status {
+	case "SUCCESS":
+		return biz.ChannelRefundSucceeded
+	case "CLOSED", "ABNORMAL":
+		return biz.ChannelRefundFailed
+	default:
+		return biz.ChannelRefundProcessing
+	}
+}
+
+// wechatNotification is the envelope of a WeChat Pay notification.
+type wechatNotification struct {
+	ID        string `json:"id"`
+	EventType string `json:"event_type"`
+	Resource  struct {
+		Algorithm      string `json:"algorithm"`
+		Ciphertext     string `json:"ciphertext"`
+		AssociatedData string `json:"associated_data"`
+		Nonce          string `json:"nonce"`
+	} `json:"resource"`
+}
+
+func (c *wechatChannel) ParseNotify(_ context.Context, req *biz.NotifyRequest) (*biz.ChannelNotify, error) {
+	ts, err := strconv.ParseInt(req.Header.Get("Wechatpay-Timestamp"), 10, 64)
+	if err != nil {
+		return nil, biz.InvalidNotification(c.Name(), errors.New("missing timestamp"))
+	}
+	if d := time.Since(time.Unix(ts, 0)); d > wechatNotifySkew || d < -wechatNotifySkew {
+		return nil, biz.InvalidNotification(c.Name(), errors.New("stale timestamp"))
+	}
+	if err := c.verify(req.Header, req.Body); err != nil {
+		return nil, biz.InvalidNotification(c.Name(), err)
+	}
+	var n wechatNotification
+	if err := json.Unmarshal(req.Body, &n); err != nil {
+		return nil, biz.InvalidNotification(c.Name(), err)
+	}
+	if n.EventType != "TRANSACTION.SUCCESS" {
+		return &biz.ChannelNotify{ID: n.ID}, nil
+	}
+	plain, err := c.decrypt(n.Resource.Ciphertext, n.Resource.AssociatedData, n.Resource.Nonce)
+	if err != nil {
+		return nil, biz.InvalidNotification(c.Name(), err)
+	}
+	var t wechatTransaction
+	if err := json.Unmarshal(plain, &t); err != nil {
+		return nil, biz.InvalidNotification(c.Name(), err)
+	}
+	return &biz.ChannelNotify{ID: n.ID, Trade: t.toChannelTrade()}, nil
+}
+
+// decrypt opens an AEAD_AES_256_GCM notification resource with the API v3 key.
+func (c *wechatChannel) decrypt(ciphertext, associatedData, nonce string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher([]byte(c.apiV3Key))
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCMWithNonceSize(block, len(nonce))
+	if err != nil {
+		return nil, err
+	}
+	return gcm.Open(nil, []byte(nonce), data, []byte(associatedData))
+}
+
+// wechatError is a failure reported by WeChat Pay.
+type wechatError struct {
+	Status  int    `json:"-"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *wechatError) Error() string {
+	return fmt.Sprintf("%d %s: %s", e.Status, e.Code, e.Message)
+}
+
+// call sends a signed request and decodes the verified response into out.
+func (c *wechatChannel) call(ctx context.Context, method, path string, in, out interface{}) error {
+	var body []byte
+	if in != nil {
+		var err error
+		if body, err = json.Marshal(in); err != nil {
+			return err
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.gateway+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	auth, err := c.authorization(method, path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", auth)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if err := c.verify(resp.Header, data); err != nil {
+		return err
+	}
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		werr := &wechatError{Status: resp.StatusCode}
+		_ = json.Unmarshal(data, werr)
+		return werr
+	}
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
+
+// authorization builds the WECHATPAY2-SHA256-RSA2048 header of a request.
+func (c *wechatChannel) authorization(method, path string, body []byte) (string, error) {
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	nonce := biz.NewTradeNo("N")
+	msg := method + "\n" + path + "\n" + ts + "\n" + nonce + "\n" + string(body) + "\n"
+	sign, err := signSHA256WithRSA(c.key, []byte(msg))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`WECHATPAY2-SHA256-RSA2048 mchid="%s",nonce_str="%s",timestamp="%s",serial_no="%s",signature="%s"`,
+		c.mchID, nonce, ts, c.serialNo, sign), nil
+}
+
+// verify checks the platform signature WeChat Pay puts on its responses.
+func (c *wechatChannel) verify(h http.Header, body []byte) error {
+	sign := h.Get("Wechatpay-Signature")
+	if sign == "" {
+		return errors.New("response not signed")
+	}
+	msg := h.Get("Wechatpay-Timestamp") + "\n" + h.Get("Wechatpay-Nonce") + "\n" + string(body) + "\n"
+	if err := verifySHA256WithRSA(c.platformKey, []byte(msg), sign); err != nil {
+		return fmt.Errorf("verify response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/robfig/cron/v3"
+)
+
+// CronServer runs the scheduled payment jobs.
+type CronServer struct {
+	cron *cron.Cron
+	log  *log.Helper
+}
+
+// NewCronServer new a cron server.
+func NewCronServer(c *conf.Server, payment *biz.PaymentUsecase, combined *biz.CombinedUsecase, refunds *biz.RefundUsecase, events *biz.EventUsecase, logger log.Logger) (*CronServer, error) {
+	s := &CronServer{
+		// Runs are frequent, skip a run while the previous one is still going.
+		cron: cron.New(cron.WithChain(cron.SkipIfStillRunning(cron.DiscardLogger))),
+		log:  log.NewHelper(logger),
+	}
+	if spec := c.Cron.GetPoll(); spec != "" {
+		if _, err := s.cron.AddFunc(spec, func() {
+			if _, err := payment.PollPending(context.Background()); err != nil {
+				s.log.Errorf("poll pending payments: %v", err)
+			}
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if spec := c.Cron.GetRelay(); spec != "" {
+		if _, err := s.cron.AddFunc(spec, func() {
+			if _, err := events.Relay(context.Background()); err != nil {
+				s.log.Errorf("relay payment events: %v", err)
+			}
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if spec := c.Cron.GetRecover(); spec != "" {
+		if _, err := s.cron.AddFunc(spec, func() {
+			if _, err := combined.Recover(context.Background()); err != nil {
+				s.log.Errorf("recover combined payments: %v", err)
+			}
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if spec := c.Cron.GetRefund(); spec != "" {
+		if _, err := s.cron.AddFunc(spec, func() {
+			if _, err := refunds.Sync(context.Background()); err != nil {
+				s.log.Errorf("sync refunds: %v", err)
+			}
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Start implements transport.Server.
+func (s *CronServer) Start(context.Context) error {
+	s.cron.Start()
+	return nil
+}
+
+// Stop implements transport.Server, it waits for running jobs.
+func (s *CronServer) Stop(ctx context.Context) error {
+	select {
+	case <-s.cron.Stop().Done():
+	case <-ctx.Done():
+	}
+	return nil
+}
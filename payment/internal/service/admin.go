@@ -0,0 +1,251 @@
+package service
+
+import (
+	"bytes"
+	"context"
+
+	v1 "github.com/go-kratos/kratos-layout/payment/api/payment/v1"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// PaymentAdminService is a payment back office service.
+type PaymentAdminService struct {
+	v1.UnimplementedPaymentAdminServer
+
+	statements *biz.StatementUsecase
+	reports    *biz.ReportUsecase
+	risk       *biz.RiskUsecase
+}
+
+// NewPaymentAdminService new a payment back office service.
+func NewPaymentAdminService(statements *biz.StatementUsecase, reports *biz.ReportUsecase, risk *biz.RiskUsecase) *PaymentAdminService {
+	return &PaymentAdminService{statements: statements, reports: reports, risk: risk}
+}
+
+// ImportStatement implements v1.PaymentAdminServer.
+func (s *PaymentAdminService) ImportStatement(ctx context.Context, in *v1.ImportStatementRequest) (*v1.StatementImport, error) {
+	day, err := biz.ParseDay(in.Day)
+	if err != nil {
+		return nil, err
+	}
+	imp, err := s.statements.Import(ctx, in.Channel, day, in.FileName, bytes.NewReader(in.Content))
+	if err != nil {
+		return nil, err
+	}
+	return toStatementImportProto(imp), nil
+}
+
+// GetStatementImport implements v1.PaymentAdminServer.
+func (s *PaymentAdminService) GetStatementImport(ctx context.Context, in *v1.GetStatementImportRequest) (*v1.StatementImport, error) {
+	imp, err := s.statements.GetImport(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	return toStatementImportProto(imp), nil
+}
+
+// ListStatementLines implements v1.PaymentAdminServer.
+func (s *PaymentAdminService) ListStatementLines(ctx context.Context, in *v1.ListStatementLinesRequest) (*v1.ListStatementLinesReply, error) {
+	lines, total, err := s.statements.ListLines(ctx, in.ImportId, matchResults[in.Result], int(in.Page), int(in.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListStatementLinesReply{Total: total}
+	for _, l := range lines {
+		reply.Lines = append(reply.Lines, &v1.StatementLine{
+			Id:             l.ID,
+			ImportId:       l.ImportID,
+			Result:         toMatchResultProto(l.Result),
+			TradeNo:        l.TradeNo,
+			ChannelTradeNo: l.ChannelTradeNo,
+			ChannelAmount:  l.ChannelAmount,
+			LocalAmount:    l.LocalAmount,
+		})
+	}
+	return reply, nil
+}
+
+// ListStatementExceptions implements v1.PaymentAdminServer.
+func (s *PaymentAdminService) ListStatementExceptions(ctx context.Context, in *v1.ListStatementExceptionsRequest) (*v1.ListStatementExceptionsReply, error) {
+	es, total, err := s.statements.ListExceptions(ctx, &biz.ExceptionFilter{
+		Status:   exceptionStatuses[in.Status],
+		Channel:  in.Channel,
+		ImportID: in.ImportId,
+	}, int(in.Page), int(in.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListStatementExceptionsReply{Total: total}
+	for _, e := range es {
+		reply.Exceptions = append(reply.Exceptions, toStatementExceptionProto(e))
+	}
+	return reply, nil
+}
+
+// ResolveStatementException implements v1.PaymentAdminServer.
+func (s *PaymentAdminService) ResolveStatementException(ctx context.Context, in *v1.ResolveStatementExceptionRequest) (*v1.StatementException, error) {
+	e, err := s.statements.ResolveException(ctx, in.Id, in.Operator, in.Resolution)
+	if err != nil {
+		return nil, err
+	}
+	return toStatementExceptionProto(e), nil
+}
+
+// GetPaymentReport implements v1.PaymentAdminServer.
+func (s *PaymentAdminService) GetPaymentReport(ctx context.Context, in *v1.GetPaymentReportRequest) (*v1.GetPaymentReportReply, error) {
+	start, err := biz.ParseDay(in.StartDay)
+	if err != nil {
+		return nil, err
+	}
+	end, err := biz.ParseDay(in.EndDay)
+	if err != nil {
+		return nil, err
+	}
+	rows, totals, err := s.reports.GetReport(ctx, &biz.ReportFilter{
+		Start:      start,
+		End:        end,
+		MerchantID: in.MerchantId,
+		Method:     paymentMethods[in.Method],
+	})
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetPaymentReportReply{}
+	for _, r := range rows {
+		row := toReportRowProto(r)
+		row.Day = r.Day.Format(biz.DayLayout)
+		reply.Rows = append(reply.Rows, row)
+	}
+	for _, t := range totals {
+		reply.Totals = append(reply.Totals, toReportRowProto(t))
+	}
+	return reply, nil
+}
+
+var paymentMethods = map[v1.PaymentMethod]biz.Method{
+	v1.PaymentMethod_METHOD_COUPON:  biz.MethodCoupon,
+	v1.PaymentMethod_METHOD_POINTS:  biz.MethodPoints,
+	v1.PaymentMethod_METHOD_BALANCE: biz.MethodBalance,
+	v1.PaymentMethod_METHOD_CHANNEL: biz.MethodChannel,
+}
+
+func toReportRowProto(st *biz.PaymentStat) *v1.PaymentReportRow {
+	row := &v1.PaymentReportRow{
+		MerchantId: st.MerchantID,
+		Channel:    st.Channel,
+		Count:      st.Count,
+		Paid:       st.Paid,
+		Discount:   st.Discount,
+		Refunded:   st.Refunded,
+	}
+	for k, v := range paymentMethods {
+		if v == st.Method {
+			row.Method = k
+		}
+	}
+	return row
+}
+
+// ListRiskDecisions implements v1.PaymentAdminServer.
+func (s *PaymentAdminService) ListRiskDecisions(ctx context.Context, in *v1.ListRiskDecisionsRequest) (*v1.ListRiskDecisionsReply, error) {
+	ds, total, err := s.risk.ListDecisions(ctx, &biz.RiskDecisionFilter{
+		UserID: in.UserId,
+		Action: riskActions[in.Action],
+	}, int(in.Page), int(in.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListRiskDecisionsReply{Total: total}
+	for _, d := range ds {
+		rd := &v1.RiskDecision{
+			Id:        d.ID,
+			UserId:    d.UserID,
+			DeviceId:  d.DeviceID,
+			Ip:        d.IP,
+			BizNo:     d.BizNo,
+			Amount:    d.Amount,
+			Rules:     d.Rules,
+			Detail:    d.Detail,
+			CreatedAt: timestamppb.New(d.CreatedAt),
+		}
+		for k, v := range riskActions {
+			if v == d.Action {
+				rd.Action = k
+			}
+		}
+		reply.Decisions = append(reply.Decisions, rd)
+	}
+	return reply, nil
+}
+
+var riskActions = map[v1.RiskAction]biz.RiskAction{
+	v1.RiskAction_RISK_ALLOW:     biz.RiskAllow,
+	v1.RiskAction_RISK_CHALLENGE: biz.RiskChallenge,
+	v1.RiskAction_RISK_DENY:      biz.RiskDeny,
+}
+
+var matchResults = map[v1.MatchResult]biz.MatchResult{
+	v1.MatchResult_MATCHED:            biz.MatchMatched,
+	v1.MatchResult_AMOUNT_MISMATCH:    biz.MatchAmountMismatch,
+	v1.MatchResult_MISSING_LOCALLY:    biz.MatchLong,
+	v1.MatchResult_MISSING_AT_CHANNEL: biz.MatchShort,
+}
+
+var exceptionStatuses = map[v1.StatementExceptionStatus]biz.ExceptionStatus{
+	v1.StatementExceptionStatus_STATEMENT_EXCEPTION_OPEN:     biz.ExceptionOpen,
+	v1.StatementExceptionStatus_STATEMENT_EXCEPTION_RESOLVED: biz.ExceptionResolved,
+}
+
+func toMatchResultProto(r biz.MatchResult) v1.MatchResult {
+	for k, v := range matchResults {
+		if v == r {
+			return k
+		}
+	}
+	return v1.MatchResult_MATCH_RESULT_UNSPECIFIED
+}
+
+func toStatementImportProto(imp *biz.StatementImport) *v1.StatementImport {
+	return &v1.StatementImport{
+		Id:               imp.ID,
+		Channel:          imp.Channel,
+		Day:              imp.Day.Format(biz.DayLayout),
+		FileName:         imp.FileName,
+		Lines:            imp.Lines,
+		Matched:          imp.Matched,
+		AmountMismatches: imp.AmountMismatches,
+		MissingLocally:   imp.MissingLocally,
+		MissingAtChannel: imp.MissingAtChannel,
+		CreatedAt:        timestamppb.New(imp.CreatedAt),
+	}
+}
+
+func toStatementExceptionProto(e *biz.StatementException) *v1.StatementException {
+	pb := &v1.StatementException{
+		Id:             e.ID,
+		ImportId:       e.ImportID,
+		Channel:        e.Channel,
+		Day:            e.Day.Format(biz.DayLayout),
+		Result:         toMatchResultProto(e.Result),
+		TradeNo:        e.TradeNo,
+		ChannelTradeNo: e.ChannelTradeNo,
+		ChannelAmount:  e.ChannelAmount,
+		LocalAmount:    e.LocalAmount,
+		Detail:         e.Detail,
+		Resolution:     e.Resolution,
+		ResolvedBy:     e.ResolvedBy,
+		CreatedAt:      timestamppb.New(e.CreatedAt),
+	}
+	for k, v := range exceptionStatuses {
+		if v == e.Status {
+			pb.Status = k
+		}
+	}
+	if !e.ResolvedAt.IsZero() {
+		pb.ResolvedAt = timestamppb.New(e.ResolvedAt)
+	}
+	return pb
+}
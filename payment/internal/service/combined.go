@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/go-kratos/kratos-layout/payment/api/payment/v1"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateCombinedPayment implements v1.PaymentServer.
+func (s *PaymentService) CreateCombinedPayment(ctx context.Context, in *v1.CreateCombinedPaymentRequest) (*v1.CombinedPaymentInfo, error) {
+	c, err := s.combined.CreateCombined(ctx, &biz.CombinedPayment{
+		BizNo:         in.BizNo,
+		UserID:        in.UserId,
+		MerchantID:    in.MerchantId,
+		Subject:       in.Subject,
+		Amount:        in.Amount,
+		CouponID:      in.CouponId,
+		Points:        in.Points,
+		BalanceAmount: in.Balance,
+		Channel:       in.Channel,
+	}, toRiskContext(ctx, in.Risk))
+	if err != nil {
+		return nil, err
+	}
+	return toCombinedProto(c), nil
+}
+
+// GetCombinedPayment implements v1.PaymentServer.
+func (s *PaymentService) GetCombinedPayment(ctx context.Context, in *v1.GetCombinedPaymentRequest) (*v1.CombinedPaymentInfo, error) {
+	c, err := s.combined.GetCombined(ctx, in.PayNo)
+	if err != nil {
+		return nil, err
+	}
+	return toCombinedProto(c), nil
+}
+
+// CancelCombinedPayment implements v1.PaymentServer.
+func (s *PaymentService) CancelCombinedPayment(ctx context.Context, in *v1.CancelCombinedPaymentRequest) (*v1.CombinedPaymentInfo, error) {
+	c, err := s.combined.CancelCombined(ctx, in.PayNo)
+	if err != nil {
+		return nil, err
+	}
+	return toCombinedProto(c), nil
+}
+
+var combinedStatuses = map[biz.CombinedStatus]v1.CombinedStatus{
+	biz.CombinedTrying:     v1.CombinedStatus_COMBINED_TRYING,
+	biz.CombinedPaying:     v1.CombinedStatus_COMBINED_PAYING,
+	biz.CombinedConfirming: v1.CombinedStatus_COMBINED_CONFIRMING,
+	biz.CombinedSucceeded:  v1.CombinedStatus_COMBINED_SUCCEEDED,
+	biz.CombinedCancelling: v1.CombinedStatus_COMBINED_CANCELLING,
+	biz.CombinedCancelled:  v1.CombinedStatus_COMBINED_CANCELLED,
+}
+
+func toCombinedProto(c *biz.CombinedPayment) *v1.CombinedPaymentInfo {
+	pb := &v1.CombinedPaymentInfo{
+		PayNo:         c.PayNo,
+		BizNo:         c.BizNo,
+		UserId:        c.UserID,
+		MerchantId:    c.MerchantID,
+		Subject:       c.Subject,
+		Amount:        c.Amount,
+		CouponId:      c.CouponID,
+		CouponAmount:  c.CouponAmount,
+		Points:        c.Points,
+		PointsAmount:  c.PointsAmount,
+		BalanceAmount: c.BalanceAmount,
+		Channel:       c.Channel,
+		ChannelAmount: c.ChannelAmount,
+		Status:        combinedStatuses[c.Status],
+		FailReason:    c.FailReason,
+		CreatedAt:     timestamppb.New(c.CreatedAt),
+	}
+	if c.Payment != nil {
+		pb.Payment = toPaymentProto(c.Payment)
+	}
+	return pb
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// maxNotifyBody bounds the notifications we read, real ones are a few KB.
+const maxNotifyBody = 64 << 10
+
+// NotifyService receives the asynchronous notifications of the channels.
+// They are plain HTTP callbacks in each channel's own format rather than
+// API calls, so they are served by a route instead of a proto service.
+type NotifyService struct {
+	uc  *biz.PaymentUsecase
+	log *log.Helper
+}
+
+// NewNotifyService new a notify service.
+func NewNotifyService(uc *biz.PaymentUsecase, logger log.Logger) *NotifyService {
+	return &NotifyService{uc: uc, log: log.NewHelper(logger)}
+}
+
+// Notify handles POST /v1/payments/notify/{channel} and answers the channel
+// in the form it expects, so that it stops or keeps resending.
+func (s *NotifyService) Notify(ctx khttp.Context) error {
+	channel := ctx.Vars().Get("channel")
+	body, err := io.ReadAll(io.LimitReader(ctx.Request().Body, maxNotifyBody))
+	if err == nil {
+		err = s.uc.HandleNotify(ctx, channel, &biz.NotifyRequest{Header: ctx.Request().Header, Body: body})
+	}
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("notify %s: %v", channel, err)
+	}
+	if channel == biz.ChannelAlipay {
+		// Alipay keeps resending until it reads exactly "success".
+		if err != nil {
+			return ctx.String(http.StatusOK, "failure")
+		}
+		return ctx.String(http.StatusOK, "success")
+	}
+	if err != nil {
+		return ctx.JSON(int(errors.FromError(err).Code), map[string]string{"code": "FAIL", "message": errors.FromError(err).Message})
+	}
+	ctx.Response().WriteHeader(http.StatusOK)
+	return nil
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"net"
+
+	v1 "github.com/go-kratos/kratos-layout/payment/api/payment/v1"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// PaymentService is a payment service.
+type PaymentService struct {
+	v1.UnimplementedPaymentServer
+
+	uc       *biz.PaymentUsecase
+	combined *biz.CombinedUsecase
+	refunds  *biz.RefundUsecase
+	risk     *biz.RiskUsecase
+}
+
+// NewPaymentService new a payment service.
+func NewPaymentService(uc *biz.PaymentUsecase, combined *biz.CombinedUsecase, refunds *biz.RefundUsecase, risk *biz.RiskUsecase) *PaymentService {
+	return &PaymentService{uc: uc, combined: combined, refunds: refunds, risk: risk}
+}
+
+// CreatePayment implements v1.PaymentServer.
+func (s *PaymentService) CreatePayment(ctx context.Context, in *v1.CreatePaymentRequest) (*v1.PaymentInfo, error) {
+	p, err := s.uc.CreatePayment(ctx, &biz.Payment{
+		BizNo:      in.BizNo,
+		UserID:     in.UserId,
+		MerchantID: in.MerchantId,
+		Purpose:    toBizPurpose(in.Purpose),
+		Subject:    in.Subject,
+		Amount:     in.Amount,
+		Channel:    in.Channel,
+	}, toRiskContext(ctx, in.Risk))
+	if err != nil {
+		return nil, err
+	}
+	return toPaymentProto(p), nil
+}
+
+// GetPayment implements v1.PaymentServer.
+func (s *PaymentService) GetPayment(ctx context.Context, in *v1.GetPaymentRequest) (*v1.PaymentInfo, error) {
+	p, err := s.uc.GetPayment(ctx, in.TradeNo)
+	if err != nil {
+		return nil, err
+	}
+	return toPaymentProto(p), nil
+}
+
+// ClosePayment implements v1.PaymentServer.
+func (s *PaymentService) ClosePayment(ctx context.Context, in *v1.ClosePaymentRequest) (*v1.PaymentInfo, error) {
+	p, err := s.uc.ClosePayment(ctx, in.TradeNo)
+	if err != nil {
+		return nil, err
+	}
+	return toPaymentProto(p), nil
+}
+
+// SimulatePay implements v1.PaymentServer.
+func (s *PaymentService) SimulatePay(ctx context.Context, in *v1.SimulatePayRequest) (*v1.PaymentInfo, error) {
+	p, err := s.uc.SimulatePay(ctx, in.TradeNo)
+	if err != nil {
+		return nil, err
+	}
+	return toPaymentProto(p), nil
+}
+
+// ListPaymentTransitions implements v1.PaymentServer.
+func (s *PaymentService) ListPaymentTransitions(ctx context.Context, in *v1.ListPaymentTransitionsRequest) (*v1.ListPaymentTransitionsReply, error) {
+	ts, err := s.uc.ListTransitions(ctx, in.TradeNo)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListPaymentTransitionsReply{Transitions: make([]*v1.PaymentTransition, 0, len(ts))}
+	for _, t := range ts {
+		reply.Transitions = append(reply.Transitions, &v1.PaymentTransition{
+			From:      statuses[t.From],
+			To:        statuses[t.To],
+			Event:     t.Event,
+			Version:   t.Version,
+			CreatedAt: timestamppb.New(t.CreatedAt),
+		})
+	}
+	return reply, nil
+}
+
+// GetSettlementSummary implements v1.PaymentServer.
+func (s *PaymentService) GetSettlementSummary(ctx context.Context, in *v1.GetSettlementSummaryRequest) (*v1.GetSettlementSummaryReply, error) {
+	sum, err := s.uc.GetSettlementSummary(ctx, toBizPurpose(in.Purpose), in.StartTime.AsTime(), in.EndTime.AsTime())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetSettlementSummaryReply{Amount: sum.Amount, Count: sum.Count}, nil
+}
+
+// ListSettledPayments implements v1.PaymentServer.
+func (s *PaymentService) ListSettledPayments(ctx context.Context, in *v1.ListSettledPaymentsRequest) (*v1.ListSettledPaymentsReply, error) {
+	ps, err := s.uc.ListSettledPayments(ctx, toBizPurpose(in.Purpose), in.StartTime.AsTime(), in.EndTime.AsTime(), in.AfterTradeNo, int(in.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListSettledPaymentsReply{Payments: make([]*v1.SettledPayment, 0, len(ps))}
+	for _, p := range ps {
+		reply.Payments = append(reply.Payments, &v1.SettledPayment{
+			TradeNo: p.TradeNo,
+			BizNo:   p.BizNo,
+			Amount:  p.Amount,
+			PaidAt:  timestamppb.New(p.PaidAt),
+		})
+	}
+	return reply, nil
+}
+
+// VerifyChallenge implements v1.PaymentServer.
+func (s *PaymentService) VerifyChallenge(ctx context.Context, in *v1.VerifyChallengeRequest) (*v1.VerifyChallengeReply, error) {
+	if err := s.risk.VerifyChallenge(ctx, in.DecisionId, in.UserId); err != nil {
+		return nil, err
+	}
+	return &v1.VerifyChallengeReply{}, nil
+}
+
+func toBizPurpose(p v1.Purpose) biz.Purpose {
+	switch p {
+	case v1.Purpose_PURPOSE_TOP_UP:
+		return biz.PurposeTopUp
+	default:
+		return biz.PurposeOrder
+	}
+}
+
+func toPurposeProto(p biz.Purpose) v1.Purpose {
+	switch p {
+	case biz.PurposeTopUp:
+		return v1.Purpose_PURPOSE_TOP_UP
+	case biz.PurposeCombined:
+		return v1.Purpose_PURPOSE_COMBINED
+	default:
+		return v1.Purpose_PURPOSE_ORDER
+	}
+}
+
+var statuses = map[biz.Status]v1.PaymentStatus{
+	biz.StatusCreated:           v1.PaymentStatus_PAYMENT_CREATED,
+	biz.StatusPaying:            v1.PaymentStatus_PAYMENT_PAYING,
+	biz.StatusPaid:              v1.PaymentStatus_PAYMENT_PAID,
+	biz.StatusFailed:            v1.PaymentStatus_PAYMENT_FAILED,
+	biz.StatusClosed:            v1.PaymentStatus_PAYMENT_CLOSED,
+	biz.StatusRefunding:         v1.PaymentStatus_PAYMENT_REFUNDING,
+	biz.StatusPartiallyRefunded: v1.PaymentStatus_PAYMENT_PARTIALLY_REFUNDED,
+	biz.StatusRefunded:          v1.PaymentStatus_PAYMENT_REFUNDED,
+}
+
+func toPaymentProto(p *biz.Payment) *v1.PaymentInfo {
+	pb := &v1.PaymentInfo{
+		TradeNo:        p.TradeNo,
+		BizNo:          p.BizNo,
+		UserId:         p.UserID,
+		MerchantId:     p.MerchantID,
+		Purpose:        toPurposeProto(p.Purpose),
+		Subject:        p.Subject,
+		Amount:         p.Amount,
+		Channel:        p.Channel,
+		ChannelTradeNo: p.ChannelTradeNo,
+		PayUrl:         p.PayURL,
+		Status:         statuses[p.Status],
+		Version:        p.Version,
+		ExpireAt:       timestamppb.New(p.ExpireAt),
+		CreatedAt:      timestamppb.New(p.CreatedAt),
+	}
+	if !p.PaidAt.IsZero() {
+		pb.PaidAt = timestamppb.New(p.PaidAt)
+	}
+	return pb
+}
+
+// toRiskContext returns what the payer told the risk engine, with the
+// address the request came from.
+func toRiskContext(ctx context.Context, in *v1.RiskContext) *biz.RiskContext {
+	return &biz.RiskContext{
+		DeviceID:    in.GetDeviceId(),
+		IP:          peerIP(ctx),
+		ChallengeID: in.GetChallengeId(),
+	}
+}
+
+// peerIP returns the address of the peer of the transport of ctx, or "".
+func peerIP(ctx context.Context) string {
+	var addr string
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		if ht, ok := tr.(http.Transporter); ok {
+			addr = ht.Request().RemoteAddr
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && addr == "" {
+		addr = p.Addr.String()
+	}
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
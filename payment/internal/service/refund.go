@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/go-kratos/kratos-layout/payment/api/payment/v1"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateRefund implements v1.PaymentServer.
+func (s *PaymentService) CreateRefund(ctx context.Context, in *v1.CreateRefundRequest) (*v1.RefundInfo, error) {
+	r, err := s.refunds.CreateRefund(ctx, &biz.Refund{
+		RefundNo: in.RefundNo,
+		TradeNo:  in.TradeNo,
+		Amount:   in.Amount,
+		Reason:   in.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toRefundProto(r), nil
+}
+
+// GetRefund implements v1.PaymentServer.
+func (s *PaymentService) GetRefund(ctx context.Context, in *v1.GetRefundRequest) (*v1.RefundInfo, error) {
+	r, err := s.refunds.GetRefund(ctx, in.RefundNo)
+	if err != nil {
+		return nil, err
+	}
+	return toRefundProto(r), nil
+}
+
+// ListRefunds implements v1.PaymentServer.
+func (s *PaymentService) ListRefunds(ctx context.Context, in *v1.ListRefundsRequest) (*v1.ListRefundsReply, error) {
+	refunds, refunded, err := s.refunds.ListRefunds(ctx, in.TradeNo)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListRefundsReply{RefundedAmount: refunded}
+	for _, r := range refunds {
+		reply.Refunds = append(reply.Refunds, toRefundProto(r))
+	}
+	return reply, nil
+}
+
+var refundStatuses = map[biz.RefundStatus]v1.RefundStatus{
+	biz.RefundProcessing: v1.RefundStatus_REFUND_PROCESSING,
+	biz.RefundSucceeded:  v1.RefundStatus_REFUND_SUCCEEDED,
+	biz.RefundFailed:     v1.RefundStatus_REFUND_FAILED,
+}
+
+func toRefundProto(r *biz.Refund) *v1.RefundInfo {
+	pb := &v1.RefundInfo{
+		RefundNo:        r.RefundNo,
+		TradeNo:         r.TradeNo,
+		BizNo:           r.BizNo,
+		UserId:          r.UserID,
+		Amount:          r.Amount,
+		Reason:          r.Reason,
+		CouponAmount:    r.CouponAmount,
+		PointsAmount:    r.PointsAmount,
+		BalanceAmount:   r.BalanceAmount,
+		ChannelAmount:   r.ChannelAmount,
+		CouponReturned:  r.CouponReturned,
+		Channel:         r.Channel,
+		ChannelRefundNo: r.ChannelRefundNo,
+		Status:          refundStatuses[r.Status],
+		FailReason:      r.FailReason,
+		CreatedAt:       timestamppb.New(r.CreatedAt),
+	}
+	if !r.CompletedAt.IsZero() {
+		pb.CompletedAt = timestamppb.New(r.CompletedAt)
+	}
+	return pb
+}
@@ -0,0 +1,293 @@
+package saga
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultAttempts   = 3
+	defaultBackoff    = 100 * time.Millisecond
+	defaultMaxBackoff = 5 * time.Minute
+	defaultLease      = time.Minute
+	// recoverBatch is how many due sagas one pass of Recover takes up.
+	recoverBatch = 100
+)
+
+// Option is an Orchestrator option.
+type Option func(*Orchestrator)
+
+// WithAttempts sets how many times in a row an action or a compensation is
+// attempted before giving up, 3 by default. An action given up on is
+// compensated, a compensation is attempted again by Recover.
+func WithAttempts(n int) Option {
+	return func(o *Orchestrator) {
+		if n > 0 {
+			o.attempts = n
+		}
+	}
+}
+
+// WithBackoff sets the wait before the first retry, doubled for each retry
+// after it up to max, 100ms and 5m by default.
+func WithBackoff(base, max time.Duration) Option {
+	return func(o *Orchestrator) {
+		if base > 0 {
+			o.backoff = base
+		}
+		if max > 0 {
+			o.maxBackoff = max
+		}
+	}
+}
+
+// WithLease sets how long a saga being run is left alone by Recover, 1m by
+// default. It must outlast one attempt at a step, the lease is pushed past
+// the wait before each retry.
+func WithLease(d time.Duration) Option {
+	return func(o *Orchestrator) {
+		if d > 0 {
+			o.lease = d
+		}
+	}
+}
+
+// WithLogger sets the logger.
+func WithLogger(logger log.Logger) Option {
+	return func(o *Orchestrator) {
+		o.log = log.NewHelper(logger)
+	}
+}
+
+// Orchestrator runs sagas of registered definitions.
+type Orchestrator struct {
+	store       Store
+	mu          sync.RWMutex
+	definitions map[string]*Definition
+	attempts    int
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	lease       time.Duration
+	log         *log.Helper
+}
+
+// NewOrchestrator new an Orchestrator persisting sagas to store.
+func NewOrchestrator(store Store, opts ...Option) *Orchestrator {
+	o := &Orchestrator{
+		store:       store,
+		definitions: make(map[string]*Definition),
+		attempts:    defaultAttempts,
+		backoff:     defaultBackoff,
+		maxBackoff:  defaultMaxBackoff,
+		lease:       defaultLease,
+		log:         log.NewHelper(log.GetLogger()),
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// Register registers def, replacing the definition of the same name. Sagas
+// persisted are resumed with the definition registered under their name, so
+// steps may be added at the end of a definition but not moved.
+func (o *Orchestrator) Register(def *Definition) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.definitions[def.Name] = def
+}
+
+func (o *Orchestrator) definition(name string) (*Definition, bool) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	def, ok := o.definitions[name]
+	return def, ok
+}
+
+// Run runs a saga of the definition name identified by id with payload, and
+// returns once it completed or was compensated. A saga whose id is taken is
+// not run again, ErrExists is returned. When a step fails the error of the
+// step is returned, after the steps before it were compensated or, when a
+// compensation failed too, left to Recover to compensate. The saga goes on
+// when ctx is cancelled, so that it is not left half done.
+func (o *Orchestrator) Run(ctx context.Context, name, id string, payload []byte) (*Saga, error) {
+	def, ok := o.definition(name)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknown, name)
+	}
+	now := time.Now()
+	s := &Saga{
+		ID:          id,
+		Name:        name,
+		Payload:     payload,
+		Data:        make(map[string]string),
+		Status:      StatusRunning,
+		NextRetryAt: now.Add(o.lease),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	ctx = context.WithoutCancel(ctx)
+	if err := o.store.Create(ctx, s); err != nil {
+		return nil, err
+	}
+	cause, err := o.execute(ctx, def, s)
+	if a := (*abortError)(nil); errors.As(cause, &a) {
+		return s, a.err
+	}
+	if cause != nil {
+		return s, cause
+	}
+	return s, err
+}
+
+// Recover takes up the sagas due, that were cut short by a crash or whose
+// compensation failed, and runs them on where they stopped. Sagas another
+// instance takes up first are skipped. It returns how many sagas it took up.
+func (o *Orchestrator) Recover(ctx context.Context) (int, error) {
+	due, err := o.store.ListDue(ctx, time.Now(), recoverBatch)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, s := range due {
+		if ctx.Err() != nil {
+			return n, ctx.Err()
+		}
+		def, ok := o.definition(s.Name)
+		if !ok {
+			o.log.WithContext(ctx).Errorf("Saga %s: %v: %s", s.ID, ErrUnknown, s.Name)
+			continue
+		}
+		// Take the saga up, so that no other instance does.
+		s.NextRetryAt = time.Now().Add(o.lease)
+		if err := o.store.Update(ctx, s); err != nil {
+			if !errors.Is(err, ErrConflict) {
+				o.log.WithContext(ctx).Errorf("Saga %s: take up: %v", s.ID, err)
+			}
+			continue
+		}
+		n++
+		o.log.WithContext(ctx).Infof("Saga %s: resuming %s at step %d, %s", s.ID, s.Name, s.Step, s.Status)
+		if _, err := o.execute(ctx, def, s); err != nil {
+			o.log.WithContext(ctx).Errorf("Saga %s: %v", s.ID, err)
+		}
+	}
+	return n, nil
+}
+
+// execute runs the steps of s left, then compensates them if one failed. It
+// returns the error of the step that failed, if any, and the error that
+// stopped it before s was completed or compensated.
+func (o *Orchestrator) execute(ctx context.Context, def *Definition, s *Saga) (cause, err error) {
+	for s.Status == StatusRunning {
+		if s.Step >= len(def.Steps) {
+			s.Status = StatusCompleted
+			return nil, o.save(ctx, s, time.Time{})
+		}
+		step := def.Steps[s.Step]
+		if cause, err = o.attempt(ctx, s, step.Name, step.Action); err != nil {
+			return nil, err
+		}
+		if cause != nil {
+			// The step failing may have taken effect, it is compensated too.
+			s.Status, s.Attempts, s.LastError = StatusCompensating, 0, cause.Error()
+			o.log.WithContext(ctx).Warnf("Saga %s: step %s failed, compensating: %v", s.ID, step.Name, cause)
+			if err := o.save(ctx, s, time.Now().Add(o.lease)); err != nil {
+				return cause, err
+			}
+			break
+		}
+		s.Step++
+		s.Attempts = 0
+		if err := o.save(ctx, s, time.Now().Add(o.lease)); err != nil {
+			return nil, err
+		}
+	}
+	for s.Status == StatusCompensating {
+		if s.Step < 0 {
+			s.Status = StatusCompensated
+			return cause, o.save(ctx, s, time.Time{})
+		}
+		if s.Step < len(def.Steps) {
+			step := def.Steps[s.Step]
+			failed, err := o.attempt(ctx, s, step.Name, step.Compensate)
+			if err != nil {
+				return cause, err
+			}
+			if failed != nil {
+				// Compensations are not given up on, Recover retries them.
+				if err := o.save(ctx, s, time.Now().Add(o.wait(s.Attempts))); err != nil {
+					return cause, err
+				}
+				return cause, fmt.Errorf("compensate step %s: %w", step.Name, failed)
+			}
+		}
+		s.Step--
+		s.Attempts = 0
+		if err := o.save(ctx, s, time.Now().Add(o.lease)); err != nil {
+			return cause, err
+		}
+	}
+	return cause, nil
+}
+
+// attempt calls fn up to o.attempts times in a row, waiting longer before
+// each retry, and returns its last error as cause. An aborted error is not
+// retried. The lease of s is pushed past each wait, err is the error saving
+// it, when another instance took s over.
+func (o *Orchestrator) attempt(ctx context.Context, s *Saga, name string, fn func(context.Context, *Saga) error) (cause, err error) {
+	if fn == nil {
+		return nil, nil
+	}
+	for i := 1; ; i++ {
+		cause = fn(ctx, s)
+		if cause == nil {
+			return nil, nil
+		}
+		s.Attempts++
+		s.LastError = cause.Error()
+		if IsAborted(cause) || i >= o.attempts {
+			return cause, nil
+		}
+		o.log.WithContext(ctx).Warnf("Saga %s: step %s attempt %d: %v", s.ID, name, s.Attempts, cause)
+		wait := o.wait(i)
+		if err := o.save(ctx, s, time.Now().Add(wait+o.lease)); err != nil {
+			return cause, err
+		}
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return cause, nil
+		}
+	}
+}
+
+// wait returns the wait before retry n, with jitter so that instances do
+// not retry in step.
+func (o *Orchestrator) wait(n int) time.Duration {
+	d := o.backoff
+	for i := 1; i < n && d < o.maxBackoff; i++ {
+		d *= 2
+	}
+	if d > o.maxBackoff {
+		d = o.maxBackoff
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// save persists s, taken up again by Recover at next unless it is zero.
+func (o *Orchestrator) save(ctx context.Context, s *Saga, next time.Time) error {
+	s.NextRetryAt = next
+	s.UpdatedAt = time.Now()
+	if err := o.store.Update(ctx, s); err != nil {
+		o.log.WithContext(ctx).Errorf("Saga %s: save: %v", s.ID, err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,288 @@
+package saga
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newTestStore(t *testing.T) Store {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())),
+		&gorm.Config{TranslateError: true, Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+	if err := db.AutoMigrate(&Record{}); err != nil {
+		t.Fatal(err)
+	}
+	return NewGormStore(func(ctx context.Context) *gorm.DB { return db.WithContext(ctx) })
+}
+
+// trace records the calls of the steps of a test definition.
+type trace struct {
+	mu    sync.Mutex
+	calls []string
+}
+
+func (tr *trace) add(call string) {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	tr.calls = append(tr.calls, call)
+}
+
+func (tr *trace) get() []string {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	return append([]string(nil), tr.calls...)
+}
+
+// definition returns a definition of steps named a, b, c..., whose actions
+// fail with the errors of fail in turn.
+func (tr *trace) definition(steps int, fail map[string][]error) *Definition {
+	def := &Definition{Name: "test"}
+	for i := 0; i < steps; i++ {
+		name := string(rune('a' + i))
+		def.Steps = append(def.Steps, Step{
+			Name: name,
+			Action: func(_ context.Context, s *Saga) error {
+				tr.add(name)
+				s.Set(name, "done")
+				tr.mu.Lock()
+				defer tr.mu.Unlock()
+				if errs := fail[name]; len(errs) > 0 {
+					fail[name] = errs[1:]
+					return errs[0]
+				}
+				return nil
+			},
+			Compensate: func(_ context.Context, s *Saga) error {
+				tr.add("undo " + name + " " + s.Get(name))
+				tr.mu.Lock()
+				defer tr.mu.Unlock()
+				if errs := fail["undo "+name]; len(errs) > 0 {
+					fail["undo "+name] = errs[1:]
+					return errs[0]
+				}
+				return nil
+			},
+		})
+	}
+	return def
+}
+
+func newTestOrchestrator(store Store, def *Definition, opts ...Option) *Orchestrator {
+	opts = append([]Option{WithBackoff(time.Millisecond, 2*time.Millisecond), WithLogger(log.DefaultLogger)}, opts...)
+	o := NewOrchestrator(store, opts...)
+	o.Register(def)
+	return o
+}
+
+func TestRun(t *testing.T) {
+	errFail := errors.New("fail")
+	tests := []struct {
+		name   string
+		fail   map[string][]error
+		status Status
+		err    error
+		calls  []string
+	}{
+		{"completes", nil, StatusCompleted, nil,
+			[]string{"a", "b", "c"}},
+		{"retries", map[string][]error{"b": {errFail, errFail}}, StatusCompleted, nil,
+			[]string{"a", "b", "b", "b", "c"}},
+		{"compensates in reverse", map[string][]error{"c": {errFail, errFail, errFail}}, StatusCompensated, errFail,
+			[]string{"a", "b", "c", "c", "c", "undo c done", "undo b done", "undo a done"}},
+		{"aborted not retried", map[string][]error{"b": {Abort(errFail)}}, StatusCompensated, errFail,
+			[]string{"a", "b", "undo b done", "undo a done"}},
+		{"compensation retried", map[string][]error{"b": {Abort(errFail)}, "undo a": {errFail}}, StatusCompensated, errFail,
+			[]string{"a", "b", "undo b done", "undo a done", "undo a done"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := newTestStore(t)
+			tr := &trace{}
+			o := newTestOrchestrator(store, tr.definition(3, tt.fail))
+			s, err := o.Run(ctx, "test", "s1", []byte("payload"))
+			if !errors.Is(err, tt.err) || IsAborted(err) {
+				t.Errorf("Run: %v, want %v", err, tt.err)
+			}
+			if got := tr.get(); !reflect.DeepEqual(got, tt.calls) {
+				t.Errorf("calls %q, want %q", got, tt.calls)
+			}
+			saved, err := store.Get(ctx, "s1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if saved.Status != tt.status || s.Status != tt.status {
+				t.Errorf("status %s saved %s, want %s", s.Status, saved.Status, tt.status)
+			}
+			if !saved.NextRetryAt.IsZero() {
+				t.Errorf("%s saga left due at %v", saved.Status, saved.NextRetryAt)
+			}
+			if string(saved.Payload) != "payload" || saved.Get("a") != "done" {
+				t.Errorf("saved payload %q data %v", saved.Payload, saved.Data)
+			}
+		})
+	}
+}
+
+func TestRunExists(t *testing.T) {
+	ctx := context.Background()
+	tr := &trace{}
+	o := newTestOrchestrator(newTestStore(t), tr.definition(2, nil))
+	if _, err := o.Run(ctx, "test", "s1", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := o.Run(ctx, "test", "s1", nil); !errors.Is(err, ErrExists) {
+		t.Errorf("running s1 again: %v", err)
+	}
+	if got := tr.get(); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("calls %q, want the steps once", got)
+	}
+	if _, err := o.Run(ctx, "other", "s2", nil); !errors.Is(err, ErrUnknown) {
+		t.Errorf("running an unknown saga: %v", err)
+	}
+}
+
+func TestRecover(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	tr := &trace{}
+	o := newTestOrchestrator(store, tr.definition(3, nil))
+	now := time.Now()
+	for _, s := range []*Saga{
+		// Cut short after step a, its lease run out.
+		{ID: "expired", Name: "test", Status: StatusRunning, Step: 1, Data: map[string]string{"a": "done"}, NextRetryAt: now.Add(-time.Second)},
+		// Being run by another instance.
+		{ID: "leased", Name: "test", Status: StatusRunning, Step: 1, NextRetryAt: now.Add(time.Minute)},
+		// Compensating, its lease run out.
+		{ID: "compensating", Name: "test", Status: StatusCompensating, Step: 0, Data: map[string]string{"a": "done"}, NextRetryAt: now.Add(-time.Second)},
+		{ID: "completed", Name: "test", Status: StatusCompleted, Step: 3},
+	} {
+		if err := store.Create(ctx, s); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	n, err := o.Recover(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Errorf("took up %d sagas, want 2", n)
+	}
+	want := map[string]Status{
+		"expired":      StatusCompleted,
+		"leased":       StatusRunning,
+		"compensating": StatusCompensated,
+		"completed":    StatusCompleted,
+	}
+	for id, status := range want {
+		s, err := store.Get(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.Status != status {
+			t.Errorf("saga %s is %s, want %s", id, s.Status, status)
+		}
+	}
+	calls := tr.get()
+	if len(calls) != 3 {
+		t.Errorf("calls %q, want b and c of one saga and undo a of the other", calls)
+	}
+
+	if n, err = o.Recover(ctx); err != nil || n != 0 {
+		t.Errorf("recovering again took up %d sagas: %v", n, err)
+	}
+}
+
+func TestRecoverTakeover(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	if err := store.Create(ctx, &Saga{ID: "s1", Name: "test", Status: StatusRunning, NextRetryAt: time.Now().Add(-time.Second)}); err != nil {
+		t.Fatal(err)
+	}
+	stale, err := store.Get(ctx, "s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := &trace{}
+	o := newTestOrchestrator(store, tr.definition(1, nil))
+	if n, err := o.Recover(ctx); err != nil || n != 1 {
+		t.Fatalf("took up %d sagas: %v", n, err)
+	}
+	// The instance that ran s1 before cannot save it any more.
+	stale.Step++
+	if err := store.Update(ctx, stale); !errors.Is(err, ErrConflict) {
+		t.Errorf("saving a saga taken over: %v", err)
+	}
+	if s, _ := store.Get(ctx, "s1"); s.Status != StatusCompleted {
+		t.Errorf("saga taken over is %s", s.Status)
+	}
+}
+
+func TestLeaseCoversBackoff(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	tr := &trace{}
+	def := tr.definition(1, map[string][]error{"a": {errors.New("fail")}})
+	o := newTestOrchestrator(store, def, WithLease(50*time.Millisecond), WithBackoff(400*time.Millisecond, 400*time.Millisecond))
+	other := newTestOrchestrator(store, def)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := o.Run(ctx, "test", "s1", nil)
+		done <- err
+	}()
+	// Past the lease the saga was created with, within the wait before
+	// the retry of step a.
+	time.Sleep(150 * time.Millisecond)
+	if n, err := other.Recover(ctx); err != nil || n != 0 {
+		t.Errorf("another instance took up %d sagas during the backoff: %v", n, err)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if got := tr.get(); !reflect.DeepEqual(got, []string{"a", "a"}) {
+		t.Errorf("calls %q, want a twice", got)
+	}
+}
+
+func TestWait(t *testing.T) {
+	o := NewOrchestrator(nil, WithBackoff(100*time.Millisecond, time.Second))
+	tests := []struct {
+		retry int
+		max   time.Duration
+	}{
+		{1, 100 * time.Millisecond},
+		{2, 200 * time.Millisecond},
+		{3, 400 * time.Millisecond},
+		{4, 800 * time.Millisecond},
+		{5, time.Second},
+		{50, time.Second},
+	}
+	for _, tt := range tests {
+		for i := 0; i < 100; i++ {
+			if d := o.wait(tt.retry); d < tt.max/2 || d > tt.max {
+				t.Fatalf("wait before retry %d is %v, want within [%v, %v]", tt.retry, d, tt.max/2, tt.max)
+			}
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package saga
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Runner recovers the sagas of an Orchestrator on start and then every
+// interval, as a transport.Server of the app.
+type Runner struct {
+	orchestrator *Orchestrator
+	interval     time.Duration
+	cancel       context.CancelFunc
+	wg           sync.WaitGroup
+	log          *log.Helper
+}
+
+// NewRunner new a Runner recovering the sagas of o every interval.
+func NewRunner(o *Orchestrator, interval time.Duration, logger log.Logger) *Runner {
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	return &Runner{orchestrator: o, interval: interval, log: log.NewHelper(logger)}
+}
+
+// Start implements transport.Server.
+func (r *Runner) Start(context.Context) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		for {
+			n, err := r.orchestrator.Recover(ctx)
+			if err != nil && ctx.Err() == nil {
+				r.log.Errorf("recover sagas: %v", err)
+			}
+			if n > 0 {
+				r.log.Infof("recovered %d sagas", n)
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop implements transport.Server, it waits for the saga in hand.
+func (r *Runner) Stop(ctx context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	done := make(chan struct{})
+	go func() {
+		r.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+	return nil
+}
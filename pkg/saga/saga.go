@@ -0,0 +1,129 @@
+// Package saga runs sequences of steps across services that share no
+// transaction. Each step has a compensation undoing it; when a step fails
+// for good the steps done before it are compensated in reverse order. The
+// state of each saga is persisted after every step, so that a saga cut
+// short by a crash is resumed by Recover where it stopped.
+package saga
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrExists is returned when creating a saga whose id is taken.
+	ErrExists = errors.New("saga: saga exists")
+	// ErrConflict is returned when a saga was updated since it was read,
+	// by another instance that took it over.
+	ErrConflict = errors.New("saga: saga changed concurrently")
+	// ErrNotFound is returned when a saga does not exist.
+	ErrNotFound = errors.New("saga: saga not found")
+	// ErrUnknown is returned when running a saga of no registered definition.
+	ErrUnknown = errors.New("saga: unknown saga")
+)
+
+// Status is the status of a saga.
+type Status string
+
+const (
+	// StatusRunning is a saga running its steps.
+	StatusRunning Status = "running"
+	// StatusCompensating is a saga undoing the steps it did after one failed.
+	StatusCompensating Status = "compensating"
+	// StatusCompleted is a saga that did all its steps.
+	StatusCompleted Status = "completed"
+	// StatusCompensated is a saga whose steps done were all undone.
+	StatusCompensated Status = "compensated"
+)
+
+// Step is a step of a saga. Action and Compensate may both run more than
+// once, after a retry or a crash, and must be idempotent; Compensate may
+// also run for an Action that never took effect. Compensate may be nil for
+// a step with nothing to undo.
+type Step struct {
+	Name       string
+	Action     func(ctx context.Context, s *Saga) error
+	Compensate func(ctx context.Context, s *Saga) error
+}
+
+// Definition is a named sequence of steps.
+type Definition struct {
+	Name  string
+	Steps []Step
+}
+
+// Saga is the persisted state of a run of a definition.
+type Saga struct {
+	// ID identifies the saga, usually the number of what it makes.
+	ID   string
+	Name string
+	// Payload is the input of the saga, as given to Run.
+	Payload []byte
+	// Data is what steps pass on to the later steps and to compensations,
+	// persisted with the saga.
+	Data   map[string]string
+	Status Status
+	// Step is the index of the step running or, when compensating, of the
+	// step done last that is left to compensate.
+	Step int
+	// Attempts counts the failed attempts at the current step.
+	Attempts int
+	// LastError is the error the saga failed with.
+	LastError string
+	// NextRetryAt is when Recover takes the saga up next. While an instance
+	// runs the saga it is pushed ahead, so that Recover leaves it alone.
+	NextRetryAt time.Time
+	Version     int64
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Get returns the value of key of the data of s.
+func (s *Saga) Get(key string) string {
+	return s.Data[key]
+}
+
+// Set sets key of the data of s to value, persisted once the step is done.
+func (s *Saga) Set(key, value string) {
+	if s.Data == nil {
+		s.Data = make(map[string]string)
+	}
+	s.Data[key] = value
+}
+
+// Store persists sagas.
+type Store interface {
+	// Create saves a new saga, or returns ErrExists.
+	Create(ctx context.Context, s *Saga) error
+	// Update saves a saga and bumps its version if that is still the
+	// version read, or returns ErrConflict.
+	Update(ctx context.Context, s *Saga) error
+	// Get returns a saga, or ErrNotFound.
+	Get(ctx context.Context, id string) (*Saga, error)
+	// ListDue lists up to limit sagas running or compensating whose
+	// NextRetryAt is not after now, oldest first.
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*Saga, error)
+}
+
+type abortError struct {
+	err error
+}
+
+func (e *abortError) Error() string { return e.err.Error() }
+func (e *abortError) Unwrap() error { return e.err }
+
+// Abort marks err returned by an Action as final: the action is not retried
+// and the saga is compensated at once.
+func Abort(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &abortError{err: err}
+}
+
+// IsAborted reports whether err was marked by Abort.
+func IsAborted(err error) bool {
+	var e *abortError
+	return errors.As(err, &e)
+}
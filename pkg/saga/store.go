@@ -0,0 +1,142 @@
+package saga
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Record is the sagas table, migrate it with the tables of the service.
+type Record struct {
+	ID          string            `gorm:"primaryKey;size:64"`
+	Name        string            `gorm:"size:64"`
+	Payload     []byte            `gorm:"type:blob"`
+	Data        map[string]string `gorm:"serializer:json;type:text"`
+	Status      string            `gorm:"size:16;index:idx_sagas_due,priority:1"`
+	Step        int
+	Attempts    int
+	LastError   string     `gorm:"size:1024"`
+	NextRetryAt *time.Time `gorm:"index:idx_sagas_due,priority:2"`
+	Version     int64
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// TableName implements schema.Tabler.
+func (Record) TableName() string {
+	return "sagas"
+}
+
+type gormStore struct {
+	db func(ctx context.Context) *gorm.DB
+}
+
+// NewGormStore returns a Store on the sagas table of the database db returns
+// for a ctx, so that a saga may be saved in the transaction ctx carries.
+func NewGormStore(db func(ctx context.Context) *gorm.DB) Store {
+	return &gormStore{db: db}
+}
+
+func (s *gormStore) Create(ctx context.Context, sg *Saga) error {
+	po := toRecord(sg)
+	err := s.db(ctx).Create(po).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return ErrExists
+	}
+	return err
+}
+
+func (s *gormStore) Update(ctx context.Context, sg *Saga) error {
+	po := toRecord(sg)
+	po.Version++
+	res := s.db(ctx).Model(po).Where("version = ?", sg.Version).
+		Select("data", "status", "step", "attempts", "last_error", "next_retry_at", "version", "updated_at").
+		Updates(po)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrConflict
+	}
+	sg.Version = po.Version
+	return nil
+}
+
+func (s *gormStore) Get(ctx context.Context, id string) (*Saga, error) {
+	var po Record
+	err := s.db(ctx).Where("id = ?", id).First(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toSaga(&po), nil
+}
+
+func (s *gormStore) ListDue(ctx context.Context, now time.Time, limit int) ([]*Saga, error) {
+	var pos []*Record
+	err := s.db(ctx).Where("status IN ? AND next_retry_at <= ?", []string{string(StatusRunning), string(StatusCompensating)}, now).
+		Order("next_retry_at").Limit(limit).Find(&pos).Error
+	if err != nil {
+		return nil, err
+	}
+	rv := make([]*Saga, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toSaga(po))
+	}
+	return rv, nil
+}
+
+func toRecord(s *Saga) *Record {
+	po := &Record{
+		ID:        s.ID,
+		Name:      s.Name,
+		Payload:   s.Payload,
+		Data:      s.Data,
+		Status:    string(s.Status),
+		Step:      s.Step,
+		Attempts:  s.Attempts,
+		LastError: truncate(s.LastError, 1024),
+		Version:   s.Version,
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+	}
+	if !s.NextRetryAt.IsZero() {
+		po.NextRetryAt = &s.NextRetryAt
+	}
+	return po
+}
+
+func toSaga(po *Record) *Saga {
+	s := &Saga{
+		ID:        po.ID,
+		Name:      po.Name,
+		Payload:   po.Payload,
+		Data:      po.Data,
+		Status:    Status(po.Status),
+		Step:      po.Step,
+		Attempts:  po.Attempts,
+		LastError: po.LastError,
+		Version:   po.Version,
+		CreatedAt: po.CreatedAt,
+		UpdatedAt: po.UpdatedAt,
+	}
+	if po.NextRetryAt != nil {
+		s.NextRetryAt = *po.NextRetryAt
+	}
+	return s
+}
+
+// truncate cuts s to n bytes at most, on a rune boundary.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && s[n]&0xC0 == 0x80 {
+		n--
+	}
+	return s[:n]
+}
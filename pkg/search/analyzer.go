@@ -0,0 +1,77 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// analyze splits text into terms: runs of letters and digits into
+// lower-cased words, and runs of CJK characters, written without spaces,
+// into overlapping bigrams. Indexed text also yields each CJK character on
+// its own, for a query of a single character to find the words holding it;
+// a longer query is matched by its bigrams only.
+func analyze(text string, query bool) []string {
+	var (
+		terms []string
+		word  []rune
+		cjk   []rune
+	)
+	flushWord := func() {
+		if len(word) > 0 {
+			terms = append(terms, string(word))
+			word = word[:0]
+		}
+	}
+	flushCJK := func() {
+		switch {
+		case len(cjk) == 0:
+		case len(cjk) == 1:
+			terms = append(terms, string(cjk))
+		default:
+			for i := 0; i+1 < len(cjk); i++ {
+				terms = append(terms, string(cjk[i:i+2]))
+			}
+			if !query {
+				for _, r := range cjk {
+					terms = append(terms, string(r))
+				}
+			}
+		}
+		cjk = cjk[:0]
+	}
+	for _, r := range text {
+		r = fold(r)
+		switch {
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushCJK()
+			word = append(word, r)
+		default:
+			flushWord()
+			flushCJK()
+		}
+	}
+	flushWord()
+	flushCJK()
+	return terms
+}
+
+// normalize folds text the way terms are, for phrases to be compared.
+func normalize(text string) string {
+	return strings.Map(fold, strings.TrimSpace(text))
+}
+
+// fold lower-cases a rune and turns a full-width ASCII one, common in CJK
+// text, into its ASCII form.
+func fold(r rune) rune {
+	if r >= 0xFF01 && r <= 0xFF5E {
+		r -= 0xFEE0
+	}
+	return unicode.ToLower(r)
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
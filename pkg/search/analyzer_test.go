@@ -0,0 +1,52 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAnalyze(t *testing.T) {
+	tests := []struct {
+		text  string
+		query bool
+		want  []string
+	}{
+		{"Green Tea", false, []string{"green", "tea"}},
+		{"  tea,cup;pot-2kg ", false, []string{"tea", "cup", "pot", "2kg"}},
+		{"绿茶", false, []string{"绿茶", "绿", "茶"}},
+		{"绿茶", true, []string{"绿茶"}},
+		{"茶", true, []string{"茶"}},
+		{"西湖龙井", true, []string{"西湖", "湖龙", "龙井"}},
+		{"iPhone15手机壳", false, []string{"iphone15", "手机", "机壳", "手", "机", "壳"}},
+		{"iPhone15手机壳", true, []string{"iphone15", "手机", "机壳"}},
+		{"新款T恤ABC", true, []string{"新款", "t", "恤", "abc"}},
+		// Full-width letters and digits fold to ASCII, full-width
+		// punctuation splits words as ASCII punctuation does.
+		{"ＡＢＣ１２３", false, []string{"abc123"}},
+		{"Ｔｅａ，ｃｕｐ", true, []string{"tea", "cup"}},
+		{"ひらがなカタ", true, []string{"ひら", "らが", "がな", "なカ", "カタ"}},
+		{"", false, nil},
+		{"!? ,", true, nil},
+	}
+	for _, tt := range tests {
+		if got := analyze(tt.text, tt.query); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("analyze(%q, %t) = %q, want %q", tt.text, tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"  Green Tea ", "green tea"},
+		{"ＧＲＥＥＮ　Ｔｅａ", "green　tea"},
+		{"绿茶", "绿茶"},
+	}
+	for _, tt := range tests {
+		if got := normalize(tt.text); got != tt.want {
+			t.Errorf("normalize(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,454 @@
+// Package search is a full-text search engine embedded in the process and
+// kept in memory. It indexes documents of weighted text fields, keywords to
+// filter and facet on, and numbers to filter, bucket and sort by; matches
+// are ranked with BM25. Text is split into lower-cased words and, for CJK
+// text written without spaces, into overlapping bigrams; a query matches
+// the documents holding each of its terms.
+package search
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	// bm25K1 and bm25B are the usual BM25 parameters: how fast the weight
+	// of a term saturates, and how much the length of a document counts.
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// ByScore sorts by relevance to the query text.
+const ByScore = ""
+
+// Field is text indexed with a weight, a term in it counting Boost times.
+type Field struct {
+	Text  string
+	Boost float64
+}
+
+// Document is what the index holds of a document.
+type Document struct {
+	ID     int64
+	Fields []Field
+	// Keywords are the exact values of a document by field, to filter and
+	// facet on.
+	Keywords map[string][]string
+	// Numbers are the values of a document by field, to filter, bucket and
+	// sort by.
+	Numbers map[string]float64
+	// Suggestions are the phrases a document offers as completions, those
+	// of heavier documents offered first.
+	Suggestions []string
+	Weight      float64
+}
+
+// Filter keeps the documents with any of Values in the keywords of Field.
+type Filter struct {
+	Field  string
+	Values []string
+}
+
+// Range keeps the documents whose number of Field is within Min and Max,
+// both included; infinities leave a side open.
+type Range struct {
+	Field    string
+	Min, Max float64
+}
+
+// RangeFacet counts the documents matched by the bucket their number of
+// Field falls in: below Bounds[0], from each bound up to the next one, and
+// from the last bound up.
+type RangeFacet struct {
+	Field  string
+	Bounds []float64
+}
+
+// Sort orders by a number field, or by score for ByScore.
+type Sort struct {
+	Field string
+	Desc  bool
+}
+
+// Query is a search. Empty Text matches every document. Filters and Ranges
+// all apply.
+type Query struct {
+	Text        string
+	Filters     []Filter
+	Ranges      []Range
+	Facets      []string
+	RangeFacets []RangeFacet
+	// Sort orders the matches, ties broken by the next Sort and then by id.
+	Sort   []Sort
+	Offset int
+	Limit  int
+}
+
+// Hit is a document matched.
+type Hit struct {
+	ID    int64
+	Score float64
+}
+
+// FacetValue is how many documents matched hold a keyword.
+type FacetValue struct {
+	Value string
+	Count int
+}
+
+// Result is the page of the matches asked for and the facets of all of
+// them.
+type Result struct {
+	Total int
+	Hits  []Hit
+	// Facets are the values of each keyword facet, most frequent first.
+	Facets map[string][]FacetValue
+	// RangeFacets are the counts of the buckets of each range facet.
+	RangeFacets map[string][]int
+}
+
+type entry struct {
+	doc      *Document
+	terms    map[string]float64
+	length   float64
+	keywords map[string]map[string]bool
+}
+
+type suggestion struct {
+	key    string
+	text   string
+	weight float64
+	refs   int
+}
+
+// Index is a search index, safe for concurrent use.
+type Index struct {
+	mu          sync.RWMutex
+	docs        map[int64]*entry
+	postings    map[string]map[int64]float64
+	totalLength float64
+	suggestions map[string]*suggestion
+	// sorted are the suggestions by key, nil once they change.
+	sorted []*suggestion
+}
+
+// New returns an empty index.
+func New() *Index {
+	return &Index{
+		docs:        make(map[int64]*entry),
+		postings:    make(map[string]map[int64]float64),
+		suggestions: make(map[string]*suggestion),
+	}
+}
+
+// Put indexes a document, replacing the one of its id.
+func (x *Index) Put(doc *Document) {
+	e := &entry{
+		doc:      doc,
+		terms:    make(map[string]float64),
+		keywords: make(map[string]map[string]bool, len(doc.Keywords)),
+	}
+	for _, f := range doc.Fields {
+		boost := f.Boost
+		if boost <= 0 {
+			boost = 1
+		}
+		for _, t := range analyze(f.Text, false) {
+			e.terms[t] += boost
+			e.length += boost
+		}
+	}
+	for field, values := range doc.Keywords {
+		set := make(map[string]bool, len(values))
+		for _, v := range values {
+			set[v] = true
+		}
+		e.keywords[field] = set
+	}
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.remove(doc.ID)
+	x.docs[doc.ID] = e
+	x.totalLength += e.length
+	for t, tf := range e.terms {
+		p, ok := x.postings[t]
+		if !ok {
+			p = make(map[int64]float64)
+			x.postings[t] = p
+		}
+		p[doc.ID] = tf
+	}
+	for _, text := range doc.Suggestions {
+		key := normalize(text)
+		if key == "" {
+			continue
+		}
+		s, ok := x.suggestions[key]
+		if !ok {
+			s = &suggestion{key: key, text: strings.TrimSpace(text)}
+			x.suggestions[key] = s
+			x.sorted = nil
+		}
+		s.weight += doc.Weight
+		s.refs++
+	}
+}
+
+// Delete removes a document, if indexed.
+func (x *Index) Delete(id int64) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.remove(id)
+}
+
+func (x *Index) remove(id int64) {
+	e, ok := x.docs[id]
+	if !ok {
+		return
+	}
+	delete(x.docs, id)
+	x.totalLength -= e.length
+	for t := range e.terms {
+		p := x.postings[t]
+		delete(p, id)
+		if len(p) == 0 {
+			delete(x.postings, t)
+		}
+	}
+	for _, text := range e.doc.Suggestions {
+		s, ok := x.suggestions[normalize(text)]
+		if !ok {
+			continue
+		}
+		s.weight -= e.doc.Weight
+		if s.refs--; s.refs <= 0 {
+			delete(x.suggestions, s.key)
+			x.sorted = nil
+		}
+	}
+}
+
+// Len returns how many documents are indexed.
+func (x *Index) Len() int {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	return len(x.docs)
+}
+
+// Documents returns the documents indexed by id, to be put in a new index.
+func (x *Index) Documents() []*Document {
+	x.mu.RLock()
+	docs := make([]*Document, 0, len(x.docs))
+	for _, e := range x.docs {
+		docs = append(docs, e.doc)
+	}
+	x.mu.RUnlock()
+	sort.Slice(docs, func(i, j int) bool { return docs[i].ID < docs[j].ID })
+	return docs
+}
+
+// Search runs a query.
+func (x *Index) Search(q *Query) *Result {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	var hits []Hit
+	for id, score := range x.match(q.Text) {
+		if e := x.docs[id]; e.matches(q) {
+			hits = append(hits, Hit{ID: id, Score: score})
+		}
+	}
+	res := &Result{
+		Total:       len(hits),
+		Facets:      make(map[string][]FacetValue, len(q.Facets)),
+		RangeFacets: make(map[string][]int, len(q.RangeFacets)),
+	}
+	for _, field := range q.Facets {
+		res.Facets[field] = x.facet(hits, field)
+	}
+	for _, rf := range q.RangeFacets {
+		counts := make([]int, len(rf.Bounds)+1)
+		for _, h := range hits {
+			v, ok := x.docs[h.ID].doc.Numbers[rf.Field]
+			if ok {
+				counts[sort.SearchFloat64s(rf.Bounds, math.Nextafter(v, math.Inf(1)))]++
+			}
+		}
+		res.RangeFacets[rf.Field] = counts
+	}
+	sort.Slice(hits, func(i, j int) bool { return x.less(q.Sort, hits[i], hits[j]) })
+	if q.Offset < len(hits) {
+		hits = hits[q.Offset:]
+		if q.Limit > 0 && q.Limit < len(hits) {
+			hits = hits[:q.Limit]
+		}
+		res.Hits = hits
+	}
+	return res
+}
+
+// match returns the documents holding every term of text with their BM25
+// scores, or every document for text without terms.
+func (x *Index) match(text string) map[int64]float64 {
+	terms := unique(analyze(text, true))
+	if len(terms) == 0 {
+		scores := make(map[int64]float64, len(x.docs))
+		for id := range x.docs {
+			scores[id] = 0
+		}
+		return scores
+	}
+	postings := make([]map[int64]float64, 0, len(terms))
+	for _, t := range terms {
+		p, ok := x.postings[t]
+		if !ok {
+			return nil
+		}
+		postings = append(postings, p)
+	}
+	// Walking the rarest term and looking the others up does the least work.
+	sort.Slice(postings, func(i, j int) bool { return len(postings[i]) < len(postings[j]) })
+	n := float64(len(x.docs))
+	avg := x.totalLength / n
+	scores := make(map[int64]float64)
+next:
+	for id := range postings[0] {
+		length := x.docs[id].length
+		score := 0.0
+		for _, p := range postings {
+			tf, ok := p[id]
+			if !ok {
+				continue next
+			}
+			df := float64(len(p))
+			idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+			score += idf * tf * (bm25K1 + 1) / (tf + bm25K1*(1-bm25B+bm25B*length/avg))
+		}
+		scores[id] = score
+	}
+	return scores
+}
+
+func (e *entry) matches(q *Query) bool {
+	for _, f := range q.Filters {
+		set := e.keywords[f.Field]
+		found := false
+		for _, v := range f.Values {
+			if set[v] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for _, r := range q.Ranges {
+		v, ok := e.doc.Numbers[r.Field]
+		if !ok || v < r.Min || v > r.Max {
+			return false
+		}
+	}
+	return true
+}
+
+func (x *Index) facet(hits []Hit, field string) []FacetValue {
+	counts := make(map[string]int)
+	for _, h := range hits {
+		for v := range x.docs[h.ID].keywords[field] {
+			counts[v]++
+		}
+	}
+	values := make([]FacetValue, 0, len(counts))
+	for v, n := range counts {
+		values = append(values, FacetValue{Value: v, Count: n})
+	}
+	sort.Slice(values, func(i, j int) bool {
+		if values[i].Count != values[j].Count {
+			return values[i].Count > values[j].Count
+		}
+		return values[i].Value < values[j].Value
+	})
+	return values
+}
+
+func (x *Index) less(sorts []Sort, a, b Hit) bool {
+	for _, s := range sorts {
+		va, vb := a.Score, b.Score
+		if s.Field != ByScore {
+			va, vb = x.docs[a.ID].doc.Numbers[s.Field], x.docs[b.ID].doc.Numbers[s.Field]
+		}
+		if va == vb {
+			continue
+		}
+		return va < vb != s.Desc
+	}
+	return a.ID < b.ID
+}
+
+// Suggest returns up to limit phrases starting with prefix, those of the
+// heaviest documents first.
+func (x *Index) Suggest(prefix string, limit int) []string {
+	key := normalize(prefix)
+	if key == "" || limit <= 0 {
+		return nil
+	}
+	x.mu.RLock()
+	sorted := x.sorted
+	x.mu.RUnlock()
+	if sorted == nil {
+		sorted = x.sortSuggestions()
+	}
+	i := sort.Search(len(sorted), func(i int) bool { return sorted[i].key >= key })
+	var found []suggestion
+	x.mu.RLock()
+	for ; i < len(sorted) && strings.HasPrefix(sorted[i].key, key); i++ {
+		found = append(found, *sorted[i])
+	}
+	x.mu.RUnlock()
+	sort.Slice(found, func(i, j int) bool {
+		if found[i].weight != found[j].weight {
+			return found[i].weight > found[j].weight
+		}
+		if found[i].refs != found[j].refs {
+			return found[i].refs > found[j].refs
+		}
+		return found[i].key < found[j].key
+	})
+	if len(found) > limit {
+		found = found[:limit]
+	}
+	rv := make([]string, 0, len(found))
+	for _, s := range found {
+		rv = append(rv, s.text)
+	}
+	return rv
+}
+
+func (x *Index) sortSuggestions() []*suggestion {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if x.sorted != nil {
+		return x.sorted
+	}
+	sorted := make([]*suggestion, 0, len(x.suggestions))
+	for _, s := range x.suggestions {
+		sorted = append(sorted, s)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].key < sorted[j].key })
+	x.sorted = sorted
+	return sorted
+}
+
+func unique(terms []string) []string {
+	seen := make(map[string]bool, len(terms))
+	rv := terms[:0]
+	for _, t := range terms {
+		if !seen[t] {
+			seen[t] = true
+			rv = append(rv, t)
+		}
+	}
+	return rv
+}
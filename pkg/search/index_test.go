@@ -0,0 +1,226 @@
+package search
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func testIndex() *Index {
+	x := New()
+	for _, doc := range []*Document{
+		{ID: 1, Fields: []Field{{Text: "Green Tea", Boost: 3}, {Text: "loose leaf from Hangzhou"}},
+			Keywords: map[string][]string{"brand": {"west"}}, Numbers: map[string]float64{"price": 10},
+			Suggestions: []string{"Green Tea"}, Weight: 5},
+		{ID: 2, Fields: []Field{{Text: "Black Tea"}, {Text: "红茶 礼盒"}},
+			Keywords: map[string][]string{"brand": {"east"}}, Numbers: map[string]float64{"price": 99.5},
+			Suggestions: []string{"Black Tea", "红茶"}, Weight: 3},
+		{ID: 3, Fields: []Field{{Text: "Tea Cup"}, {Text: "白瓷茶杯"}},
+			Keywords: map[string][]string{"brand": {"west", "east"}}, Numbers: map[string]float64{"price": 100},
+			Suggestions: []string{"Tea Cup"}, Weight: 1},
+		{ID: 4, Fields: []Field{{Text: "Green Tea Cake"}}, Keywords: map[string][]string{"brand": {"south"}},
+			Suggestions: []string{"green tea"}, Weight: 1},
+	} {
+		x.Put(doc)
+	}
+	return x
+}
+
+func hitIDs(res *Result) []int64 {
+	ids := make([]int64, 0, len(res.Hits))
+	for _, h := range res.Hits {
+		ids = append(ids, h.ID)
+	}
+	return ids
+}
+
+func sortedIDs(res *Result) []int64 {
+	ids := hitIDs(res)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func TestSearchMatchesAllTerms(t *testing.T) {
+	x := testIndex()
+	tests := []struct {
+		text string
+		want []int64
+	}{
+		{"tea", []int64{1, 2, 3, 4}},
+		{"green tea", []int64{1, 4}},
+		{"TEA green", []int64{1, 4}},
+		{"green cup", nil},
+		{"green coffee", nil},
+		{"红茶", []int64{2}},
+		{"茶", []int64{2, 3}},
+		{"茶杯", []int64{3}},
+		{"白瓷杯", nil},
+		{"hangzhou 绿茶", nil},
+		{"", []int64{1, 2, 3, 4}},
+		{" ,", []int64{1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		res := x.Search(&Query{Text: tt.text})
+		if got := sortedIDs(res); !reflect.DeepEqual(got, append([]int64{}, tt.want...)) || res.Total != len(tt.want) {
+			t.Errorf("searching %q: %v of %d, want %v", tt.text, got, res.Total, tt.want)
+		}
+	}
+}
+
+func TestSearchRanksByBoost(t *testing.T) {
+	x := testIndex()
+	res := x.Search(&Query{Text: "green", Sort: []Sort{{Field: ByScore, Desc: true}}})
+	if got := hitIDs(res); !reflect.DeepEqual(got, []int64{1, 4}) {
+		t.Errorf("green by score: %v, want the boosted title first", got)
+	}
+	if res.Hits[0].Score <= res.Hits[1].Score {
+		t.Errorf("scores %v not descending", res.Hits)
+	}
+}
+
+func TestSearchFiltersAndSorts(t *testing.T) {
+	x := testIndex()
+	tests := []struct {
+		name  string
+		query *Query
+		want  []int64
+	}{
+		{"filter any value", &Query{Filters: []Filter{{Field: "brand", Values: []string{"west", "south"}}}, Sort: []Sort{{Field: "price"}}},
+			[]int64{4, 1, 3}},
+		{"filters all apply", &Query{Filters: []Filter{{Field: "brand", Values: []string{"west"}}, {Field: "brand", Values: []string{"east"}}}},
+			[]int64{3}},
+		{"range both ends included", &Query{Ranges: []Range{{Field: "price", Min: 10, Max: 99.5}}},
+			[]int64{1, 2}},
+		{"range open above", &Query{Ranges: []Range{{Field: "price", Min: 50, Max: math.Inf(1)}}, Sort: []Sort{{Field: "price", Desc: true}}},
+			[]int64{3, 2}},
+		{"page", &Query{Text: "tea", Sort: []Sort{{Field: "price", Desc: true}}, Offset: 1, Limit: 2},
+			[]int64{2, 1}},
+		{"page past the end", &Query{Text: "tea", Offset: 4},
+			[]int64{}},
+	}
+	for _, tt := range tests {
+		if got := hitIDs(x.Search(tt.query)); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSearchFacets(t *testing.T) {
+	x := testIndex()
+	x.Put(&Document{ID: 5, Fields: []Field{{Text: "tea tray"}}, Numbers: map[string]float64{"price": 9.99}})
+	res := x.Search(&Query{
+		Text:        "tea",
+		Facets:      []string{"brand"},
+		RangeFacets: []RangeFacet{{Field: "price", Bounds: []float64{10, 100}}},
+	})
+	wantFacets := []FacetValue{{"east", 2}, {"west", 2}, {"south", 1}}
+	if got := res.Facets["brand"]; !reflect.DeepEqual(got, wantFacets) {
+		t.Errorf("brand facet: %v, want %v", got, wantFacets)
+	}
+	// 9.99 below the first bound, 10 and 99.5 from it, 100 from the last
+	// one; document 4 has no price.
+	if got, want := res.RangeFacets["price"], []int{1, 2, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("price buckets: %v, want %v", got, want)
+	}
+}
+
+func TestPutReplaces(t *testing.T) {
+	x := testIndex()
+	x.Put(&Document{ID: 1, Fields: []Field{{Text: "Oolong"}}, Suggestions: []string{"Oolong"}, Weight: 2})
+
+	if x.Len() != 4 {
+		t.Errorf("%d documents after replacing one, want 4", x.Len())
+	}
+	if got := sortedIDs(x.Search(&Query{Text: "hangzhou"})); len(got) != 0 {
+		t.Errorf("old text of the replaced document still matches: %v", got)
+	}
+	if got := sortedIDs(x.Search(&Query{Text: "green"})); !reflect.DeepEqual(got, []int64{4}) {
+		t.Errorf("green after replacing 1: %v, want [4]", got)
+	}
+	if got := sortedIDs(x.Search(&Query{Text: "oolong"})); !reflect.DeepEqual(got, []int64{1}) {
+		t.Errorf("oolong: %v, want [1]", got)
+	}
+	if _, ok := x.postings["hangzhou"]; ok {
+		t.Error("posting of a term no document holds is kept")
+	}
+	// "Green Tea" was offered by 1 and 4, the one of 4 is left.
+	s := x.suggestions["green tea"]
+	if s == nil || s.refs != 1 || s.weight != 1 {
+		t.Fatalf("green tea suggestion after replacing 1: %+v, want 1 ref of weight 1", s)
+	}
+	if got, want := x.Suggest("o", 5), []string{"Oolong"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("suggesting o: %v, want %v", got, want)
+	}
+
+	// Putting the same document again counts it once.
+	x.Put(&Document{ID: 4, Fields: []Field{{Text: "Green Tea Cake"}}, Suggestions: []string{"green tea"}, Weight: 1})
+	if s := x.suggestions["green tea"]; s == nil || s.refs != 1 || s.weight != 1 {
+		t.Errorf("green tea suggestion after putting 4 again: %+v", s)
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	x := testIndex()
+	tests := []struct {
+		prefix string
+		limit  int
+		want   []string
+	}{
+		// Green Tea of 1 and 4 weighs 6, Tea Cup 1.
+		{"t", 5, []string{"Tea Cup"}},
+		{"g", 5, []string{"Green Tea"}},
+		{"ＧＲ", 5, []string{"Green Tea"}},
+		{"", 5, nil},
+		{"b", 0, nil},
+		{"红", 5, []string{"红茶"}},
+	}
+	for _, tt := range tests {
+		if got := x.Suggest(tt.prefix, tt.limit); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Suggest(%q, %d) = %q, want %q", tt.prefix, tt.limit, got, tt.want)
+		}
+	}
+
+	x.Put(&Document{ID: 6, Suggestions: []string{"Ginger Tea"}, Weight: 10})
+	if got, want := x.Suggest("g", 5), []string{"Ginger Tea", "Green Tea"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("heavier first: %q, want %q", got, want)
+	}
+	if got, want := x.Suggest("g", 1), []string{"Ginger Tea"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("limited to 1: %q, want %q", got, want)
+	}
+}
+
+func TestSuggestAfterDelete(t *testing.T) {
+	x := testIndex()
+	if got := x.Suggest("b", 5); !reflect.DeepEqual(got, []string{"Black Tea"}) {
+		t.Fatalf("suggesting b: %q", got)
+	}
+	x.Delete(2)
+	if got := x.Suggest("b", 5); len(got) != 0 {
+		t.Errorf("suggestion of a deleted document: %q", got)
+	}
+	if got := x.Suggest("红", 5); len(got) != 0 {
+		t.Errorf("suggestion of a deleted document: %q", got)
+	}
+
+	// Green Tea is still offered by 4, with its weight only.
+	x.Delete(1)
+	if got := x.Suggest("g", 5); !reflect.DeepEqual(got, []string{"Green Tea"}) {
+		t.Errorf("suggestion shared with a deleted document: %q", got)
+	}
+	if s := x.suggestions["green tea"]; s == nil || s.weight != 1 {
+		t.Errorf("green tea suggestion after deleting 1: %+v", s)
+	}
+	x.Delete(4)
+	if got := x.Suggest("g", 5); len(got) != 0 {
+		t.Errorf("suggestion of deleted documents: %q", got)
+	}
+
+	x.Delete(42)
+	if x.Len() != 1 {
+		t.Errorf("%d documents left, want 1", x.Len())
+	}
+	if got := x.Suggest("t", 5); !reflect.DeepEqual(got, []string{"Tea Cup"}) {
+		t.Errorf("suggesting t: %q", got)
+	}
+}
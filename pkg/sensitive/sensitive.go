@@ -0,0 +1,217 @@
+// Package sensitive finds the words of a list in text at once, with an
+// Aho-Corasick automaton. Matching ignores case and full-width forms, and
+// skips the spaces, punctuation and symbols put between the characters of
+// a word to slip it through, so that "Ｆ.u c-k" matches "fuck".
+package sensitive
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Match is a word found in a text.
+type Match struct {
+	// Word is the word of the list, as it was given.
+	Word string
+	// Start and End are the byte offsets of the text the word spans.
+	Start, End int
+}
+
+type node struct {
+	next map[rune]int32
+	fail int32
+	// words are the indexes of the words ending here, those of the nodes
+	// down the failure links too.
+	words []int32
+	depth int
+}
+
+// Matcher finds the words of a list. A Matcher is immutable, safe for
+// concurrent use.
+type Matcher struct {
+	nodes []node
+	words []string
+	// lengths are the runes of each word that count.
+	lengths []int
+}
+
+// New returns a matcher of words. Words with nothing but spaces,
+// punctuation and symbols are dropped, those normalized alike kept once.
+func New(words []string) *Matcher {
+	m := &Matcher{nodes: []node{{}}}
+	seen := make(map[string]bool, len(words))
+	for _, w := range words {
+		key := normalize(w)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		m.insert(key, strings.TrimSpace(w))
+	}
+	m.link()
+	return m
+}
+
+func (m *Matcher) insert(key, word string) {
+	cur := int32(0)
+	for _, r := range key {
+		n := &m.nodes[cur]
+		next, ok := n.next[r]
+		if !ok {
+			if n.next == nil {
+				n.next = make(map[rune]int32)
+			}
+			next = int32(len(m.nodes))
+			n.next[r] = next
+			m.nodes = append(m.nodes, node{depth: m.nodes[cur].depth + 1})
+		}
+		cur = next
+	}
+	m.nodes[cur].words = append(m.nodes[cur].words, int32(len(m.words)))
+	m.words = append(m.words, word)
+	m.lengths = append(m.lengths, m.nodes[cur].depth)
+}
+
+// link sets the failure links breadth first, each node's from those of
+// the shallower ones.
+func (m *Matcher) link() {
+	queue := make([]int32, 0, len(m.nodes))
+	for _, child := range m.nodes[0].next {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range m.nodes[cur].next {
+			f := m.nodes[cur].fail
+			for f != 0 && !m.has(f, r) {
+				f = m.nodes[f].fail
+			}
+			if next, ok := m.nodes[f].next[r]; ok && next != child {
+				m.nodes[child].fail = next
+			}
+			fail := m.nodes[child].fail
+			m.nodes[child].words = append(m.nodes[child].words, m.nodes[fail].words...)
+			queue = append(queue, child)
+		}
+	}
+}
+
+func (m *Matcher) has(n int32, r rune) bool {
+	_, ok := m.nodes[n].next[r]
+	return ok
+}
+
+// Len returns how many words the matcher finds.
+func (m *Matcher) Len() int {
+	return len(m.words)
+}
+
+// Find returns the words found in text by where they end, overlapping
+// ones too.
+func (m *Matcher) Find(text string) []Match {
+	var found []Match
+	m.scan(text, func(word int32, start, end int) bool {
+		found = append(found, Match{Word: m.words[word], Start: start, End: end})
+		return true
+	})
+	return found
+}
+
+// Contains reports whether any word is in text.
+func (m *Matcher) Contains(text string) bool {
+	found := false
+	m.scan(text, func(int32, int, int) bool {
+		found = true
+		return false
+	})
+	return found
+}
+
+// scan walks text through the automaton, calling fn with each word found
+// until it returns false.
+func (m *Matcher) scan(text string, fn func(word int32, start, end int) bool) {
+	if len(m.words) == 0 {
+		return
+	}
+	// starts are the byte offsets of the runes matched so far, skipped
+	// ones left out, to find where a word starts from its length.
+	var starts []int
+	cur := int32(0)
+	for i, c := range text {
+		r, ok := fold(c)
+		if !ok {
+			continue
+		}
+		starts = append(starts, i)
+		for cur != 0 && !m.has(cur, r) {
+			cur = m.nodes[cur].fail
+		}
+		cur = m.nodes[cur].next[r]
+		end := i + utf8.RuneLen(c)
+		for _, w := range m.nodes[cur].words {
+			if !fn(w, starts[len(starts)-m.lengths[w]], end) {
+				return
+			}
+		}
+	}
+}
+
+// Mask replaces the runes of text within matches with mask, leaving the
+// spaces, punctuation and symbols between them.
+func Mask(text string, matches []Match, mask rune) string {
+	if len(matches) == 0 {
+		return text
+	}
+	sorted := append([]Match(nil), matches...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start < sorted[j].Start })
+	// Overlapping matches are merged into spans apart.
+	spans := sorted[:1]
+	for _, m := range sorted[1:] {
+		last := &spans[len(spans)-1]
+		if m.Start < last.End {
+			last.End = max(last.End, m.End)
+			continue
+		}
+		spans = append(spans, m)
+	}
+	var b strings.Builder
+	b.Grow(len(text))
+	s := 0
+	for i, r := range text {
+		for s < len(spans) && spans[s].End <= i {
+			s++
+		}
+		if _, ok := fold(r); ok && s < len(spans) && spans[s].Start <= i {
+			b.WriteRune(mask)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// normalize folds the runes of a word and drops those skipped.
+func normalize(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r, ok := fold(r); ok {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// fold maps full-width forms to ASCII and lower-cases a rune, and reports
+// whether it counts: letters and digits do, the rest is skipped.
+func fold(r rune) (rune, bool) {
+	if r >= 0xFF01 && r <= 0xFF5E {
+		r -= 0xFEE0
+	}
+	if !unicode.IsLetter(r) && !unicode.IsNumber(r) {
+		return r, false
+	}
+	return unicode.ToLower(r), true
+}
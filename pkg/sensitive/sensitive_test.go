@@ -0,0 +1,95 @@
+package sensitive
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFind(t *testing.T) {
+	tests := []struct {
+		name  string
+		words []string
+		text  string
+		want  []Match
+	}{
+		{"overlapping", []string{"he", "she", "his", "hers"}, "ushers",
+			[]Match{{"she", 1, 4}, {"he", 2, 4}, {"hers", 2, 6}}},
+		{"nested", []string{"ab", "abcd", "bc"}, "xabcd",
+			[]Match{{"ab", 1, 3}, {"bc", 2, 4}, {"abcd", 1, 5}}},
+		{"repeated", []string{"aa"}, "aaa",
+			[]Match{{"aa", 0, 2}, {"aa", 1, 3}}},
+		{"case and full width", []string{"Fuck"}, "Ｆ.u c-K",
+			[]Match{{"Fuck", 0, 9}}},
+		{"multi-byte", []string{"傻瓜"}, "你是傻 瓜吗",
+			[]Match{{"傻瓜", 6, 13}}},
+		{"full-width punctuation between", []string{"傻瓜"}, "傻，瓜",
+			[]Match{{"傻瓜", 0, 9}}},
+		{"failure to a shorter word", []string{"abcx", "bcd"}, "abcd",
+			[]Match{{"bcd", 1, 4}}},
+		{"spaced out", []string{"bad"}, "b a d-ly good", []Match{{"bad", 0, 5}}},
+		{"no words", nil, "anything", nil},
+		{"empty text", []string{"bad"}, "", nil},
+	}
+	for _, tt := range tests {
+		if got := New(tt.words).Find(tt.text); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Find(%q) = %v, want %v", tt.name, tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	m := New([]string{"", "  ", "!!", " Bad ", "bad", "ＢＡＤ", "b-a-d", "worse"})
+	if m.Len() != 2 {
+		t.Errorf("%d words, want 2", m.Len())
+	}
+	if got, want := m.Find("BAD"), []Match{{"Bad", 0, 3}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Find(BAD) = %v, want the word as first given, trimmed", got)
+	}
+}
+
+func TestContains(t *testing.T) {
+	m := New([]string{"spam", "垃圾"})
+	tests := []struct {
+		text string
+		want bool
+	}{
+		{"no S.P.A.M here", true},
+		{"垃 圾 广告", true},
+		{"spa m", true},
+		{"sp", false},
+		{"垃", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := m.Contains(tt.text); got != tt.want {
+			t.Errorf("Contains(%q) = %t, want %t", tt.text, got, tt.want)
+		}
+	}
+	if New(nil).Contains("spam") {
+		t.Error("a matcher of no words found one")
+	}
+}
+
+func TestMask(t *testing.T) {
+	tests := []struct {
+		name  string
+		words []string
+		text  string
+		want  string
+	}{
+		{"overlapping merged", []string{"he", "she", "hers"}, "ushers!", "u*****!"},
+		{"nested", []string{"ab", "abcd", "bc"}, "xabcdy", "x****y"},
+		{"apart", []string{"ab", "cd"}, "ab-cd", "**-**"},
+		{"separators kept", []string{"fuck"}, "oh Ｆ.u c-k!", "oh *.* *-*!"},
+		{"multi-byte", []string{"傻瓜"}, "你是傻 瓜吗", "你是* *吗"},
+		{"full-width punctuation kept", []string{"傻瓜"}, "傻，瓜！", "*，*！"},
+		{"mixed widths", []string{"坏蛋", "bad"}, "坏蛋bad坏", "*****坏"},
+		{"nothing found", []string{"bad"}, "good", "good"},
+	}
+	for _, tt := range tests {
+		m := New(tt.words)
+		if got := Mask(tt.text, m.Find(tt.text), '*'); got != tt.want {
+			t.Errorf("%s: Mask(%q) = %q, want %q", tt.name, tt.text, got, tt.want)
+		}
+	}
+}
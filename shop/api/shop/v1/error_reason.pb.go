@@ -0,0 +1,319 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.3
+// source: shop/v1/error_reason.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ErrorReason int32
+
+const (
+	ErrorReason_SHOP_UNSPECIFIED                   ErrorReason = 0
+	ErrorReason_INSUFFICIENT_STOCK                 ErrorReason = 1
+	ErrorReason_RESERVATION_NOT_FOUND              ErrorReason = 2
+	ErrorReason_RESERVATION_CONFIRMED              ErrorReason = 3
+	ErrorReason_CATEGORY_NOT_FOUND                 ErrorReason = 4
+	ErrorReason_INVALID_CATEGORY                   ErrorReason = 5
+	ErrorReason_CATEGORY_IN_USE                    ErrorReason = 6
+	ErrorReason_SPU_NOT_FOUND                      ErrorReason = 7
+	ErrorReason_INVALID_SPU                        ErrorReason = 8
+	ErrorReason_SPU_VERSION_CONFLICT               ErrorReason = 9
+	ErrorReason_SKU_NOT_FOUND                      ErrorReason = 10
+	ErrorReason_INVALID_SKU                        ErrorReason = 11
+	ErrorReason_RESERVATION_RELEASED               ErrorReason = 12
+	ErrorReason_INVALID_RESERVATION                ErrorReason = 13
+	ErrorReason_FLASH_SALE_NOT_FOUND               ErrorReason = 14
+	ErrorReason_INVALID_FLASH_SALE                 ErrorReason = 15
+	ErrorReason_FLASH_SALE_STARTED                 ErrorReason = 16
+	ErrorReason_FLASH_SALE_VERSION_CONFLICT        ErrorReason = 17
+	ErrorReason_INVALID_SEARCH                     ErrorReason = 18
+	ErrorReason_REVIEW_NOT_FOUND                   ErrorReason = 19
+	ErrorReason_INVALID_REVIEW                     ErrorReason = 20
+	ErrorReason_REVIEW_NOT_ALLOWED                 ErrorReason = 21
+	ErrorReason_REVIEW_EXISTS                      ErrorReason = 22
+	ErrorReason_REVIEW_REJECTED                    ErrorReason = 23
+	ErrorReason_REVIEW_MODERATED                   ErrorReason = 24
+	ErrorReason_MERCHANT_NOT_FOUND                 ErrorReason = 25
+	ErrorReason_INVALID_MERCHANT                   ErrorReason = 26
+	ErrorReason_MERCHANT_EXISTS                    ErrorReason = 27
+	ErrorReason_MERCHANT_SUSPENDED                 ErrorReason = 28
+	ErrorReason_MERCHANT_VERSION_CONFLICT          ErrorReason = 29
+	ErrorReason_APPLICATION_NOT_FOUND              ErrorReason = 30
+	ErrorReason_INVALID_APPLICATION                ErrorReason = 31
+	ErrorReason_APPLICATION_PENDING                ErrorReason = 32
+	ErrorReason_APPLICATION_REVIEWED               ErrorReason = 33
+	ErrorReason_STAFF_NOT_FOUND                    ErrorReason = 34
+	ErrorReason_STAFF_EXISTS                       ErrorReason = 35
+	ErrorReason_INVALID_STAFF                      ErrorReason = 36
+	ErrorReason_STAFF_PERMISSION_DENIED            ErrorReason = 37
+	ErrorReason_SHIPPING_TEMPLATE_NOT_FOUND        ErrorReason = 38
+	ErrorReason_INVALID_SHIPPING_TEMPLATE          ErrorReason = 39
+	ErrorReason_SHIPPING_TEMPLATE_VERSION_CONFLICT ErrorReason = 40
+	ErrorReason_SHIPPING_TEMPLATE_IN_USE           ErrorReason = 41
+	ErrorReason_REGION_NOT_DELIVERED               ErrorReason = 42
+)
+
+// Enum value maps for ErrorReason.
+var (
+	ErrorReason_name = map[int32]string{
+		0:  "SHOP_UNSPECIFIED",
+		1:  "INSUFFICIENT_STOCK",
+		2:  "RESERVATION_NOT_FOUND",
+		3:  "RESERVATION_CONFIRMED",
+		4:  "CATEGORY_NOT_FOUND",
+		5:  "INVALID_CATEGORY",
+		6:  "CATEGORY_IN_USE",
+		7:  "SPU_NOT_FOUND",
+		8:  "INVALID_SPU",
+		9:  "SPU_VERSION_CONFLICT",
+		10: "SKU_NOT_FOUND",
+		11: "INVALID_SKU",
+		12: "RESERVATION_RELEASED",
+		13: "INVALID_RESERVATION",
+		14: "FLASH_SALE_NOT_FOUND",
+		15: "INVALID_FLASH_SALE",
+		16: "FLASH_SALE_STARTED",
+		17: "FLASH_SALE_VERSION_CONFLICT",
+		18: "INVALID_SEARCH",
+		19: "REVIEW_NOT_FOUND",
+		20: "INVALID_REVIEW",
+		21: "REVIEW_NOT_ALLOWED",
+		22: "REVIEW_EXISTS",
+		23: "REVIEW_REJECTED",
+		24: "REVIEW_MODERATED",
+		25: "MERCHANT_NOT_FOUND",
+		26: "INVALID_MERCHANT",
+		27: "MERCHANT_EXISTS",
+		28: "MERCHANT_SUSPENDED",
+		29: "MERCHANT_VERSION_CONFLICT",
+		30: "APPLICATION_NOT_FOUND",
+		31: "INVALID_APPLICATION",
+		32: "APPLICATION_PENDING",
+		33: "APPLICATION_REVIEWED",
+		34: "STAFF_NOT_FOUND",
+		35: "STAFF_EXISTS",
+		36: "INVALID_STAFF",
+		37: "STAFF_PERMISSION_DENIED",
+		38: "SHIPPING_TEMPLATE_NOT_FOUND",
+		39: "INVALID_SHIPPING_TEMPLATE",
+		40: "SHIPPING_TEMPLATE_VERSION_CONFLICT",
+		41: "SHIPPING_TEMPLATE_IN_USE",
+		42: "REGION_NOT_DELIVERED",
+	}
+	ErrorReason_value = map[string]int32{
+		"SHOP_UNSPECIFIED":                   0,
+		"INSUFFICIENT_STOCK":                 1,
+		"RESERVATION_NOT_FOUND":              2,
+		"RESERVATION_CONFIRMED":              3,
+		"CATEGORY_NOT_FOUND":                 4,
+		"INVALID_CATEGORY":                   5,
+		"CATEGORY_IN_USE":                    6,
+		"SPU_NOT_FOUND":                      7,
+		"INVALID_SPU":                        8,
+		"SPU_VERSION_CONFLICT":               9,
+		"SKU_NOT_FOUND":                      10,
+		"INVALID_SKU":                        11,
+		"RESERVATION_RELEASED":               12,
+		"INVALID_RESERVATION":                13,
+		"FLASH_SALE_NOT_FOUND":               14,
+		"INVALID_FLASH_SALE":                 15,
+		"FLASH_SALE_STARTED":                 16,
+		"FLASH_SALE_VERSION_CONFLICT":        17,
+		"INVALID_SEARCH":                     18,
+		"REVIEW_NOT_FOUND":                   19,
+		"INVALID_REVIEW":                     20,
+		"REVIEW_NOT_ALLOWED":                 21,
+		"REVIEW_EXISTS":                      22,
+		"REVIEW_REJECTED":                    23,
+		"REVIEW_MODERATED":                   24,
+		"MERCHANT_NOT_FOUND":                 25,
+		"INVALID_MERCHANT":                   26,
+		"MERCHANT_EXISTS":                    27,
+		"MERCHANT_SUSPENDED":                 28,
+		"MERCHANT_VERSION_CONFLICT":          29,
+		"APPLICATION_NOT_FOUND":              30,
+		"INVALID_APPLICATION":                31,
+		"APPLICATION_PENDING":                32,
+		"APPLICATION_REVIEWED":               33,
+		"STAFF_NOT_FOUND":                    34,
+		"STAFF_EXISTS":                       35,
+		"INVALID_STAFF":                      36,
+		"STAFF_PERMISSION_DENIED":            37,
+		"SHIPPING_TEMPLATE_NOT_FOUND":        38,
+		"INVALID_SHIPPING_TEMPLATE":          39,
+		"SHIPPING_TEMPLATE_VERSION_CONFLICT": 40,
+		"SHIPPING_TEMPLATE_IN_USE":           41,
+		"REGION_NOT_DELIVERED":               42,
+	}
+)
+
+func (x ErrorReason) Enum() *ErrorReason {
+	p := new(ErrorReason)
+	*p = x
+	return p
+}
+
+func (x ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_shop_v1_error_reason_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorReason) Type() protoreflect.EnumType {
+	return &file_shop_v1_error_reason_proto_enumTypes[0]
+}
+
+func (x ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorReason.Descriptor instead.
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_shop_v1_error_reason_proto_rawDescGZIP(), []int{0}
+}
+
+var File_shop_v1_error_reason_proto protoreflect.FileDescriptor
+
+var file_shop_v1_error_reason_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x73, 0x68, 0x6f, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x73, 0x68,
+	0x6f, 0x70, 0x2e, 0x76, 0x31, 0x2a, 0xa0, 0x08, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x48, 0x4f, 0x50, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x49,
+	0x4e, 0x53, 0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x4f, 0x43,
+	0x4b, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x19,
+	0x0a, 0x15, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f,
+	0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x41, 0x54,
+	0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0x04, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x41, 0x54,
+	0x45, 0x47, 0x4f, 0x52, 0x59, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x41, 0x54, 0x45, 0x47,
+	0x4f, 0x52, 0x59, 0x5f, 0x49, 0x4e, 0x5f, 0x55, 0x53, 0x45, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d,
+	0x53, 0x50, 0x55, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x07, 0x12,
+	0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x50, 0x55, 0x10, 0x08,
+	0x12, 0x18, 0x0a, 0x14, 0x53, 0x50, 0x55, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x09, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x4b,
+	0x55, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x0a, 0x12, 0x0f, 0x0a,
+	0x0b, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x4b, 0x55, 0x10, 0x0b, 0x12, 0x18,
+	0x0a, 0x14, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45,
+	0x4c, 0x45, 0x41, 0x53, 0x45, 0x44, 0x10, 0x0c, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x0d, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x4c, 0x41, 0x53, 0x48, 0x5f, 0x53, 0x41, 0x4c, 0x45, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x0e, 0x12, 0x16, 0x0a, 0x12, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x46, 0x4c, 0x41, 0x53, 0x48, 0x5f, 0x53, 0x41, 0x4c,
+	0x45, 0x10, 0x0f, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x4c, 0x41, 0x53, 0x48, 0x5f, 0x53, 0x41, 0x4c,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x10, 0x12, 0x1f, 0x0a, 0x1b, 0x46,
+	0x4c, 0x41, 0x53, 0x48, 0x5f, 0x53, 0x41, 0x4c, 0x45, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x11, 0x12, 0x12, 0x0a, 0x0e,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x10, 0x12,
+	0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x13, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x5f, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x10, 0x14, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45,
+	0x56, 0x49, 0x45, 0x57, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44,
+	0x10, 0x15, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x45, 0x58, 0x49,
+	0x53, 0x54, 0x53, 0x10, 0x16, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f,
+	0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x17, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45,
+	0x56, 0x49, 0x45, 0x57, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x45, 0x44, 0x10, 0x18,
+	0x12, 0x16, 0x0a, 0x12, 0x4d, 0x45, 0x52, 0x43, 0x48, 0x41, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x19, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x4d, 0x45, 0x52, 0x43, 0x48, 0x41, 0x4e, 0x54, 0x10, 0x1a, 0x12, 0x13,
+	0x0a, 0x0f, 0x4d, 0x45, 0x52, 0x43, 0x48, 0x41, 0x4e, 0x54, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54,
+	0x53, 0x10, 0x1b, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x45, 0x52, 0x43, 0x48, 0x41, 0x4e, 0x54, 0x5f,
+	0x53, 0x55, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x1c, 0x12, 0x1d, 0x0a, 0x19, 0x4d,
+	0x45, 0x52, 0x43, 0x48, 0x41, 0x4e, 0x54, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x1d, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x50,
+	0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
+	0x55, 0x4e, 0x44, 0x10, 0x1e, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x1f, 0x12, 0x17,
+	0x0a, 0x13, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x45,
+	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x20, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x50, 0x50, 0x4c, 0x49,
+	0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x45, 0x44, 0x10,
+	0x21, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x46, 0x46, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x22, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54, 0x41, 0x46, 0x46, 0x5f,
+	0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x23, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x46, 0x46, 0x10, 0x24, 0x12, 0x1b, 0x0a, 0x17, 0x53,
+	0x54, 0x41, 0x46, 0x46, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x25, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x48, 0x49, 0x50,
+	0x50, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x4c, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x26, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x48, 0x49, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x45,
+	0x4d, 0x50, 0x4c, 0x41, 0x54, 0x45, 0x10, 0x27, 0x12, 0x26, 0x0a, 0x22, 0x53, 0x48, 0x49, 0x50,
+	0x50, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x4c, 0x41, 0x54, 0x45, 0x5f, 0x56, 0x45,
+	0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x28,
+	0x12, 0x1c, 0x0a, 0x18, 0x53, 0x48, 0x49, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x45, 0x4d,
+	0x50, 0x4c, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x55, 0x53, 0x45, 0x10, 0x29, 0x12, 0x18,
+	0x0a, 0x14, 0x52, 0x45, 0x47, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x44, 0x45, 0x4c,
+	0x49, 0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x2a, 0x42, 0x4f, 0x0a, 0x07, 0x73, 0x68, 0x6f, 0x70,
+	0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x67, 0x6f, 0x2d, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x6b, 0x72, 0x61, 0x74,
+	0x6f, 0x73, 0x2d, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x2f, 0x73, 0x68, 0x6f, 0x70, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x73, 0x68, 0x6f, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0xa2, 0x02, 0x09,
+	0x41, 0x50, 0x49, 0x53, 0x68, 0x6f, 0x70, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_shop_v1_error_reason_proto_rawDescOnce sync.Once
+	file_shop_v1_error_reason_proto_rawDescData = file_shop_v1_error_reason_proto_rawDesc
+)
+
+func file_shop_v1_error_reason_proto_rawDescGZIP() []byte {
+	file_shop_v1_error_reason_proto_rawDescOnce.Do(func() {
+		file_shop_v1_error_reason_proto_rawDescData = protoimpl.X.CompressGZIP(file_shop_v1_error_reason_proto_rawDescData)
+	})
+	return file_shop_v1_error_reason_proto_rawDescData
+}
+
+var file_shop_v1_error_reason_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_shop_v1_error_reason_proto_goTypes = []interface{}{
+	(ErrorReason)(0), // 0: shop.v1.ErrorReason
+}
+var file_shop_v1_error_reason_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_shop_v1_error_reason_proto_init() }
+func file_shop_v1_error_reason_proto_init() {
+	if File_shop_v1_error_reason_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_shop_v1_error_reason_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_shop_v1_error_reason_proto_goTypes,
+		DependencyIndexes: file_shop_v1_error_reason_proto_depIdxs,
+		EnumInfos:         file_shop_v1_error_reason_proto_enumTypes,
+	}.Build()
+	File_shop_v1_error_reason_proto = out.File
+	file_shop_v1_error_reason_proto_rawDesc = nil
+	file_shop_v1_error_reason_proto_goTypes = nil
+	file_shop_v1_error_reason_proto_depIdxs = nil
+}
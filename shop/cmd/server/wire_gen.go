@@ -13,6 +13,7 @@ import (
 	"github.com/go-kratos/kratos-layout/internal/server"
 	"github.com/go-kratos/kratos-layout/internal/service"
 	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/config"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
@@ -23,7 +24,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, shop *conf.Shop, configConfig config.Config, logger log.Logger) (*kratos.App, func(), error) {
 	dataData, cleanup, err := data.NewData(confData, logger)
 	if err != nil {
 		return nil, nil, err
@@ -31,10 +32,74 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 	greeterRepo := data.NewGreeterRepo(dataData, logger)
 	greeterUsecase := biz.NewGreeterUsecase(greeterRepo, logger)
 	greeterService := service.NewGreeterService(greeterUsecase)
-	grpcServer := server.NewGRPCServer(confServer, greeterService, logger)
-	httpServer := server.NewHTTPServer(confServer, greeterService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	merchantRepo := data.NewMerchantRepo(dataData, logger)
+	categoryRepo := data.NewCategoryRepo(dataData, logger)
+	spuRepo := data.NewSpuRepo(dataData, logger)
+	skuRepo := data.NewSkuRepo(dataData, logger)
+	shippingTemplateRepo := data.NewShippingTemplateRepo(dataData, logger)
+	inventoryRepo, err := data.NewInventoryRepo(confData, dataData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	catalogEventRepo := data.NewCatalogEventRepo(dataData, logger)
+	transaction := data.NewTransaction(dataData)
+	catalogUsecase := biz.NewCatalogUsecase(merchantRepo, categoryRepo, spuRepo, skuRepo, shippingTemplateRepo, inventoryRepo, catalogEventRepo, transaction, logger)
+	catalogService := service.NewCatalogService(catalogUsecase)
+	catalogManagementService := service.NewCatalogManagementService(catalogUsecase)
+	inventoryUsecase := biz.NewInventoryUsecase(inventoryRepo, logger)
+	inventoryService := service.NewInventoryService(inventoryUsecase)
+	flashSaleRepo := data.NewFlashSaleRepo(dataData, logger)
+	flashSalePolicy := data.NewFlashSalePolicy(shop)
+	flashSaleUsecase := biz.NewFlashSaleUsecase(flashSaleRepo, catalogUsecase, inventoryRepo, flashSalePolicy, logger)
+	flashSaleService := service.NewFlashSaleService(flashSaleUsecase)
+	flashSaleManagementService := service.NewFlashSaleManagementService(flashSaleUsecase)
+	searchIndex, cleanup2, err := data.NewSearchIndex(shop, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	searchPolicy := data.NewSearchPolicy(shop)
+	searchUsecase := biz.NewSearchUsecase(searchIndex, catalogEventRepo, spuRepo, categoryRepo, searchPolicy, logger)
+	searchService := service.NewSearchService(searchUsecase)
+	reviewRepo := data.NewReviewRepo(dataData, logger)
+	ratingStatsRepo := data.NewRatingStatsRepo(dataData, logger)
+	orderClient, cleanup3, err := data.NewOrderClient(confData)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	orderRepo := data.NewOrderRepo(orderClient, logger)
+	sensitiveWordSource, err := data.NewSensitiveWordSource(configConfig, logger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	moderator := biz.NewModerator(sensitiveWordSource, logger)
+	reviewUsecase := biz.NewReviewUsecase(reviewRepo, ratingStatsRepo, orderRepo, catalogUsecase, moderator, transaction, logger)
+	reviewService := service.NewReviewService(reviewUsecase)
+	reviewModerationService := service.NewReviewModerationService(reviewUsecase)
+	merchantApplicationRepo := data.NewMerchantApplicationRepo(dataData, logger)
+	staffRepo := data.NewStaffRepo(dataData, logger)
+	merchantUsecase := biz.NewMerchantUsecase(merchantApplicationRepo, merchantRepo, staffRepo, catalogUsecase, transaction, logger)
+	merchantOnboardingService := service.NewMerchantOnboardingService(merchantUsecase)
+	merchantService := service.NewMerchantService(merchantUsecase)
+	merchantManagementService := service.NewMerchantManagementService(merchantUsecase)
+	merchantAdminService := service.NewMerchantAdminService(merchantUsecase)
+	shippingUsecase := biz.NewShippingUsecase(shippingTemplateRepo, spuRepo, skuRepo, transaction, logger)
+	shippingService := service.NewShippingService(shippingUsecase)
+	freightService := service.NewFreightService(shippingUsecase)
+	grpcServer := server.NewGRPCServer(confServer, greeterService, catalogService, catalogManagementService, inventoryService, flashSaleService, flashSaleManagementService, searchService, reviewService, reviewModerationService, merchantOnboardingService, merchantService, merchantManagementService, merchantAdminService, shippingService, freightService, logger)
+	httpServer := server.NewHTTPServer(confServer, greeterService, catalogService, catalogManagementService, inventoryService, flashSaleService, flashSaleManagementService, searchService, reviewService, reviewModerationService, merchantOnboardingService, merchantService, merchantManagementService, merchantAdminService, shippingService, freightService, logger)
+	inventoryServer := server.NewInventoryServer(inventoryUsecase, flashSaleUsecase, confData, shop, logger)
+	searchServer := server.NewSearchServer(searchUsecase, shop, logger)
+	app := newApp(logger, grpcServer, httpServer, inventoryServer, searchServer)
 	return app, func() {
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -0,0 +1,805 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	v1 "github.com/go-kratos/kratos-layout/shop/api/shop/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrSpuNotFound is spu not found, also for an SPU of another merchant.
+	ErrSpuNotFound = errors.NotFound(v1.ErrorReason_SPU_NOT_FOUND.String(), "spu not found")
+	// ErrInvalidSpu is returned for an SPU described wrong, out of a leaf
+	// category, or that cannot be listed, delisted or deleted as asked.
+	ErrInvalidSpu = errors.BadRequest(v1.ErrorReason_INVALID_SPU.String(), "invalid spu")
+	// ErrSpuConflict is returned when an SPU changed since it was read.
+	ErrSpuConflict = errors.Conflict(v1.ErrorReason_SPU_VERSION_CONFLICT.String(), "spu changed concurrently")
+	// ErrSkuNotFound is sku not found, also for a SKU of another merchant.
+	ErrSkuNotFound = errors.NotFound(v1.ErrorReason_SKU_NOT_FOUND.String(), "sku not found")
+	// ErrInvalidSku is returned for SKUs priced wrong, sale attributes that
+	// make no sense, or the last SKU enabled of a listed SPU disabled.
+	ErrInvalidSku = errors.BadRequest(v1.ErrorReason_INVALID_SKU.String(), "invalid sku")
+)
+
+const (
+	maxSpuImages      = 9
+	maxAttributes     = 50
+	maxSaleAttributes = 3
+	maxSaleValues     = 20
+	maxSkusPerSpu     = 200
+	// maxNameLength is the most runes of an attribute name or value.
+	maxNameLength  = 32
+	maxTitleLength = 100
+	maxBrandLength = 64
+	maxBatchSkus   = 200
+)
+
+// SpuStatus is the listing status of an SPU.
+type SpuStatus string
+
+const (
+	// SpuDraft is an SPU never listed.
+	SpuDraft SpuStatus = "draft"
+	// SpuListed is an SPU buyers see, its SKUs enabled on sale.
+	SpuListed SpuStatus = "listed"
+	// SpuDelisted is an SPU taken off sale.
+	SpuDelisted SpuStatus = "delisted"
+)
+
+// Attribute is a descriptive attribute of an SPU, or the value a SKU takes
+// for a sale attribute.
+type Attribute struct {
+	Name  string
+	Value string
+}
+
+// SaleAttribute is an attribute SKUs of an SPU differ by, such as colour or
+// size, with the values they take.
+type SaleAttribute struct {
+	Name   string
+	Values []string
+}
+
+// Spu is a standard product unit, what buyers see as a product.
+type Spu struct {
+	ID          int64
+	MerchantID  int64
+	CategoryID  int64
+	Title       string
+	SubTitle    string
+	Brand       string
+	Description string
+	Images      []string
+	Attributes  []Attribute
+	// SaleAttributes are set as SKUs are generated.
+	SaleAttributes []SaleAttribute
+	// ShippingTemplateID is the template the SPU ships with, zero for the
+	// default template of its merchant.
+	ShippingTemplateID int64
+	Status             SpuStatus
+	// MinPrice and MaxPrice are those of the SKUs enabled, kept on the SPU
+	// for lists to be sorted by price.
+	MinPrice int64
+	MaxPrice int64
+	// Sales is the quantity of its SKUs sold, counted as the stock of
+	// reservations is confirmed.
+	Sales     int64
+	Skus      []*Sku
+	Version   int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (s *Spu) validate() error {
+	if s.MerchantID <= 0 || s.Title == "" || utf8.RuneCountInString(s.Title) > maxTitleLength ||
+		utf8.RuneCountInString(s.Brand) > maxBrandLength ||
+		len(s.Images) > maxSpuImages || len(s.Attributes) > maxAttributes {
+		return ErrInvalidSpu
+	}
+	for _, a := range s.Attributes {
+		if a.Name == "" || a.Value == "" {
+			return ErrInvalidSpu
+		}
+	}
+	return nil
+}
+
+// reprice sets the prices of an SPU from those of its SKUs enabled.
+func (s *Spu) reprice(skus []*Sku) {
+	s.MinPrice, s.MaxPrice = 0, 0
+	for _, k := range skus {
+		if !k.Enabled {
+			continue
+		}
+		if s.MinPrice == 0 || k.Price < s.MinPrice {
+			s.MinPrice = k.Price
+		}
+		if k.Price > s.MaxPrice {
+			s.MaxPrice = k.Price
+		}
+	}
+}
+
+// Sku is a stock keeping unit, an SPU in a combination of the values of its
+// sale attributes.
+type Sku struct {
+	ID         int64
+	SpuID      int64
+	MerchantID int64
+	// SaleAttributes are the value of each sale attribute of the SPU.
+	SaleAttributes []Attribute
+	// Title is that of the SPU followed by the values of the SKU, set as
+	// the SKU is read.
+	Title string
+	Image string
+	Code  string
+	Price int64
+	// Weight is the weight in grams, for the freight charged by weight.
+	Weight int64
+	// Stock is the stock left to sell. It is set as SKUs are generated,
+	// then kept by the inventory.
+	Stock int64
+	// Enabled is whether the merchant sells the SKU.
+	Enabled bool
+	// OnSale is whether the SKU is enabled and its SPU listed, set as the
+	// SKU is read.
+	OnSale    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Key identifies the combination of a SKU among those of its SPU, whatever
+// the order of its sale attributes.
+func (k *Sku) Key() string {
+	return skuKey(k.SaleAttributes)
+}
+
+func skuKey(attrs []Attribute) string {
+	parts := make([]string, 0, len(attrs))
+	for _, a := range attrs {
+		parts = append(parts, a.Name+"="+a.Value)
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ";")
+}
+
+// describe sets what a SKU read shows from its SPU.
+func (k *Sku) describe(s *Spu) {
+	values := make([]string, 0, len(k.SaleAttributes))
+	for _, a := range k.SaleAttributes {
+		values = append(values, a.Value)
+	}
+	k.Title = strings.TrimSpace(s.Title + " " + strings.Join(values, " "))
+	if k.Image == "" && len(s.Images) > 0 {
+		k.Image = s.Images[0]
+	}
+	k.OnSale = k.Enabled && s.Status == SpuListed
+}
+
+// SpuSort is the order SPUs are listed in.
+type SpuSort string
+
+const (
+	// SortNewest lists the newest SPUs first.
+	SortNewest SpuSort = ""
+	// SortPriceAsc lists the cheapest SPUs first.
+	SortPriceAsc SpuSort = "price_asc"
+	// SortPriceDesc lists the dearest SPUs first.
+	SortPriceDesc SpuSort = "price_desc"
+)
+
+// SpuFilter filters SPUs, on the fields set.
+type SpuFilter struct {
+	MerchantID  int64
+	CategoryIDs []int64
+	Status      SpuStatus
+	Sort        SpuSort
+}
+
+// SpuRepo is an SPU repo. SPUs are read without their SKUs.
+type SpuRepo interface {
+	Save(ctx context.Context, s *Spu) (*Spu, error)
+	// Update saves an SPU at its version and bumps it, or returns
+	// ErrSpuConflict.
+	Update(ctx context.Context, s *Spu) error
+	Delete(ctx context.Context, id int64) error
+	Find(ctx context.Context, id int64) (*Spu, error)
+	FindByIDs(ctx context.Context, ids []int64) (map[int64]*Spu, error)
+	List(ctx context.Context, filter *SpuFilter, page, pageSize int) ([]*Spu, int64, error)
+	CountByCategory(ctx context.Context, categoryID int64) (int64, error)
+	CountByShippingTemplate(ctx context.Context, templateID int64) (int64, error)
+	// DelistByMerchant delists the SPUs listed of a merchant, bumping their
+	// versions, and returns their ids.
+	DelistByMerchant(ctx context.Context, merchantID int64) ([]int64, error)
+}
+
+// SkuRepo is a SKU repo.
+type SkuRepo interface {
+	SaveAll(ctx context.Context, skus []*Sku) error
+	Update(ctx context.Context, k *Sku) error
+	Find(ctx context.Context, id int64) (*Sku, error)
+	FindByIDs(ctx context.Context, ids []int64) ([]*Sku, error)
+	ListBySpu(ctx context.Context, spuID int64) ([]*Sku, error)
+	DeleteBySpu(ctx context.Context, spuID int64) error
+}
+
+// CatalogUsecase is a catalog usecase. The platform manages the category
+// tree, merchants their SPUs and SKUs, and buyers read what is listed. Each
+// change of an SPU is recorded as a catalog event for the search index.
+// Merchants suspended list nothing.
+type CatalogUsecase struct {
+	merchants  MerchantRepo
+	categories CategoryRepo
+	spus       SpuRepo
+	skus       SkuRepo
+	templates  ShippingTemplateRepo
+	inventory  InventoryRepo
+	events     CatalogEventRepo
+	tx         Transaction
+	log        *log.Helper
+}
+
+// NewCatalogUsecase new a catalog usecase.
+func NewCatalogUsecase(merchants MerchantRepo, categories CategoryRepo, spus SpuRepo, skus SkuRepo, templates ShippingTemplateRepo, inventory InventoryRepo, events CatalogEventRepo, tx Transaction, logger log.Logger) *CatalogUsecase {
+	return &CatalogUsecase{
+		merchants:  merchants,
+		categories: categories,
+		spus:       spus,
+		skus:       skus,
+		templates:  templates,
+		inventory:  inventory,
+		events:     events,
+		tx:         tx,
+		log:        log.NewHelper(logger),
+	}
+}
+
+// CreateSpu creates a draft SPU of an active merchant in a leaf category,
+// shipping with a template of the merchant when one is given.
+func (uc *CatalogUsecase) CreateSpu(ctx context.Context, s *Spu) (*Spu, error) {
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+	if err := uc.checkMerchant(ctx, s.MerchantID); err != nil {
+		return nil, err
+	}
+	if err := uc.checkLeaf(ctx, s.CategoryID); err != nil {
+		return nil, err
+	}
+	if err := uc.checkTemplate(ctx, s.MerchantID, s.ShippingTemplateID); err != nil {
+		return nil, err
+	}
+	s.Status = SpuDraft
+	s.SaleAttributes = nil
+	s, err := uc.spus.Save(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("CreateSpu: %d of merchant %d", s.ID, s.MerchantID)
+	return s, nil
+}
+
+// UpdateSpu changes the description and shipping template of an SPU of a
+// merchant at the version it was read.
+func (uc *CatalogUsecase) UpdateSpu(ctx context.Context, s *Spu) (*Spu, error) {
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		old, err := uc.findSpu(ctx, s.MerchantID, s.ID)
+		if err != nil {
+			return err
+		}
+		if old.Version != s.Version {
+			return ErrSpuConflict
+		}
+		if old.CategoryID != s.CategoryID {
+			if err := uc.checkLeaf(ctx, s.CategoryID); err != nil {
+				return err
+			}
+		}
+		if old.ShippingTemplateID != s.ShippingTemplateID {
+			if err := uc.checkTemplate(ctx, s.MerchantID, s.ShippingTemplateID); err != nil {
+				return err
+			}
+		}
+		old.CategoryID, old.ShippingTemplateID = s.CategoryID, s.ShippingTemplateID
+		old.Title, old.SubTitle, old.Brand, old.Description = s.Title, s.SubTitle, s.Brand, s.Description
+		old.Images, old.Attributes = s.Images, s.Attributes
+		if err := uc.spus.Update(ctx, old); err != nil {
+			return err
+		}
+		return uc.events.Add(ctx, old.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uc.GetSpu(ctx, s.MerchantID, s.ID)
+}
+
+// DeleteSpu deletes an SPU of a merchant not listed and its SKUs.
+func (uc *CatalogUsecase) DeleteSpu(ctx context.Context, merchantID, id int64) error {
+	return uc.tx.InTx(ctx, func(ctx context.Context) error {
+		s, err := uc.findSpu(ctx, merchantID, id)
+		if err != nil {
+			return err
+		}
+		if s.Status == SpuListed {
+			return ErrInvalidSpu
+		}
+		if err := uc.skus.DeleteBySpu(ctx, id); err != nil {
+			return err
+		}
+		if err := uc.spus.Delete(ctx, id); err != nil {
+			return err
+		}
+		return uc.events.Add(ctx, id)
+	})
+}
+
+// GetSpu returns an SPU of a merchant with all its SKUs.
+func (uc *CatalogUsecase) GetSpu(ctx context.Context, merchantID, id int64) (*Spu, error) {
+	s, err := uc.findSpu(ctx, merchantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if s.Skus, err = uc.skus.ListBySpu(ctx, id); err != nil {
+		return nil, err
+	}
+	for _, k := range s.Skus {
+		k.describe(s)
+	}
+	if err := uc.stock(ctx, s.Skus); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// ListSpus lists the SPUs of a merchant, in a category and those below it
+// when one is given.
+func (uc *CatalogUsecase) ListSpus(ctx context.Context, merchantID, categoryID int64, status SpuStatus, page, pageSize int) ([]*Spu, int64, error) {
+	filter := &SpuFilter{MerchantID: merchantID, Status: status}
+	if categoryID != 0 {
+		ids, err := uc.subtree(ctx, categoryID)
+		if err != nil {
+			return nil, 0, err
+		}
+		filter.CategoryIDs = ids
+	}
+	page, pageSize = pagination(page, pageSize)
+	return uc.spus.List(ctx, filter, page, pageSize)
+}
+
+// SetSpuListed lists an SPU of an active merchant with a SKU enabled, or
+// delists a listed one.
+func (uc *CatalogUsecase) SetSpuListed(ctx context.Context, merchantID, id int64, listed bool) (*Spu, error) {
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		s, err := uc.findSpu(ctx, merchantID, id)
+		if err != nil {
+			return err
+		}
+		switch {
+		case listed && s.Status == SpuListed, !listed && s.Status != SpuListed:
+			return nil
+		case listed:
+			if err := uc.checkMerchant(ctx, merchantID); err != nil {
+				return err
+			}
+			skus, err := uc.skus.ListBySpu(ctx, id)
+			if err != nil {
+				return err
+			}
+			if !anyEnabled(skus) {
+				return ErrInvalidSpu
+			}
+			s.Status = SpuListed
+		default:
+			s.Status = SpuDelisted
+		}
+		if err := uc.spus.Update(ctx, s); err != nil {
+			return err
+		}
+		return uc.events.Add(ctx, s.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("SetSpuListed: %d %t", id, listed)
+	return uc.GetSpu(ctx, merchantID, id)
+}
+
+// GenerateSkus sets the sale attributes of an SPU of a merchant and
+// generates a SKU for each combination of their values. The SKUs of
+// combinations kept are left as they are, new ones take price, stock and
+// weight, and those of combinations dropped are disabled, not deleted, for
+// the orders that hold them.
+func (uc *CatalogUsecase) GenerateSkus(ctx context.Context, merchantID, spuID int64, attrs []SaleAttribute, price, stock, weight int64) (*Spu, error) {
+	combos, err := combinations(attrs)
+	if err != nil {
+		return nil, err
+	}
+	created := 0
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		s, err := uc.findSpu(ctx, merchantID, spuID)
+		if err != nil {
+			return err
+		}
+		existing, err := uc.skus.ListBySpu(ctx, spuID)
+		if err != nil {
+			return err
+		}
+		byKey := make(map[string]*Sku, len(existing))
+		for _, k := range existing {
+			byKey[k.Key()] = k
+		}
+		var added []*Sku
+		kept := make(map[string]bool, len(combos))
+		for _, c := range combos {
+			key := skuKey(c)
+			kept[key] = true
+			if _, ok := byKey[key]; ok {
+				continue
+			}
+			added = append(added, &Sku{
+				SpuID:          spuID,
+				MerchantID:     merchantID,
+				SaleAttributes: c,
+				Price:          price,
+				Weight:         weight,
+				Stock:          stock,
+				Enabled:        true,
+			})
+		}
+		if len(added) > 0 && (price <= 0 || stock < 0 || weight < 0) {
+			return ErrInvalidSku
+		}
+		for _, k := range existing {
+			if kept[k.Key()] || !k.Enabled {
+				continue
+			}
+			k.Enabled = false
+			if err := uc.skus.Update(ctx, k); err != nil {
+				return err
+			}
+		}
+		if len(added) > 0 {
+			if err := uc.skus.SaveAll(ctx, added); err != nil {
+				return err
+			}
+		}
+		all := append(existing, added...)
+		if s.Status == SpuListed && !anyEnabled(all) {
+			return ErrInvalidSku
+		}
+		s.SaleAttributes = attrs
+		s.reprice(all)
+		created = len(added)
+		if err := uc.spus.Update(ctx, s); err != nil {
+			return err
+		}
+		return uc.events.Add(ctx, s.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("GenerateSkus: %d new SKUs of %d combinations for SPU %d", created, len(combos), spuID)
+	return uc.GetSpu(ctx, merchantID, spuID)
+}
+
+// UpdateSku changes the price, weight, stock, image, code or enabling of a
+// SKU of a merchant. The last SKU enabled of a listed SPU cannot be disabled. The
+// stock is changed by what it takes to reach the stock given from the
+// stock left now, through the inventory.
+func (uc *CatalogUsecase) UpdateSku(ctx context.Context, k *Sku) (*Sku, error) {
+	if k.Price <= 0 || k.Weight < 0 || k.Stock < 0 {
+		return nil, ErrInvalidSku
+	}
+	var rv *Sku
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		old, err := uc.skus.Find(ctx, k.ID)
+		if err != nil {
+			return err
+		}
+		if old.MerchantID != k.MerchantID {
+			return ErrSkuNotFound
+		}
+		s, err := uc.spus.Find(ctx, old.SpuID)
+		if err != nil {
+			return err
+		}
+		old.Price, old.Weight, old.Image, old.Code, old.Enabled = k.Price, k.Weight, k.Image, k.Code, k.Enabled
+		if err := uc.skus.Update(ctx, old); err != nil {
+			return err
+		}
+		skus, err := uc.skus.ListBySpu(ctx, s.ID)
+		if err != nil {
+			return err
+		}
+		if s.Status == SpuListed && !anyEnabled(skus) {
+			return ErrInvalidSku
+		}
+		// Updating the SPU for its prices serializes the changes to its SKUs.
+		s.reprice(skus)
+		if err := uc.spus.Update(ctx, s); err != nil {
+			return err
+		}
+		if err := uc.events.Add(ctx, s.ID); err != nil {
+			return err
+		}
+		old.describe(s)
+		rv = old
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	stocks, err := uc.inventory.Stocks(ctx, []int64{rv.ID})
+	if err != nil {
+		return nil, err
+	}
+	if delta := k.Stock - stocks[rv.ID]; delta != 0 {
+		if err := uc.inventory.Adjust(ctx, rv.ID, delta); err != nil {
+			return nil, err
+		}
+	}
+	rv.Stock = k.Stock
+	return rv, nil
+}
+
+// ListProducts lists the SPUs listed, in a category and those below it
+// when one is given, of a merchant when one is given.
+func (uc *CatalogUsecase) ListProducts(ctx context.Context, categoryID, merchantID int64, sort SpuSort, page, pageSize int) ([]*Spu, int64, error) {
+	filter := &SpuFilter{MerchantID: merchantID, Status: SpuListed, Sort: sort}
+	if categoryID != 0 {
+		ids, err := uc.subtree(ctx, categoryID)
+		if err != nil {
+			return nil, 0, err
+		}
+		filter.CategoryIDs = ids
+	}
+	page, pageSize = pagination(page, pageSize)
+	return uc.spus.List(ctx, filter, page, pageSize)
+}
+
+// GetProduct returns a listed SPU with its SKUs on sale only, and the
+// values of its sale attributes they take.
+func (uc *CatalogUsecase) GetProduct(ctx context.Context, spuID int64) (*Spu, error) {
+	s, err := uc.spus.Find(ctx, spuID)
+	if err != nil {
+		return nil, err
+	}
+	if s.Status != SpuListed {
+		return nil, ErrSpuNotFound
+	}
+	skus, err := uc.skus.ListBySpu(ctx, spuID)
+	if err != nil {
+		return nil, err
+	}
+	used := make(map[Attribute]bool)
+	for _, k := range skus {
+		if !k.Enabled {
+			continue
+		}
+		k.describe(s)
+		s.Skus = append(s.Skus, k)
+		for _, a := range k.SaleAttributes {
+			used[a] = true
+		}
+	}
+	attrs := make([]SaleAttribute, 0, len(s.SaleAttributes))
+	for _, sa := range s.SaleAttributes {
+		a := SaleAttribute{Name: sa.Name}
+		for _, v := range sa.Values {
+			if used[Attribute{Name: sa.Name, Value: v}] {
+				a.Values = append(a.Values, v)
+			}
+		}
+		attrs = append(attrs, a)
+	}
+	s.SaleAttributes = attrs
+	if err := uc.stock(ctx, s.Skus); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// BatchGetSkus returns the SKUs of ids that exist, on sale or not.
+func (uc *CatalogUsecase) BatchGetSkus(ctx context.Context, ids []int64) ([]*Sku, error) {
+	if len(ids) > maxBatchSkus {
+		return nil, ErrInvalidSku
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	skus, err := uc.skus.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	spuIDs := make([]int64, 0, len(skus))
+	for _, k := range skus {
+		spuIDs = append(spuIDs, k.SpuID)
+	}
+	spus, err := uc.spus.FindByIDs(ctx, spuIDs)
+	if err != nil {
+		return nil, err
+	}
+	rv := make([]*Sku, 0, len(skus))
+	for _, k := range skus {
+		// Left by an SPU deleted meanwhile.
+		if s, ok := spus[k.SpuID]; ok {
+			k.describe(s)
+			rv = append(rv, k)
+		}
+	}
+	if err := uc.stock(ctx, rv); err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+// stock sets the stock left of SKUs read from the inventory, the stock
+// kept with them lagging behind its reservations.
+func (uc *CatalogUsecase) stock(ctx context.Context, skus []*Sku) error {
+	if len(skus) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(skus))
+	for _, k := range skus {
+		ids = append(ids, k.ID)
+	}
+	stocks, err := uc.inventory.Stocks(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, k := range skus {
+		if n, ok := stocks[k.ID]; ok {
+			k.Stock = n
+		}
+	}
+	return nil
+}
+
+// findSpu returns an SPU of a merchant.
+func (uc *CatalogUsecase) findSpu(ctx context.Context, merchantID, id int64) (*Spu, error) {
+	s, err := uc.spus.Find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if s.MerchantID != merchantID {
+		return nil, ErrSpuNotFound
+	}
+	return s, nil
+}
+
+// checkMerchant checks a merchant exists and is not suspended, locking it
+// in the transaction carried by ctx so that a suspension waits for an SPU
+// listed there and delists it with the others.
+func (uc *CatalogUsecase) checkMerchant(ctx context.Context, merchantID int64) error {
+	m, err := uc.merchants.Lock(ctx, merchantID)
+	if err != nil {
+		return err
+	}
+	if m.Status == MerchantSuspended {
+		return ErrMerchantSuspended
+	}
+	return nil
+}
+
+// delistMerchant delists the SPUs listed of a merchant, and returns how
+// many, in the transaction carried by ctx.
+func (uc *CatalogUsecase) delistMerchant(ctx context.Context, merchantID int64) (int, error) {
+	ids, err := uc.spus.DelistByMerchant(ctx, merchantID)
+	if err != nil {
+		return 0, err
+	}
+	for _, id := range ids {
+		if err := uc.events.Add(ctx, id); err != nil {
+			return 0, err
+		}
+	}
+	return len(ids), nil
+}
+
+// checkTemplate checks a shipping template is of a merchant, unless zero.
+func (uc *CatalogUsecase) checkTemplate(ctx context.Context, merchantID, templateID int64) error {
+	if templateID == 0 {
+		return nil
+	}
+	t, err := uc.templates.Find(ctx, templateID)
+	if err != nil {
+		return err
+	}
+	if t.MerchantID != merchantID {
+		return ErrShippingTemplateNotFound
+	}
+	return nil
+}
+
+// checkLeaf checks SPUs may be in a category, one without children.
+func (uc *CatalogUsecase) checkLeaf(ctx context.Context, categoryID int64) error {
+	if _, err := uc.categories.Find(ctx, categoryID); err != nil {
+		if errors.Is(err, ErrCategoryNotFound) {
+			return ErrInvalidSpu
+		}
+		return err
+	}
+	n, err := uc.categories.CountChildren(ctx, categoryID)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return ErrInvalidSpu
+	}
+	return nil
+}
+
+func anyEnabled(skus []*Sku) bool {
+	for _, k := range skus {
+		if k.Enabled {
+			return true
+		}
+	}
+	return false
+}
+
+// combinations returns each combination of the values of sale attributes,
+// in the order of the attributes and their values.
+func combinations(attrs []SaleAttribute) ([][]Attribute, error) {
+	if len(attrs) == 0 || len(attrs) > maxSaleAttributes {
+		return nil, ErrInvalidSku
+	}
+	total := 1
+	names := make(map[string]bool, len(attrs))
+	for _, a := range attrs {
+		if !validName(a.Name) || names[a.Name] || len(a.Values) == 0 || len(a.Values) > maxSaleValues {
+			return nil, ErrInvalidSku
+		}
+		names[a.Name] = true
+		values := make(map[string]bool, len(a.Values))
+		for _, v := range a.Values {
+			if !validName(v) || values[v] {
+				return nil, ErrInvalidSku
+			}
+			values[v] = true
+		}
+		if total *= len(a.Values); total > maxSkusPerSpu {
+			return nil, ErrInvalidSku
+		}
+	}
+	combos := [][]Attribute{nil}
+	for _, a := range attrs {
+		next := make([][]Attribute, 0, len(combos)*len(a.Values))
+		for _, c := range combos {
+			for _, v := range a.Values {
+				combo := make([]Attribute, len(c), len(c)+1)
+				copy(combo, c)
+				next = append(next, append(combo, Attribute{Name: a.Name, Value: v}))
+			}
+		}
+		combos = next
+	}
+	return combos, nil
+}
+
+// validName reports whether s may name a sale attribute or a value of one,
+// the separators of SKU keys left out.
+func validName(s string) bool {
+	return s != "" && utf8.RuneCountInString(s) <= maxNameLength && !strings.ContainsAny(s, "=;")
+}
+
+func pagination(page, pageSize int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	return page, pageSize
+}
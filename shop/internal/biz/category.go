@@ -0,0 +1,178 @@
+package biz
+
+import (
+	"context"
+	"sort"
+
+	v1 "github.com/go-kratos/kratos-layout/shop/api/shop/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+var (
+	// ErrCategoryNotFound is category not found.
+	ErrCategoryNotFound = errors.NotFound(v1.ErrorReason_CATEGORY_NOT_FOUND.String(), "category not found")
+	// ErrInvalidCategory is returned for a category without a name, too deep,
+	// or under a category holding SPUs.
+	ErrInvalidCategory = errors.BadRequest(v1.ErrorReason_INVALID_CATEGORY.String(), "invalid category")
+	// ErrCategoryInUse is returned when deleting a category with children or SPUs.
+	ErrCategoryInUse = errors.Conflict(v1.ErrorReason_CATEGORY_IN_USE.String(), "category in use")
+)
+
+// maxCategoryLevel is how deep the category tree goes.
+const maxCategoryLevel = 3
+
+// Category is a category of the catalog, a node of the category tree.
+type Category struct {
+	ID int64
+	// ParentID is 0 at the top.
+	ParentID int64
+	Name     string
+	Sort     int32
+	// Level is 1 at the top.
+	Level    int32
+	Children []*Category
+}
+
+// CategoryRepo is a category repo.
+type CategoryRepo interface {
+	Save(ctx context.Context, c *Category) (*Category, error)
+	Update(ctx context.Context, c *Category) error
+	Delete(ctx context.Context, id int64) error
+	Find(ctx context.Context, id int64) (*Category, error)
+	// ListAll lists every category, without children.
+	ListAll(ctx context.Context) ([]*Category, error)
+	CountChildren(ctx context.Context, id int64) (int64, error)
+}
+
+// CreateCategory adds a category under its parent, or at the top.
+func (uc *CatalogUsecase) CreateCategory(ctx context.Context, c *Category) (*Category, error) {
+	if c.Name == "" {
+		return nil, ErrInvalidCategory
+	}
+	c.Level = 1
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if c.ParentID != 0 {
+			parent, err := uc.categories.Find(ctx, c.ParentID)
+			if err != nil {
+				return err
+			}
+			if parent.Level >= maxCategoryLevel {
+				return ErrInvalidCategory
+			}
+			// SPUs are only in leaf categories.
+			n, err := uc.spus.CountByCategory(ctx, parent.ID)
+			if err != nil {
+				return err
+			}
+			if n > 0 {
+				return ErrInvalidCategory
+			}
+			c.Level = parent.Level + 1
+		}
+		var err error
+		c, err = uc.categories.Save(ctx, c)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("CreateCategory: %d %s under %d", c.ID, c.Name, c.ParentID)
+	return c, nil
+}
+
+// UpdateCategory renames or reorders a category, it stays where it is in
+// the tree.
+func (uc *CatalogUsecase) UpdateCategory(ctx context.Context, c *Category) (*Category, error) {
+	if c.Name == "" {
+		return nil, ErrInvalidCategory
+	}
+	old, err := uc.categories.Find(ctx, c.ID)
+	if err != nil {
+		return nil, err
+	}
+	old.Name, old.Sort = c.Name, c.Sort
+	if err := uc.categories.Update(ctx, old); err != nil {
+		return nil, err
+	}
+	return old, nil
+}
+
+// DeleteCategory deletes a category without children nor SPUs.
+func (uc *CatalogUsecase) DeleteCategory(ctx context.Context, id int64) error {
+	return uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.categories.Find(ctx, id); err != nil {
+			return err
+		}
+		n, err := uc.categories.CountChildren(ctx, id)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			n, err = uc.spus.CountByCategory(ctx, id)
+			if err != nil {
+				return err
+			}
+		}
+		if n > 0 {
+			return ErrCategoryInUse
+		}
+		return uc.categories.Delete(ctx, id)
+	})
+}
+
+// ListCategories returns the category tree, its top categories holding the
+// others, each level sorted.
+func (uc *CatalogUsecase) ListCategories(ctx context.Context) ([]*Category, error) {
+	cs, err := uc.categories.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return buildTree(cs), nil
+}
+
+// buildTree links categories to their parents and returns the top ones.
+// Categories whose parent is missing are left out.
+func buildTree(cs []*Category) []*Category {
+	sort.Slice(cs, func(i, j int) bool {
+		if cs[i].Sort != cs[j].Sort {
+			return cs[i].Sort < cs[j].Sort
+		}
+		return cs[i].ID < cs[j].ID
+	})
+	byID := make(map[int64]*Category, len(cs))
+	for _, c := range cs {
+		byID[c.ID] = c
+	}
+	var roots []*Category
+	for _, c := range cs {
+		if c.ParentID == 0 {
+			roots = append(roots, c)
+		} else if p, ok := byID[c.ParentID]; ok {
+			p.Children = append(p.Children, c)
+		}
+	}
+	return roots
+}
+
+// subtree returns the id of a category and of the categories below it.
+func (uc *CatalogUsecase) subtree(ctx context.Context, id int64) ([]int64, error) {
+	cs, err := uc.categories.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	children := make(map[int64][]int64, len(cs))
+	found := false
+	for _, c := range cs {
+		children[c.ParentID] = append(children[c.ParentID], c.ID)
+		found = found || c.ID == id
+	}
+	if !found {
+		return nil, ErrCategoryNotFound
+	}
+	ids := []int64{id}
+	for i := 0; i < len(ids); i++ {
+		ids = append(ids, children[ids[i]]...)
+	}
+	return ids, nil
+}
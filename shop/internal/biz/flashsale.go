@@ -0,0 +1,276 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/go-kratos/kratos-layout/shop/api/shop/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrFlashSaleNotFound is flash sale not found, also for a flash sale of
+	// another merchant.
+	ErrFlashSaleNotFound = errors.NotFound(v1.ErrorReason_FLASH_SALE_NOT_FOUND.String(), "flash sale not found")
+	// ErrInvalidFlashSale is returned for a flash sale priced or timed wrong,
+	// with more stock than its SKU has, or overlapping another of its SKU.
+	ErrInvalidFlashSale = errors.BadRequest(v1.ErrorReason_INVALID_FLASH_SALE.String(), "invalid flash sale")
+	// ErrFlashSaleStarted is returned when changing or cancelling a flash
+	// sale started or cancelled already.
+	ErrFlashSaleStarted = errors.Conflict(v1.ErrorReason_FLASH_SALE_STARTED.String(), "flash sale started")
+	// ErrFlashSaleConflict is returned when a flash sale changed since it was read.
+	ErrFlashSaleConflict = errors.Conflict(v1.ErrorReason_FLASH_SALE_VERSION_CONFLICT.String(), "flash sale changed concurrently")
+)
+
+const (
+	// maxFlashSaleDuration is how long a flash sale runs at most.
+	maxFlashSaleDuration = 7 * 24 * time.Hour
+	// defaultWarmAhead is how long before a flash sale starts its stock is
+	// warmed when FlashSalePolicy leaves it unset.
+	defaultWarmAhead = 10 * time.Minute
+	// warmBatch is how many flash sales one page of a warm-up reads.
+	warmBatch = 100
+)
+
+// FlashSaleStatus is the status of a flash sale.
+type FlashSaleStatus string
+
+const (
+	// FlashSaleScheduled runs between its start and end.
+	FlashSaleScheduled FlashSaleStatus = "scheduled"
+	// FlashSaleCancelled was cancelled before it started.
+	FlashSaleCancelled FlashSaleStatus = "cancelled"
+)
+
+// FlashSale sells up to Stock of a SKU at Price between StartAt and EndAt,
+// at most PerUserLimit to a buyer. The SKU is described as it was when the
+// flash sale was scheduled.
+type FlashSale struct {
+	ID            int64
+	MerchantID    int64
+	SpuID         int64
+	SkuID         int64
+	Title         string
+	Image         string
+	OriginalPrice int64
+	Price         int64
+	Stock         int64
+	PerUserLimit  int32
+	StartAt       time.Time
+	EndAt         time.Time
+	Status        FlashSaleStatus
+	Version       int64
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// started reports whether f started by now, or can no longer run.
+func (f *FlashSale) started(now time.Time) bool {
+	return f.Status != FlashSaleScheduled || !now.Before(f.StartAt)
+}
+
+// FlashSaleFilter filters flash sales, on the fields set.
+type FlashSaleFilter struct {
+	MerchantID int64
+	Status     FlashSaleStatus
+	// EndsAfter keeps those ending after it.
+	EndsAfter time.Time
+	// StartsBefore keeps those starting before it.
+	StartsBefore time.Time
+}
+
+// FlashSaleRepo is a flash sale repo.
+type FlashSaleRepo interface {
+	Save(ctx context.Context, f *FlashSale) (*FlashSale, error)
+	// Update saves a flash sale at its version and bumps it, or returns
+	// ErrFlashSaleConflict.
+	Update(ctx context.Context, f *FlashSale) error
+	Find(ctx context.Context, id int64) (*FlashSale, error)
+	// List lists flash sales soonest first, or latest first when latest.
+	List(ctx context.Context, filter *FlashSaleFilter, latest bool, page, pageSize int) ([]*FlashSale, int64, error)
+	// Overlaps reports whether a flash sale of a SKU scheduled, other than
+	// exceptID, runs some time between start and end.
+	Overlaps(ctx context.Context, skuID, exceptID int64, start, end time.Time) (bool, error)
+}
+
+// FlashSalePolicy is how flash sales are run.
+type FlashSalePolicy struct {
+	// WarmAhead is how long before a flash sale starts the stock of its SKU
+	// is warmed.
+	WarmAhead time.Duration
+}
+
+// FlashSaleUsecase is a flash sale usecase. Merchants schedule flash sales
+// on their SKUs, the order service admits buyers and places their orders,
+// and the stock of their SKUs is warmed before they start so that the
+// first orders find it loaded.
+type FlashSaleUsecase struct {
+	repo      FlashSaleRepo
+	catalog   *CatalogUsecase
+	inventory InventoryRepo
+	warmAhead time.Duration
+	log       *log.Helper
+}
+
+// NewFlashSaleUsecase new a flash sale usecase.
+func NewFlashSaleUsecase(repo FlashSaleRepo, catalog *CatalogUsecase, inventory InventoryRepo, policy *FlashSalePolicy, logger log.Logger) *FlashSaleUsecase {
+	uc := &FlashSaleUsecase{
+		repo:      repo,
+		catalog:   catalog,
+		inventory: inventory,
+		warmAhead: policy.WarmAhead,
+		log:       log.NewHelper(logger),
+	}
+	if uc.warmAhead <= 0 {
+		uc.warmAhead = defaultWarmAhead
+	}
+	return uc
+}
+
+// CreateFlashSale schedules a flash sale of a SKU of its merchant.
+func (uc *FlashSaleUsecase) CreateFlashSale(ctx context.Context, f *FlashSale) (*FlashSale, error) {
+	skus, err := uc.catalog.BatchGetSkus(ctx, []int64{f.SkuID})
+	if err != nil {
+		return nil, err
+	}
+	if len(skus) == 0 || skus[0].MerchantID != f.MerchantID {
+		return nil, ErrSkuNotFound
+	}
+	k := skus[0]
+	f.SpuID, f.Title, f.Image, f.OriginalPrice = k.SpuID, k.Title, k.Image, k.Price
+	if !k.OnSale {
+		return nil, ErrInvalidFlashSale
+	}
+	if err := uc.validate(ctx, f, k.Stock); err != nil {
+		return nil, err
+	}
+	f.Status = FlashSaleScheduled
+	f, err = uc.repo.Save(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("CreateFlashSale: %d of SKU %d, %d at %d from %s", f.ID, f.SkuID, f.Stock, f.Price, f.StartAt.Format(time.RFC3339))
+	return f, nil
+}
+
+// UpdateFlashSale changes the price, stock, limit and time of a flash sale
+// not started, at the version it was read.
+func (uc *FlashSaleUsecase) UpdateFlashSale(ctx context.Context, f *FlashSale) (*FlashSale, error) {
+	old, err := uc.find(ctx, f.MerchantID, f.ID)
+	if err != nil {
+		return nil, err
+	}
+	if old.started(time.Now()) {
+		return nil, ErrFlashSaleStarted
+	}
+	stocks, err := uc.inventory.Stocks(ctx, []int64{old.SkuID})
+	if err != nil {
+		return nil, err
+	}
+	old.Price, old.Stock, old.PerUserLimit = f.Price, f.Stock, f.PerUserLimit
+	old.StartAt, old.EndAt, old.Version = f.StartAt, f.EndAt, f.Version
+	if err := uc.validate(ctx, old, stocks[old.SkuID]); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.Update(ctx, old); err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("UpdateFlashSale: %d", old.ID)
+	return old, nil
+}
+
+// CancelFlashSale cancels a flash sale not started.
+func (uc *FlashSaleUsecase) CancelFlashSale(ctx context.Context, merchantID, id int64) (*FlashSale, error) {
+	f, err := uc.find(ctx, merchantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if f.started(time.Now()) {
+		return nil, ErrFlashSaleStarted
+	}
+	f.Status = FlashSaleCancelled
+	if err := uc.repo.Update(ctx, f); err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("CancelFlashSale: %d", f.ID)
+	return f, nil
+}
+
+// GetFlashSale returns a flash sale.
+func (uc *FlashSaleUsecase) GetFlashSale(ctx context.Context, id int64) (*FlashSale, error) {
+	return uc.repo.Find(ctx, id)
+}
+
+// ListFlashSales lists the flash sales scheduled and not ended, soonest
+// first, those starting before startsBefore unless it is zero.
+func (uc *FlashSaleUsecase) ListFlashSales(ctx context.Context, startsBefore time.Time, page, pageSize int) ([]*FlashSale, int64, error) {
+	page, pageSize = pagination(page, pageSize)
+	filter := &FlashSaleFilter{Status: FlashSaleScheduled, EndsAfter: time.Now(), StartsBefore: startsBefore}
+	return uc.repo.List(ctx, filter, false, page, pageSize)
+}
+
+// ListMerchantFlashSales lists the flash sales of a merchant, latest start
+// first.
+func (uc *FlashSaleUsecase) ListMerchantFlashSales(ctx context.Context, merchantID int64, page, pageSize int) ([]*FlashSale, int64, error) {
+	page, pageSize = pagination(page, pageSize)
+	return uc.repo.List(ctx, &FlashSaleFilter{MerchantID: merchantID}, true, page, pageSize)
+}
+
+// Warm warms the stock of the SKUs of the flash sales starting soon or
+// running, and returns how many flash sales it warmed.
+func (uc *FlashSaleUsecase) Warm(ctx context.Context) (int, error) {
+	now := time.Now()
+	filter := &FlashSaleFilter{Status: FlashSaleScheduled, EndsAfter: now, StartsBefore: now.Add(uc.warmAhead)}
+	n := 0
+	for page := 1; ; page++ {
+		fs, _, err := uc.repo.List(ctx, filter, false, page, warmBatch)
+		if err != nil {
+			return n, err
+		}
+		ids := make([]int64, 0, len(fs))
+		for _, f := range fs {
+			ids = append(ids, f.SkuID)
+		}
+		if len(ids) > 0 {
+			if err := uc.inventory.Warm(ctx, ids); err != nil {
+				return n, err
+			}
+		}
+		n += len(fs)
+		if len(fs) < warmBatch {
+			return n, nil
+		}
+	}
+}
+
+// validate checks the terms of a flash sale of a SKU with stock left.
+func (uc *FlashSaleUsecase) validate(ctx context.Context, f *FlashSale, stock int64) error {
+	now := time.Now()
+	if f.Price <= 0 || f.Price >= f.OriginalPrice || f.Stock <= 0 || f.Stock > stock ||
+		f.PerUserLimit <= 0 || int64(f.PerUserLimit) > f.Stock ||
+		!f.StartAt.After(now) || !f.EndAt.After(f.StartAt) || f.EndAt.Sub(f.StartAt) > maxFlashSaleDuration {
+		return ErrInvalidFlashSale
+	}
+	overlaps, err := uc.repo.Overlaps(ctx, f.SkuID, f.ID, f.StartAt, f.EndAt)
+	if err != nil {
+		return err
+	}
+	if overlaps {
+		return ErrInvalidFlashSale
+	}
+	return nil
+}
+
+// find returns a flash sale of a merchant.
+func (uc *FlashSaleUsecase) find(ctx context.Context, merchantID, id int64) (*FlashSale, error) {
+	f, err := uc.repo.Find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if f.MerchantID != merchantID {
+		return nil, ErrFlashSaleNotFound
+	}
+	return f, nil
+}
@@ -0,0 +1,174 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	v1 "github.com/go-kratos/kratos-layout/shop/api/shop/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrInsufficientStock is returned when a SKU of a reservation is short
+	// of stock, with the SKU in its sku_id metadata.
+	ErrInsufficientStock = errors.BadRequest(v1.ErrorReason_INSUFFICIENT_STOCK.String(), "insufficient stock")
+	// ErrReservationNotFound is reservation not found.
+	ErrReservationNotFound = errors.NotFound(v1.ErrorReason_RESERVATION_NOT_FOUND.String(), "reservation not found")
+	// ErrReservationConfirmed is returned when releasing a reservation confirmed.
+	ErrReservationConfirmed = errors.Conflict(v1.ErrorReason_RESERVATION_CONFIRMED.String(), "reservation confirmed")
+	// ErrReservationReleased is returned when confirming a reservation released.
+	ErrReservationReleased = errors.Conflict(v1.ErrorReason_RESERVATION_RELEASED.String(), "reservation released")
+	// ErrInvalidReservation is returned for a reservation without a number
+	// or items, or with quantities out of range.
+	ErrInvalidReservation = errors.BadRequest(v1.ErrorReason_INVALID_RESERVATION.String(), "invalid reservation")
+)
+
+const (
+	maxReservationItems = 100
+	maxReservationNo    = 64
+)
+
+// ReservationStatus is the status of a reservation.
+type ReservationStatus string
+
+const (
+	// ReservationReserved holds the stock of its items.
+	ReservationReserved ReservationStatus = "reserved"
+	// ReservationConfirmed has its stock sold.
+	ReservationConfirmed ReservationStatus = "confirmed"
+	// ReservationReleased gave its stock back, or was released before it
+	// was made and reserves nothing.
+	ReservationReleased ReservationStatus = "released"
+)
+
+// StockItem is a quantity of a SKU.
+type StockItem struct {
+	SkuID    int64
+	Quantity int64
+}
+
+// Reservation is stock held for an order until it is paid or cancelled.
+type Reservation struct {
+	// ReservationNo is chosen by the caller, such as the order number.
+	ReservationNo string
+	Items         []*StockItem
+	Status        ReservationStatus
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// InventoryRepo keeps the stock left to sell of SKUs and the reservations
+// holding part of it. Reserving, confirming and releasing are atomic and
+// idempotent on the reservation number; stock never goes below zero.
+type InventoryRepo interface {
+	// Reserve takes the stock of the items of r, or returns
+	// ErrInsufficientStock and takes nothing. A reservation made before,
+	// or released before it was made, is returned as it is.
+	Reserve(ctx context.Context, r *Reservation) (*Reservation, error)
+	// Confirm marks a reservation sold, or returns ErrReservationReleased
+	// or ErrReservationNotFound.
+	Confirm(ctx context.Context, reservationNo string) (*Reservation, error)
+	// Release gives the stock of a reservation back, or returns
+	// ErrReservationConfirmed. An unknown reservation is recorded released.
+	Release(ctx context.Context, reservationNo string) (*Reservation, error)
+	// Adjust changes the stock of a SKU by delta, or returns
+	// ErrInsufficientStock when it would go below zero.
+	Adjust(ctx context.Context, skuID, delta int64) error
+	// Stocks returns the stock left to sell of SKUs, those unknown left out.
+	Stocks(ctx context.Context, skuIDs []int64) (map[int64]int64, error)
+	// Warm loads the stock of SKUs into a cache ahead of a rush, unless
+	// loaded. A repo on the database does nothing.
+	Warm(ctx context.Context, skuIDs []int64) error
+	// WriteBack writes the reservations made in a cache back to the
+	// database until ctx is done. A repo on the database returns at once.
+	WriteBack(ctx context.Context) error
+	// Reconcile corrects the stock in a cache that drifted from the
+	// database, and returns how many SKUs it corrected.
+	Reconcile(ctx context.Context) (int, error)
+}
+
+// InventoryUsecase is an inventory usecase. Orders reserve the stock of
+// their items as they are placed, confirm it once paid and release it as
+// they are cancelled.
+type InventoryUsecase struct {
+	repo InventoryRepo
+	log  *log.Helper
+}
+
+// NewInventoryUsecase new an inventory usecase.
+func NewInventoryUsecase(repo InventoryRepo, logger log.Logger) *InventoryUsecase {
+	return &InventoryUsecase{repo: repo, log: log.NewHelper(logger)}
+}
+
+// ReserveStock reserves the stock of items under a reservation number, all
+// of it or nothing. The quantities of a SKU given twice add up.
+func (uc *InventoryUsecase) ReserveStock(ctx context.Context, reservationNo string, items []*StockItem) (*Reservation, error) {
+	if reservationNo == "" || len(reservationNo) > maxReservationNo || len(items) == 0 || len(items) > maxReservationItems {
+		return nil, ErrInvalidReservation
+	}
+	merged := make(map[int64]*StockItem, len(items))
+	r := &Reservation{ReservationNo: reservationNo, Status: ReservationReserved}
+	for _, it := range items {
+		if it.SkuID <= 0 || it.Quantity <= 0 {
+			return nil, ErrInvalidReservation
+		}
+		if m, ok := merged[it.SkuID]; ok {
+			m.Quantity += it.Quantity
+			continue
+		}
+		m := &StockItem{SkuID: it.SkuID, Quantity: it.Quantity}
+		merged[it.SkuID] = m
+		r.Items = append(r.Items, m)
+	}
+	// Taking the SKUs in the same order keeps reservations from deadlocking.
+	sort.Slice(r.Items, func(i, j int) bool { return r.Items[i].SkuID < r.Items[j].SkuID })
+	r, err := uc.repo.Reserve(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("ReserveStock: %s %s", r.ReservationNo, r.Status)
+	return r, nil
+}
+
+// ConfirmStock confirms the stock of a reservation is sold.
+func (uc *InventoryUsecase) ConfirmStock(ctx context.Context, reservationNo string) (*Reservation, error) {
+	r, err := uc.repo.Confirm(ctx, reservationNo)
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("ConfirmStock: %s", reservationNo)
+	return r, nil
+}
+
+// ReleaseStock gives back the stock of a reservation not confirmed.
+func (uc *InventoryUsecase) ReleaseStock(ctx context.Context, reservationNo string) (*Reservation, error) {
+	if reservationNo == "" || len(reservationNo) > maxReservationNo {
+		return nil, ErrInvalidReservation
+	}
+	r, err := uc.repo.Release(ctx, reservationNo)
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("ReleaseStock: %s", reservationNo)
+	return r, nil
+}
+
+// WriteBack writes the reservations back to the database until ctx is done.
+func (uc *InventoryUsecase) WriteBack(ctx context.Context) error {
+	return uc.repo.WriteBack(ctx)
+}
+
+// Reconcile corrects the stock drifted from the database, and returns how
+// many SKUs it corrected.
+func (uc *InventoryUsecase) Reconcile(ctx context.Context) (int, error) {
+	return uc.repo.Reconcile(ctx)
+}
+
+// InsufficientStock returns ErrInsufficientStock for a SKU.
+func InsufficientStock(skuID int64) error {
+	return ErrInsufficientStock.WithMetadata(map[string]string{"sku_id": strconv.FormatInt(skuID, 10)})
+}
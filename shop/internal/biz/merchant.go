@@ -0,0 +1,658 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	v1 "github.com/go-kratos/kratos-layout/shop/api/shop/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrMerchantNotFound is merchant not found.
+	ErrMerchantNotFound = errors.NotFound(v1.ErrorReason_MERCHANT_NOT_FOUND.String(), "merchant not found")
+	// ErrInvalidMerchant is returned for a shop profile described wrong.
+	ErrInvalidMerchant = errors.BadRequest(v1.ErrorReason_INVALID_MERCHANT.String(), "invalid merchant")
+	// ErrMerchantExists is returned for a shop name or a business licence
+	// another merchant has already.
+	ErrMerchantExists = errors.Conflict(v1.ErrorReason_MERCHANT_EXISTS.String(), "merchant exists")
+	// ErrMerchantSuspended is returned for a merchant suspended selling or
+	// changing its shop, or suspended again.
+	ErrMerchantSuspended = errors.Forbidden(v1.ErrorReason_MERCHANT_SUSPENDED.String(), "merchant suspended")
+	// ErrMerchantConflict is returned when a merchant changed since it was read.
+	ErrMerchantConflict = errors.Conflict(v1.ErrorReason_MERCHANT_VERSION_CONFLICT.String(), "merchant changed concurrently")
+	// ErrApplicationNotFound is application not found, also for an
+	// application of another user.
+	ErrApplicationNotFound = errors.NotFound(v1.ErrorReason_APPLICATION_NOT_FOUND.String(), "application not found")
+	// ErrInvalidApplication is returned for an application with its
+	// business licence or contact missing or wrong.
+	ErrInvalidApplication = errors.BadRequest(v1.ErrorReason_INVALID_APPLICATION.String(), "invalid application")
+	// ErrApplicationPending is returned for an application of a user with
+	// another one pending.
+	ErrApplicationPending = errors.Conflict(v1.ErrorReason_APPLICATION_PENDING.String(), "application pending")
+	// ErrApplicationReviewed is returned when reviewing an application
+	// reviewed already.
+	ErrApplicationReviewed = errors.Conflict(v1.ErrorReason_APPLICATION_REVIEWED.String(), "application reviewed")
+	// ErrStaffNotFound is staff not found.
+	ErrStaffNotFound = errors.NotFound(v1.ErrorReason_STAFF_NOT_FOUND.String(), "staff not found")
+	// ErrStaffExists is returned for a user on the staff of a shop already.
+	ErrStaffExists = errors.Conflict(v1.ErrorReason_STAFF_EXISTS.String(), "staff exists")
+	// ErrInvalidStaff is returned for a role that does not exist, or a
+	// shop with as much staff as it may have.
+	ErrInvalidStaff = errors.BadRequest(v1.ErrorReason_INVALID_STAFF.String(), "invalid staff")
+	// ErrStaffPermissionDenied is returned for a user not on the staff of a
+	// shop, or whose role does not allow what was asked.
+	ErrStaffPermissionDenied = errors.Forbidden(v1.ErrorReason_STAFF_PERMISSION_DENIED.String(), "staff permission denied")
+)
+
+const (
+	maxShopNameLength    = 30
+	maxShopDescLength    = 500
+	maxLicenceLength     = 100
+	maxPhoneLength       = 20
+	maxStaffPerMerchant  = 50
+	creditCodeLength     = 18
+	creditCodeCharacters = "0123456789ABCDEFGHJKLMNPQRTUWXY"
+)
+
+// creditCodeWeights are the weights of the first 17 characters of a unified
+// social credit code for its check character, per GB 32100-2015.
+var creditCodeWeights = [creditCodeLength - 1]int{1, 3, 9, 27, 19, 26, 16, 17, 20, 29, 25, 13, 8, 24, 10, 30, 28}
+
+// Licence is the business licence of a merchant.
+type Licence struct {
+	CompanyName string
+	// CreditCode is the unified social credit code of the company.
+	CreditCode  string
+	LegalPerson string
+	Address     string
+	// Image is the scan of the licence.
+	Image string
+	// ValidUntil is when the licence expires, zero for a licence that does
+	// not.
+	ValidUntil time.Time
+}
+
+func (l *Licence) validate() error {
+	for _, f := range []string{l.CompanyName, l.LegalPerson, l.Address} {
+		if f == "" || utf8.RuneCountInString(f) > maxLicenceLength {
+			return ErrInvalidApplication
+		}
+	}
+	if l.Image == "" || len(l.Image) > maxImageLength {
+		return ErrInvalidApplication
+	}
+	if !validCreditCode(l.CreditCode) || !l.ValidUntil.IsZero() && !l.ValidUntil.After(time.Now()) {
+		return ErrInvalidApplication
+	}
+	return nil
+}
+
+// validCreditCode checks the format and the check character of a unified
+// social credit code.
+func validCreditCode(code string) bool {
+	if len(code) != creditCodeLength {
+		return false
+	}
+	sum := 0
+	for i := 0; i < creditCodeLength; i++ {
+		v := strings.IndexByte(creditCodeCharacters, code[i])
+		if v < 0 {
+			return false
+		}
+		if i < creditCodeLength-1 {
+			sum += v * creditCodeWeights[i]
+		}
+	}
+	n := len(creditCodeCharacters)
+	return creditCodeCharacters[(n-sum%n)%n] == code[creditCodeLength-1]
+}
+
+func validPhone(phone string) bool {
+	if phone == "" || len(phone) > maxPhoneLength {
+		return false
+	}
+	for _, r := range phone {
+		if (r < '0' || r > '9') && r != '+' && r != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// ApplicationStatus is the status of a merchant application.
+type ApplicationStatus string
+
+const (
+	// ApplicationPending waits for review.
+	ApplicationPending ApplicationStatus = "pending"
+	// ApplicationApproved opened its shop.
+	ApplicationApproved ApplicationStatus = "approved"
+	// ApplicationRejected was rejected, the user may apply again.
+	ApplicationRejected ApplicationStatus = "rejected"
+)
+
+// MerchantApplication is what a user applies to open a shop with.
+type MerchantApplication struct {
+	ID           int64
+	UserID       int64
+	ShopName     string
+	Licence      Licence
+	ContactName  string
+	ContactPhone string
+	Status       ApplicationStatus
+	// Reason is why the application was rejected.
+	Reason string
+	// MerchantID is the merchant an approved application opened.
+	MerchantID int64
+	CreatedAt  time.Time
+	ReviewedAt time.Time
+}
+
+func (a *MerchantApplication) validate() error {
+	if a.UserID <= 0 || a.ShopName == "" || utf8.RuneCountInString(a.ShopName) > maxShopNameLength ||
+		a.ContactName == "" || utf8.RuneCountInString(a.ContactName) > maxLicenceLength || !validPhone(a.ContactPhone) {
+		return ErrInvalidApplication
+	}
+	return a.Licence.validate()
+}
+
+// MerchantStatus is the status of a merchant.
+type MerchantStatus string
+
+const (
+	// MerchantActive sells.
+	MerchantActive MerchantStatus = "active"
+	// MerchantSuspended was suspended by the platform, its SPUs delisted
+	// and kept from being listed.
+	MerchantSuspended MerchantStatus = "suspended"
+)
+
+// Merchant is a merchant and its shop, one and the same: the id of a
+// merchant is that of its shop.
+type Merchant struct {
+	ID           int64
+	Name         string
+	Logo         string
+	Description  string
+	ServicePhone string
+	Licence      Licence
+	OwnerID      int64
+	Status       MerchantStatus
+	// SuspendReason is why the merchant was suspended last.
+	SuspendReason string
+	SuspendedAt   time.Time
+	Version       int64
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (m *Merchant) validate() error {
+	if m.Name == "" || utf8.RuneCountInString(m.Name) > maxShopNameLength || len(m.Logo) > maxImageLength ||
+		utf8.RuneCountInString(m.Description) > maxShopDescLength ||
+		m.ServicePhone != "" && !validPhone(m.ServicePhone) {
+		return ErrInvalidMerchant
+	}
+	return nil
+}
+
+// StaffRole is the role of a user on the staff of a shop.
+type StaffRole string
+
+const (
+	// RoleOwner is the user whose application opened the shop.
+	RoleOwner StaffRole = "owner"
+	// RoleAdmin manages the shop as its owner does, but for the admins.
+	RoleAdmin StaffRole = "admin"
+	// RoleOperator manages the products and orders.
+	RoleOperator StaffRole = "operator"
+	// RoleSupport serves the buyers.
+	RoleSupport StaffRole = "support"
+)
+
+// roleRanks rank the roles: staff manage the staff of lower roles only.
+var roleRanks = map[StaffRole]int{
+	RoleOwner:    4,
+	RoleAdmin:    3,
+	RoleOperator: 2,
+	RoleSupport:  1,
+}
+
+// Permission is what staff may do in a shop.
+type Permission string
+
+const (
+	// PermissionProfile changes the shop profile.
+	PermissionProfile Permission = "profile"
+	// PermissionStaff manages the staff.
+	PermissionStaff Permission = "staff"
+	// PermissionCatalog manages the SPUs, SKUs and flash sales.
+	PermissionCatalog Permission = "catalog"
+	// PermissionOrders ships orders and handles after-sales.
+	PermissionOrders Permission = "orders"
+	// PermissionService answers buyers and their reviews.
+	PermissionService Permission = "service"
+)
+
+// rolePermissions are the permissions of each role.
+var rolePermissions = map[StaffRole][]Permission{
+	RoleOwner:    {PermissionProfile, PermissionStaff, PermissionCatalog, PermissionOrders, PermissionService},
+	RoleAdmin:    {PermissionProfile, PermissionStaff, PermissionCatalog, PermissionOrders, PermissionService},
+	RoleOperator: {PermissionCatalog, PermissionOrders},
+	RoleSupport:  {PermissionService},
+}
+
+// Staff is a user on the staff of a shop, with a role in it.
+type Staff struct {
+	MerchantID int64
+	UserID     int64
+	Role       StaffRole
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// Can reports whether the role of s allows a permission.
+func (s *Staff) Can(p Permission) bool {
+	return slices.Contains(rolePermissions[s.Role], p)
+}
+
+// MerchantApplicationRepo is a merchant application repo.
+type MerchantApplicationRepo interface {
+	Save(ctx context.Context, a *MerchantApplication) (*MerchantApplication, error)
+	Find(ctx context.Context, id int64) (*MerchantApplication, error)
+	// Transition saves the review of an application, and reports whether
+	// it was in from.
+	Transition(ctx context.Context, a *MerchantApplication, from ApplicationStatus) (bool, error)
+	// List lists the applications of a user when one is given, in a status
+	// when one is given, the oldest first or the latest first.
+	List(ctx context.Context, userID int64, status ApplicationStatus, oldest bool, page, pageSize int) ([]*MerchantApplication, int64, error)
+}
+
+// MerchantRepo is a merchant repo.
+type MerchantRepo interface {
+	// Save saves a merchant, or returns ErrMerchantExists for its name or
+	// credit code taken.
+	Save(ctx context.Context, m *Merchant) (*Merchant, error)
+	// Update saves a merchant at its version and bumps it, or returns
+	// ErrMerchantConflict, or ErrMerchantExists for its name taken.
+	Update(ctx context.Context, m *Merchant) error
+	Find(ctx context.Context, id int64) (*Merchant, error)
+	// Lock returns a merchant as Find does, its row locked until the
+	// transaction carried by ctx ends.
+	Lock(ctx context.Context, id int64) (*Merchant, error)
+	// Taken reports whether a merchant has a name or a credit code.
+	Taken(ctx context.Context, name, creditCode string) (bool, error)
+	List(ctx context.Context, status MerchantStatus, page, pageSize int) ([]*Merchant, int64, error)
+}
+
+// StaffRepo is a staff repo.
+type StaffRepo interface {
+	// Save saves staff, or returns ErrStaffExists.
+	Save(ctx context.Context, s *Staff) (*Staff, error)
+	Update(ctx context.Context, s *Staff) error
+	Delete(ctx context.Context, merchantID, userID int64) error
+	Find(ctx context.Context, merchantID, userID int64) (*Staff, error)
+	ListByMerchant(ctx context.Context, merchantID int64) ([]*Staff, error)
+	ListByUser(ctx context.Context, userID int64) ([]*Staff, error)
+}
+
+// MerchantUsecase is a merchant usecase. Users apply to open shops and the
+// platform reviews their applications, an approved one opening a shop the
+// user owns. Owners and admins manage the profile and staff of their
+// shops, and the platform suspends shops, their SPUs delisted at once.
+type MerchantUsecase struct {
+	applications MerchantApplicationRepo
+	merchants    MerchantRepo
+	staff        StaffRepo
+	catalog      *CatalogUsecase
+	tx           Transaction
+	log          *log.Helper
+}
+
+// NewMerchantUsecase new a merchant usecase.
+func NewMerchantUsecase(applications MerchantApplicationRepo, merchants MerchantRepo, staff StaffRepo, catalog *CatalogUsecase, tx Transaction, logger log.Logger) *MerchantUsecase {
+	return &MerchantUsecase{
+		applications: applications,
+		merchants:    merchants,
+		staff:        staff,
+		catalog:      catalog,
+		tx:           tx,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// SubmitApplication applies to open a shop, for a user with no other
+// application pending.
+func (uc *MerchantUsecase) SubmitApplication(ctx context.Context, a *MerchantApplication) (*MerchantApplication, error) {
+	a.Licence.CreditCode = strings.ToUpper(a.Licence.CreditCode)
+	if err := a.validate(); err != nil {
+		return nil, err
+	}
+	taken, err := uc.merchants.Taken(ctx, a.ShopName, a.Licence.CreditCode)
+	if err != nil {
+		return nil, err
+	}
+	if taken {
+		return nil, ErrMerchantExists
+	}
+	_, n, err := uc.applications.List(ctx, a.UserID, ApplicationPending, false, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+	if n > 0 {
+		return nil, ErrApplicationPending
+	}
+	a.Status, a.Reason, a.MerchantID = ApplicationPending, "", 0
+	if a, err = uc.applications.Save(ctx, a); err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("SubmitApplication: %d by user %d for %q", a.ID, a.UserID, a.ShopName)
+	return a, nil
+}
+
+// GetApplication returns an application of a user.
+func (uc *MerchantUsecase) GetApplication(ctx context.Context, userID, id int64) (*MerchantApplication, error) {
+	a, err := uc.applications.Find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if a.UserID != userID {
+		return nil, ErrApplicationNotFound
+	}
+	return a, nil
+}
+
+// ListUserApplications lists the applications of a user, latest first.
+func (uc *MerchantUsecase) ListUserApplications(ctx context.Context, userID int64, page, pageSize int) ([]*MerchantApplication, int64, error) {
+	page, pageSize = pagination(page, pageSize)
+	return uc.applications.List(ctx, userID, "", false, page, pageSize)
+}
+
+// ListApplications lists the applications in a status, or in any for an
+// empty one, oldest first.
+func (uc *MerchantUsecase) ListApplications(ctx context.Context, status ApplicationStatus, page, pageSize int) ([]*MerchantApplication, int64, error) {
+	page, pageSize = pagination(page, pageSize)
+	return uc.applications.List(ctx, 0, status, true, page, pageSize)
+}
+
+// ReviewApplication approves an application pending, opening its shop
+// with the user as owner, or rejects it. An application whose shop name or
+// licence another merchant took since is left pending, to be rejected.
+func (uc *MerchantUsecase) ReviewApplication(ctx context.Context, id int64, approve bool, reason string) (*MerchantApplication, error) {
+	if utf8.RuneCountInString(reason) > maxReasonLength || !approve && reason == "" {
+		return nil, ErrInvalidApplication
+	}
+	var a *MerchantApplication
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		if a, err = uc.applications.Find(ctx, id); err != nil {
+			return err
+		}
+		if a.Status != ApplicationPending {
+			return ErrApplicationReviewed
+		}
+		a.ReviewedAt = time.Now()
+		if !approve {
+			a.Status, a.Reason = ApplicationRejected, reason
+		} else {
+			m, err := uc.merchants.Save(ctx, &Merchant{
+				Name:    a.ShopName,
+				Licence: a.Licence,
+				OwnerID: a.UserID,
+				Status:  MerchantActive,
+			})
+			if err != nil {
+				return err
+			}
+			if _, err := uc.staff.Save(ctx, &Staff{MerchantID: m.ID, UserID: a.UserID, Role: RoleOwner}); err != nil {
+				return err
+			}
+			a.Status, a.MerchantID = ApplicationApproved, m.ID
+		}
+		ok, err := uc.applications.Transition(ctx, a, ApplicationPending)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return ErrApplicationReviewed
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("ReviewApplication: %d %s, merchant %d", a.ID, a.Status, a.MerchantID)
+	return a, nil
+}
+
+// GetMerchant returns a merchant.
+func (uc *MerchantUsecase) GetMerchant(ctx context.Context, id int64) (*Merchant, error) {
+	return uc.merchants.Find(ctx, id)
+}
+
+// ListMerchants lists the merchants in a status, or in any for an empty
+// one, latest first.
+func (uc *MerchantUsecase) ListMerchants(ctx context.Context, status MerchantStatus, page, pageSize int) ([]*Merchant, int64, error) {
+	page, pageSize = pagination(page, pageSize)
+	return uc.merchants.List(ctx, status, page, pageSize)
+}
+
+// UpdateProfile changes the name, logo, description and service phone of
+// a shop not suspended at the version it was read, for staff allowed to.
+func (uc *MerchantUsecase) UpdateProfile(ctx context.Context, operatorID int64, m *Merchant) (*Merchant, error) {
+	if err := m.validate(); err != nil {
+		return nil, err
+	}
+	if _, err := uc.authorize(ctx, m.ID, operatorID, PermissionProfile); err != nil {
+		return nil, err
+	}
+	old, err := uc.merchants.Find(ctx, m.ID)
+	if err != nil {
+		return nil, err
+	}
+	if old.Status == MerchantSuspended {
+		return nil, ErrMerchantSuspended
+	}
+	if old.Version != m.Version {
+		return nil, ErrMerchantConflict
+	}
+	old.Name, old.Logo, old.Description, old.ServicePhone = m.Name, m.Logo, m.Description, m.ServicePhone
+	if err := uc.merchants.Update(ctx, old); err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("UpdateProfile: %d by user %d", old.ID, operatorID)
+	return old, nil
+}
+
+// SuspendMerchant suspends an active merchant and delists all its SPUs,
+// at once.
+func (uc *MerchantUsecase) SuspendMerchant(ctx context.Context, id int64, reason string) (*Merchant, error) {
+	if reason == "" || utf8.RuneCountInString(reason) > maxReasonLength {
+		return nil, ErrInvalidMerchant
+	}
+	var (
+		m        *Merchant
+		delisted int
+	)
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		if m, err = uc.merchants.Find(ctx, id); err != nil {
+			return err
+		}
+		if m.Status == MerchantSuspended {
+			return ErrMerchantSuspended
+		}
+		m.Status, m.SuspendReason, m.SuspendedAt = MerchantSuspended, reason, time.Now()
+		if err := uc.merchants.Update(ctx, m); err != nil {
+			return err
+		}
+		delisted, err = uc.catalog.delistMerchant(ctx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("SuspendMerchant: %d, %d SPUs delisted: %s", id, delisted, reason)
+	return m, nil
+}
+
+// ReinstateMerchant reinstates a merchant suspended. Its SPUs stay
+// delisted, for the merchant to list again.
+func (uc *MerchantUsecase) ReinstateMerchant(ctx context.Context, id int64) (*Merchant, error) {
+	m, err := uc.merchants.Find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if m.Status != MerchantSuspended {
+		return nil, ErrInvalidMerchant
+	}
+	m.Status = MerchantActive
+	if err := uc.merchants.Update(ctx, m); err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("ReinstateMerchant: %d", id)
+	return m, nil
+}
+
+// ListStaff lists the staff of a shop, for its staff.
+func (uc *MerchantUsecase) ListStaff(ctx context.Context, operatorID, merchantID int64) ([]*Staff, error) {
+	if _, err := uc.member(ctx, merchantID, operatorID); err != nil {
+		return nil, err
+	}
+	return uc.staff.ListByMerchant(ctx, merchantID)
+}
+
+// AddStaff adds a user to the staff of a shop in a role below that of the
+// operator, who must be allowed to manage the staff.
+func (uc *MerchantUsecase) AddStaff(ctx context.Context, operatorID int64, s *Staff) (*Staff, error) {
+	op, err := uc.authorize(ctx, s.MerchantID, operatorID, PermissionStaff)
+	if err != nil {
+		return nil, err
+	}
+	if err := op.outranks(s.Role); err != nil {
+		return nil, err
+	}
+	if s.UserID <= 0 {
+		return nil, ErrInvalidStaff
+	}
+	staff, err := uc.staff.ListByMerchant(ctx, s.MerchantID)
+	if err != nil {
+		return nil, err
+	}
+	if len(staff) >= maxStaffPerMerchant {
+		return nil, ErrInvalidStaff
+	}
+	if s, err = uc.staff.Save(ctx, s); err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("AddStaff: user %d as %s of %d by user %d", s.UserID, s.Role, s.MerchantID, operatorID)
+	return s, nil
+}
+
+// UpdateStaffRole changes the role of staff below the operator to another
+// below the operator.
+func (uc *MerchantUsecase) UpdateStaffRole(ctx context.Context, operatorID int64, s *Staff) (*Staff, error) {
+	op, err := uc.authorize(ctx, s.MerchantID, operatorID, PermissionStaff)
+	if err != nil {
+		return nil, err
+	}
+	if err := op.outranks(s.Role); err != nil {
+		return nil, err
+	}
+	old, err := uc.staff.Find(ctx, s.MerchantID, s.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := op.outranks(old.Role); err != nil {
+		return nil, err
+	}
+	old.Role = s.Role
+	if err := uc.staff.Update(ctx, old); err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("UpdateStaffRole: user %d as %s of %d by user %d", old.UserID, old.Role, old.MerchantID, operatorID)
+	return old, nil
+}
+
+// RemoveStaff removes staff below the operator from a shop. Staff but the
+// owner may also leave a shop.
+func (uc *MerchantUsecase) RemoveStaff(ctx context.Context, operatorID, merchantID, userID int64) error {
+	s, err := uc.staff.Find(ctx, merchantID, userID)
+	if err != nil {
+		return err
+	}
+	if operatorID != userID || s.Role == RoleOwner {
+		op, err := uc.authorize(ctx, merchantID, operatorID, PermissionStaff)
+		if err != nil {
+			return err
+		}
+		if err := op.outranks(s.Role); err != nil {
+			return err
+		}
+	}
+	if err := uc.staff.Delete(ctx, merchantID, userID); err != nil {
+		return err
+	}
+	uc.log.WithContext(ctx).Infof("RemoveStaff: user %d from %d by user %d", userID, merchantID, operatorID)
+	return nil
+}
+
+// ListUserStaff lists the shops a user is on the staff of, with the role
+// in each.
+func (uc *MerchantUsecase) ListUserStaff(ctx context.Context, userID int64) ([]*Staff, error) {
+	return uc.staff.ListByUser(ctx, userID)
+}
+
+// CheckPermission returns the staff of a user in a shop and whether its
+// role allows a permission, for the gateway to check what staff ask for.
+// A user not on the staff is not allowed anything.
+func (uc *MerchantUsecase) CheckPermission(ctx context.Context, merchantID, userID int64, p Permission) (*Staff, bool, error) {
+	s, err := uc.staff.Find(ctx, merchantID, userID)
+	if errors.Is(err, ErrStaffNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return s, s.Can(p), nil
+}
+
+// member returns the staff of a user in a shop, or ErrStaffPermissionDenied.
+func (uc *MerchantUsecase) member(ctx context.Context, merchantID, userID int64) (*Staff, error) {
+	s, err := uc.staff.Find(ctx, merchantID, userID)
+	if errors.Is(err, ErrStaffNotFound) {
+		return nil, ErrStaffPermissionDenied
+	}
+	return s, err
+}
+
+// authorize returns the staff of a user in a shop whose role allows a
+// permission, or ErrStaffPermissionDenied.
+func (uc *MerchantUsecase) authorize(ctx context.Context, merchantID, userID int64, p Permission) (*Staff, error) {
+	s, err := uc.member(ctx, merchantID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !s.Can(p) {
+		return nil, ErrStaffPermissionDenied
+	}
+	return s, nil
+}
+
+// outranks checks staff may give or take a role: one that exists, other
+// than owner, below its own.
+func (s *Staff) outranks(role StaffRole) error {
+	rank, ok := roleRanks[role]
+	if !ok {
+		return ErrInvalidStaff
+	}
+	if role == RoleOwner || rank >= roleRanks[s.Role] {
+		return ErrStaffPermissionDenied
+	}
+	return nil
+}
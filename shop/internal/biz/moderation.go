@@ -0,0 +1,109 @@
+package biz
+
+import (
+	"github.com/go-kratos/kratos-layout/pkg/sensitive"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// maskRune replaces the characters of the words masked.
+const maskRune = '*'
+
+// ModerationAction is what moderation does with a text.
+type ModerationAction string
+
+const (
+	// ModerationPublish publishes the text, its words to mask masked.
+	ModerationPublish ModerationAction = "publish"
+	// ModerationHold holds the text for a moderator to publish or reject.
+	ModerationHold ModerationAction = "hold"
+	// ModerationReject rejects the text.
+	ModerationReject ModerationAction = "reject"
+)
+
+// SensitiveWords are the sensitive words in force, by what is done with a
+// text holding them.
+type SensitiveWords struct {
+	// Block rejects a text.
+	Block *sensitive.Matcher
+	// Hold holds a text for a moderator.
+	Hold *sensitive.Matcher
+	// Mask masks the words and publishes the text.
+	Mask *sensitive.Matcher
+}
+
+// SensitiveWordSource provides the sensitive words in force, reloaded as
+// they change.
+type SensitiveWordSource interface {
+	Words() *SensitiveWords
+}
+
+// Moderation is the outcome of moderating texts.
+type Moderation struct {
+	Action ModerationAction
+	// Texts are the texts moderated, the words to mask masked.
+	Texts []string
+	// Words are the words found that blocked or held the texts.
+	Words []string
+}
+
+// Moderator runs the texts users write through the sensitive words before
+// they are published: a word to block rejects them, a word to hold holds
+// them for a moderator, and words to mask are masked.
+type Moderator struct {
+	source SensitiveWordSource
+	log    *log.Helper
+}
+
+// NewModerator new a moderator.
+func NewModerator(source SensitiveWordSource, logger log.Logger) *Moderator {
+	return &Moderator{
+		source: source,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// Moderate moderates texts as a whole, the most severe action any of them
+// calls for applying to all.
+func (m *Moderator) Moderate(texts ...string) *Moderation {
+	words := m.source.Words()
+	mod := &Moderation{Action: ModerationPublish, Texts: make([]string, 0, len(texts))}
+	for _, text := range texts {
+		if found := find(words.Block, text); len(found) > 0 {
+			mod.Action = ModerationReject
+			mod.Words = appendWords(mod.Words, found)
+		}
+	}
+	if mod.Action == ModerationReject {
+		mod.Texts = append(mod.Texts, texts...)
+		return mod
+	}
+	for _, text := range texts {
+		if found := find(words.Hold, text); len(found) > 0 {
+			mod.Action = ModerationHold
+			mod.Words = appendWords(mod.Words, found)
+		}
+		mod.Texts = append(mod.Texts, sensitive.Mask(text, find(words.Mask, text), maskRune))
+	}
+	return mod
+}
+
+func find(m *sensitive.Matcher, text string) []sensitive.Match {
+	if m == nil {
+		return nil
+	}
+	return m.Find(text)
+}
+
+func appendWords(words []string, found []sensitive.Match) []string {
+next:
+	for _, f := range found {
+		for _, w := range words {
+			if w == f.Word {
+				continue next
+			}
+		}
+		words = append(words, f.Word)
+	}
+	return words
+}
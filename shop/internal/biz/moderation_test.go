@@ -0,0 +1,66 @@
+package biz
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-kratos/kratos-layout/pkg/sensitive"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type staticWords SensitiveWords
+
+func (s *staticWords) Words() *SensitiveWords {
+	return (*SensitiveWords)(s)
+}
+
+func TestModerate(t *testing.T) {
+	m := NewModerator(&staticWords{
+		Block: sensitive.New([]string{"scam", "诈骗"}),
+		Hold:  sensitive.New([]string{"refund", "微信"}),
+		Mask:  sensitive.New([]string{"damn", "垃圾"}),
+	}, log.DefaultLogger)
+	tests := []struct {
+		name   string
+		texts  []string
+		action ModerationAction
+		want   []string
+		words  []string
+	}{
+		{"clean", []string{"great tea", "好喝"},
+			ModerationPublish, []string{"great tea", "好喝"}, nil},
+		{"masked", []string{"damn good", "不是垃 圾"},
+			ModerationPublish, []string{"**** good", "不是* *"}, nil},
+		{"held and masked", []string{"damn, add 微 信 for a refund", "垃圾"},
+			ModerationHold, []string{"****, add 微 信 for a refund", "**"}, []string{"微信", "refund"}},
+		{"held by any text", []string{"fine", "ask for a REFUND"},
+			ModerationHold, []string{"fine", "ask for a REFUND"}, []string{"refund"}},
+		// Blocking wins over holding and leaves the texts as written.
+		{"blocked", []string{"damn refund", "a S-C-A-M, 诈骗"},
+			ModerationReject, []string{"damn refund", "a S-C-A-M, 诈骗"}, []string{"scam", "诈骗"}},
+		{"blocked by any text", []string{"scam", "垃圾", "scam again"},
+			ModerationReject, []string{"scam", "垃圾", "scam again"}, []string{"scam"}},
+		{"no texts", nil, ModerationPublish, []string{}, nil},
+	}
+	for _, tt := range tests {
+		mod := m.Moderate(tt.texts...)
+		if mod.Action != tt.action {
+			t.Errorf("%s: %s, want %s", tt.name, mod.Action, tt.action)
+		}
+		if !reflect.DeepEqual(mod.Texts, tt.want) {
+			t.Errorf("%s: texts %q, want %q", tt.name, mod.Texts, tt.want)
+		}
+		if !reflect.DeepEqual(mod.Words, tt.words) {
+			t.Errorf("%s: words %q, want %q", tt.name, mod.Words, tt.words)
+		}
+	}
+}
+
+func TestModerateWithoutWords(t *testing.T) {
+	m := NewModerator(&staticWords{}, log.DefaultLogger)
+	mod := m.Moderate("scam refund damn")
+	if mod.Action != ModerationPublish || !reflect.DeepEqual(mod.Texts, []string{"scam refund damn"}) || mod.Words != nil {
+		t.Errorf("moderating with no words: %+v", mod)
+	}
+}
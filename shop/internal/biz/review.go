@@ -0,0 +1,433 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"time"
+	"unicode/utf8"
+
+	v1 "github.com/go-kratos/kratos-layout/shop/api/shop/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrReviewNotFound is review not found, also for a review of another
+	// user followed up.
+	ErrReviewNotFound = errors.NotFound(v1.ErrorReason_REVIEW_NOT_FOUND.String(), "review not found")
+	// ErrInvalidReview is returned for a review rated out of 1 to 5 stars,
+	// written too long, or with too many images.
+	ErrInvalidReview = errors.BadRequest(v1.ErrorReason_INVALID_REVIEW.String(), "invalid review")
+	// ErrReviewNotAllowed is returned for a review of an item the user did
+	// not buy in an order completed, or too long after.
+	ErrReviewNotAllowed = errors.Forbidden(v1.ErrorReason_REVIEW_NOT_ALLOWED.String(), "review not allowed")
+	// ErrReviewExists is returned for an item of an order reviewed already,
+	// or a review followed up already.
+	ErrReviewExists = errors.Conflict(v1.ErrorReason_REVIEW_EXISTS.String(), "review exists")
+	// ErrReviewRejected is returned for a review holding words it cannot be
+	// published with.
+	ErrReviewRejected = errors.BadRequest(v1.ErrorReason_REVIEW_REJECTED.String(), "review rejected by moderation")
+	// ErrReviewModerated is returned when moderating a review not in a
+	// status it can be moderated from, or moderated concurrently.
+	ErrReviewModerated = errors.Conflict(v1.ErrorReason_REVIEW_MODERATED.String(), "review moderated already")
+)
+
+const (
+	maxReviewLength = 500
+	maxReviewImages = 9
+	maxImageLength  = 512
+	maxReasonLength = 255
+	// reviewWindow is how long after an order is completed its items can
+	// be reviewed.
+	reviewWindow = 90 * 24 * time.Hour
+	// followUpWindow is how long after a review it can be followed up.
+	followUpWindow = 180 * 24 * time.Hour
+)
+
+// ReviewStatus is the moderation status of a review.
+type ReviewStatus string
+
+const (
+	// ReviewPending is held for a moderator.
+	ReviewPending ReviewStatus = "pending"
+	// ReviewPublished is shown to buyers.
+	ReviewPublished ReviewStatus = "published"
+	// ReviewRejected was rejected by a moderator, or taken down once
+	// published.
+	ReviewRejected ReviewStatus = "rejected"
+)
+
+// Review is a review of a SKU bought in an order, or the follow-up its
+// author wrote of it later.
+type Review struct {
+	ID int64
+	// ParentID is the review a follow-up follows, zero for a review.
+	ParentID   int64
+	UserID     int64
+	OrderNo    string
+	SpuID      int64
+	SkuID      int64
+	MerchantID int64
+	// SaleAttributes are those of the SKU reviewed.
+	SaleAttributes []Attribute
+	// Rating is 1 to 5 stars, zero for a follow-up.
+	Rating  int32
+	Content string
+	Images  []string
+	// Anonymous hides the author from other buyers.
+	Anonymous bool
+	Status    ReviewStatus
+	// Words are the sensitive words that held the review for a moderator.
+	Words []string
+	// Reason is why a moderator rejected the review.
+	Reason string
+	// FollowUp is the follow-up published of a review, set as reviews are
+	// listed.
+	FollowUp    *Review
+	CreatedAt   time.Time
+	PublishedAt time.Time
+}
+
+func (r *Review) validate() error {
+	if utf8.RuneCountInString(r.Content) > maxReviewLength || len(r.Images) > maxReviewImages {
+		return ErrInvalidReview
+	}
+	for _, img := range r.Images {
+		if img == "" || len(img) > maxImageLength {
+			return ErrInvalidReview
+		}
+	}
+	return nil
+}
+
+// conceal clears what a review shows only to its author and moderators.
+func (r *Review) conceal() {
+	r.OrderNo, r.Words = "", nil
+	if r.Anonymous {
+		r.UserID = 0
+	}
+}
+
+// ReviewFilter filters reviews, on the fields set.
+type ReviewFilter struct {
+	SpuID  int64
+	UserID int64
+	Status ReviewStatus
+	// MinRating and MaxRating keep the reviews rated within them.
+	MinRating int32
+	MaxRating int32
+	// WithImages keeps the reviews with images.
+	WithImages bool
+	// FollowUps lists the follow-ups along with the reviews.
+	FollowUps bool
+	// Oldest lists the oldest first, the latest first otherwise.
+	Oldest bool
+}
+
+// ReviewRepo is a review repo.
+type ReviewRepo interface {
+	// Save saves a review, or returns ErrReviewExists for an item of an
+	// order reviewed already, or a review followed up already.
+	Save(ctx context.Context, r *Review) (*Review, error)
+	Find(ctx context.Context, id int64) (*Review, error)
+	// Transition moves a review from a status to another, and reports
+	// whether it was in from.
+	Transition(ctx context.Context, r *Review, from ReviewStatus) (bool, error)
+	List(ctx context.Context, filter *ReviewFilter, page, pageSize int) ([]*Review, int64, error)
+	// FollowUps returns the follow-ups of reviews in a status by review.
+	FollowUps(ctx context.Context, ids []int64, status ReviewStatus) (map[int64]*Review, error)
+}
+
+// RatingStats are the ratings of the reviews published of an SPU.
+type RatingStats struct {
+	SpuID int64
+	Count int64
+	// Total is the sum of the stars.
+	Total int64
+	// Stars are how many reviews rated each of 1 to 5 stars.
+	Stars      [5]int64
+	WithImages int64
+}
+
+// Average returns the average stars, zero with no review.
+func (s *RatingStats) Average() float64 {
+	if s.Count == 0 {
+		return 0
+	}
+	return float64(s.Total) / float64(s.Count)
+}
+
+// GoodRate returns the share of reviews rated 4 or 5 stars, one with no
+// review.
+func (s *RatingStats) GoodRate() float64 {
+	if s.Count == 0 {
+		return 1
+	}
+	return float64(s.Stars[3]+s.Stars[4]) / float64(s.Count)
+}
+
+// RatingStatsRepo keeps the rating stats of SPUs as reviews are published
+// and taken down.
+type RatingStatsRepo interface {
+	// Add adds a review to the stats of its SPU, or removes it for a
+	// negative delta.
+	Add(ctx context.Context, r *Review, delta int64) error
+	// Find returns the stats of an SPU, zero ones for an SPU not reviewed.
+	Find(ctx context.Context, spuID int64) (*RatingStats, error)
+}
+
+// Order is an order as the order service has it, for its items to be
+// reviewed.
+type Order struct {
+	OrderNo     string
+	UserID      int64
+	Completed   bool
+	CompletedAt time.Time
+	SkuIDs      []int64
+}
+
+// OrderRepo reads the orders of the order service.
+type OrderRepo interface {
+	// Find returns an order, or ErrReviewNotAllowed when not found.
+	Find(ctx context.Context, orderNo string) (*Order, error)
+}
+
+// ReviewUsecase is a review usecase. Buyers review the items of their
+// orders completed and may follow a review up once. Reviews and follow-ups
+// are moderated before they are published, some held for a moderator, and
+// the rating stats of an SPU follow its reviews as they are published and
+// taken down.
+type ReviewUsecase struct {
+	repo      ReviewRepo
+	stats     RatingStatsRepo
+	orders    OrderRepo
+	catalog   *CatalogUsecase
+	moderator *Moderator
+	tx        Transaction
+	log       *log.Helper
+}
+
+// NewReviewUsecase new a review usecase.
+func NewReviewUsecase(repo ReviewRepo, stats RatingStatsRepo, orders OrderRepo, catalog *CatalogUsecase, moderator *Moderator, tx Transaction, logger log.Logger) *ReviewUsecase {
+	return &ReviewUsecase{
+		repo:      repo,
+		stats:     stats,
+		orders:    orders,
+		catalog:   catalog,
+		moderator: moderator,
+		tx:        tx,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// CreateReview reviews a SKU the user bought in an order completed.
+func (uc *ReviewUsecase) CreateReview(ctx context.Context, r *Review) (*Review, error) {
+	if r.Rating < 1 || r.Rating > 5 || r.OrderNo == "" {
+		return nil, ErrInvalidReview
+	}
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+	o, err := uc.orders.Find(ctx, r.OrderNo)
+	if err != nil {
+		return nil, err
+	}
+	if o.UserID != r.UserID || !o.Completed || !slices.Contains(o.SkuIDs, r.SkuID) ||
+		time.Since(o.CompletedAt) > reviewWindow {
+		return nil, ErrReviewNotAllowed
+	}
+	skus, err := uc.catalog.BatchGetSkus(ctx, []int64{r.SkuID})
+	if err != nil {
+		return nil, err
+	}
+	if len(skus) == 0 {
+		return nil, ErrSkuNotFound
+	}
+	k := skus[0]
+	r.ParentID, r.SpuID, r.MerchantID, r.SaleAttributes = 0, k.SpuID, k.MerchantID, k.SaleAttributes
+	if err := uc.moderate(r); err != nil {
+		return nil, err
+	}
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if r, err = uc.repo.Save(ctx, r); err != nil {
+			return err
+		}
+		if r.Status == ReviewPublished {
+			return uc.stats.Add(ctx, r, 1)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("CreateReview: %d of SKU %d in %s, %d stars, %s", r.ID, r.SkuID, r.OrderNo, r.Rating, r.Status)
+	return r, nil
+}
+
+// FollowUpReview follows up a review of the user, held or published.
+func (uc *ReviewUsecase) FollowUpReview(ctx context.Context, f *Review) (*Review, error) {
+	if f.Content == "" {
+		return nil, ErrInvalidReview
+	}
+	if err := f.validate(); err != nil {
+		return nil, err
+	}
+	r, err := uc.repo.Find(ctx, f.ParentID)
+	if err != nil {
+		return nil, err
+	}
+	if r.UserID != f.UserID || r.ParentID != 0 {
+		return nil, ErrReviewNotFound
+	}
+	if r.Status == ReviewRejected || time.Since(r.CreatedAt) > followUpWindow {
+		return nil, ErrReviewNotAllowed
+	}
+	f.OrderNo, f.SpuID, f.SkuID, f.MerchantID = r.OrderNo, r.SpuID, r.SkuID, r.MerchantID
+	f.SaleAttributes, f.Anonymous, f.Rating = r.SaleAttributes, r.Anonymous, 0
+	if err := uc.moderate(f); err != nil {
+		return nil, err
+	}
+	if f, err = uc.repo.Save(ctx, f); err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("FollowUpReview: %d of %d, %s", f.ID, r.ID, f.Status)
+	return f, nil
+}
+
+// moderate runs the content of a review through the moderator, masking
+// it, holding the review or rejecting it.
+func (uc *ReviewUsecase) moderate(r *Review) error {
+	m := uc.moderator.Moderate(r.Content)
+	switch m.Action {
+	case ModerationReject:
+		uc.log.Infof("Review of SKU %d by user %d rejected for %v", r.SkuID, r.UserID, m.Words)
+		return ErrReviewRejected
+	case ModerationHold:
+		r.Status = ReviewPending
+	default:
+		r.Status, r.PublishedAt = ReviewPublished, time.Now()
+	}
+	r.Content, r.Words = m.Texts[0], m.Words
+	return nil
+}
+
+// ListReviews lists the reviews published of an SPU with their follow-ups
+// published, latest first, rated within minRating and maxRating when set.
+// Reviews are listed without their order, anonymous ones without their
+// author.
+func (uc *ReviewUsecase) ListReviews(ctx context.Context, spuID int64, minRating, maxRating int32, withImages bool, page, pageSize int) ([]*Review, int64, error) {
+	page, pageSize = pagination(page, pageSize)
+	filter := &ReviewFilter{
+		SpuID:      spuID,
+		Status:     ReviewPublished,
+		MinRating:  minRating,
+		MaxRating:  maxRating,
+		WithImages: withImages,
+	}
+	rs, total, err := uc.repo.List(ctx, filter, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := uc.followUps(ctx, rs, ReviewPublished); err != nil {
+		return nil, 0, err
+	}
+	for _, r := range rs {
+		r.conceal()
+		if r.FollowUp != nil {
+			r.FollowUp.conceal()
+		}
+	}
+	return rs, total, nil
+}
+
+// ListUserReviews lists the reviews of a user in every status, latest
+// first, with their follow-ups.
+func (uc *ReviewUsecase) ListUserReviews(ctx context.Context, userID int64, page, pageSize int) ([]*Review, int64, error) {
+	page, pageSize = pagination(page, pageSize)
+	rs, total, err := uc.repo.List(ctx, &ReviewFilter{UserID: userID}, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := uc.followUps(ctx, rs, ""); err != nil {
+		return nil, 0, err
+	}
+	return rs, total, nil
+}
+
+// GetRatingStats returns the rating stats of an SPU.
+func (uc *ReviewUsecase) GetRatingStats(ctx context.Context, spuID int64) (*RatingStats, error) {
+	return uc.stats.Find(ctx, spuID)
+}
+
+// ListPendingReviews lists the reviews and follow-ups held for a
+// moderator, oldest first.
+func (uc *ReviewUsecase) ListPendingReviews(ctx context.Context, page, pageSize int) ([]*Review, int64, error) {
+	page, pageSize = pagination(page, pageSize)
+	return uc.repo.List(ctx, &ReviewFilter{Status: ReviewPending, FollowUps: true, Oldest: true}, page, pageSize)
+}
+
+// ModerateReview publishes a review or follow-up held, or rejects one held
+// or published, taking it down.
+func (uc *ReviewUsecase) ModerateReview(ctx context.Context, id int64, publish bool, reason string) (*Review, error) {
+	if utf8.RuneCountInString(reason) > maxReasonLength {
+		return nil, ErrInvalidReview
+	}
+	r, err := uc.repo.Find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	from := r.Status
+	switch {
+	case publish && from == ReviewPending:
+		r.Status, r.PublishedAt = ReviewPublished, time.Now()
+	case !publish && from != ReviewRejected:
+		r.Status, r.Reason = ReviewRejected, reason
+	default:
+		return nil, ErrReviewModerated
+	}
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		ok, err := uc.repo.Transition(ctx, r, from)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return ErrReviewModerated
+		}
+		if r.ParentID != 0 {
+			return nil
+		}
+		switch {
+		case r.Status == ReviewPublished:
+			return uc.stats.Add(ctx, r, 1)
+		case from == ReviewPublished:
+			return uc.stats.Add(ctx, r, -1)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("ModerateReview: %d from %s to %s", r.ID, from, r.Status)
+	return r, nil
+}
+
+// followUps sets the follow-ups in a status of reviews, in any status for
+// an empty one.
+func (uc *ReviewUsecase) followUps(ctx context.Context, rs []*Review, status ReviewStatus) error {
+	if len(rs) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(rs))
+	for _, r := range rs {
+		ids = append(ids, r.ID)
+	}
+	fs, err := uc.repo.FollowUps(ctx, ids, status)
+	if err != nil {
+		return err
+	}
+	for _, r := range rs {
+		r.FollowUp = fs[r.ID]
+	}
+	return nil
+}
@@ -0,0 +1,421 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"time"
+	"unicode/utf8"
+
+	v1 "github.com/go-kratos/kratos-layout/shop/api/shop/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ErrInvalidSearch is returned for a keyword too long, prices out of range,
+// too many filters or a page too deep.
+var ErrInvalidSearch = errors.BadRequest(v1.ErrorReason_INVALID_SEARCH.String(), "invalid search")
+
+const (
+	maxKeywordLength = 100
+	maxSearchFilters = 20
+	// maxSearchWindow is how deep pages of results go.
+	maxSearchWindow    = 10000
+	maxFacetValues     = 20
+	maxAttributeFacets = 10
+	defaultSuggestions = 10
+	maxSuggestions     = 20
+	// syncBatch is how many catalog events one read applies at most.
+	syncBatch = 500
+	// catalogEventLag is how old catalog events are before they are
+	// applied, for the transactions holding lower ids to have committed.
+	catalogEventLag = 5 * time.Second
+	// defaultSearchRetention is how long catalog events are kept when
+	// SearchPolicy leaves it unset.
+	defaultSearchRetention = 7 * 24 * time.Hour
+)
+
+// CatalogEvent records that an SPU changed, for the search indexes to read
+// it again.
+type CatalogEvent struct {
+	ID        int64
+	SpuID     int64
+	CreatedAt time.Time
+}
+
+// CatalogEventRepo keeps the catalog events, in the order of their ids.
+type CatalogEventRepo interface {
+	// Add records a change of an SPU, joining the transaction carried by ctx.
+	Add(ctx context.Context, spuID int64) error
+	// ListAfter lists the events after an id created before a time.
+	ListAfter(ctx context.Context, id int64, before time.Time, limit int) ([]*CatalogEvent, error)
+	// LastID returns the id of the last event, 0 for none.
+	LastID(ctx context.Context) (int64, error)
+	// Prune deletes the events created before a time, and returns how many.
+	Prune(ctx context.Context, before time.Time) (int64, error)
+}
+
+// SearchSort is the order products are searched in.
+type SearchSort string
+
+const (
+	// SearchRelevance lists the most relevant products first, the best
+	// selling ones first without a keyword.
+	SearchRelevance SearchSort = ""
+	// SearchSales lists the best selling products first.
+	SearchSales SearchSort = "sales"
+	// SearchPriceAsc lists the cheapest products first.
+	SearchPriceAsc SearchSort = "price_asc"
+	// SearchPriceDesc lists the dearest products first.
+	SearchPriceDesc SearchSort = "price_desc"
+)
+
+// ProductQuery is a search of the products listed, on the fields set.
+type ProductQuery struct {
+	Keyword string
+	// CategoryID matches the products in it and in the categories below.
+	CategoryID int64
+	// Brands match the products of any of them.
+	Brands []string
+	// MinPrice and MaxPrice bound the lowest price of the products, 0
+	// leaving a side open.
+	MinPrice int64
+	MaxPrice int64
+	// Attributes match the products with each attribute named, with any of
+	// the values given for it; sale attributes match by any of their values.
+	Attributes []Attribute
+	Sort       SearchSort
+	Page       int
+	PageSize   int
+}
+
+// FacetValue is how many products matched hold a value.
+type FacetValue struct {
+	Value string
+	Count int64
+}
+
+// CategoryFacet is how many products matched are in a category or below.
+type CategoryFacet struct {
+	ID    int64
+	Name  string
+	Count int64
+}
+
+// PriceRangeFacet is how many products matched have their lowest price from
+// Min up to Max excluded, Max 0 for no upper bound.
+type PriceRangeFacet struct {
+	Min   int64
+	Max   int64
+	Count int64
+}
+
+// AttributeFacet is the values of an attribute the products matched take.
+type AttributeFacet struct {
+	Name   string
+	Values []*FacetValue
+}
+
+// SearchFacets are the facets of all the products matched, the most frequent
+// values first.
+type SearchFacets struct {
+	Categories  []*CategoryFacet
+	Brands      []*FacetValue
+	PriceRanges []*PriceRangeFacet
+	Attributes  []*AttributeFacet
+}
+
+// SearchHits is what the index returns for a query: the SPU ids of the page
+// asked for, and the facets, categories at every level without names.
+type SearchHits struct {
+	SpuIDs []int64
+	Total  int64
+	Facets *SearchFacets
+}
+
+// SearchResult is a page of the products matched and the facets of all.
+type SearchResult struct {
+	Products []*Spu
+	Total    int64
+	Facets   *SearchFacets
+}
+
+// ProductDocument is what a product is indexed with.
+type ProductDocument struct {
+	Spu *Spu
+	// Categories are the category of the SPU and those above it, top first.
+	Categories []*Category
+}
+
+// SearchIndex is the search index of the products listed, kept by each
+// instance and caught up with the catalog events.
+type SearchIndex interface {
+	// Put indexes a product, replacing it if indexed.
+	Put(ctx context.Context, d *ProductDocument) error
+	// Delete removes a product, if indexed.
+	Delete(ctx context.Context, spuID int64) error
+	// Replace swaps the index for one of docs, synced up to an event.
+	Replace(ctx context.Context, docs []*ProductDocument, eventID int64) error
+	// Search runs a query with the prices faceted on split at priceBounds.
+	Search(ctx context.Context, q *ProductQuery, priceBounds []int64) (*SearchHits, error)
+	// Suggest returns up to limit completions of a prefix.
+	Suggest(ctx context.Context, prefix string, limit int) ([]string, error)
+	// Synced returns the last event applied and when, a zero time for an
+	// index never built.
+	Synced(ctx context.Context) (int64, time.Time, error)
+	// Commit records the events applied up to an id, saving the index now
+	// and then.
+	Commit(ctx context.Context, eventID int64) error
+}
+
+// SearchPolicy is how the search runs.
+type SearchPolicy struct {
+	// PriceBounds split the price ranges faceted on, in cents ascending.
+	PriceBounds []int64
+	// Retention is how long catalog events are kept; an index synced
+	// before that is rebuilt.
+	Retention time.Duration
+}
+
+// SearchUsecase is a search usecase. Each instance keeps a search index of
+// the products listed, built from the database and then caught up with the
+// catalog events; the products found are read from the database.
+type SearchUsecase struct {
+	index       SearchIndex
+	events      CatalogEventRepo
+	spus        SpuRepo
+	categories  CategoryRepo
+	priceBounds []int64
+	retention   time.Duration
+	log         *log.Helper
+}
+
+// NewSearchUsecase new a search usecase.
+func NewSearchUsecase(index SearchIndex, events CatalogEventRepo, spus SpuRepo, categories CategoryRepo, policy *SearchPolicy, logger log.Logger) *SearchUsecase {
+	uc := &SearchUsecase{
+		index:       index,
+		events:      events,
+		spus:        spus,
+		categories:  categories,
+		priceBounds: policy.PriceBounds,
+		retention:   policy.Retention,
+		log:         log.NewHelper(logger),
+	}
+	if uc.retention <= 0 {
+		uc.retention = defaultSearchRetention
+	}
+	return uc
+}
+
+// SearchProducts searches the products listed, with the facets of all the
+// products matched: the categories right below the one searched, or the
+// top ones, the brands, the price ranges and the attributes.
+func (uc *SearchUsecase) SearchProducts(ctx context.Context, q *ProductQuery) (*SearchResult, error) {
+	if utf8.RuneCountInString(q.Keyword) > maxKeywordLength || q.MinPrice < 0 || q.MaxPrice < 0 ||
+		(q.MaxPrice > 0 && q.MaxPrice < q.MinPrice) ||
+		len(q.Brands) > maxSearchFilters || len(q.Attributes) > maxSearchFilters {
+		return nil, ErrInvalidSearch
+	}
+	q.Page, q.PageSize = pagination(q.Page, q.PageSize)
+	if q.Page*q.PageSize > maxSearchWindow {
+		return nil, ErrInvalidSearch
+	}
+	hits, err := uc.index.Search(ctx, q, uc.priceBounds)
+	if err != nil {
+		return nil, err
+	}
+	rv := &SearchResult{Total: hits.Total, Facets: hits.Facets}
+	if len(hits.SpuIDs) > 0 {
+		spus, err := uc.spus.FindByIDs(ctx, hits.SpuIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range hits.SpuIDs {
+			// The index lags behind the catalog by a few seconds.
+			if s, ok := spus[id]; ok && s.Status == SpuListed {
+				rv.Products = append(rv.Products, s)
+			}
+		}
+	}
+	if rv.Facets.Categories, err = uc.categoryFacets(ctx, q.CategoryID, rv.Facets.Categories); err != nil {
+		return nil, err
+	}
+	rv.Facets.Brands = trimFacet(rv.Facets.Brands)
+	if len(rv.Facets.Attributes) > maxAttributeFacets {
+		rv.Facets.Attributes = rv.Facets.Attributes[:maxAttributeFacets]
+	}
+	for _, a := range rv.Facets.Attributes {
+		a.Values = trimFacet(a.Values)
+	}
+	return rv, nil
+}
+
+// categoryFacets keeps the categories right below parent, named and the
+// most frequent first.
+func (uc *SearchUsecase) categoryFacets(ctx context.Context, parentID int64, facets []*CategoryFacet) ([]*CategoryFacet, error) {
+	if len(facets) == 0 {
+		return nil, nil
+	}
+	cs, err := uc.categories.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]*Category, len(cs))
+	for _, c := range cs {
+		byID[c.ID] = c
+	}
+	var rv []*CategoryFacet
+	for _, f := range facets {
+		if c, ok := byID[f.ID]; ok && c.ParentID == parentID {
+			f.Name = c.Name
+			rv = append(rv, f)
+		}
+	}
+	sort.SliceStable(rv, func(i, j int) bool { return rv[i].Count > rv[j].Count })
+	return rv, nil
+}
+
+// SuggestProducts completes a prefix from the titles, brands and categories
+// of the products listed, those of the best selling first.
+func (uc *SearchUsecase) SuggestProducts(ctx context.Context, prefix string, limit int) ([]string, error) {
+	if utf8.RuneCountInString(prefix) > maxKeywordLength {
+		return nil, ErrInvalidSearch
+	}
+	if limit <= 0 || limit > maxSuggestions {
+		limit = defaultSuggestions
+	}
+	return uc.index.Suggest(ctx, prefix, limit)
+}
+
+// Sync catches the index up with the catalog events, or builds it again
+// when it was never built or synced before the events kept, and returns
+// how many products it indexed again.
+func (uc *SearchUsecase) Sync(ctx context.Context) (int, error) {
+	cursor, syncedAt, err := uc.index.Synced(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if syncedAt.IsZero() || time.Since(syncedAt) > uc.retention {
+		return uc.rebuild(ctx)
+	}
+	n := 0
+	for {
+		events, err := uc.events.ListAfter(ctx, cursor, time.Now().Add(-catalogEventLag), syncBatch)
+		if err != nil {
+			return n, err
+		}
+		if len(events) == 0 {
+			return n, uc.index.Commit(ctx, cursor)
+		}
+		seen := make(map[int64]bool, len(events))
+		ids := make([]int64, 0, len(events))
+		for _, e := range events {
+			if !seen[e.SpuID] {
+				seen[e.SpuID] = true
+				ids = append(ids, e.SpuID)
+			}
+		}
+		if err := uc.reindex(ctx, ids); err != nil {
+			return n, err
+		}
+		n += len(ids)
+		cursor = events[len(events)-1].ID
+		if len(events) < syncBatch {
+			return n, uc.index.Commit(ctx, cursor)
+		}
+	}
+}
+
+// reindex indexes SPUs as they are now, removing those deleted or not
+// listed.
+func (uc *SearchUsecase) reindex(ctx context.Context, ids []int64) error {
+	spus, err := uc.spus.FindByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+	var listed []*Spu
+	for _, id := range ids {
+		if s, ok := spus[id]; ok && s.Status == SpuListed {
+			listed = append(listed, s)
+		} else if err := uc.index.Delete(ctx, id); err != nil {
+			return err
+		}
+	}
+	docs, err := uc.documents(ctx, listed)
+	if err != nil {
+		return err
+	}
+	for _, d := range docs {
+		if err := uc.index.Put(ctx, d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rebuild builds the index again from every SPU listed. The events recorded
+// meanwhile are applied again by the next sync.
+func (uc *SearchUsecase) rebuild(ctx context.Context) (int, error) {
+	eventID, err := uc.events.LastID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var docs []*ProductDocument
+	filter := &SpuFilter{Status: SpuListed}
+	for page := 1; ; page++ {
+		spus, _, err := uc.spus.List(ctx, filter, page, syncBatch)
+		if err != nil {
+			return 0, err
+		}
+		ds, err := uc.documents(ctx, spus)
+		if err != nil {
+			return 0, err
+		}
+		docs = append(docs, ds...)
+		if len(spus) < syncBatch {
+			break
+		}
+	}
+	if err := uc.index.Replace(ctx, docs, eventID); err != nil {
+		return 0, err
+	}
+	uc.log.WithContext(ctx).Infof("Sync: rebuilt the search index of %d products up to event %d", len(docs), eventID)
+	return len(docs), nil
+}
+
+// documents returns the documents of SPUs, with the path of their category.
+func (uc *SearchUsecase) documents(ctx context.Context, spus []*Spu) ([]*ProductDocument, error) {
+	if len(spus) == 0 {
+		return nil, nil
+	}
+	cs, err := uc.categories.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]*Category, len(cs))
+	for _, c := range cs {
+		byID[c.ID] = c
+	}
+	docs := make([]*ProductDocument, 0, len(spus))
+	for _, s := range spus {
+		d := &ProductDocument{Spu: s}
+		for c, ok := byID[s.CategoryID]; ok && len(d.Categories) < maxCategoryLevel; c, ok = byID[c.ParentID] {
+			d.Categories = append([]*Category{c}, d.Categories...)
+		}
+		docs = append(docs, d)
+	}
+	return docs, nil
+}
+
+// PruneEvents deletes the catalog events past their retention, and returns
+// how many.
+func (uc *SearchUsecase) PruneEvents(ctx context.Context) (int64, error) {
+	return uc.events.Prune(ctx, time.Now().Add(-uc.retention))
+}
+
+func trimFacet(values []*FacetValue) []*FacetValue {
+	if len(values) > maxFacetValues {
+		return values[:maxFacetValues]
+	}
+	return values
+}
@@ -0,0 +1,462 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	v1 "github.com/go-kratos/kratos-layout/shop/api/shop/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrShippingTemplateNotFound is shipping template not found, also for
+	// a template of another merchant.
+	ErrShippingTemplateNotFound = errors.NotFound(v1.ErrorReason_SHIPPING_TEMPLATE_NOT_FOUND.String(), "shipping template not found")
+	// ErrInvalidShippingTemplate is returned for a template whose rules are
+	// wrong, overlap, or leave out the default rule.
+	ErrInvalidShippingTemplate = errors.BadRequest(v1.ErrorReason_INVALID_SHIPPING_TEMPLATE.String(), "invalid shipping template")
+	// ErrShippingTemplateConflict is returned when a template changed since
+	// it was read.
+	ErrShippingTemplateConflict = errors.Conflict(v1.ErrorReason_SHIPPING_TEMPLATE_VERSION_CONFLICT.String(), "shipping template changed concurrently")
+	// ErrShippingTemplateInUse is returned when deleting a template SPUs
+	// ship with, or the default template of a merchant with others.
+	ErrShippingTemplateInUse = errors.Conflict(v1.ErrorReason_SHIPPING_TEMPLATE_IN_USE.String(), "shipping template in use")
+	// ErrRegionNotDelivered is returned when pricing the freight of items
+	// to a region their template does not deliver to.
+	ErrRegionNotDelivered = errors.BadRequest(v1.ErrorReason_REGION_NOT_DELIVERED.String(), "region not delivered")
+)
+
+const (
+	maxTemplateNameLength = 50
+	maxFreightRules       = 50
+	maxFreeShippingRules  = 20
+	maxTemplateRegions    = 100
+)
+
+// FreightMethod is what the freight of a template is charged by.
+type FreightMethod string
+
+const (
+	// FreightByCount charges by the pieces shipped.
+	FreightByCount FreightMethod = "count"
+	// FreightByWeight charges by the grams shipped, the weights of the SKUs
+	// times their quantities.
+	FreightByWeight FreightMethod = "weight"
+)
+
+// FreightRule is the freight charged to some regions: FirstFee for the
+// first First units, pieces or grams, then AdditionalFee for each
+// Additional units started beyond.
+type FreightRule struct {
+	// Regions are the provinces the rule charges, none for the default rule
+	// charging the provinces no other rule has.
+	Regions       []string
+	First         int64
+	FirstFee      int64
+	Additional    int64
+	AdditionalFee int64
+}
+
+func (r *FreightRule) validate() error {
+	if r.First <= 0 || r.FirstFee < 0 || r.Additional < 0 || r.AdditionalFee < 0 ||
+		r.AdditionalFee > 0 && r.Additional == 0 {
+		return ErrInvalidShippingTemplate
+	}
+	return nil
+}
+
+// fee is what the rule charges for units.
+func (r *FreightRule) fee(units int64) int64 {
+	if units <= 0 {
+		return 0
+	}
+	fee := r.FirstFee
+	if units > r.First && r.Additional > 0 {
+		fee += (units - r.First + r.Additional - 1) / r.Additional * r.AdditionalFee
+	}
+	return fee
+}
+
+// FreeShippingRule waives the freight of the items of a template to some
+// regions costing MinAmount or more and counting MinQuantity pieces or
+// more, a zero minimum asking for nothing.
+type FreeShippingRule struct {
+	// Regions are the provinces the rule waives the freight to, none for
+	// all of them.
+	Regions     []string
+	MinAmount   int64
+	MinQuantity int64
+}
+
+func (r *FreeShippingRule) matches(province string, amount, quantity int64) bool {
+	if len(r.Regions) > 0 && !slices.Contains(r.Regions, province) {
+		return false
+	}
+	return amount >= r.MinAmount && quantity >= r.MinQuantity
+}
+
+// ShippingTemplate is how a merchant charges the freight of the SPUs
+// shipping with it. SPUs without a template ship with the default template
+// of their merchant, and for free when it has none.
+type ShippingTemplate struct {
+	ID         int64
+	MerchantID int64
+	Name       string
+	Method     FreightMethod
+	// Rules are the freight charged to each region, one of them the
+	// default rule.
+	Rules     []FreightRule
+	FreeRules []FreeShippingRule
+	// Excluded are the provinces not delivered to.
+	Excluded []string
+	// Default is whether the template is the default of its merchant.
+	Default   bool
+	Version   int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (t *ShippingTemplate) validate() error {
+	if t.MerchantID <= 0 || t.Name == "" || utf8.RuneCountInString(t.Name) > maxTemplateNameLength ||
+		t.Method != FreightByCount && t.Method != FreightByWeight ||
+		len(t.Rules) == 0 || len(t.Rules) > maxFreightRules || len(t.FreeRules) > maxFreeShippingRules {
+		return ErrInvalidShippingTemplate
+	}
+	// Each province is charged by one rule at most, and not if excluded.
+	seen := make(map[string]bool)
+	regions := func(rs []string) error {
+		if len(rs) > maxTemplateRegions {
+			return ErrInvalidShippingTemplate
+		}
+		for _, r := range rs {
+			if r == "" || utf8.RuneCountInString(r) > maxNameLength || seen[r] {
+				return ErrInvalidShippingTemplate
+			}
+			seen[r] = true
+		}
+		return nil
+	}
+	if err := regions(t.Excluded); err != nil {
+		return err
+	}
+	defaults := 0
+	for i := range t.Rules {
+		r := &t.Rules[i]
+		if err := r.validate(); err != nil {
+			return err
+		}
+		if len(r.Regions) == 0 {
+			defaults++
+		}
+		if err := regions(r.Regions); err != nil {
+			return err
+		}
+	}
+	if defaults != 1 {
+		return ErrInvalidShippingTemplate
+	}
+	for _, r := range t.FreeRules {
+		if r.MinAmount < 0 || r.MinQuantity < 0 || len(r.Regions) > maxTemplateRegions {
+			return ErrInvalidShippingTemplate
+		}
+	}
+	return nil
+}
+
+// rule returns the rule charging a province, the default rule for one no
+// rule has or for none.
+func (t *ShippingTemplate) rule(province string) *FreightRule {
+	var def *FreightRule
+	for i := range t.Rules {
+		r := &t.Rules[i]
+		if len(r.Regions) == 0 {
+			def = r
+		} else if province != "" && slices.Contains(r.Regions, province) {
+			return r
+		}
+	}
+	return def
+}
+
+// freight is what the template charges for units of items costing amount
+// and counting quantity pieces, shipped to a province.
+func (t *ShippingTemplate) freight(province string, units, amount, quantity int64) (int64, error) {
+	if province != "" && slices.Contains(t.Excluded, province) {
+		return 0, ErrRegionNotDelivered
+	}
+	for _, r := range t.FreeRules {
+		if r.matches(province, amount, quantity) {
+			return 0, nil
+		}
+	}
+	return t.rule(province).fee(units), nil
+}
+
+// FreightItem is an item to price the freight of, costing Amount.
+type FreightItem struct {
+	SkuID    int64
+	Quantity int64
+	Amount   int64
+}
+
+// Freight is the freight of the items of a merchant.
+type Freight struct {
+	MerchantID int64
+	Fee        int64
+}
+
+// ShippingTemplateRepo is a shipping template repo.
+type ShippingTemplateRepo interface {
+	Save(ctx context.Context, t *ShippingTemplate) (*ShippingTemplate, error)
+	// Update saves a template at its version and bumps it, or returns
+	// ErrShippingTemplateConflict.
+	Update(ctx context.Context, t *ShippingTemplate) error
+	Delete(ctx context.Context, id int64) error
+	Find(ctx context.Context, id int64) (*ShippingTemplate, error)
+	FindByIDs(ctx context.Context, ids []int64) (map[int64]*ShippingTemplate, error)
+	// FindDefaults returns the default templates of merchants by merchant.
+	FindDefaults(ctx context.Context, merchantIDs []int64) (map[int64]*ShippingTemplate, error)
+	ListByMerchant(ctx context.Context, merchantID int64) ([]*ShippingTemplate, error)
+	// ClearDefault makes the templates of a merchant but one not default.
+	ClearDefault(ctx context.Context, merchantID, keepID int64) error
+}
+
+// ShippingUsecase is a shipping usecase. Merchants define the templates
+// their SPUs ship with, and the orders price the freight of their items
+// with them.
+type ShippingUsecase struct {
+	templates ShippingTemplateRepo
+	spus      SpuRepo
+	skus      SkuRepo
+	tx        Transaction
+	log       *log.Helper
+}
+
+// NewShippingUsecase new a shipping usecase.
+func NewShippingUsecase(templates ShippingTemplateRepo, spus SpuRepo, skus SkuRepo, tx Transaction, logger log.Logger) *ShippingUsecase {
+	return &ShippingUsecase{
+		templates: templates,
+		spus:      spus,
+		skus:      skus,
+		tx:        tx,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// CreateTemplate creates a template of a merchant, its default when asked
+// or when it is the first.
+func (uc *ShippingUsecase) CreateTemplate(ctx context.Context, t *ShippingTemplate) (*ShippingTemplate, error) {
+	if err := t.validate(); err != nil {
+		return nil, err
+	}
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		existing, err := uc.templates.ListByMerchant(ctx, t.MerchantID)
+		if err != nil {
+			return err
+		}
+		t.Default = t.Default || len(existing) == 0
+		if t, err = uc.templates.Save(ctx, t); err != nil {
+			return err
+		}
+		if t.Default {
+			return uc.templates.ClearDefault(ctx, t.MerchantID, t.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("CreateTemplate: %d of merchant %d by %s", t.ID, t.MerchantID, t.Method)
+	return t, nil
+}
+
+// UpdateTemplate changes a template of a merchant at the version it was
+// read. A template is made the default of its merchant by another being
+// made so, not by being unmarked.
+func (uc *ShippingUsecase) UpdateTemplate(ctx context.Context, t *ShippingTemplate) (*ShippingTemplate, error) {
+	if err := t.validate(); err != nil {
+		return nil, err
+	}
+	var old *ShippingTemplate
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		if old, err = uc.findTemplate(ctx, t.MerchantID, t.ID); err != nil {
+			return err
+		}
+		if old.Version != t.Version {
+			return ErrShippingTemplateConflict
+		}
+		old.Name, old.Method, old.Rules, old.FreeRules, old.Excluded = t.Name, t.Method, t.Rules, t.FreeRules, t.Excluded
+		old.Default = old.Default || t.Default
+		if err := uc.templates.Update(ctx, old); err != nil {
+			return err
+		}
+		if old.Default {
+			return uc.templates.ClearDefault(ctx, old.MerchantID, old.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("UpdateTemplate: %d of merchant %d", old.ID, old.MerchantID)
+	return old, nil
+}
+
+// DeleteTemplate deletes a template of a merchant no SPU ships with. The
+// default template is deleted last, the SPUs without a template shipping
+// for free after.
+func (uc *ShippingUsecase) DeleteTemplate(ctx context.Context, merchantID, id int64) error {
+	return uc.tx.InTx(ctx, func(ctx context.Context) error {
+		t, err := uc.findTemplate(ctx, merchantID, id)
+		if err != nil {
+			return err
+		}
+		n, err := uc.spus.CountByShippingTemplate(ctx, id)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			return ErrShippingTemplateInUse
+		}
+		if t.Default {
+			all, err := uc.templates.ListByMerchant(ctx, merchantID)
+			if err != nil {
+				return err
+			}
+			if len(all) > 1 {
+				return ErrShippingTemplateInUse
+			}
+		}
+		return uc.templates.Delete(ctx, id)
+	})
+}
+
+// GetTemplate returns a template of a merchant.
+func (uc *ShippingUsecase) GetTemplate(ctx context.Context, merchantID, id int64) (*ShippingTemplate, error) {
+	return uc.findTemplate(ctx, merchantID, id)
+}
+
+// ListTemplates lists the templates of a merchant, oldest first.
+func (uc *ShippingUsecase) ListTemplates(ctx context.Context, merchantID int64) ([]*ShippingTemplate, error) {
+	return uc.templates.ListByMerchant(ctx, merchantID)
+}
+
+// CalculateFreight prices the freight of items shipped to a province, one
+// fee per merchant in the order the merchants first appear in. The items of
+// a merchant are charged by template, each template charging its items
+// together, and the fees of its templates add up. Without a province the
+// default rules charge and no region is refused, for carts priced before
+// the address is known.
+func (uc *ShippingUsecase) CalculateFreight(ctx context.Context, province string, items []*FreightItem) ([]*Freight, error) {
+	if len(items) == 0 || len(items) > maxBatchSkus {
+		return nil, ErrInvalidSku
+	}
+	ids := make([]int64, 0, len(items))
+	for _, it := range items {
+		if it.Quantity <= 0 || it.Amount < 0 {
+			return nil, ErrInvalidSku
+		}
+		ids = append(ids, it.SkuID)
+	}
+	found, err := uc.skus.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	skus := make(map[int64]*Sku, len(found))
+	spuIDs := make([]int64, 0, len(found))
+	merchantIDs := make([]int64, 0, len(found))
+	for _, k := range found {
+		skus[k.ID] = k
+		spuIDs = append(spuIDs, k.SpuID)
+		merchantIDs = append(merchantIDs, k.MerchantID)
+	}
+	spus, err := uc.spus.FindByIDs(ctx, spuIDs)
+	if err != nil {
+		return nil, err
+	}
+	var templateIDs []int64
+	for _, s := range spus {
+		if s.ShippingTemplateID != 0 {
+			templateIDs = append(templateIDs, s.ShippingTemplateID)
+		}
+	}
+	templates := map[int64]*ShippingTemplate{}
+	if len(templateIDs) > 0 {
+		if templates, err = uc.templates.FindByIDs(ctx, templateIDs); err != nil {
+			return nil, err
+		}
+	}
+	defaults, err := uc.templates.FindDefaults(ctx, merchantIDs)
+	if err != nil {
+		return nil, err
+	}
+	type charge struct {
+		template                *ShippingTemplate
+		units, amount, quantity int64
+		skuID                   int64
+	}
+	var freights []*Freight
+	charges := make(map[int64][]*charge)
+	for _, it := range items {
+		k, ok := skus[it.SkuID]
+		if !ok {
+			return nil, ErrSkuNotFound.WithMetadata(map[string]string{"sku_id": strconv.FormatInt(it.SkuID, 10)})
+		}
+		if _, ok := charges[k.MerchantID]; !ok {
+			freights = append(freights, &Freight{MerchantID: k.MerchantID})
+			charges[k.MerchantID] = nil
+		}
+		t := defaults[k.MerchantID]
+		if s, ok := spus[k.SpuID]; ok && templates[s.ShippingTemplateID] != nil {
+			t = templates[s.ShippingTemplateID]
+		}
+		if t == nil {
+			continue
+		}
+		var c *charge
+		for _, cc := range charges[k.MerchantID] {
+			if cc.template == t {
+				c = cc
+			}
+		}
+		if c == nil {
+			c = &charge{template: t, skuID: k.ID}
+			charges[k.MerchantID] = append(charges[k.MerchantID], c)
+		}
+		units := it.Quantity
+		if t.Method == FreightByWeight {
+			units *= k.Weight
+		}
+		c.units += units
+		c.amount += it.Amount
+		c.quantity += it.Quantity
+	}
+	for _, f := range freights {
+		for _, c := range charges[f.MerchantID] {
+			fee, err := c.template.freight(province, c.units, c.amount, c.quantity)
+			if err != nil {
+				return nil, ErrRegionNotDelivered.WithMetadata(map[string]string{"sku_id": strconv.FormatInt(c.skuID, 10)})
+			}
+			f.Fee += fee
+		}
+	}
+	return freights, nil
+}
+
+// findTemplate returns a template of a merchant.
+func (uc *ShippingUsecase) findTemplate(ctx context.Context, merchantID, id int64) (*ShippingTemplate, error) {
+	t, err := uc.templates.Find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if t.MerchantID != merchantID {
+		return nil, ErrShippingTemplateNotFound
+	}
+	return t, nil
+}
@@ -0,0 +1,337 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Spu is the spus table.
+type Spu struct {
+	ID                 int64               `gorm:"primaryKey"`
+	MerchantID         int64               `gorm:"index"`
+	CategoryID         int64               `gorm:"index:idx_spus_listing,priority:2"`
+	Title              string              `gorm:"size:255"`
+	SubTitle           string              `gorm:"size:255"`
+	Brand              string              `gorm:"size:64"`
+	Description        string              `gorm:"type:text"`
+	Images             []string            `gorm:"serializer:json;type:text"`
+	Attributes         []biz.Attribute     `gorm:"serializer:json;type:text"`
+	SaleAttributes     []biz.SaleAttribute `gorm:"serializer:json;type:text"`
+	ShippingTemplateID int64               `gorm:"index"`
+	Status             string              `gorm:"size:16;index:idx_spus_listing,priority:1"`
+	MinPrice           int64
+	MaxPrice           int64
+	Sales              int64
+	Version            int64
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// Sku is the skus table. AttrKey is the combination of the values of the
+// sale attributes, see biz.Sku.Key.
+type Sku struct {
+	ID             int64           `gorm:"primaryKey"`
+	SpuID          int64           `gorm:"uniqueIndex:idx_skus_spu_key,priority:1"`
+	MerchantID     int64           `gorm:"index"`
+	AttrKey        string          `gorm:"size:512;uniqueIndex:idx_skus_spu_key,priority:2"`
+	SaleAttributes []biz.Attribute `gorm:"serializer:json;type:text"`
+	Image          string          `gorm:"size:512"`
+	Code           string          `gorm:"size:64"`
+	Price          int64
+	Weight         int64
+	Stock          int64
+	Enabled        bool
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type spuRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSpuRepo .
+func NewSpuRepo(data *Data, logger log.Logger) biz.SpuRepo {
+	return &spuRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *spuRepo) Save(ctx context.Context, s *biz.Spu) (*biz.Spu, error) {
+	po := toSpuPO(s)
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return nil, err
+	}
+	return toSpu(po), nil
+}
+
+func (r *spuRepo) Update(ctx context.Context, s *biz.Spu) error {
+	po := toSpuPO(s)
+	po.Version++
+	res := r.data.DB(ctx).Model(po).Where("version = ?", s.Version).
+		Select("category_id", "title", "sub_title", "brand", "description", "images", "attributes",
+			"sale_attributes", "shipping_template_id", "status", "min_price", "max_price", "version", "updated_at").
+		Updates(po)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return biz.ErrSpuConflict
+	}
+	s.Version = po.Version
+	return nil
+}
+
+func (r *spuRepo) Delete(ctx context.Context, id int64) error {
+	return r.data.DB(ctx).Delete(&Spu{}, id).Error
+}
+
+func (r *spuRepo) Find(ctx context.Context, id int64) (*biz.Spu, error) {
+	var po Spu
+	err := r.data.DB(ctx).First(&po, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrSpuNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toSpu(&po), nil
+}
+
+func (r *spuRepo) FindByIDs(ctx context.Context, ids []int64) (map[int64]*biz.Spu, error) {
+	var pos []*Spu
+	if err := r.data.DB(ctx).Where("id IN ?", ids).Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	rv := make(map[int64]*biz.Spu, len(pos))
+	for _, po := range pos {
+		rv[po.ID] = toSpu(po)
+	}
+	return rv, nil
+}
+
+func (r *spuRepo) List(ctx context.Context, filter *biz.SpuFilter, page, pageSize int) ([]*biz.Spu, int64, error) {
+	db := r.data.DB(ctx).Model(&Spu{})
+	if filter.MerchantID != 0 {
+		db = db.Where("merchant_id = ?", filter.MerchantID)
+	}
+	if len(filter.CategoryIDs) > 0 {
+		db = db.Where("category_id IN ?", filter.CategoryIDs)
+	}
+	if filter.Status != "" {
+		db = db.Where("status = ?", string(filter.Status))
+	}
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	switch filter.Sort {
+	case biz.SortPriceAsc:
+		db = db.Order("min_price").Order("id DESC")
+	case biz.SortPriceDesc:
+		db = db.Order("max_price DESC").Order("id DESC")
+	default:
+		db = db.Order("id DESC")
+	}
+	var pos []*Spu
+	if err := db.Offset((page - 1) * pageSize).Limit(pageSize).Find(&pos).Error; err != nil {
+		return nil, 0, err
+	}
+	rv := make([]*biz.Spu, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toSpu(po))
+	}
+	return rv, total, nil
+}
+
+func (r *spuRepo) CountByCategory(ctx context.Context, categoryID int64) (int64, error) {
+	var n int64
+	err := r.data.DB(ctx).Model(&Spu{}).Where("category_id = ?", categoryID).Count(&n).Error
+	return n, err
+}
+
+func (r *spuRepo) CountByShippingTemplate(ctx context.Context, templateID int64) (int64, error) {
+	var n int64
+	err := r.data.DB(ctx).Model(&Spu{}).Where("shipping_template_id = ?", templateID).Count(&n).Error
+	return n, err
+}
+
+func (r *spuRepo) DelistByMerchant(ctx context.Context, merchantID int64) ([]int64, error) {
+	var ids []int64
+	// The rows are locked, so that the ids returned are those delisted.
+	err := r.data.DB(ctx).Model(&Spu{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("merchant_id = ? AND status = ?", merchantID, string(biz.SpuListed)).
+		Pluck("id", &ids).Error
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+	err = r.data.DB(ctx).Model(&Spu{}).Where("id IN ?", ids).Updates(map[string]any{
+		"status":     string(biz.SpuDelisted),
+		"version":    gorm.Expr("version + 1"),
+		"updated_at": time.Now(),
+	}).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+type skuRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSkuRepo .
+func NewSkuRepo(data *Data, logger log.Logger) biz.SkuRepo {
+	return &skuRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *skuRepo) SaveAll(ctx context.Context, skus []*biz.Sku) error {
+	pos := make([]*Sku, 0, len(skus))
+	for _, k := range skus {
+		pos = append(pos, toSkuPO(k))
+	}
+	if err := r.data.DB(ctx).Create(pos).Error; err != nil {
+		return err
+	}
+	for i, po := range pos {
+		skus[i].ID, skus[i].CreatedAt, skus[i].UpdatedAt = po.ID, po.CreatedAt, po.UpdatedAt
+	}
+	return nil
+}
+
+func (r *skuRepo) Update(ctx context.Context, k *biz.Sku) error {
+	po := toSkuPO(k)
+	return r.data.DB(ctx).Model(po).
+		Select("image", "code", "price", "weight", "enabled", "updated_at").
+		Updates(po).Error
+}
+
+func (r *skuRepo) Find(ctx context.Context, id int64) (*biz.Sku, error) {
+	var po Sku
+	err := r.data.DB(ctx).First(&po, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrSkuNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toSku(&po), nil
+}
+
+func (r *skuRepo) FindByIDs(ctx context.Context, ids []int64) ([]*biz.Sku, error) {
+	var pos []*Sku
+	if err := r.data.DB(ctx).Where("id IN ?", ids).Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	rv := make([]*biz.Sku, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toSku(po))
+	}
+	return rv, nil
+}
+
+func (r *skuRepo) ListBySpu(ctx context.Context, spuID int64) ([]*biz.Sku, error) {
+	var pos []*Sku
+	if err := r.data.DB(ctx).Where("spu_id = ?", spuID).Order("id").Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	rv := make([]*biz.Sku, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toSku(po))
+	}
+	return rv, nil
+}
+
+func (r *skuRepo) DeleteBySpu(ctx context.Context, spuID int64) error {
+	return r.data.DB(ctx).Where("spu_id = ?", spuID).Delete(&Sku{}).Error
+}
+
+func toSpuPO(s *biz.Spu) *Spu {
+	return &Spu{
+		ID:                 s.ID,
+		MerchantID:         s.MerchantID,
+		CategoryID:         s.CategoryID,
+		Title:              s.Title,
+		SubTitle:           s.SubTitle,
+		Brand:              s.Brand,
+		Description:        s.Description,
+		Images:             s.Images,
+		Attributes:         s.Attributes,
+		SaleAttributes:     s.SaleAttributes,
+		ShippingTemplateID: s.ShippingTemplateID,
+		Status:             string(s.Status),
+		MinPrice:           s.MinPrice,
+		MaxPrice:           s.MaxPrice,
+		Version:            s.Version,
+		CreatedAt:          s.CreatedAt,
+	}
+}
+
+func toSpu(po *Spu) *biz.Spu {
+	return &biz.Spu{
+		ID:                 po.ID,
+		MerchantID:         po.MerchantID,
+		CategoryID:         po.CategoryID,
+		Title:              po.Title,
+		SubTitle:           po.SubTitle,
+		Brand:              po.Brand,
+		Description:        po.Description,
+		Images:             po.Images,
+		Attributes:         po.Attributes,
+		SaleAttributes:     po.SaleAttributes,
+		ShippingTemplateID: po.ShippingTemplateID,
+		Status:             biz.SpuStatus(po.Status),
+		MinPrice:           po.MinPrice,
+		MaxPrice:           po.MaxPrice,
+		Sales:              po.Sales,
+		Version:            po.Version,
+		CreatedAt:          po.CreatedAt,
+		UpdatedAt:          po.UpdatedAt,
+	}
+}
+
+func toSkuPO(k *biz.Sku) *Sku {
+	return &Sku{
+		ID:             k.ID,
+		SpuID:          k.SpuID,
+		MerchantID:     k.MerchantID,
+		AttrKey:        k.Key(),
+		SaleAttributes: k.SaleAttributes,
+		Image:          k.Image,
+		Code:           k.Code,
+		Price:          k.Price,
+		Weight:         k.Weight,
+		Stock:          k.Stock,
+		Enabled:        k.Enabled,
+		CreatedAt:      k.CreatedAt,
+	}
+}
+
+func toSku(po *Sku) *biz.Sku {
+	return &biz.Sku{
+		ID:             po.ID,
+		SpuID:          po.SpuID,
+		MerchantID:     po.MerchantID,
+		SaleAttributes: po.SaleAttributes,
+		Image:          po.Image,
+		Code:           po.Code,
+		Price:          po.Price,
+		Weight:         po.Weight,
+		Stock:          po.Stock,
+		Enabled:        po.Enabled,
+		CreatedAt:      po.CreatedAt,
+		UpdatedAt:      po.UpdatedAt,
+	}
+}
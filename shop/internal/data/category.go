@@ -0,0 +1,93 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// Category is the categories table.
+type Category struct {
+	ID        int64  `gorm:"primaryKey"`
+	ParentID  int64  `gorm:"index"`
+	Name      string `gorm:"size:64"`
+	Sort      int32
+	Level     int32
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type categoryRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewCategoryRepo .
+func NewCategoryRepo(data *Data, logger log.Logger) biz.CategoryRepo {
+	return &categoryRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *categoryRepo) Save(ctx context.Context, c *biz.Category) (*biz.Category, error) {
+	po := &Category{ParentID: c.ParentID, Name: c.Name, Sort: c.Sort, Level: c.Level}
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return nil, err
+	}
+	return toCategory(po), nil
+}
+
+func (r *categoryRepo) Update(ctx context.Context, c *biz.Category) error {
+	return r.data.DB(ctx).Model(&Category{ID: c.ID}).
+		Updates(map[string]any{"name": c.Name, "sort": c.Sort}).Error
+}
+
+func (r *categoryRepo) Delete(ctx context.Context, id int64) error {
+	return r.data.DB(ctx).Delete(&Category{}, id).Error
+}
+
+func (r *categoryRepo) Find(ctx context.Context, id int64) (*biz.Category, error) {
+	var po Category
+	err := r.data.DB(ctx).First(&po, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrCategoryNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toCategory(&po), nil
+}
+
+func (r *categoryRepo) ListAll(ctx context.Context) ([]*biz.Category, error) {
+	var pos []*Category
+	if err := r.data.DB(ctx).Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	rv := make([]*biz.Category, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toCategory(po))
+	}
+	return rv, nil
+}
+
+func (r *categoryRepo) CountChildren(ctx context.Context, id int64) (int64, error) {
+	var n int64
+	err := r.data.DB(ctx).Model(&Category{}).Where("parent_id = ?", id).Count(&n).Error
+	return n, err
+}
+
+func toCategory(po *Category) *biz.Category {
+	return &biz.Category{
+		ID:       po.ID,
+		ParentID: po.ParentID,
+		Name:     po.Name,
+		Sort:     po.Sort,
+		Level:    po.Level,
+	}
+}
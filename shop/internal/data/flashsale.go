@@ -0,0 +1,173 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// FlashSale is the flash_sales table.
+type FlashSale struct {
+	ID            int64 `gorm:"primaryKey"`
+	MerchantID    int64 `gorm:"index"`
+	SpuID         int64
+	SkuID         int64  `gorm:"index"`
+	Title         string `gorm:"size:255"`
+	Image         string `gorm:"size:512"`
+	OriginalPrice int64
+	Price         int64
+	Stock         int64
+	PerUserLimit  int32
+	StartAt       time.Time `gorm:"index:idx_flash_sales_schedule,priority:2"`
+	EndAt         time.Time
+	Status        string `gorm:"size:16;index:idx_flash_sales_schedule,priority:1"`
+	Version       int64
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NewFlashSalePolicy .
+func NewFlashSalePolicy(c *conf.Shop) *biz.FlashSalePolicy {
+	return &biz.FlashSalePolicy{
+		WarmAhead: c.GetFlashSale().GetWarmAhead().AsDuration(),
+	}
+}
+
+type flashSaleRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewFlashSaleRepo .
+func NewFlashSaleRepo(data *Data, logger log.Logger) biz.FlashSaleRepo {
+	return &flashSaleRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *flashSaleRepo) Save(ctx context.Context, f *biz.FlashSale) (*biz.FlashSale, error) {
+	po := toFlashSalePO(f)
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return nil, err
+	}
+	return toFlashSale(po), nil
+}
+
+func (r *flashSaleRepo) Update(ctx context.Context, f *biz.FlashSale) error {
+	po := toFlashSalePO(f)
+	po.Version++
+	res := r.data.DB(ctx).Model(po).Where("version = ?", f.Version).
+		Select("price", "stock", "per_user_limit", "start_at", "end_at", "status", "version", "updated_at").
+		Updates(po)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return biz.ErrFlashSaleConflict
+	}
+	f.Version, f.UpdatedAt = po.Version, po.UpdatedAt
+	return nil
+}
+
+func (r *flashSaleRepo) Find(ctx context.Context, id int64) (*biz.FlashSale, error) {
+	var po FlashSale
+	err := r.data.DB(ctx).First(&po, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrFlashSaleNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toFlashSale(&po), nil
+}
+
+func (r *flashSaleRepo) List(ctx context.Context, filter *biz.FlashSaleFilter, latest bool, page, pageSize int) ([]*biz.FlashSale, int64, error) {
+	db := r.data.DB(ctx).Model(&FlashSale{})
+	if filter.MerchantID != 0 {
+		db = db.Where("merchant_id = ?", filter.MerchantID)
+	}
+	if filter.Status != "" {
+		db = db.Where("status = ?", string(filter.Status))
+	}
+	if !filter.EndsAfter.IsZero() {
+		db = db.Where("end_at > ?", filter.EndsAfter)
+	}
+	if !filter.StartsBefore.IsZero() {
+		db = db.Where("start_at < ?", filter.StartsBefore)
+	}
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if latest {
+		db = db.Order("start_at DESC").Order("id DESC")
+	} else {
+		db = db.Order("start_at").Order("id")
+	}
+	var pos []*FlashSale
+	if err := db.Offset((page - 1) * pageSize).Limit(pageSize).Find(&pos).Error; err != nil {
+		return nil, 0, err
+	}
+	rv := make([]*biz.FlashSale, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toFlashSale(po))
+	}
+	return rv, total, nil
+}
+
+func (r *flashSaleRepo) Overlaps(ctx context.Context, skuID, exceptID int64, start, end time.Time) (bool, error) {
+	var n int64
+	err := r.data.DB(ctx).Model(&FlashSale{}).
+		Where("sku_id = ? AND id <> ? AND status = ?", skuID, exceptID, string(biz.FlashSaleScheduled)).
+		Where("start_at < ? AND end_at > ?", end, start).
+		Count(&n).Error
+	return n > 0, err
+}
+
+func toFlashSalePO(f *biz.FlashSale) *FlashSale {
+	return &FlashSale{
+		ID:            f.ID,
+		MerchantID:    f.MerchantID,
+		SpuID:         f.SpuID,
+		SkuID:         f.SkuID,
+		Title:         f.Title,
+		Image:         f.Image,
+		OriginalPrice: f.OriginalPrice,
+		Price:         f.Price,
+		Stock:         f.Stock,
+		PerUserLimit:  f.PerUserLimit,
+		StartAt:       f.StartAt,
+		EndAt:         f.EndAt,
+		Status:        string(f.Status),
+		Version:       f.Version,
+		CreatedAt:     f.CreatedAt,
+	}
+}
+
+func toFlashSale(po *FlashSale) *biz.FlashSale {
+	return &biz.FlashSale{
+		ID:            po.ID,
+		MerchantID:    po.MerchantID,
+		SpuID:         po.SpuID,
+		SkuID:         po.SkuID,
+		Title:         po.Title,
+		Image:         po.Image,
+		OriginalPrice: po.OriginalPrice,
+		Price:         po.Price,
+		Stock:         po.Stock,
+		PerUserLimit:  po.PerUserLimit,
+		StartAt:       po.StartAt,
+		EndAt:         po.EndAt,
+		Status:        biz.FlashSaleStatus(po.Status),
+		Version:       po.Version,
+		CreatedAt:     po.CreatedAt,
+		UpdatedAt:     po.UpdatedAt,
+	}
+}
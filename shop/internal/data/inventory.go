@@ -0,0 +1,846 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// defaultRetention is how long reservations settled are kept in redis
+	// by default.
+	defaultRetention = 7 * 24 * time.Hour
+	// inventoryKeyPrefix prefixes the inventory keys, its hash tag keeping
+	// them in one slot for the scripts to touch them together.
+	inventoryKeyPrefix = "shop:{inventory}:"
+	// stockKeyPrefix prefixes the stock left of each SKU.
+	stockKeyPrefix = inventoryKeyPrefix + "stock:"
+	// reservationKeyPrefix prefixes the hash of each reservation.
+	reservationKeyPrefix = inventoryKeyPrefix + "reservation:"
+	// adjustKeyPrefix prefixes the mark of a SKU whose stock is adjusted.
+	adjustKeyPrefix = inventoryKeyPrefix + "adjusting:"
+	// writeBackKey lists the reservations changed to write back.
+	writeBackKey = inventoryKeyPrefix + "writeback"
+	// writingBackKey lists the reservations being written back.
+	writingBackKey = inventoryKeyPrefix + "writeback:processing"
+	// writeBackSeqKey counts the changes queued to write back.
+	writeBackSeqKey = inventoryKeyPrefix + "writeback:seq"
+	// writeBackRetry is how long a reservation failing to write back waits.
+	writeBackRetry = time.Second
+	// adjustMark is how long a SKU stays marked while its stock is adjusted,
+	// should the adjustment die halfway.
+	adjustMark = 30 * time.Second
+	// reconcileBatch is how many SKUs one reconciliation round compares.
+	reconcileBatch = 500
+	// inventoryAttempts bounds the attempts of a script racing with another.
+	inventoryAttempts = 3
+)
+
+// Reservation is the reservations table.
+type Reservation struct {
+	ID            int64            `gorm:"primaryKey"`
+	ReservationNo string           `gorm:"size:64;uniqueIndex"`
+	Items         []*biz.StockItem `gorm:"serializer:json;type:text"`
+	Status        string           `gorm:"size:16"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NewInventoryRepo returns the inventory repo of the configured kind.
+func NewInventoryRepo(c *conf.Data, data *Data, logger log.Logger) (biz.InventoryRepo, error) {
+	db := &sqlInventoryRepo{data: data, log: log.NewHelper(logger)}
+	switch kind := c.GetInventory().GetKind(); kind {
+	case "", "redis":
+		retention := c.GetInventory().GetRetention().AsDuration()
+		if retention <= 0 {
+			retention = defaultRetention
+		}
+		return &redisInventoryRepo{data: data, db: db, retention: retention, log: log.NewHelper(logger)}, nil
+	case "sql":
+		return db, nil
+	default:
+		return nil, fmt.Errorf("unknown inventory repo %q", kind)
+	}
+}
+
+// sqlInventoryRepo keeps the stock in the skus table and takes it with
+// conditional updates, strictly but a row lock per SKU at a time.
+type sqlInventoryRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func (r *sqlInventoryRepo) Reserve(ctx context.Context, res *biz.Reservation) (*biz.Reservation, error) {
+	po := &Reservation{ReservationNo: res.ReservationNo, Items: res.Items, Status: string(biz.ReservationReserved)}
+	err := r.data.InTx(ctx, func(ctx context.Context) error {
+		if err := r.data.DB(ctx).Create(po).Error; err != nil {
+			return err
+		}
+		for _, it := range res.Items {
+			ok, err := r.take(ctx, it.SkuID, it.Quantity)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return biz.InsufficientStock(it.SkuID)
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return r.find(ctx, res.ReservationNo)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toReservation(po), nil
+}
+
+func (r *sqlInventoryRepo) Confirm(ctx context.Context, reservationNo string) (*biz.Reservation, error) {
+	var rv *biz.Reservation
+	err := r.data.InTx(ctx, func(ctx context.Context) error {
+		po, err := r.lock(ctx, reservationNo)
+		if err != nil {
+			return err
+		}
+		switch biz.ReservationStatus(po.Status) {
+		case biz.ReservationReleased:
+			return biz.ErrReservationReleased
+		case biz.ReservationReserved:
+			po.Status = string(biz.ReservationConfirmed)
+			if err := r.data.DB(ctx).Model(po).Update("status", po.Status).Error; err != nil {
+				return err
+			}
+			if err := r.sell(ctx, po.Items); err != nil {
+				return err
+			}
+		}
+		rv = toReservation(po)
+		return nil
+	})
+	return rv, err
+}
+
+func (r *sqlInventoryRepo) Release(ctx context.Context, reservationNo string) (*biz.Reservation, error) {
+	var rv *biz.Reservation
+	err := r.data.InTx(ctx, func(ctx context.Context) error {
+		po, err := r.lock(ctx, reservationNo)
+		if errors.Is(err, biz.ErrReservationNotFound) {
+			// Released before it is made, a reservation arriving late
+			// finds it and takes nothing.
+			po = &Reservation{ReservationNo: reservationNo, Status: string(biz.ReservationReleased)}
+			if err := r.data.DB(ctx).Create(po).Error; err != nil {
+				return err
+			}
+			rv = toReservation(po)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch biz.ReservationStatus(po.Status) {
+		case biz.ReservationConfirmed:
+			return biz.ErrReservationConfirmed
+		case biz.ReservationReserved:
+			if err := r.shift(ctx, po.Items, 1); err != nil {
+				return err
+			}
+			po.Status = string(biz.ReservationReleased)
+			if err := r.data.DB(ctx).Model(po).Update("status", po.Status).Error; err != nil {
+				return err
+			}
+		}
+		rv = toReservation(po)
+		return nil
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		// Reserved meanwhile, release that.
+		return r.Release(ctx, reservationNo)
+	}
+	return rv, err
+}
+
+func (r *sqlInventoryRepo) Adjust(ctx context.Context, skuID, delta int64) error {
+	res := r.data.DB(ctx).Model(&Sku{}).Where("id = ? AND stock + ? >= 0", skuID, delta).
+		Update("stock", gorm.Expr("stock + ?", delta))
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		stocks, err := r.Stocks(ctx, []int64{skuID})
+		if err != nil {
+			return err
+		}
+		if _, ok := stocks[skuID]; !ok {
+			return biz.ErrSkuNotFound
+		}
+		return biz.InsufficientStock(skuID)
+	}
+	return nil
+}
+
+func (r *sqlInventoryRepo) Stocks(ctx context.Context, skuIDs []int64) (map[int64]int64, error) {
+	var pos []*Sku
+	if err := r.data.DB(ctx).Select("id", "stock").Where("id IN ?", skuIDs).Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	stocks := make(map[int64]int64, len(pos))
+	for _, po := range pos {
+		stocks[po.ID] = po.Stock
+	}
+	return stocks, nil
+}
+
+func (r *sqlInventoryRepo) Warm(context.Context, []int64) error {
+	return nil
+}
+
+func (r *sqlInventoryRepo) WriteBack(context.Context) error {
+	return nil
+}
+
+func (r *sqlInventoryRepo) Reconcile(context.Context) (int, error) {
+	return 0, nil
+}
+
+// take takes quantity of the stock of a SKU if it has that much left.
+func (r *sqlInventoryRepo) take(ctx context.Context, skuID, quantity int64) (bool, error) {
+	res := r.data.DB(ctx).Model(&Sku{}).Where("id = ? AND stock >= ?", skuID, quantity).
+		Update("stock", gorm.Expr("stock - ?", quantity))
+	return res.RowsAffected > 0, res.Error
+}
+
+// shift adds the quantities of items, times sign, to the stock of their SKUs.
+func (r *sqlInventoryRepo) shift(ctx context.Context, items []*biz.StockItem, sign int64) error {
+	for _, it := range items {
+		err := r.data.DB(ctx).Model(&Sku{}).Where("id = ?", it.SkuID).
+			Update("stock", gorm.Expr("stock + ?", sign*it.Quantity)).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sell adds the quantities of items confirmed to the sales of the SPUs of
+// their SKUs, recording the SPUs changed for the search index.
+func (r *sqlInventoryRepo) sell(ctx context.Context, items []*biz.StockItem) error {
+	ids := make([]int64, 0, len(items))
+	for _, it := range items {
+		ids = append(ids, it.SkuID)
+	}
+	var pos []*Sku
+	if err := r.data.DB(ctx).Select("id", "spu_id").Where("id IN ?", ids).Find(&pos).Error; err != nil {
+		return err
+	}
+	spuIDs := make(map[int64]int64, len(pos))
+	for _, po := range pos {
+		spuIDs[po.ID] = po.SpuID
+	}
+	sold := make(map[int64]int64, len(items))
+	var order []int64
+	for _, it := range items {
+		spuID, ok := spuIDs[it.SkuID]
+		if !ok {
+			continue
+		}
+		if _, ok := sold[spuID]; !ok {
+			order = append(order, spuID)
+		}
+		sold[spuID] += it.Quantity
+	}
+	for _, spuID := range order {
+		err := r.data.DB(ctx).Model(&Spu{}).Where("id = ?", spuID).
+			Update("sales", gorm.Expr("sales + ?", sold[spuID])).Error
+		if err != nil {
+			return err
+		}
+		if err := r.data.DB(ctx).Create(&CatalogEvent{SpuID: spuID}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// apply brings a reservation in the database to the status it has in a
+// cache, moving the stock of the SKUs as the change does. Applying it again
+// changes nothing.
+func (r *sqlInventoryRepo) apply(ctx context.Context, res *biz.Reservation) error {
+	return r.data.InTx(ctx, func(ctx context.Context) error {
+		po, err := r.lock(ctx, res.ReservationNo)
+		if errors.Is(err, biz.ErrReservationNotFound) {
+			po = &Reservation{ReservationNo: res.ReservationNo, Items: res.Items, Status: string(res.Status)}
+			if err := r.data.DB(ctx).Create(po).Error; err != nil {
+				return err
+			}
+			// Released before it was written back, it took nothing.
+			if res.Status == biz.ReservationReleased {
+				return nil
+			}
+			if err := r.shift(ctx, res.Items, -1); err != nil {
+				return err
+			}
+			if res.Status == biz.ReservationConfirmed {
+				return r.sell(ctx, res.Items)
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		from := biz.ReservationStatus(po.Status)
+		if from == res.Status {
+			return nil
+		}
+		if from != biz.ReservationReserved {
+			r.log.WithContext(ctx).Warnf("Reservation %s: %s in the database, %s in the cache", res.ReservationNo, from, res.Status)
+			return nil
+		}
+		switch res.Status {
+		case biz.ReservationReleased:
+			if err := r.shift(ctx, po.Items, 1); err != nil {
+				return err
+			}
+		case biz.ReservationConfirmed:
+			if err := r.sell(ctx, po.Items); err != nil {
+				return err
+			}
+		}
+		return r.data.DB(ctx).Model(po).Update("status", string(res.Status)).Error
+	})
+}
+
+func (r *sqlInventoryRepo) lock(ctx context.Context, reservationNo string) (*Reservation, error) {
+	var po Reservation
+	err := r.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("reservation_no = ?", reservationNo).First(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrReservationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &po, nil
+}
+
+func (r *sqlInventoryRepo) find(ctx context.Context, reservationNo string) (*biz.Reservation, error) {
+	var po Reservation
+	err := r.data.DB(ctx).Where("reservation_no = ?", reservationNo).First(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrReservationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toReservation(&po), nil
+}
+
+func toReservation(po *Reservation) *biz.Reservation {
+	return &biz.Reservation{
+		ReservationNo: po.ReservationNo,
+		Items:         po.Items,
+		Status:        biz.ReservationStatus(po.Status),
+		CreatedAt:     po.CreatedAt,
+		UpdatedAt:     po.UpdatedAt,
+	}
+}
+
+// reserveScript takes the stock of the SKUs of a reservation at once, or
+// none of it when one is short or not loaded. A reservation known is
+// returned as it is.
+//
+// KEYS: reservation, write-back list, write-back sequence, stock of each SKU.
+// ARGV: reservation number, items, quantity of each SKU.
+var reserveScript = redis.NewScript(`
+local status = redis.call('HGET', KEYS[1], 'status')
+if status then
+  return {status, redis.call('HGET', KEYS[1], 'items')}
+end
+for i = 4, #KEYS do
+  local stock = redis.call('GET', KEYS[i])
+  if not stock then
+    return {'missing', tostring(i - 3)}
+  end
+  if tonumber(stock) < tonumber(ARGV[i - 1]) then
+    return {'short', tostring(i - 3)}
+  end
+end
+for i = 4, #KEYS do
+  redis.call('DECRBY', KEYS[i], ARGV[i - 1])
+end
+redis.call('HSET', KEYS[1], 'status', 'reserved', 'items', ARGV[2])
+redis.call('RPUSH', KEYS[2], ARGV[1])
+redis.call('INCR', KEYS[3])
+return {'reserved', ARGV[2]}
+`)
+
+// confirmScript confirms a reservation reserved. It returns nothing for an
+// unknown one.
+//
+// KEYS: reservation, write-back list, write-back sequence.
+// ARGV: reservation number, retention in milliseconds.
+var confirmScript = redis.NewScript(`
+local status = redis.call('HGET', KEYS[1], 'status')
+if not status then
+  return {}
+end
+if status == 'reserved' then
+  status = 'confirmed'
+  redis.call('HSET', KEYS[1], 'status', status)
+  redis.call('PEXPIRE', KEYS[1], ARGV[2])
+  redis.call('RPUSH', KEYS[2], ARGV[1])
+  redis.call('INCR', KEYS[3])
+end
+return {status, redis.call('HGET', KEYS[1], 'items')}
+`)
+
+// releaseScript gives back the stock of a reservation reserved with the
+// items read before, or records an unknown one released when none were.
+// It returns retry when the reservation changed since it was read.
+//
+// KEYS: reservation, write-back list, write-back sequence, stock of each SKU.
+// ARGV: reservation number, retention in milliseconds, items read, quantity
+// of each SKU.
+var releaseScript = redis.NewScript(`
+local status = redis.call('HGET', KEYS[1], 'status')
+if not status then
+  if ARGV[3] ~= '' then
+    return {'retry'}
+  end
+  redis.call('HSET', KEYS[1], 'status', 'released', 'items', '[]')
+elseif status == 'reserved' then
+  if redis.call('HGET', KEYS[1], 'items') ~= ARGV[3] then
+    return {'retry'}
+  end
+  for i = 4, #KEYS do
+    redis.call('INCRBY', KEYS[i], ARGV[i])
+  end
+  redis.call('HSET', KEYS[1], 'status', 'released')
+else
+  return {status, redis.call('HGET', KEYS[1], 'items')}
+end
+redis.call('PEXPIRE', KEYS[1], ARGV[2])
+redis.call('RPUSH', KEYS[2], ARGV[1])
+redis.call('INCR', KEYS[3])
+return {'released', redis.call('HGET', KEYS[1], 'items')}
+`)
+
+// adjustScript adds a delta to the stock of a SKU unless it goes below zero.
+// It returns missing for a SKU not loaded.
+//
+// KEYS: stock of the SKU. ARGV: delta.
+var adjustScript = redis.NewScript(`
+local stock = redis.call('GET', KEYS[1])
+if not stock then
+  return 'missing'
+end
+local n = tonumber(stock) + tonumber(ARGV[1])
+if n < 0 then
+  return 'short'
+end
+redis.call('SET', KEYS[1], n)
+return 'ok'
+`)
+
+// reconcileScript sets the stock of a SKU to the one read from the database,
+// unless a change was queued to write back since the sequence was read, is
+// still to be written back, or the SKU is being adjusted. It returns the
+// stock replaced, false when it did not set it, or busy when it is not
+// safe to.
+//
+// KEYS: stock of the SKU, write-back list, list being written back,
+// write-back sequence, adjusting mark of the SKU.
+// ARGV: sequence read, stock in the database.
+var reconcileScript = redis.NewScript(`
+local seq = redis.call('GET', KEYS[4]) or '0'
+if seq ~= ARGV[1] or redis.call('LLEN', KEYS[2]) > 0 or redis.call('LLEN', KEYS[3]) > 0 then
+  return 'busy'
+end
+if redis.call('EXISTS', KEYS[5]) == 1 then
+  return false
+end
+local stock = redis.call('GET', KEYS[1])
+if not stock or stock == ARGV[2] then
+  return false
+end
+redis.call('SET', KEYS[1], ARGV[2])
+return stock
+`)
+
+// redisInventoryRepo takes stock in redis with scripts, atomically and
+// without touching the database, and writes the reservations back to the
+// database in the background through a list. The stock of a SKU is loaded
+// from the database the first time it is reserved; redis is ahead of the
+// database by the reservations left to write back.
+type redisInventoryRepo struct {
+	data      *Data
+	db        *sqlInventoryRepo
+	retention time.Duration
+	log       *log.Helper
+}
+
+func stockKey(skuID int64) string {
+	return stockKeyPrefix + strconv.FormatInt(skuID, 10)
+}
+
+func reservationKey(reservationNo string) string {
+	return reservationKeyPrefix + reservationNo
+}
+
+func (r *redisInventoryRepo) Reserve(ctx context.Context, res *biz.Reservation) (*biz.Reservation, error) {
+	items, err := json.Marshal(res.Items)
+	if err != nil {
+		return nil, err
+	}
+	keys := []string{reservationKey(res.ReservationNo), writeBackKey, writeBackSeqKey}
+	args := []any{res.ReservationNo, string(items)}
+	for _, it := range res.Items {
+		keys = append(keys, stockKey(it.SkuID))
+		args = append(args, it.Quantity)
+	}
+	for i := 0; i < inventoryAttempts; i++ {
+		vals, err := reserveScript.Run(ctx, r.data.rdb, keys, args...).StringSlice()
+		if err != nil {
+			return nil, err
+		}
+		switch vals[0] {
+		case "missing", "short":
+			n, _ := strconv.Atoi(vals[1])
+			it := res.Items[n-1]
+			if vals[0] == "short" {
+				return nil, biz.InsufficientStock(it.SkuID)
+			}
+			if err := r.load(ctx, it.SkuID); err != nil {
+				return nil, err
+			}
+		default:
+			return r.reservation(res.ReservationNo, vals)
+		}
+	}
+	return nil, fmt.Errorf("reserve %s: stock kept missing", res.ReservationNo)
+}
+
+func (r *redisInventoryRepo) Confirm(ctx context.Context, reservationNo string) (*biz.Reservation, error) {
+	vals, err := confirmScript.Run(ctx, r.data.rdb,
+		[]string{reservationKey(reservationNo), writeBackKey, writeBackSeqKey},
+		reservationNo, r.retention.Milliseconds()).StringSlice()
+	if err != nil {
+		return nil, err
+	}
+	if len(vals) == 0 {
+		// Settled long ago and dropped from redis, or made before redis
+		// was, the database knows it.
+		return r.db.Confirm(ctx, reservationNo)
+	}
+	if biz.ReservationStatus(vals[0]) == biz.ReservationReleased {
+		return nil, biz.ErrReservationReleased
+	}
+	return r.reservation(reservationNo, vals)
+}
+
+func (r *redisInventoryRepo) Release(ctx context.Context, reservationNo string) (*biz.Reservation, error) {
+	key := reservationKey(reservationNo)
+	for i := 0; i < inventoryAttempts; i++ {
+		read, err := r.data.rdb.HMGet(ctx, key, "status", "items").Result()
+		if err != nil {
+			return nil, err
+		}
+		keys := []string{key, writeBackKey, writeBackSeqKey}
+		args := []any{reservationNo, r.retention.Milliseconds(), ""}
+		if read[0] == nil {
+			if _, err := r.db.find(ctx, reservationNo); !errors.Is(err, biz.ErrReservationNotFound) {
+				if err != nil {
+					return nil, err
+				}
+				return r.db.Release(ctx, reservationNo)
+			}
+		} else if items, _ := read[1].(string); biz.ReservationStatus(fmt.Sprint(read[0])) == biz.ReservationReserved {
+			var its []*biz.StockItem
+			if err := json.Unmarshal([]byte(items), &its); err != nil {
+				return nil, err
+			}
+			args[2] = items
+			for _, it := range its {
+				keys = append(keys, stockKey(it.SkuID))
+				args = append(args, it.Quantity)
+			}
+		}
+		vals, err := releaseScript.Run(ctx, r.data.rdb, keys, args...).StringSlice()
+		if err != nil {
+			return nil, err
+		}
+		switch vals[0] {
+		case "retry":
+			continue
+		case string(biz.ReservationConfirmed):
+			return nil, biz.ErrReservationConfirmed
+		default:
+			return r.reservation(reservationNo, vals)
+		}
+	}
+	return nil, fmt.Errorf("release %s: reservation kept changing", reservationNo)
+}
+
+func (r *redisInventoryRepo) Adjust(ctx context.Context, skuID, delta int64) error {
+	if err := r.load(ctx, skuID); err != nil {
+		return err
+	}
+	// The mark keeps reconciliations off the SKU between redis and the
+	// database adjusted.
+	mark := adjustKeyPrefix + strconv.FormatInt(skuID, 10)
+	if err := r.data.rdb.Set(ctx, mark, 1, adjustMark).Err(); err != nil {
+		return err
+	}
+	defer r.data.rdb.Del(context.WithoutCancel(ctx), mark)
+	res, err := adjustScript.Run(ctx, r.data.rdb, []string{stockKey(skuID)}, delta).Text()
+	if err != nil {
+		return err
+	}
+	if res == "short" {
+		return biz.InsufficientStock(skuID)
+	}
+	if res != "ok" {
+		return fmt.Errorf("adjust stock of SKU %d: %s", skuID, res)
+	}
+	err = r.data.DB(ctx).Model(&Sku{}).Where("id = ?", skuID).
+		Update("stock", gorm.Expr("stock + ?", delta)).Error
+	if err != nil {
+		if rerr := r.data.rdb.DecrBy(context.WithoutCancel(ctx), stockKey(skuID), delta).Err(); rerr != nil {
+			r.log.WithContext(ctx).Errorf("SKU %d: undo stock adjustment %d: %v", skuID, delta, rerr)
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *redisInventoryRepo) Stocks(ctx context.Context, skuIDs []int64) (map[int64]int64, error) {
+	if len(skuIDs) == 0 {
+		return map[int64]int64{}, nil
+	}
+	keys := make([]string, 0, len(skuIDs))
+	for _, id := range skuIDs {
+		keys = append(keys, stockKey(id))
+	}
+	vals, err := r.data.rdb.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+	stocks := make(map[int64]int64, len(skuIDs))
+	var missing []int64
+	for i, v := range vals {
+		s, ok := v.(string)
+		if !ok {
+			missing = append(missing, skuIDs[i])
+			continue
+		}
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		stocks[skuIDs[i]] = n
+	}
+	if len(missing) > 0 {
+		// Never reserved, the database is up to date.
+		loaded, err := r.db.Stocks(ctx, missing)
+		if err != nil {
+			return nil, err
+		}
+		for id, n := range loaded {
+			stocks[id] = n
+		}
+	}
+	return stocks, nil
+}
+
+func (r *redisInventoryRepo) Warm(ctx context.Context, skuIDs []int64) error {
+	stocks, err := r.db.Stocks(ctx, skuIDs)
+	if err != nil {
+		return err
+	}
+	// Those loaded already are ahead of the database, SETNX leaves them.
+	_, err = r.data.rdb.Pipelined(ctx, func(p redis.Pipeliner) error {
+		for id, stock := range stocks {
+			p.SetNX(ctx, stockKey(id), stock, 0)
+		}
+		return nil
+	})
+	return err
+}
+
+// WriteBack moves the reservations changed to a second list one at a time
+// and removes them from it once written back, so that those an instance
+// stopped on are taken up again as the next starts.
+func (r *redisInventoryRepo) WriteBack(ctx context.Context) error {
+	for ctx.Err() == nil {
+		n, err := r.data.rdb.LMove(ctx, writingBackKey, writeBackKey, "RIGHT", "LEFT").Result()
+		if errors.Is(err, redis.Nil) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		r.log.Infof("writing back %s again", n)
+	}
+	for ctx.Err() == nil {
+		no, err := r.data.rdb.BLMove(ctx, writeBackKey, writingBackKey, "LEFT", "RIGHT", time.Second).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			r.log.Errorf("write back reservations: %v", err)
+			r.sleep(ctx, writeBackRetry)
+			continue
+		}
+		for {
+			err := r.writeBack(context.WithoutCancel(ctx), no)
+			if err == nil {
+				break
+			}
+			r.log.Errorf("write back reservation %s: %v", no, err)
+			if !r.sleep(ctx, writeBackRetry) {
+				// Left in the list being written back, for the next start.
+				return nil
+			}
+		}
+		if err := r.data.rdb.LRem(context.WithoutCancel(ctx), writingBackKey, 1, no).Err(); err != nil {
+			r.log.Errorf("write back reservation %s: %v", no, err)
+		}
+	}
+	return nil
+}
+
+// writeBack writes a reservation back as it is in redis now, whatever the
+// changes queued before.
+func (r *redisInventoryRepo) writeBack(ctx context.Context, reservationNo string) error {
+	read, err := r.data.rdb.HMGet(ctx, reservationKey(reservationNo), "status", "items").Result()
+	if err != nil {
+		return err
+	}
+	if read[0] == nil {
+		r.log.WithContext(ctx).Warnf("Reservation %s: expired before it was written back", reservationNo)
+		return nil
+	}
+	res, err := r.reservation(reservationNo, []string{fmt.Sprint(read[0]), fmt.Sprint(read[1])})
+	if err != nil {
+		return err
+	}
+	return r.db.apply(ctx, res)
+}
+
+// Reconcile compares the stock of the SKUs loaded in redis with the
+// database while nothing is left to write back, and sets those that
+// drifted to the database, the stock of the reservations being written
+// back then in both. A round stops as soon as a reservation changes.
+func (r *redisInventoryRepo) Reconcile(ctx context.Context) (int, error) {
+	seq, err := r.data.rdb.Get(ctx, writeBackSeqKey).Result()
+	if errors.Is(err, redis.Nil) {
+		seq = "0"
+	} else if err != nil {
+		return 0, err
+	}
+	n := 0
+	var cursor uint64
+	for {
+		keys, next, err := r.data.rdb.Scan(ctx, cursor, stockKeyPrefix+"*", reconcileBatch).Result()
+		if err != nil {
+			return n, err
+		}
+		ids := make([]int64, 0, len(keys))
+		for _, k := range keys {
+			if id, err := strconv.ParseInt(strings.TrimPrefix(k, stockKeyPrefix), 10, 64); err == nil {
+				ids = append(ids, id)
+			}
+		}
+		fixed, busy, err := r.reconcile(ctx, seq, ids)
+		n += fixed
+		if err != nil || busy {
+			return n, err
+		}
+		if cursor = next; cursor == 0 {
+			return n, nil
+		}
+	}
+}
+
+// reconcile reconciles the stock of SKUs, and reports whether it stopped
+// as a reservation changed.
+func (r *redisInventoryRepo) reconcile(ctx context.Context, seq string, ids []int64) (int, bool, error) {
+	if len(ids) == 0 {
+		return 0, false, nil
+	}
+	stocks, err := r.db.Stocks(ctx, ids)
+	if err != nil {
+		return 0, false, err
+	}
+	n := 0
+	for _, id := range ids {
+		stock, ok := stocks[id]
+		if !ok {
+			// Of a SKU deleted.
+			if err := r.data.rdb.Del(ctx, stockKey(id)).Err(); err != nil {
+				return n, false, err
+			}
+			continue
+		}
+		keys := []string{stockKey(id), writeBackKey, writingBackKey, writeBackSeqKey, adjustKeyPrefix + strconv.FormatInt(id, 10)}
+		old, err := reconcileScript.Run(ctx, r.data.rdb, keys, seq, stock).Text()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			return n, false, err
+		}
+		if old == "busy" {
+			return n, true, nil
+		}
+		r.log.WithContext(ctx).Warnf("SKU %d: stock %s in redis reconciled to %d", id, old, stock)
+		n++
+	}
+	return n, false, nil
+}
+
+// load loads the stock of a SKU from the database unless it is loaded.
+func (r *redisInventoryRepo) load(ctx context.Context, skuID int64) error {
+	stocks, err := r.db.Stocks(ctx, []int64{skuID})
+	if err != nil {
+		return err
+	}
+	stock, ok := stocks[skuID]
+	if !ok {
+		return biz.InsufficientStock(skuID)
+	}
+	return r.data.rdb.SetNX(ctx, stockKey(skuID), stock, 0).Err()
+}
+
+func (r *redisInventoryRepo) reservation(reservationNo string, vals []string) (*biz.Reservation, error) {
+	res := &biz.Reservation{ReservationNo: reservationNo, Status: biz.ReservationStatus(vals[0])}
+	if err := json.Unmarshal([]byte(vals[1]), &res.Items); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// sleep waits for d, or reports false once ctx is done.
+func (r *redisInventoryRepo) sleep(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-time.After(d):
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,280 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/conf"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// newTestData opens an in-memory database, on one connection so that
+// transactions wait for each other as row locks would, and a miniredis.
+func newTestData(t *testing.T, stocks map[int64]int64) *Data {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())),
+		&gorm.Config{TranslateError: true, Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+	if err := db.AutoMigrate(&Spu{}, &Sku{}, &Reservation{}, &CatalogEvent{}); err != nil {
+		t.Fatal(err)
+	}
+	for id, stock := range stocks {
+		if err := db.Create(&Spu{ID: id}).Error; err != nil {
+			t.Fatal(err)
+		}
+		if err := db.Create(&Sku{ID: id, SpuID: id, AttrKey: "-", Stock: stock, Enabled: true}).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	rdb := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	return &Data{db: db, rdb: rdb}
+}
+
+func newTestInventoryRepo(t *testing.T, kind string, d *Data) biz.InventoryRepo {
+	t.Helper()
+	repo, err := NewInventoryRepo(&conf.Data{Inventory: &conf.Data_Inventory{Kind: kind}}, d, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return repo
+}
+
+func checkStocks(t *testing.T, repo biz.InventoryRepo, want map[int64]int64) {
+	t.Helper()
+	ids := make([]int64, 0, len(want))
+	for id := range want {
+		ids = append(ids, id)
+	}
+	got, err := repo.Stocks(context.Background(), ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for id, stock := range want {
+		if got[id] != stock {
+			t.Errorf("stock of SKU %d is %d, want %d", id, got[id], stock)
+		}
+	}
+}
+
+func TestInventoryIdempotent(t *testing.T) {
+	for _, kind := range []string{"sql", "redis"} {
+		t.Run(kind, func(t *testing.T) {
+			ctx := context.Background()
+			repo := newTestInventoryRepo(t, kind, newTestData(t, map[int64]int64{1: 10, 2: 5}))
+			items := []*biz.StockItem{{SkuID: 1, Quantity: 3}, {SkuID: 2, Quantity: 2}}
+
+			for i := 0; i < 2; i++ {
+				res, err := repo.Reserve(ctx, &biz.Reservation{ReservationNo: "o1", Items: items})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if res.Status != biz.ReservationReserved || len(res.Items) != 2 {
+					t.Errorf("reserving o1 #%d: %s with %d items", i+1, res.Status, len(res.Items))
+				}
+			}
+			checkStocks(t, repo, map[int64]int64{1: 7, 2: 3})
+
+			_, err := repo.Reserve(ctx, &biz.Reservation{ReservationNo: "o2", Items: []*biz.StockItem{{SkuID: 1, Quantity: 1}, {SkuID: 2, Quantity: 4}}})
+			if !errors.Is(err, biz.ErrInsufficientStock) {
+				t.Errorf("reserving more than left: %v", err)
+			}
+			checkStocks(t, repo, map[int64]int64{1: 7, 2: 3})
+
+			for i := 0; i < 2; i++ {
+				res, err := repo.Confirm(ctx, "o1")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if res.Status != biz.ReservationConfirmed {
+					t.Errorf("confirming o1 #%d: %s", i+1, res.Status)
+				}
+			}
+			if _, err := repo.Release(ctx, "o1"); !errors.Is(err, biz.ErrReservationConfirmed) {
+				t.Errorf("releasing o1 confirmed: %v", err)
+			}
+			checkStocks(t, repo, map[int64]int64{1: 7, 2: 3})
+
+			if _, err := repo.Reserve(ctx, &biz.Reservation{ReservationNo: "o3", Items: []*biz.StockItem{{SkuID: 1, Quantity: 2}}}); err != nil {
+				t.Fatal(err)
+			}
+			for i := 0; i < 2; i++ {
+				res, err := repo.Release(ctx, "o3")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if res.Status != biz.ReservationReleased {
+					t.Errorf("releasing o3 #%d: %s", i+1, res.Status)
+				}
+			}
+			if _, err := repo.Confirm(ctx, "o3"); !errors.Is(err, biz.ErrReservationReleased) {
+				t.Errorf("confirming o3 released: %v", err)
+			}
+			checkStocks(t, repo, map[int64]int64{1: 7, 2: 3})
+
+			// Released before it is made, as a cancellation overtaking the
+			// order would, the reservation takes nothing.
+			if _, err := repo.Release(ctx, "o4"); err != nil {
+				t.Fatal(err)
+			}
+			res, err := repo.Reserve(ctx, &biz.Reservation{ReservationNo: "o4", Items: items})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Status != biz.ReservationReleased {
+				t.Errorf("reserving o4 released before: %s", res.Status)
+			}
+			checkStocks(t, repo, map[int64]int64{1: 7, 2: 3})
+
+			if _, err := repo.Confirm(ctx, "o5"); !errors.Is(err, biz.ErrReservationNotFound) {
+				t.Errorf("confirming o5 unknown: %v", err)
+			}
+		})
+	}
+}
+
+func TestInventoryConcurrentReserve(t *testing.T) {
+	const stock = 10
+	for _, kind := range []string{"sql", "redis"} {
+		t.Run(kind, func(t *testing.T) {
+			ctx := context.Background()
+			repo := newTestInventoryRepo(t, kind, newTestData(t, map[int64]int64{1: stock}))
+
+			var (
+				wg       sync.WaitGroup
+				mu       sync.Mutex
+				reserved int64
+			)
+			for i := 0; i < 40; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					quantity := int64(i%3 + 1)
+					_, err := repo.Reserve(ctx, &biz.Reservation{
+						ReservationNo: fmt.Sprintf("c%d", i),
+						Items:         []*biz.StockItem{{SkuID: 1, Quantity: quantity}},
+					})
+					if errors.Is(err, biz.ErrInsufficientStock) {
+						return
+					}
+					if err != nil {
+						t.Errorf("reserving c%d: %v", i, err)
+						return
+					}
+					mu.Lock()
+					reserved += quantity
+					mu.Unlock()
+				}(i)
+			}
+			wg.Wait()
+
+			stocks, err := repo.Stocks(ctx, []int64{1})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if stocks[1] < 0 || stocks[1]+reserved != stock {
+				t.Errorf("reserved %d, %d left of %d", reserved, stocks[1], stock)
+			}
+			// What is left is less than the smallest quantity asked for.
+			if stocks[1] >= 1 {
+				if _, err := repo.Reserve(ctx, &biz.Reservation{ReservationNo: "last", Items: []*biz.StockItem{{SkuID: 1, Quantity: stocks[1]}}}); err != nil {
+					t.Errorf("reserving the %d left: %v", stocks[1], err)
+				}
+			}
+		})
+	}
+}
+
+func TestInventoryReconcile(t *testing.T) {
+	ctx := context.Background()
+	d := newTestData(t, map[int64]int64{1: 10, 2: 5, 3: 4})
+	repo := newTestInventoryRepo(t, "redis", d)
+	if err := repo.Warm(ctx, []int64{1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.Reserve(ctx, &biz.Reservation{ReservationNo: "o1", Items: []*biz.StockItem{{SkuID: 1, Quantity: 2}}}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Redis is ahead of the database until o1 is written back.
+	n, err := repo.Reconcile(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 0 {
+		t.Errorf("reconciled %d SKUs with o1 left to write back", n)
+	}
+	checkStocks(t, repo, map[int64]int64{1: 8})
+
+	wctx, cancel := context.WithCancel(ctx)
+	done := make(chan error, 1)
+	go func() { done <- repo.WriteBack(wctx) }()
+	for deadline := time.Now().Add(5 * time.Second); ; {
+		var po Sku
+		if err := d.db.First(&po, 1).Error; err != nil {
+			t.Fatal(err)
+		}
+		left, err := d.rdb.LLen(ctx, writingBackKey).Result()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if po.Stock == 8 && left == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("o1 not written back, stock %d in the database", po.Stock)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	// Drift both ways, and a SKU deleted from the database.
+	if err := d.rdb.Set(ctx, stockKey(1), 100, 0).Err(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.db.Model(&Sku{}).Where("id = ?", 2).Update("stock", 9).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := d.db.Delete(&Sku{}, 3).Error; err != nil {
+		t.Fatal(err)
+	}
+	if n, err = repo.Reconcile(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Errorf("reconciled %d SKUs, want 2", n)
+	}
+	checkStocks(t, repo, map[int64]int64{1: 8, 2: 9})
+	if exists, err := d.rdb.Exists(ctx, stockKey(3)).Result(); err != nil || exists != 0 {
+		t.Errorf("stock of SKU 3 deleted left in redis: %d, %v", exists, err)
+	}
+
+	if n, err = repo.Reconcile(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if n != 0 {
+		t.Errorf("reconciled %d SKUs again", n)
+	}
+}
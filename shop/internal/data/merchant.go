@@ -0,0 +1,451 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Licence is a business licence, embedded in the merchant_applications and
+// merchants tables.
+type Licence struct {
+	CompanyName string `gorm:"size:100"`
+	CreditCode  string `gorm:"size:18"`
+	LegalPerson string `gorm:"size:100"`
+	Address     string `gorm:"size:100"`
+	Image       string `gorm:"size:512"`
+	ValidUntil  *time.Time
+}
+
+// MerchantApplication is the merchant_applications table.
+type MerchantApplication struct {
+	ID           int64   `gorm:"primaryKey"`
+	UserID       int64   `gorm:"index"`
+	ShopName     string  `gorm:"size:30"`
+	Licence      Licence `gorm:"embedded;embeddedPrefix:licence_"`
+	ContactName  string  `gorm:"size:100"`
+	ContactPhone string  `gorm:"size:20"`
+	Status       string  `gorm:"size:16;index"`
+	Reason       string  `gorm:"size:255"`
+	MerchantID   int64
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	ReviewedAt   *time.Time
+}
+
+// Merchant is the merchants table. The unique indexes keep two merchants
+// from sharing a shop name or a licence.
+type Merchant struct {
+	ID            int64  `gorm:"primaryKey"`
+	Name          string `gorm:"size:30;uniqueIndex"`
+	Logo          string `gorm:"size:512"`
+	Description   string `gorm:"type:text"`
+	ServicePhone  string `gorm:"size:20"`
+	CompanyName   string `gorm:"size:100"`
+	CreditCode    string `gorm:"size:18;uniqueIndex"`
+	LegalPerson   string `gorm:"size:100"`
+	Address       string `gorm:"size:100"`
+	LicenceImage  string `gorm:"size:512"`
+	ValidUntil    *time.Time
+	OwnerID       int64  `gorm:"index"`
+	Status        string `gorm:"size:16;index"`
+	SuspendReason string `gorm:"size:255"`
+	SuspendedAt   *time.Time
+	Version       int64
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// Staff is the staff table, a row per user on the staff of a shop.
+type Staff struct {
+	MerchantID int64  `gorm:"primaryKey;autoIncrement:false"`
+	UserID     int64  `gorm:"primaryKey;autoIncrement:false;index"`
+	Role       string `gorm:"size:16"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type merchantApplicationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewMerchantApplicationRepo .
+func NewMerchantApplicationRepo(data *Data, logger log.Logger) biz.MerchantApplicationRepo {
+	return &merchantApplicationRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *merchantApplicationRepo) Save(ctx context.Context, a *biz.MerchantApplication) (*biz.MerchantApplication, error) {
+	po := toMerchantApplicationPO(a)
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return nil, err
+	}
+	return toMerchantApplication(po), nil
+}
+
+func (r *merchantApplicationRepo) Find(ctx context.Context, id int64) (*biz.MerchantApplication, error) {
+	var po MerchantApplication
+	err := r.data.DB(ctx).First(&po, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrApplicationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toMerchantApplication(&po), nil
+}
+
+func (r *merchantApplicationRepo) Transition(ctx context.Context, a *biz.MerchantApplication, from biz.ApplicationStatus) (bool, error) {
+	po := toMerchantApplicationPO(a)
+	res := r.data.DB(ctx).Model(po).Where("status = ?", string(from)).
+		Select("status", "reason", "merchant_id", "reviewed_at", "updated_at").
+		Updates(po)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+func (r *merchantApplicationRepo) List(ctx context.Context, userID int64, status biz.ApplicationStatus, oldest bool, page, pageSize int) ([]*biz.MerchantApplication, int64, error) {
+	db := r.data.DB(ctx).Model(&MerchantApplication{})
+	if userID != 0 {
+		db = db.Where("user_id = ?", userID)
+	}
+	if status != "" {
+		db = db.Where("status = ?", string(status))
+	}
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if oldest {
+		db = db.Order("id")
+	} else {
+		db = db.Order("id DESC")
+	}
+	var pos []*MerchantApplication
+	if err := db.Offset((page - 1) * pageSize).Limit(pageSize).Find(&pos).Error; err != nil {
+		return nil, 0, err
+	}
+	rv := make([]*biz.MerchantApplication, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toMerchantApplication(po))
+	}
+	return rv, total, nil
+}
+
+type merchantRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewMerchantRepo .
+func NewMerchantRepo(data *Data, logger log.Logger) biz.MerchantRepo {
+	return &merchantRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *merchantRepo) Save(ctx context.Context, m *biz.Merchant) (*biz.Merchant, error) {
+	po := toMerchantPO(m)
+	err := r.data.DB(ctx).Create(po).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return nil, biz.ErrMerchantExists
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toMerchant(po), nil
+}
+
+func (r *merchantRepo) Update(ctx context.Context, m *biz.Merchant) error {
+	po := toMerchantPO(m)
+	po.Version++
+	res := r.data.DB(ctx).Model(po).Where("version = ?", m.Version).
+		Select("name", "logo", "description", "service_phone", "status", "suspend_reason", "suspended_at",
+			"version", "updated_at").
+		Updates(po)
+	if errors.Is(res.Error, gorm.ErrDuplicatedKey) {
+		return biz.ErrMerchantExists
+	}
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return biz.ErrMerchantConflict
+	}
+	m.Version, m.UpdatedAt = po.Version, po.UpdatedAt
+	return nil
+}
+
+func (r *merchantRepo) Find(ctx context.Context, id int64) (*biz.Merchant, error) {
+	var po Merchant
+	err := r.data.DB(ctx).First(&po, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrMerchantNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toMerchant(&po), nil
+}
+
+func (r *merchantRepo) Lock(ctx context.Context, id int64) (*biz.Merchant, error) {
+	var po Merchant
+	err := r.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).First(&po, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrMerchantNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toMerchant(&po), nil
+}
+
+func (r *merchantRepo) Taken(ctx context.Context, name, creditCode string) (bool, error) {
+	var n int64
+	err := r.data.DB(ctx).Model(&Merchant{}).
+		Where("name = ? OR credit_code = ?", name, creditCode).
+		Count(&n).Error
+	return n > 0, err
+}
+
+func (r *merchantRepo) List(ctx context.Context, status biz.MerchantStatus, page, pageSize int) ([]*biz.Merchant, int64, error) {
+	db := r.data.DB(ctx).Model(&Merchant{})
+	if status != "" {
+		db = db.Where("status = ?", string(status))
+	}
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var pos []*Merchant
+	if err := db.Order("id DESC").Offset((page - 1) * pageSize).Limit(pageSize).Find(&pos).Error; err != nil {
+		return nil, 0, err
+	}
+	rv := make([]*biz.Merchant, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toMerchant(po))
+	}
+	return rv, total, nil
+}
+
+type staffRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewStaffRepo .
+func NewStaffRepo(data *Data, logger log.Logger) biz.StaffRepo {
+	return &staffRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *staffRepo) Save(ctx context.Context, s *biz.Staff) (*biz.Staff, error) {
+	po := toStaffPO(s)
+	err := r.data.DB(ctx).Create(po).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return nil, biz.ErrStaffExists
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toStaff(po), nil
+}
+
+func (r *staffRepo) Update(ctx context.Context, s *biz.Staff) error {
+	po := toStaffPO(s)
+	err := r.data.DB(ctx).Model(po).Select("role", "updated_at").Updates(po).Error
+	if err != nil {
+		return err
+	}
+	s.UpdatedAt = po.UpdatedAt
+	return nil
+}
+
+func (r *staffRepo) Delete(ctx context.Context, merchantID, userID int64) error {
+	return r.data.DB(ctx).Where("merchant_id = ? AND user_id = ?", merchantID, userID).Delete(&Staff{}).Error
+}
+
+func (r *staffRepo) Find(ctx context.Context, merchantID, userID int64) (*biz.Staff, error) {
+	var po Staff
+	err := r.data.DB(ctx).Where("merchant_id = ? AND user_id = ?", merchantID, userID).Take(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrStaffNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toStaff(&po), nil
+}
+
+func (r *staffRepo) ListByMerchant(ctx context.Context, merchantID int64) ([]*biz.Staff, error) {
+	return r.list(r.data.DB(ctx).Where("merchant_id = ?", merchantID))
+}
+
+func (r *staffRepo) ListByUser(ctx context.Context, userID int64) ([]*biz.Staff, error) {
+	return r.list(r.data.DB(ctx).Where("user_id = ?", userID))
+}
+
+func (r *staffRepo) list(db *gorm.DB) ([]*biz.Staff, error) {
+	var pos []*Staff
+	if err := db.Order("created_at").Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	rv := make([]*biz.Staff, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toStaff(po))
+	}
+	return rv, nil
+}
+
+func toLicencePO(l biz.Licence) Licence {
+	po := Licence{
+		CompanyName: l.CompanyName,
+		CreditCode:  l.CreditCode,
+		LegalPerson: l.LegalPerson,
+		Address:     l.Address,
+		Image:       l.Image,
+	}
+	if !l.ValidUntil.IsZero() {
+		po.ValidUntil = &l.ValidUntil
+	}
+	return po
+}
+
+func toLicence(po Licence) biz.Licence {
+	l := biz.Licence{
+		CompanyName: po.CompanyName,
+		CreditCode:  po.CreditCode,
+		LegalPerson: po.LegalPerson,
+		Address:     po.Address,
+		Image:       po.Image,
+	}
+	if po.ValidUntil != nil {
+		l.ValidUntil = *po.ValidUntil
+	}
+	return l
+}
+
+func toMerchantApplicationPO(a *biz.MerchantApplication) *MerchantApplication {
+	po := &MerchantApplication{
+		ID:           a.ID,
+		UserID:       a.UserID,
+		ShopName:     a.ShopName,
+		Licence:      toLicencePO(a.Licence),
+		ContactName:  a.ContactName,
+		ContactPhone: a.ContactPhone,
+		Status:       string(a.Status),
+		Reason:       a.Reason,
+		MerchantID:   a.MerchantID,
+		CreatedAt:    a.CreatedAt,
+	}
+	if !a.ReviewedAt.IsZero() {
+		po.ReviewedAt = &a.ReviewedAt
+	}
+	return po
+}
+
+func toMerchantApplication(po *MerchantApplication) *biz.MerchantApplication {
+	a := &biz.MerchantApplication{
+		ID:           po.ID,
+		UserID:       po.UserID,
+		ShopName:     po.ShopName,
+		Licence:      toLicence(po.Licence),
+		ContactName:  po.ContactName,
+		ContactPhone: po.ContactPhone,
+		Status:       biz.ApplicationStatus(po.Status),
+		Reason:       po.Reason,
+		MerchantID:   po.MerchantID,
+		CreatedAt:    po.CreatedAt,
+	}
+	if po.ReviewedAt != nil {
+		a.ReviewedAt = *po.ReviewedAt
+	}
+	return a
+}
+
+func toMerchantPO(m *biz.Merchant) *Merchant {
+	l := toLicencePO(m.Licence)
+	po := &Merchant{
+		ID:            m.ID,
+		Name:          m.Name,
+		Logo:          m.Logo,
+		Description:   m.Description,
+		ServicePhone:  m.ServicePhone,
+		CompanyName:   l.CompanyName,
+		CreditCode:    l.CreditCode,
+		LegalPerson:   l.LegalPerson,
+		Address:       l.Address,
+		LicenceImage:  l.Image,
+		ValidUntil:    l.ValidUntil,
+		OwnerID:       m.OwnerID,
+		Status:        string(m.Status),
+		SuspendReason: m.SuspendReason,
+		Version:       m.Version,
+		CreatedAt:     m.CreatedAt,
+	}
+	if !m.SuspendedAt.IsZero() {
+		po.SuspendedAt = &m.SuspendedAt
+	}
+	return po
+}
+
+func toMerchant(po *Merchant) *biz.Merchant {
+	m := &biz.Merchant{
+		ID:           po.ID,
+		Name:         po.Name,
+		Logo:         po.Logo,
+		Description:  po.Description,
+		ServicePhone: po.ServicePhone,
+		Licence: toLicence(Licence{
+			CompanyName: po.CompanyName,
+			CreditCode:  po.CreditCode,
+			LegalPerson: po.LegalPerson,
+			Address:     po.Address,
+			Image:       po.LicenceImage,
+			ValidUntil:  po.ValidUntil,
+		}),
+		OwnerID:       po.OwnerID,
+		Status:        biz.MerchantStatus(po.Status),
+		SuspendReason: po.SuspendReason,
+		Version:       po.Version,
+		CreatedAt:     po.CreatedAt,
+		UpdatedAt:     po.UpdatedAt,
+	}
+	if po.SuspendedAt != nil {
+		m.SuspendedAt = *po.SuspendedAt
+	}
+	return m
+}
+
+func toStaffPO(s *biz.Staff) *Staff {
+	return &Staff{
+		MerchantID: s.MerchantID,
+		UserID:     s.UserID,
+		Role:       string(s.Role),
+		CreatedAt:  s.CreatedAt,
+	}
+}
+
+func toStaff(po *Staff) *biz.Staff {
+	return &biz.Staff{
+		MerchantID: po.MerchantID,
+		UserID:     po.UserID,
+		Role:       biz.StaffRole(po.Role),
+		CreatedAt:  po.CreatedAt,
+		UpdatedAt:  po.UpdatedAt,
+	}
+}
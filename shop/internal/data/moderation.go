@@ -0,0 +1,65 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/conf"
+	"github.com/go-kratos/kratos-layout/pkg/sensitive"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// moderationConfigKey is the key of the sensitive words in the config.
+const moderationConfigKey = "moderation"
+
+type sensitiveWordSource struct {
+	words atomic.Pointer[biz.SensitiveWords]
+	log   *log.Helper
+}
+
+// NewSensitiveWordSource loads the sensitive words from the config and
+// reloads them whenever it changes. Words that fail to load are logged and
+// the previous ones stay in force.
+func NewSensitiveWordSource(c config.Config, logger log.Logger) (biz.SensitiveWordSource, error) {
+	s := &sensitiveWordSource{log: log.NewHelper(logger)}
+	words, err := loadSensitiveWords(c.Value(moderationConfigKey))
+	if err != nil {
+		return nil, err
+	}
+	s.words.Store(words)
+	err = c.Watch(moderationConfigKey, func(_ string, v config.Value) {
+		words, err := loadSensitiveWords(v)
+		if err != nil {
+			s.log.Errorf("Moderation: keeping the previous words: %v", err)
+			return
+		}
+		s.words.Store(words)
+		s.log.Infof("Moderation: reloaded %d words to block, %d to hold and %d to mask",
+			words.Block.Len(), words.Hold.Len(), words.Mask.Len())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *sensitiveWordSource) Words() *biz.SensitiveWords {
+	return s.words.Load()
+}
+
+func loadSensitiveWords(v config.Value) (*biz.SensitiveWords, error) {
+	var mc conf.Moderation
+	// An absent section publishes everything.
+	if err := v.Scan(&mc); err != nil && !errors.Is(err, config.ErrNotFound) {
+		return nil, fmt.Errorf("moderation: %w", err)
+	}
+	return &biz.SensitiveWords{
+		Block: sensitive.New(mc.Block),
+		Hold:  sensitive.New(mc.Hold),
+		Mask:  sensitive.New(mc.Mask),
+	}, nil
+}
@@ -0,0 +1,65 @@
+package data
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/conf"
+	orderv1 "github.com/go-kratos/kratos-layout/order/api/order/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+)
+
+// NewOrderClient .
+func NewOrderClient(c *conf.Data) (orderv1.OrderClient, func(), error) {
+	opts := []grpc.ClientOption{
+		grpc.WithEndpoint(c.Order.Endpoint),
+		grpc.WithMiddleware(recovery.Recovery()),
+	}
+	if c.Order.Timeout != nil {
+		opts = append(opts, grpc.WithTimeout(c.Order.Timeout.AsDuration()))
+	}
+	conn, err := grpc.DialInsecure(context.Background(), opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return orderv1.NewOrderClient(conn), func() { _ = conn.Close() }, nil
+}
+
+type orderRepo struct {
+	client orderv1.OrderClient
+	log    *log.Helper
+}
+
+// NewOrderRepo .
+func NewOrderRepo(client orderv1.OrderClient, logger log.Logger) biz.OrderRepo {
+	return &orderRepo{
+		client: client,
+		log:    log.NewHelper(logger),
+	}
+}
+
+func (r *orderRepo) Find(ctx context.Context, orderNo string) (*biz.Order, error) {
+	reply, err := r.client.GetOrder(ctx, &orderv1.GetOrderRequest{OrderNo: orderNo})
+	if e := errors.FromError(err); e != nil && e.Reason == orderv1.ErrorReason_ORDER_NOT_FOUND.String() {
+		return nil, biz.ErrReviewNotAllowed
+	}
+	if err != nil {
+		return nil, err
+	}
+	o := &biz.Order{
+		OrderNo:   reply.OrderNo,
+		UserID:    reply.UserId,
+		Completed: reply.Status == orderv1.OrderStatus_COMPLETED,
+	}
+	if reply.CompletedAt != nil {
+		o.CompletedAt = reply.CompletedAt.AsTime()
+	}
+	for _, it := range reply.Items {
+		o.SkuIDs = append(o.SkuIDs, it.SkuId)
+	}
+	return o, nil
+}
@@ -0,0 +1,275 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Review is the reviews table. A follow-up shares the order and SKU of its
+// review, so that each is written once.
+type Review struct {
+	ID             int64           `gorm:"primaryKey"`
+	ParentID       int64           `gorm:"uniqueIndex:idx_reviews_item,priority:3"`
+	UserID         int64           `gorm:"index"`
+	OrderNo        string          `gorm:"size:64;uniqueIndex:idx_reviews_item,priority:1"`
+	SpuID          int64           `gorm:"index:idx_reviews_spu,priority:1"`
+	SkuID          int64           `gorm:"uniqueIndex:idx_reviews_item,priority:2"`
+	MerchantID     int64           `gorm:"index"`
+	SaleAttributes []biz.Attribute `gorm:"serializer:json;type:text"`
+	Rating         int32
+	Content        string   `gorm:"type:text"`
+	Images         []string `gorm:"serializer:json;type:text"`
+	ImageCount     int
+	Anonymous      bool
+	Status         string   `gorm:"size:16;index:idx_reviews_spu,priority:2;index"`
+	Words          []string `gorm:"serializer:json;type:text"`
+	Reason         string   `gorm:"size:255"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	PublishedAt    *time.Time
+}
+
+type reviewRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewReviewRepo .
+func NewReviewRepo(data *Data, logger log.Logger) biz.ReviewRepo {
+	return &reviewRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *reviewRepo) Save(ctx context.Context, rv *biz.Review) (*biz.Review, error) {
+	po := toReviewPO(rv)
+	err := r.data.DB(ctx).Create(po).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return nil, biz.ErrReviewExists
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toReview(po), nil
+}
+
+func (r *reviewRepo) Find(ctx context.Context, id int64) (*biz.Review, error) {
+	var po Review
+	err := r.data.DB(ctx).First(&po, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrReviewNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toReview(&po), nil
+}
+
+func (r *reviewRepo) Transition(ctx context.Context, rv *biz.Review, from biz.ReviewStatus) (bool, error) {
+	po := toReviewPO(rv)
+	res := r.data.DB(ctx).Model(po).Where("status = ?", string(from)).
+		Select("status", "reason", "published_at", "updated_at").
+		Updates(po)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+func (r *reviewRepo) List(ctx context.Context, filter *biz.ReviewFilter, page, pageSize int) ([]*biz.Review, int64, error) {
+	db := r.data.DB(ctx).Model(&Review{})
+	if !filter.FollowUps {
+		db = db.Where("parent_id = 0")
+	}
+	if filter.SpuID != 0 {
+		db = db.Where("spu_id = ?", filter.SpuID)
+	}
+	if filter.UserID != 0 {
+		db = db.Where("user_id = ?", filter.UserID)
+	}
+	if filter.Status != "" {
+		db = db.Where("status = ?", string(filter.Status))
+	}
+	if filter.MinRating != 0 {
+		db = db.Where("rating >= ?", filter.MinRating)
+	}
+	if filter.MaxRating != 0 {
+		db = db.Where("rating <= ?", filter.MaxRating)
+	}
+	if filter.WithImages {
+		db = db.Where("image_count > 0")
+	}
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if filter.Oldest {
+		db = db.Order("id")
+	} else {
+		db = db.Order("id DESC")
+	}
+	var pos []*Review
+	if err := db.Offset((page - 1) * pageSize).Limit(pageSize).Find(&pos).Error; err != nil {
+		return nil, 0, err
+	}
+	rv := make([]*biz.Review, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toReview(po))
+	}
+	return rv, total, nil
+}
+
+func (r *reviewRepo) FollowUps(ctx context.Context, ids []int64, status biz.ReviewStatus) (map[int64]*biz.Review, error) {
+	db := r.data.DB(ctx).Where("parent_id IN ?", ids)
+	if status != "" {
+		db = db.Where("status = ?", string(status))
+	}
+	var pos []*Review
+	if err := db.Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	rv := make(map[int64]*biz.Review, len(pos))
+	for _, po := range pos {
+		rv[po.ParentID] = toReview(po)
+	}
+	return rv, nil
+}
+
+func toReviewPO(r *biz.Review) *Review {
+	po := &Review{
+		ID:             r.ID,
+		ParentID:       r.ParentID,
+		UserID:         r.UserID,
+		OrderNo:        r.OrderNo,
+		SpuID:          r.SpuID,
+		SkuID:          r.SkuID,
+		MerchantID:     r.MerchantID,
+		SaleAttributes: r.SaleAttributes,
+		Rating:         r.Rating,
+		Content:        r.Content,
+		Images:         r.Images,
+		ImageCount:     len(r.Images),
+		Anonymous:      r.Anonymous,
+		Status:         string(r.Status),
+		Words:          r.Words,
+		Reason:         r.Reason,
+		CreatedAt:      r.CreatedAt,
+	}
+	if !r.PublishedAt.IsZero() {
+		po.PublishedAt = &r.PublishedAt
+	}
+	return po
+}
+
+func toReview(po *Review) *biz.Review {
+	r := &biz.Review{
+		ID:             po.ID,
+		ParentID:       po.ParentID,
+		UserID:         po.UserID,
+		OrderNo:        po.OrderNo,
+		SpuID:          po.SpuID,
+		SkuID:          po.SkuID,
+		MerchantID:     po.MerchantID,
+		SaleAttributes: po.SaleAttributes,
+		Rating:         po.Rating,
+		Content:        po.Content,
+		Images:         po.Images,
+		Anonymous:      po.Anonymous,
+		Status:         biz.ReviewStatus(po.Status),
+		Words:          po.Words,
+		Reason:         po.Reason,
+		CreatedAt:      po.CreatedAt,
+	}
+	if po.PublishedAt != nil {
+		r.PublishedAt = *po.PublishedAt
+	}
+	return r
+}
+
+// RatingStats is the rating_stats table, a row per SPU reviewed.
+type RatingStats struct {
+	SpuID       int64 `gorm:"primaryKey;autoIncrement:false"`
+	ReviewCount int64
+	Total       int64
+	Star1       int64
+	Star2       int64
+	Star3       int64
+	Star4       int64
+	Star5       int64
+	WithImages  int64
+	UpdatedAt   time.Time
+}
+
+// starColumns are the columns counting each of 1 to 5 stars.
+var starColumns = [5]string{"star1", "star2", "star3", "star4", "star5"}
+
+type ratingStatsRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewRatingStatsRepo .
+func NewRatingStatsRepo(data *Data, logger log.Logger) biz.RatingStatsRepo {
+	return &ratingStatsRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *ratingStatsRepo) Add(ctx context.Context, rv *biz.Review, delta int64) error {
+	withImages := int64(0)
+	if len(rv.Images) > 0 {
+		withImages = delta
+	}
+	star := starColumns[rv.Rating-1]
+	row := map[string]any{
+		"spu_id":       rv.SpuID,
+		"review_count": delta,
+		"total":        delta * int64(rv.Rating),
+		"with_images":  withImages,
+		"updated_at":   time.Now(),
+	}
+	// Every star is set, a column left NULL staying NULL as it is incremented.
+	for _, c := range starColumns {
+		row[c] = int64(0)
+	}
+	row[star] = delta
+	// The row of an SPU is created by its first review and only ever
+	// incremented after, so that concurrent reviews all count.
+	return r.data.DB(ctx).Model(&RatingStats{}).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "spu_id"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"review_count": gorm.Expr("review_count + ?", delta),
+			"total":        gorm.Expr("total + ?", delta*int64(rv.Rating)),
+			star:           gorm.Expr(star+" + ?", delta),
+			"with_images":  gorm.Expr("with_images + ?", withImages),
+			"updated_at":   row["updated_at"],
+		}),
+	}).Create(row).Error
+}
+
+func (r *ratingStatsRepo) Find(ctx context.Context, spuID int64) (*biz.RatingStats, error) {
+	var po RatingStats
+	err := r.data.DB(ctx).Where("spu_id = ?", spuID).Take(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &biz.RatingStats{SpuID: spuID}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &biz.RatingStats{
+		SpuID:      po.SpuID,
+		Count:      po.ReviewCount,
+		Total:      po.Total,
+		Stars:      [5]int64{po.Star1, po.Star2, po.Star3, po.Star4, po.Star5},
+		WithImages: po.WithImages,
+	}, nil
+}
@@ -0,0 +1,386 @@
+package data
+
+import (
+	"context"
+	"encoding/gob"
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/conf"
+	"github.com/go-kratos/kratos-layout/pkg/search"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// Keyword fields of the product documents; attrField holds each
+	// attribute as "name=value".
+	categoryField = "category"
+	brandField    = "brand"
+	attrField     = "attr"
+	// Number fields of the product documents.
+	priceField = "price"
+	salesField = "sales"
+	// defaultSaveInterval is how often at most the index is saved when the
+	// config leaves it unset.
+	defaultSaveInterval = time.Minute
+)
+
+// CatalogEvent is the catalog_events table.
+type CatalogEvent struct {
+	ID        int64 `gorm:"primaryKey"`
+	SpuID     int64
+	CreatedAt time.Time `gorm:"index"`
+}
+
+type catalogEventRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewCatalogEventRepo .
+func NewCatalogEventRepo(data *Data, logger log.Logger) biz.CatalogEventRepo {
+	return &catalogEventRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *catalogEventRepo) Add(ctx context.Context, spuID int64) error {
+	return r.data.DB(ctx).Create(&CatalogEvent{SpuID: spuID}).Error
+}
+
+func (r *catalogEventRepo) ListAfter(ctx context.Context, id int64, before time.Time, limit int) ([]*biz.CatalogEvent, error) {
+	var pos []*CatalogEvent
+	err := r.data.DB(ctx).Where("id > ? AND created_at < ?", id, before).Order("id").Limit(limit).Find(&pos).Error
+	if err != nil {
+		return nil, err
+	}
+	rv := make([]*biz.CatalogEvent, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, &biz.CatalogEvent{ID: po.ID, SpuID: po.SpuID, CreatedAt: po.CreatedAt})
+	}
+	return rv, nil
+}
+
+func (r *catalogEventRepo) LastID(ctx context.Context) (int64, error) {
+	var id int64
+	err := r.data.DB(ctx).Model(&CatalogEvent{}).Select("COALESCE(MAX(id), 0)").Scan(&id).Error
+	return id, err
+}
+
+func (r *catalogEventRepo) Prune(ctx context.Context, before time.Time) (int64, error) {
+	res := r.data.DB(ctx).Where("created_at < ?", before).Delete(&CatalogEvent{})
+	return res.RowsAffected, res.Error
+}
+
+// NewSearchPolicy .
+func NewSearchPolicy(c *conf.Shop) *biz.SearchPolicy {
+	return &biz.SearchPolicy{
+		PriceBounds: c.GetSearch().GetPriceBounds(),
+		Retention:   c.GetSearch().GetRetention().AsDuration(),
+	}
+}
+
+// searchSnapshot is the index as saved to its file.
+type searchSnapshot struct {
+	EventID   int64
+	SyncedAt  time.Time
+	Documents []*search.Document
+}
+
+// searchIndex keeps the products in an embedded search index, saved to a
+// file at most every saveInterval and as the service stops, so that a
+// restart catches up from the events after the snapshot.
+type searchIndex struct {
+	path         string
+	saveInterval time.Duration
+
+	mu       sync.Mutex
+	index    *search.Index
+	eventID  int64
+	syncedAt time.Time
+	// version counts the changes, savedVersion is the one saved last.
+	version      int64
+	savedVersion int64
+	savedAt      time.Time
+	log          *log.Helper
+}
+
+// NewSearchIndex loads the search index saved, or starts an empty one to
+// be built from the database.
+func NewSearchIndex(c *conf.Shop, logger log.Logger) (biz.SearchIndex, func(), error) {
+	x := &searchIndex{
+		path:         c.GetSearch().GetPath(),
+		saveInterval: c.GetSearch().GetSaveInterval().AsDuration(),
+		index:        search.New(),
+		log:          log.NewHelper(logger),
+	}
+	if x.saveInterval <= 0 {
+		x.saveInterval = defaultSaveInterval
+	}
+	if err := x.load(); err != nil {
+		// A snapshot unreadable is rebuilt, the index is only a copy.
+		x.log.Warnf("load search index %s: %v", x.path, err)
+		x.index, x.eventID, x.syncedAt = search.New(), 0, time.Time{}
+	}
+	cleanup := func() {
+		if err := x.save(0); err != nil {
+			x.log.Errorf("save search index %s: %v", x.path, err)
+		}
+	}
+	return x, cleanup, nil
+}
+
+func (x *searchIndex) Put(_ context.Context, d *biz.ProductDocument) error {
+	x.modify().Put(toSearchDocument(d))
+	return nil
+}
+
+func (x *searchIndex) Delete(_ context.Context, spuID int64) error {
+	x.modify().Delete(spuID)
+	return nil
+}
+
+func (x *searchIndex) Replace(_ context.Context, docs []*biz.ProductDocument, eventID int64) error {
+	index := search.New()
+	for _, d := range docs {
+		index.Put(toSearchDocument(d))
+	}
+	x.mu.Lock()
+	x.index, x.eventID, x.syncedAt = index, eventID, time.Now()
+	x.version++
+	x.mu.Unlock()
+	return x.save(0)
+}
+
+func (x *searchIndex) Synced(context.Context) (int64, time.Time, error) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	return x.eventID, x.syncedAt, nil
+}
+
+func (x *searchIndex) Commit(_ context.Context, eventID int64) error {
+	x.mu.Lock()
+	if eventID != x.eventID {
+		x.eventID = eventID
+		x.version++
+	}
+	x.syncedAt = time.Now()
+	x.mu.Unlock()
+	return x.save(x.saveInterval)
+}
+
+func (x *searchIndex) Search(_ context.Context, q *biz.ProductQuery, priceBounds []int64) (*biz.SearchHits, error) {
+	sq := &search.Query{
+		Text:   q.Keyword,
+		Facets: []string{categoryField, brandField, attrField},
+		Offset: (q.Page - 1) * q.PageSize,
+		Limit:  q.PageSize,
+	}
+	if q.CategoryID != 0 {
+		sq.Filters = append(sq.Filters, search.Filter{Field: categoryField, Values: []string{strconv.FormatInt(q.CategoryID, 10)}})
+	}
+	if len(q.Brands) > 0 {
+		sq.Filters = append(sq.Filters, search.Filter{Field: brandField, Values: q.Brands})
+	}
+	// Values of the same attribute match any of them, attributes all.
+	var names []string
+	values := make(map[string][]string)
+	for _, a := range q.Attributes {
+		if _, ok := values[a.Name]; !ok {
+			names = append(names, a.Name)
+		}
+		values[a.Name] = append(values[a.Name], a.Name+"="+a.Value)
+	}
+	for _, name := range names {
+		sq.Filters = append(sq.Filters, search.Filter{Field: attrField, Values: values[name]})
+	}
+	if q.MinPrice > 0 || q.MaxPrice > 0 {
+		r := search.Range{Field: priceField, Min: float64(q.MinPrice), Max: math.Inf(1)}
+		if q.MaxPrice > 0 {
+			r.Max = float64(q.MaxPrice)
+		}
+		sq.Ranges = append(sq.Ranges, r)
+	}
+	bounds := make([]float64, 0, len(priceBounds))
+	for _, b := range priceBounds {
+		bounds = append(bounds, float64(b))
+	}
+	sq.RangeFacets = []search.RangeFacet{{Field: priceField, Bounds: bounds}}
+	switch q.Sort {
+	case biz.SearchSales:
+		sq.Sort = []search.Sort{{Field: salesField, Desc: true}, {Field: search.ByScore, Desc: true}}
+	case biz.SearchPriceAsc:
+		sq.Sort = []search.Sort{{Field: priceField}}
+	case biz.SearchPriceDesc:
+		sq.Sort = []search.Sort{{Field: priceField, Desc: true}}
+	default:
+		sq.Sort = []search.Sort{{Field: search.ByScore, Desc: true}, {Field: salesField, Desc: true}}
+	}
+	res := x.current().Search(sq)
+	hits := &biz.SearchHits{Total: int64(res.Total), Facets: &biz.SearchFacets{}}
+	for _, h := range res.Hits {
+		hits.SpuIDs = append(hits.SpuIDs, h.ID)
+	}
+	for _, v := range res.Facets[categoryField] {
+		id, _ := strconv.ParseInt(v.Value, 10, 64)
+		hits.Facets.Categories = append(hits.Facets.Categories, &biz.CategoryFacet{ID: id, Count: int64(v.Count)})
+	}
+	for _, v := range res.Facets[brandField] {
+		hits.Facets.Brands = append(hits.Facets.Brands, &biz.FacetValue{Value: v.Value, Count: int64(v.Count)})
+	}
+	// The values come most frequent first, the attributes in the order of
+	// their most frequent value.
+	byName := make(map[string]*biz.AttributeFacet)
+	for _, v := range res.Facets[attrField] {
+		name, value, _ := strings.Cut(v.Value, "=")
+		a, ok := byName[name]
+		if !ok {
+			a = &biz.AttributeFacet{Name: name}
+			byName[name] = a
+			hits.Facets.Attributes = append(hits.Facets.Attributes, a)
+		}
+		a.Values = append(a.Values, &biz.FacetValue{Value: value, Count: int64(v.Count)})
+	}
+	for i, n := range res.RangeFacets[priceField] {
+		if n == 0 {
+			continue
+		}
+		f := &biz.PriceRangeFacet{Count: int64(n)}
+		if i > 0 {
+			f.Min = priceBounds[i-1]
+		}
+		if i < len(priceBounds) {
+			f.Max = priceBounds[i]
+		}
+		hits.Facets.PriceRanges = append(hits.Facets.PriceRanges, f)
+	}
+	return hits, nil
+}
+
+func (x *searchIndex) Suggest(_ context.Context, prefix string, limit int) ([]string, error) {
+	return x.current().Suggest(prefix, limit), nil
+}
+
+func (x *searchIndex) current() *search.Index {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	return x.index
+}
+
+func (x *searchIndex) modify() *search.Index {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.version++
+	return x.index
+}
+
+func (x *searchIndex) load() error {
+	if x.path == "" {
+		return nil
+	}
+	f, err := os.Open(x.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	var s searchSnapshot
+	if err := gob.NewDecoder(f).Decode(&s); err != nil {
+		return err
+	}
+	for _, d := range s.Documents {
+		x.index.Put(d)
+	}
+	x.eventID, x.syncedAt = s.EventID, s.SyncedAt
+	x.log.Infof("loaded the search index of %d products up to event %d", len(s.Documents), s.EventID)
+	return nil
+}
+
+// save writes the index changed unless saved within interval, to a
+// temporary file renamed over the snapshot so that a crash leaves the
+// previous one whole. The documents are taken with the events they reflect,
+// both changed by the sync alone, and written out of the lock for searches
+// to go on.
+func (x *searchIndex) save(interval time.Duration) error {
+	x.mu.Lock()
+	if x.path == "" || x.version == x.savedVersion || time.Since(x.savedAt) < interval {
+		x.mu.Unlock()
+		return nil
+	}
+	version := x.version
+	s := &searchSnapshot{EventID: x.eventID, SyncedAt: x.syncedAt, Documents: x.index.Documents()}
+	x.mu.Unlock()
+	tmp, err := os.CreateTemp(filepath.Dir(x.path), filepath.Base(x.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if err := gob.NewEncoder(tmp).Encode(s); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), x.path); err != nil {
+		return err
+	}
+	x.mu.Lock()
+	x.savedAt, x.savedVersion = time.Now(), version
+	x.mu.Unlock()
+	return nil
+}
+
+// toSearchDocument indexes the title, brand, categories and attributes of a
+// product, the title counting most.
+func toSearchDocument(d *biz.ProductDocument) *search.Document {
+	s := d.Spu
+	doc := &search.Document{
+		ID: s.ID,
+		Fields: []search.Field{
+			{Text: s.Title, Boost: 3},
+			{Text: s.Brand, Boost: 2},
+			{Text: s.SubTitle, Boost: 1},
+		},
+		Keywords: map[string][]string{},
+		Numbers: map[string]float64{
+			priceField: float64(s.MinPrice),
+			salesField: float64(s.Sales),
+		},
+		Suggestions: []string{s.Title},
+		Weight:      float64(s.Sales),
+	}
+	for _, c := range d.Categories {
+		doc.Fields = append(doc.Fields, search.Field{Text: c.Name, Boost: 1.5})
+		doc.Keywords[categoryField] = append(doc.Keywords[categoryField], strconv.FormatInt(c.ID, 10))
+	}
+	if n := len(d.Categories); n > 0 {
+		doc.Suggestions = append(doc.Suggestions, d.Categories[n-1].Name)
+	}
+	if s.Brand != "" {
+		doc.Keywords[brandField] = []string{s.Brand}
+		doc.Suggestions = append(doc.Suggestions, s.Brand)
+	}
+	for _, a := range s.Attributes {
+		doc.Fields = append(doc.Fields, search.Field{Text: a.Value, Boost: 1})
+		doc.Keywords[attrField] = append(doc.Keywords[attrField], a.Name+"="+a.Value)
+	}
+	for _, sa := range s.SaleAttributes {
+		for _, v := range sa.Values {
+			doc.Fields = append(doc.Fields, search.Field{Text: v, Boost: 1})
+			doc.Keywords[attrField] = append(doc.Keywords[attrField], sa.Name+"="+v)
+		}
+	}
+	return doc
+}
@@ -0,0 +1,153 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// ShippingTemplate is the shipping_templates table.
+type ShippingTemplate struct {
+	ID         int64                  `gorm:"primaryKey"`
+	MerchantID int64                  `gorm:"index"`
+	Name       string                 `gorm:"size:50"`
+	Method     string                 `gorm:"size:16"`
+	Rules      []biz.FreightRule      `gorm:"serializer:json;type:text"`
+	FreeRules  []biz.FreeShippingRule `gorm:"serializer:json;type:text"`
+	Excluded   []string               `gorm:"serializer:json;type:text"`
+	IsDefault  bool
+	Version    int64
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type shippingTemplateRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewShippingTemplateRepo .
+func NewShippingTemplateRepo(data *Data, logger log.Logger) biz.ShippingTemplateRepo {
+	return &shippingTemplateRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *shippingTemplateRepo) Save(ctx context.Context, t *biz.ShippingTemplate) (*biz.ShippingTemplate, error) {
+	po := toShippingTemplatePO(t)
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return nil, err
+	}
+	return toShippingTemplate(po), nil
+}
+
+func (r *shippingTemplateRepo) Update(ctx context.Context, t *biz.ShippingTemplate) error {
+	po := toShippingTemplatePO(t)
+	po.Version++
+	res := r.data.DB(ctx).Model(po).Where("version = ?", t.Version).
+		Select("name", "method", "rules", "free_rules", "excluded", "is_default", "version", "updated_at").
+		Updates(po)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return biz.ErrShippingTemplateConflict
+	}
+	t.Version, t.UpdatedAt = po.Version, po.UpdatedAt
+	return nil
+}
+
+func (r *shippingTemplateRepo) Delete(ctx context.Context, id int64) error {
+	return r.data.DB(ctx).Delete(&ShippingTemplate{}, id).Error
+}
+
+func (r *shippingTemplateRepo) Find(ctx context.Context, id int64) (*biz.ShippingTemplate, error) {
+	var po ShippingTemplate
+	err := r.data.DB(ctx).First(&po, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrShippingTemplateNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toShippingTemplate(&po), nil
+}
+
+func (r *shippingTemplateRepo) FindByIDs(ctx context.Context, ids []int64) (map[int64]*biz.ShippingTemplate, error) {
+	var pos []*ShippingTemplate
+	if err := r.data.DB(ctx).Where("id IN ?", ids).Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	rv := make(map[int64]*biz.ShippingTemplate, len(pos))
+	for _, po := range pos {
+		rv[po.ID] = toShippingTemplate(po)
+	}
+	return rv, nil
+}
+
+func (r *shippingTemplateRepo) FindDefaults(ctx context.Context, merchantIDs []int64) (map[int64]*biz.ShippingTemplate, error) {
+	var pos []*ShippingTemplate
+	if err := r.data.DB(ctx).Where("merchant_id IN ? AND is_default = ?", merchantIDs, true).Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	rv := make(map[int64]*biz.ShippingTemplate, len(pos))
+	for _, po := range pos {
+		rv[po.MerchantID] = toShippingTemplate(po)
+	}
+	return rv, nil
+}
+
+func (r *shippingTemplateRepo) ListByMerchant(ctx context.Context, merchantID int64) ([]*biz.ShippingTemplate, error) {
+	var pos []*ShippingTemplate
+	if err := r.data.DB(ctx).Where("merchant_id = ?", merchantID).Order("id").Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	rv := make([]*biz.ShippingTemplate, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toShippingTemplate(po))
+	}
+	return rv, nil
+}
+
+func (r *shippingTemplateRepo) ClearDefault(ctx context.Context, merchantID, keepID int64) error {
+	return r.data.DB(ctx).Model(&ShippingTemplate{}).
+		Where("merchant_id = ? AND id <> ? AND is_default = ?", merchantID, keepID, true).
+		Updates(map[string]any{"is_default": false, "version": gorm.Expr("version + 1"), "updated_at": time.Now()}).Error
+}
+
+func toShippingTemplatePO(t *biz.ShippingTemplate) *ShippingTemplate {
+	return &ShippingTemplate{
+		ID:         t.ID,
+		MerchantID: t.MerchantID,
+		Name:       t.Name,
+		Method:     string(t.Method),
+		Rules:      t.Rules,
+		FreeRules:  t.FreeRules,
+		Excluded:   t.Excluded,
+		IsDefault:  t.Default,
+		Version:    t.Version,
+		CreatedAt:  t.CreatedAt,
+	}
+}
+
+func toShippingTemplate(po *ShippingTemplate) *biz.ShippingTemplate {
+	return &biz.ShippingTemplate{
+		ID:         po.ID,
+		MerchantID: po.MerchantID,
+		Name:       po.Name,
+		Method:     biz.FreightMethod(po.Method),
+		Rules:      po.Rules,
+		FreeRules:  po.FreeRules,
+		Excluded:   po.Excluded,
+		Default:    po.IsDefault,
+		Version:    po.Version,
+		CreatedAt:  po.CreatedAt,
+		UpdatedAt:  po.UpdatedAt,
+	}
+}
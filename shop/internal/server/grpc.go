@@ -4,6 +4,7 @@ import (
 	"github.com/go-kratos/kratos-layout/bff/api/helloworld/v1"
 	"github.com/go-kratos/kratos-layout/internal/conf"
 	"github.com/go-kratos/kratos-layout/internal/service"
+	shopv1 "github.com/go-kratos/kratos-layout/shop/api/shop/v1"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
@@ -11,7 +12,7 @@ import (
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, catalog *service.CatalogService, catalogManagement *service.CatalogManagementService, inventory *service.InventoryService, flashSale *service.FlashSaleService, flashSaleManagement *service.FlashSaleManagementService, search *service.SearchService, review *service.ReviewService, reviewModeration *service.ReviewModerationService, merchantOnboarding *service.MerchantOnboardingService, merchant *service.MerchantService, merchantManagement *service.MerchantManagementService, merchantAdmin *service.MerchantAdminService, shipping *service.ShippingService, freight *service.FreightService, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
@@ -28,5 +29,19 @@ func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, logger log.L
 	}
 	srv := grpc.NewServer(opts...)
 	v1.RegisterGreeterServer(srv, greeter)
+	shopv1.RegisterCatalogServer(srv, catalog)
+	shopv1.RegisterCatalogManagementServer(srv, catalogManagement)
+	shopv1.RegisterInventoryServer(srv, inventory)
+	shopv1.RegisterFlashSaleServer(srv, flashSale)
+	shopv1.RegisterFlashSaleManagementServer(srv, flashSaleManagement)
+	shopv1.RegisterSearchServer(srv, search)
+	shopv1.RegisterReviewServer(srv, review)
+	shopv1.RegisterReviewModerationServer(srv, reviewModeration)
+	shopv1.RegisterMerchantOnboardingServer(srv, merchantOnboarding)
+	shopv1.RegisterMerchantServer(srv, merchant)
+	shopv1.RegisterMerchantManagementServer(srv, merchantManagement)
+	shopv1.RegisterMerchantAdminServer(srv, merchantAdmin)
+	shopv1.RegisterShippingServer(srv, shipping)
+	shopv1.RegisterFreightServer(srv, freight)
 	return srv
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/go-kratos/kratos-layout/bff/api/helloworld/v1"
 	"github.com/go-kratos/kratos-layout/internal/conf"
 	"github.com/go-kratos/kratos-layout/internal/service"
+	shopv1 "github.com/go-kratos/kratos-layout/shop/api/shop/v1"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
@@ -11,7 +12,7 @@ import (
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, catalog *service.CatalogService, catalogManagement *service.CatalogManagementService, inventory *service.InventoryService, flashSale *service.FlashSaleService, flashSaleManagement *service.FlashSaleManagementService, search *service.SearchService, review *service.ReviewService, reviewModeration *service.ReviewModerationService, merchantOnboarding *service.MerchantOnboardingService, merchant *service.MerchantService, merchantManagement *service.MerchantManagementService, merchantAdmin *service.MerchantAdminService, shipping *service.ShippingService, freight *service.FreightService, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
@@ -28,5 +29,19 @@ func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, logger log.L
 	}
 	srv := http.NewServer(opts...)
 	v1.RegisterGreeterHTTPServer(srv, greeter)
+	shopv1.RegisterCatalogHTTPServer(srv, catalog)
+	shopv1.RegisterCatalogManagementHTTPServer(srv, catalogManagement)
+	shopv1.RegisterInventoryHTTPServer(srv, inventory)
+	shopv1.RegisterFlashSaleHTTPServer(srv, flashSale)
+	shopv1.RegisterFlashSaleManagementHTTPServer(srv, flashSaleManagement)
+	shopv1.RegisterSearchHTTPServer(srv, search)
+	shopv1.RegisterReviewHTTPServer(srv, review)
+	shopv1.RegisterReviewModerationHTTPServer(srv, reviewModeration)
+	shopv1.RegisterMerchantOnboardingHTTPServer(srv, merchantOnboarding)
+	shopv1.RegisterMerchantHTTPServer(srv, merchant)
+	shopv1.RegisterMerchantManagementHTTPServer(srv, merchantManagement)
+	shopv1.RegisterMerchantAdminHTTPServer(srv, merchantAdmin)
+	shopv1.RegisterShippingHTTPServer(srv, shipping)
+	shopv1.RegisterFreightHTTPServer(srv, freight)
 	return srv
 }
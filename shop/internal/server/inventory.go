@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// InventoryServer writes the reservations made in the cache back to the
+// database, reconciles the stock between them periodically, and warms the
+// stock of the flash sales about to start.
+type InventoryServer struct {
+	uc           *biz.InventoryUsecase
+	flashSales   *biz.FlashSaleUsecase
+	interval     time.Duration
+	warmInterval time.Duration
+	cancel       context.CancelFunc
+	wg           sync.WaitGroup
+	log          *log.Helper
+}
+
+// NewInventoryServer new an inventory server.
+func NewInventoryServer(uc *biz.InventoryUsecase, flashSales *biz.FlashSaleUsecase, c *conf.Data, sc *conf.Shop, logger log.Logger) *InventoryServer {
+	interval := c.GetInventory().GetReconcileInterval().AsDuration()
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	warmInterval := sc.GetFlashSale().GetWarmInterval().AsDuration()
+	if warmInterval <= 0 {
+		warmInterval = 30 * time.Second
+	}
+	return &InventoryServer{uc: uc, flashSales: flashSales, interval: interval, warmInterval: warmInterval, log: log.NewHelper(logger)}
+}
+
+// Start implements transport.Server.
+func (s *InventoryServer) Start(context.Context) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.wg.Add(3)
+	go func() {
+		defer s.wg.Done()
+		if err := s.uc.WriteBack(ctx); err != nil {
+			s.log.Errorf("write back reservations: %v", err)
+		}
+	}()
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+			n, err := s.uc.Reconcile(ctx)
+			if err != nil && ctx.Err() == nil {
+				s.log.Errorf("reconcile stock: %v", err)
+			}
+			if n > 0 {
+				s.log.Warnf("reconciled the stock of %d SKUs", n)
+			}
+		}
+	}()
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.warmInterval)
+		defer ticker.Stop()
+		for {
+			n, err := s.flashSales.Warm(ctx)
+			if err != nil && ctx.Err() == nil {
+				s.log.Errorf("warm flash sales: %v", err)
+			}
+			if n > 0 {
+				s.log.Debugf("warmed the stock of %d flash sales", n)
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop implements transport.Server, it waits for the reservation in hand.
+func (s *InventoryServer) Stop(ctx context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+	return nil
+}
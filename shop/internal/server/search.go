@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// pruneInterval is how often the catalog events past their retention are
+// deleted.
+const pruneInterval = time.Hour
+
+// SearchServer keeps the search index of the instance caught up with the
+// catalog events, building it first when it has none, and deletes the
+// events past their retention.
+type SearchServer struct {
+	uc       *biz.SearchUsecase
+	interval time.Duration
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+	log      *log.Helper
+}
+
+// NewSearchServer new a search server.
+func NewSearchServer(uc *biz.SearchUsecase, c *conf.Shop, logger log.Logger) *SearchServer {
+	interval := c.GetSearch().GetSyncInterval().AsDuration()
+	if interval <= 0 {
+		interval = 2 * time.Second
+	}
+	return &SearchServer{uc: uc, interval: interval, log: log.NewHelper(logger)}
+}
+
+// Start implements transport.Server.
+func (s *SearchServer) Start(context.Context) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.wg.Add(2)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			n, err := s.uc.Sync(ctx)
+			if err != nil && ctx.Err() == nil {
+				s.log.Errorf("sync search index: %v", err)
+			}
+			if n > 0 {
+				s.log.Debugf("indexed %d products", n)
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(pruneInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+			n, err := s.uc.PruneEvents(ctx)
+			if err != nil && ctx.Err() == nil {
+				s.log.Errorf("prune catalog events: %v", err)
+			}
+			if n > 0 {
+				s.log.Infof("pruned %d catalog events", n)
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop implements transport.Server, it waits for the sync in hand.
+func (s *SearchServer) Stop(ctx context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+	return nil
+}
@@ -0,0 +1,352 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/go-kratos/kratos-layout/shop/api/shop/v1"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CatalogService is the catalog service buyers read.
+type CatalogService struct {
+	v1.UnimplementedCatalogServer
+
+	uc *biz.CatalogUsecase
+}
+
+// NewCatalogService new a catalog service.
+func NewCatalogService(uc *biz.CatalogUsecase) *CatalogService {
+	return &CatalogService{uc: uc}
+}
+
+// ListCategories implements v1.CatalogServer.
+func (s *CatalogService) ListCategories(ctx context.Context, in *v1.ListCategoriesRequest) (*v1.ListCategoriesReply, error) {
+	cs, err := s.uc.ListCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListCategoriesReply{}
+	for _, c := range cs {
+		reply.Categories = append(reply.Categories, toCategoryProto(c))
+	}
+	return reply, nil
+}
+
+// ListProducts implements v1.CatalogServer.
+func (s *CatalogService) ListProducts(ctx context.Context, in *v1.ListProductsRequest) (*v1.ListProductsReply, error) {
+	spus, total, err := s.uc.ListProducts(ctx, in.CategoryId, in.MerchantId, productSorts[in.Sort], int(in.Page), int(in.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListProductsReply{Total: total}
+	for _, spu := range spus {
+		reply.Products = append(reply.Products, toProductSummary(spu))
+	}
+	return reply, nil
+}
+
+// GetProduct implements v1.CatalogServer.
+func (s *CatalogService) GetProduct(ctx context.Context, in *v1.GetProductRequest) (*v1.ProductInfo, error) {
+	spu, err := s.uc.GetProduct(ctx, in.SpuId)
+	if err != nil {
+		return nil, err
+	}
+	pb := &v1.ProductInfo{
+		SpuId:          spu.ID,
+		MerchantId:     spu.MerchantID,
+		CategoryId:     spu.CategoryID,
+		Title:          spu.Title,
+		SubTitle:       spu.SubTitle,
+		Description:    spu.Description,
+		Images:         spu.Images,
+		Attributes:     toAttributesProto(spu.Attributes),
+		SaleAttributes: toSaleAttributesProto(spu.SaleAttributes),
+		MinPrice:       spu.MinPrice,
+		MaxPrice:       spu.MaxPrice,
+		Brand:          spu.Brand,
+		Sales:          spu.Sales,
+	}
+	for _, k := range spu.Skus {
+		pb.Skus = append(pb.Skus, toSkuProto(k))
+	}
+	return pb, nil
+}
+
+// BatchGetSkus implements v1.CatalogServer.
+func (s *CatalogService) BatchGetSkus(ctx context.Context, in *v1.BatchGetSkusRequest) (*v1.BatchGetSkusReply, error) {
+	skus, err := s.uc.BatchGetSkus(ctx, in.Ids)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.BatchGetSkusReply{}
+	for _, k := range skus {
+		reply.Skus = append(reply.Skus, toSkuProto(k))
+	}
+	return reply, nil
+}
+
+// CatalogManagementService is the catalog back office service.
+type CatalogManagementService struct {
+	v1.UnimplementedCatalogManagementServer
+
+	uc *biz.CatalogUsecase
+}
+
+// NewCatalogManagementService new a catalog back office service.
+func NewCatalogManagementService(uc *biz.CatalogUsecase) *CatalogManagementService {
+	return &CatalogManagementService{uc: uc}
+}
+
+// CreateCategory implements v1.CatalogManagementServer.
+func (s *CatalogManagementService) CreateCategory(ctx context.Context, in *v1.CreateCategoryRequest) (*v1.CategoryInfo, error) {
+	c, err := s.uc.CreateCategory(ctx, &biz.Category{ParentID: in.ParentId, Name: in.Name, Sort: in.Sort})
+	if err != nil {
+		return nil, err
+	}
+	return toCategoryProto(c), nil
+}
+
+// UpdateCategory implements v1.CatalogManagementServer.
+func (s *CatalogManagementService) UpdateCategory(ctx context.Context, in *v1.UpdateCategoryRequest) (*v1.CategoryInfo, error) {
+	c, err := s.uc.UpdateCategory(ctx, &biz.Category{ID: in.Id, Name: in.Name, Sort: in.Sort})
+	if err != nil {
+		return nil, err
+	}
+	return toCategoryProto(c), nil
+}
+
+// DeleteCategory implements v1.CatalogManagementServer.
+func (s *CatalogManagementService) DeleteCategory(ctx context.Context, in *v1.DeleteCategoryRequest) (*v1.DeleteCategoryReply, error) {
+	if err := s.uc.DeleteCategory(ctx, in.Id); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteCategoryReply{}, nil
+}
+
+// CreateSpu implements v1.CatalogManagementServer.
+func (s *CatalogManagementService) CreateSpu(ctx context.Context, in *v1.CreateSpuRequest) (*v1.SpuInfo, error) {
+	spu, err := s.uc.CreateSpu(ctx, &biz.Spu{
+		MerchantID:         in.MerchantId,
+		CategoryID:         in.CategoryId,
+		Title:              in.Title,
+		SubTitle:           in.SubTitle,
+		Brand:              in.Brand,
+		Description:        in.Description,
+		Images:             in.Images,
+		Attributes:         toAttributes(in.Attributes),
+		ShippingTemplateID: in.ShippingTemplateId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toSpuProto(spu), nil
+}
+
+// UpdateSpu implements v1.CatalogManagementServer.
+func (s *CatalogManagementService) UpdateSpu(ctx context.Context, in *v1.UpdateSpuRequest) (*v1.SpuInfo, error) {
+	spu, err := s.uc.UpdateSpu(ctx, &biz.Spu{
+		ID:                 in.Id,
+		MerchantID:         in.MerchantId,
+		CategoryID:         in.CategoryId,
+		Title:              in.Title,
+		SubTitle:           in.SubTitle,
+		Brand:              in.Brand,
+		Description:        in.Description,
+		Images:             in.Images,
+		Attributes:         toAttributes(in.Attributes),
+		ShippingTemplateID: in.ShippingTemplateId,
+		Version:            in.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toSpuProto(spu), nil
+}
+
+// DeleteSpu implements v1.CatalogManagementServer.
+func (s *CatalogManagementService) DeleteSpu(ctx context.Context, in *v1.DeleteSpuRequest) (*v1.DeleteSpuReply, error) {
+	if err := s.uc.DeleteSpu(ctx, in.MerchantId, in.Id); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteSpuReply{}, nil
+}
+
+// GetSpu implements v1.CatalogManagementServer.
+func (s *CatalogManagementService) GetSpu(ctx context.Context, in *v1.GetSpuRequest) (*v1.SpuInfo, error) {
+	spu, err := s.uc.GetSpu(ctx, in.MerchantId, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	return toSpuProto(spu), nil
+}
+
+// ListSpus implements v1.CatalogManagementServer.
+func (s *CatalogManagementService) ListSpus(ctx context.Context, in *v1.ListSpusRequest) (*v1.ListSpusReply, error) {
+	spus, total, err := s.uc.ListSpus(ctx, in.MerchantId, in.CategoryId, spuStatuses[in.Status], int(in.Page), int(in.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListSpusReply{Total: total}
+	for _, spu := range spus {
+		reply.Spus = append(reply.Spus, toSpuProto(spu))
+	}
+	return reply, nil
+}
+
+// SetSpuListed implements v1.CatalogManagementServer.
+func (s *CatalogManagementService) SetSpuListed(ctx context.Context, in *v1.SetSpuListedRequest) (*v1.SpuInfo, error) {
+	spu, err := s.uc.SetSpuListed(ctx, in.MerchantId, in.Id, in.Listed)
+	if err != nil {
+		return nil, err
+	}
+	return toSpuProto(spu), nil
+}
+
+// GenerateSkus implements v1.CatalogManagementServer.
+func (s *CatalogManagementService) GenerateSkus(ctx context.Context, in *v1.GenerateSkusRequest) (*v1.SpuInfo, error) {
+	attrs := make([]biz.SaleAttribute, 0, len(in.SaleAttributes))
+	for _, a := range in.SaleAttributes {
+		attrs = append(attrs, biz.SaleAttribute{Name: a.Name, Values: a.Values})
+	}
+	spu, err := s.uc.GenerateSkus(ctx, in.MerchantId, in.SpuId, attrs, in.Price, in.Stock, in.Weight)
+	if err != nil {
+		return nil, err
+	}
+	return toSpuProto(spu), nil
+}
+
+// UpdateSku implements v1.CatalogManagementServer.
+func (s *CatalogManagementService) UpdateSku(ctx context.Context, in *v1.UpdateSkuRequest) (*v1.SkuInfo, error) {
+	k, err := s.uc.UpdateSku(ctx, &biz.Sku{
+		ID:         in.Id,
+		MerchantID: in.MerchantId,
+		Price:      in.Price,
+		Weight:     in.Weight,
+		Stock:      in.Stock,
+		Image:      in.Image,
+		Code:       in.Code,
+		Enabled:    in.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toSkuProto(k), nil
+}
+
+var spuStatuses = map[v1.SpuStatus]biz.SpuStatus{
+	v1.SpuStatus_DRAFT:    biz.SpuDraft,
+	v1.SpuStatus_LISTED:   biz.SpuListed,
+	v1.SpuStatus_DELISTED: biz.SpuDelisted,
+}
+
+var productSorts = map[v1.ProductSort]biz.SpuSort{
+	v1.ProductSort_PRICE_ASC:  biz.SortPriceAsc,
+	v1.ProductSort_PRICE_DESC: biz.SortPriceDesc,
+}
+
+func toCategoryProto(c *biz.Category) *v1.CategoryInfo {
+	pb := &v1.CategoryInfo{
+		Id:       c.ID,
+		ParentId: c.ParentID,
+		Name:     c.Name,
+		Sort:     c.Sort,
+		Level:    c.Level,
+	}
+	for _, child := range c.Children {
+		pb.Children = append(pb.Children, toCategoryProto(child))
+	}
+	return pb
+}
+
+func toProductSummary(spu *biz.Spu) *v1.ProductSummary {
+	pb := &v1.ProductSummary{
+		SpuId:      spu.ID,
+		MerchantId: spu.MerchantID,
+		CategoryId: spu.CategoryID,
+		Title:      spu.Title,
+		SubTitle:   spu.SubTitle,
+		MinPrice:   spu.MinPrice,
+		MaxPrice:   spu.MaxPrice,
+		Brand:      spu.Brand,
+		Sales:      spu.Sales,
+	}
+	if len(spu.Images) > 0 {
+		pb.Image = spu.Images[0]
+	}
+	return pb
+}
+
+func toSpuProto(spu *biz.Spu) *v1.SpuInfo {
+	pb := &v1.SpuInfo{
+		Id:                 spu.ID,
+		MerchantId:         spu.MerchantID,
+		CategoryId:         spu.CategoryID,
+		Title:              spu.Title,
+		SubTitle:           spu.SubTitle,
+		Description:        spu.Description,
+		Images:             spu.Images,
+		Attributes:         toAttributesProto(spu.Attributes),
+		SaleAttributes:     toSaleAttributesProto(spu.SaleAttributes),
+		MinPrice:           spu.MinPrice,
+		MaxPrice:           spu.MaxPrice,
+		Brand:              spu.Brand,
+		Sales:              spu.Sales,
+		ShippingTemplateId: spu.ShippingTemplateID,
+		Version:            spu.Version,
+		CreatedAt:          timestamppb.New(spu.CreatedAt),
+		UpdatedAt:          timestamppb.New(spu.UpdatedAt),
+	}
+	for k, v := range spuStatuses {
+		if v == spu.Status {
+			pb.Status = k
+		}
+	}
+	for _, k := range spu.Skus {
+		pb.Skus = append(pb.Skus, toSkuProto(k))
+	}
+	return pb
+}
+
+func toSkuProto(k *biz.Sku) *v1.SkuInfo {
+	return &v1.SkuInfo{
+		Id:             k.ID,
+		SpuId:          k.SpuID,
+		MerchantId:     k.MerchantID,
+		Title:          k.Title,
+		Image:          k.Image,
+		Price:          k.Price,
+		Stock:          k.Stock,
+		OnSale:         k.OnSale,
+		SaleAttributes: toAttributesProto(k.SaleAttributes),
+		Code:           k.Code,
+		Enabled:        k.Enabled,
+		Weight:         k.Weight,
+	}
+}
+
+func toAttributes(pbs []*v1.Attribute) []biz.Attribute {
+	attrs := make([]biz.Attribute, 0, len(pbs))
+	for _, a := range pbs {
+		attrs = append(attrs, biz.Attribute{Name: a.Name, Value: a.Value})
+	}
+	return attrs
+}
+
+func toAttributesProto(attrs []biz.Attribute) []*v1.Attribute {
+	pbs := make([]*v1.Attribute, 0, len(attrs))
+	for _, a := range attrs {
+		pbs = append(pbs, &v1.Attribute{Name: a.Name, Value: a.Value})
+	}
+	return pbs
+}
+
+func toSaleAttributesProto(attrs []biz.SaleAttribute) []*v1.SaleAttribute {
+	pbs := make([]*v1.SaleAttribute, 0, len(attrs))
+	for _, a := range attrs {
+		pbs = append(pbs, &v1.SaleAttribute{Name: a.Name, Values: a.Values})
+	}
+	return pbs
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/go-kratos/kratos-layout/shop/api/shop/v1"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FlashSaleService is the flash sale service buyers and the order service read.
+type FlashSaleService struct {
+	v1.UnimplementedFlashSaleServer
+
+	uc *biz.FlashSaleUsecase
+}
+
+// NewFlashSaleService new a flash sale service.
+func NewFlashSaleService(uc *biz.FlashSaleUsecase) *FlashSaleService {
+	return &FlashSaleService{uc: uc}
+}
+
+// ListFlashSales implements v1.FlashSaleServer.
+func (s *FlashSaleService) ListFlashSales(ctx context.Context, in *v1.ListFlashSalesRequest) (*v1.ListFlashSalesReply, error) {
+	var startsBefore time.Time
+	if in.StartsBefore != nil {
+		startsBefore = in.StartsBefore.AsTime()
+	}
+	fs, total, err := s.uc.ListFlashSales(ctx, startsBefore, int(in.Page), int(in.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	return toFlashSalesReply(fs, total), nil
+}
+
+// GetFlashSale implements v1.FlashSaleServer.
+func (s *FlashSaleService) GetFlashSale(ctx context.Context, in *v1.GetFlashSaleRequest) (*v1.FlashSaleInfo, error) {
+	f, err := s.uc.GetFlashSale(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	return toFlashSaleProto(f), nil
+}
+
+// FlashSaleManagementService is the flash sale service merchants manage
+// their flash sales with.
+type FlashSaleManagementService struct {
+	v1.UnimplementedFlashSaleManagementServer
+
+	uc *biz.FlashSaleUsecase
+}
+
+// NewFlashSaleManagementService new a flash sale management service.
+func NewFlashSaleManagementService(uc *biz.FlashSaleUsecase) *FlashSaleManagementService {
+	return &FlashSaleManagementService{uc: uc}
+}
+
+// CreateFlashSale implements v1.FlashSaleManagementServer.
+func (s *FlashSaleManagementService) CreateFlashSale(ctx context.Context, in *v1.CreateFlashSaleRequest) (*v1.FlashSaleInfo, error) {
+	f, err := s.uc.CreateFlashSale(ctx, &biz.FlashSale{
+		MerchantID:   in.MerchantId,
+		SkuID:        in.SkuId,
+		Price:        in.Price,
+		Stock:        in.Stock,
+		PerUserLimit: in.PerUserLimit,
+		StartAt:      in.StartAt.AsTime(),
+		EndAt:        in.EndAt.AsTime(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toFlashSaleProto(f), nil
+}
+
+// UpdateFlashSale implements v1.FlashSaleManagementServer.
+func (s *FlashSaleManagementService) UpdateFlashSale(ctx context.Context, in *v1.UpdateFlashSaleRequest) (*v1.FlashSaleInfo, error) {
+	f, err := s.uc.UpdateFlashSale(ctx, &biz.FlashSale{
+		ID:           in.Id,
+		MerchantID:   in.MerchantId,
+		Price:        in.Price,
+		Stock:        in.Stock,
+		PerUserLimit: in.PerUserLimit,
+		StartAt:      in.StartAt.AsTime(),
+		EndAt:        in.EndAt.AsTime(),
+		Version:      in.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toFlashSaleProto(f), nil
+}
+
+// CancelFlashSale implements v1.FlashSaleManagementServer.
+func (s *FlashSaleManagementService) CancelFlashSale(ctx context.Context, in *v1.CancelFlashSaleRequest) (*v1.FlashSaleInfo, error) {
+	f, err := s.uc.CancelFlashSale(ctx, in.MerchantId, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	return toFlashSaleProto(f), nil
+}
+
+// ListMerchantFlashSales implements v1.FlashSaleManagementServer.
+func (s *FlashSaleManagementService) ListMerchantFlashSales(ctx context.Context, in *v1.ListMerchantFlashSalesRequest) (*v1.ListFlashSalesReply, error) {
+	fs, total, err := s.uc.ListMerchantFlashSales(ctx, in.MerchantId, int(in.Page), int(in.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	return toFlashSalesReply(fs, total), nil
+}
+
+var flashSaleStatuses = map[biz.FlashSaleStatus]v1.FlashSaleStatus{
+	biz.FlashSaleScheduled: v1.FlashSaleStatus_SCHEDULED,
+	biz.FlashSaleCancelled: v1.FlashSaleStatus_CANCELLED,
+}
+
+func toFlashSalesReply(fs []*biz.FlashSale, total int64) *v1.ListFlashSalesReply {
+	reply := &v1.ListFlashSalesReply{Total: total}
+	for _, f := range fs {
+		reply.FlashSales = append(reply.FlashSales, toFlashSaleProto(f))
+	}
+	return reply
+}
+
+func toFlashSaleProto(f *biz.FlashSale) *v1.FlashSaleInfo {
+	return &v1.FlashSaleInfo{
+		Id:            f.ID,
+		MerchantId:    f.MerchantID,
+		SpuId:         f.SpuID,
+		SkuId:         f.SkuID,
+		Title:         f.Title,
+		Image:         f.Image,
+		OriginalPrice: f.OriginalPrice,
+		Price:         f.Price,
+		Stock:         f.Stock,
+		PerUserLimit:  f.PerUserLimit,
+		StartAt:       timestamppb.New(f.StartAt),
+		EndAt:         timestamppb.New(f.EndAt),
+		Status:        flashSaleStatuses[f.Status],
+		Version:       f.Version,
+		CreatedAt:     timestamppb.New(f.CreatedAt),
+	}
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/go-kratos/kratos-layout/shop/api/shop/v1"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+)
+
+// InventoryService is the inventory service orders reserve stock with.
+type InventoryService struct {
+	v1.UnimplementedInventoryServer
+
+	uc *biz.InventoryUsecase
+}
+
+// NewInventoryService new an inventory service.
+func NewInventoryService(uc *biz.InventoryUsecase) *InventoryService {
+	return &InventoryService{uc: uc}
+}
+
+// ReserveStock implements v1.InventoryServer.
+func (s *InventoryService) ReserveStock(ctx context.Context, in *v1.ReserveStockRequest) (*v1.ReservationInfo, error) {
+	items := make([]*biz.StockItem, 0, len(in.Items))
+	for _, it := range in.Items {
+		items = append(items, &biz.StockItem{SkuID: it.SkuId, Quantity: it.Quantity})
+	}
+	r, err := s.uc.ReserveStock(ctx, in.ReservationNo, items)
+	if err != nil {
+		return nil, err
+	}
+	return toReservationProto(r), nil
+}
+
+// ConfirmStock implements v1.InventoryServer.
+func (s *InventoryService) ConfirmStock(ctx context.Context, in *v1.ConfirmStockRequest) (*v1.ReservationInfo, error) {
+	r, err := s.uc.ConfirmStock(ctx, in.ReservationNo)
+	if err != nil {
+		return nil, err
+	}
+	return toReservationProto(r), nil
+}
+
+// ReleaseStock implements v1.InventoryServer.
+func (s *InventoryService) ReleaseStock(ctx context.Context, in *v1.ReleaseStockRequest) (*v1.ReservationInfo, error) {
+	r, err := s.uc.ReleaseStock(ctx, in.ReservationNo)
+	if err != nil {
+		return nil, err
+	}
+	return toReservationProto(r), nil
+}
+
+var reservationStatuses = map[biz.ReservationStatus]v1.ReservationStatus{
+	biz.ReservationReserved:  v1.ReservationStatus_RESERVED,
+	biz.ReservationConfirmed: v1.ReservationStatus_CONFIRMED,
+	biz.ReservationReleased:  v1.ReservationStatus_RELEASED,
+}
+
+func toReservationProto(r *biz.Reservation) *v1.ReservationInfo {
+	pb := &v1.ReservationInfo{
+		ReservationNo: r.ReservationNo,
+		Status:        reservationStatuses[r.Status],
+	}
+	for _, it := range r.Items {
+		pb.Items = append(pb.Items, &v1.StockItem{SkuId: it.SkuID, Quantity: it.Quantity})
+	}
+	return pb
+}
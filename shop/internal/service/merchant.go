@@ -0,0 +1,378 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/go-kratos/kratos-layout/shop/api/shop/v1"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MerchantOnboardingService is the merchant service users apply to open
+// shops with.
+type MerchantOnboardingService struct {
+	v1.UnimplementedMerchantOnboardingServer
+
+	uc *biz.MerchantUsecase
+}
+
+// NewMerchantOnboardingService new a merchant onboarding service.
+func NewMerchantOnboardingService(uc *biz.MerchantUsecase) *MerchantOnboardingService {
+	return &MerchantOnboardingService{uc: uc}
+}
+
+// SubmitApplication implements v1.MerchantOnboardingServer.
+func (s *MerchantOnboardingService) SubmitApplication(ctx context.Context, in *v1.SubmitApplicationRequest) (*v1.ApplicationInfo, error) {
+	a, err := s.uc.SubmitApplication(ctx, &biz.MerchantApplication{
+		UserID:       in.UserId,
+		ShopName:     in.ShopName,
+		Licence:      toLicence(in.Licence),
+		ContactName:  in.ContactName,
+		ContactPhone: in.ContactPhone,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toApplicationProto(a), nil
+}
+
+// GetApplication implements v1.MerchantOnboardingServer.
+func (s *MerchantOnboardingService) GetApplication(ctx context.Context, in *v1.GetApplicationRequest) (*v1.ApplicationInfo, error) {
+	a, err := s.uc.GetApplication(ctx, in.UserId, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	return toApplicationProto(a), nil
+}
+
+// ListUserApplications implements v1.MerchantOnboardingServer.
+func (s *MerchantOnboardingService) ListUserApplications(ctx context.Context, in *v1.ListUserApplicationsRequest) (*v1.ListApplicationsReply, error) {
+	as, total, err := s.uc.ListUserApplications(ctx, in.UserId, int(in.Page), int(in.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	return toApplicationsReply(as, total), nil
+}
+
+// MerchantService is the merchant service buyers read shop profiles with.
+type MerchantService struct {
+	v1.UnimplementedMerchantServer
+
+	uc *biz.MerchantUsecase
+}
+
+// NewMerchantService new a merchant service.
+func NewMerchantService(uc *biz.MerchantUsecase) *MerchantService {
+	return &MerchantService{uc: uc}
+}
+
+// GetMerchant implements v1.MerchantServer.
+func (s *MerchantService) GetMerchant(ctx context.Context, in *v1.GetMerchantRequest) (*v1.MerchantInfo, error) {
+	m, err := s.uc.GetMerchant(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	return toMerchantProto(m, false), nil
+}
+
+// MerchantManagementService is the merchant service staff manage their
+// shops with.
+type MerchantManagementService struct {
+	v1.UnimplementedMerchantManagementServer
+
+	uc *biz.MerchantUsecase
+}
+
+// NewMerchantManagementService new a merchant management service.
+func NewMerchantManagementService(uc *biz.MerchantUsecase) *MerchantManagementService {
+	return &MerchantManagementService{uc: uc}
+}
+
+// UpdateProfile implements v1.MerchantManagementServer.
+func (s *MerchantManagementService) UpdateProfile(ctx context.Context, in *v1.UpdateProfileRequest) (*v1.MerchantInfo, error) {
+	m, err := s.uc.UpdateProfile(ctx, in.OperatorId, &biz.Merchant{
+		ID:           in.MerchantId,
+		Name:         in.Name,
+		Logo:         in.Logo,
+		Description:  in.Description,
+		ServicePhone: in.ServicePhone,
+		Version:      in.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toMerchantProto(m, false), nil
+}
+
+// ListStaff implements v1.MerchantManagementServer.
+func (s *MerchantManagementService) ListStaff(ctx context.Context, in *v1.ListStaffRequest) (*v1.ListStaffReply, error) {
+	staff, err := s.uc.ListStaff(ctx, in.OperatorId, in.MerchantId)
+	if err != nil {
+		return nil, err
+	}
+	return toStaffReply(staff), nil
+}
+
+// AddStaff implements v1.MerchantManagementServer.
+func (s *MerchantManagementService) AddStaff(ctx context.Context, in *v1.AddStaffRequest) (*v1.StaffInfo, error) {
+	st, err := s.uc.AddStaff(ctx, in.OperatorId, &biz.Staff{
+		MerchantID: in.MerchantId,
+		UserID:     in.UserId,
+		Role:       staffRoles[in.Role],
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toStaffProto(st), nil
+}
+
+// UpdateStaffRole implements v1.MerchantManagementServer.
+func (s *MerchantManagementService) UpdateStaffRole(ctx context.Context, in *v1.UpdateStaffRoleRequest) (*v1.StaffInfo, error) {
+	st, err := s.uc.UpdateStaffRole(ctx, in.OperatorId, &biz.Staff{
+		MerchantID: in.MerchantId,
+		UserID:     in.UserId,
+		Role:       staffRoles[in.Role],
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toStaffProto(st), nil
+}
+
+// RemoveStaff implements v1.MerchantManagementServer.
+func (s *MerchantManagementService) RemoveStaff(ctx context.Context, in *v1.RemoveStaffRequest) (*v1.RemoveStaffReply, error) {
+	if err := s.uc.RemoveStaff(ctx, in.OperatorId, in.MerchantId, in.UserId); err != nil {
+		return nil, err
+	}
+	return &v1.RemoveStaffReply{}, nil
+}
+
+// ListUserShops implements v1.MerchantManagementServer.
+func (s *MerchantManagementService) ListUserShops(ctx context.Context, in *v1.ListUserShopsRequest) (*v1.ListStaffReply, error) {
+	staff, err := s.uc.ListUserStaff(ctx, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return toStaffReply(staff), nil
+}
+
+// CheckPermission implements v1.MerchantManagementServer.
+func (s *MerchantManagementService) CheckPermission(ctx context.Context, in *v1.CheckPermissionRequest) (*v1.CheckPermissionReply, error) {
+	m, err := s.uc.GetMerchant(ctx, in.MerchantId)
+	if err != nil {
+		return nil, err
+	}
+	st, ok, err := s.uc.CheckPermission(ctx, in.MerchantId, in.UserId, permissions[in.Permission])
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.CheckPermissionReply{Allowed: ok, MerchantStatus: merchantStatuses[m.Status]}
+	if st != nil {
+		reply.Role = staffRoleProtos[st.Role]
+	}
+	return reply, nil
+}
+
+// MerchantAdminService is the merchant service the platform reviews
+// applications and suspends merchants with.
+type MerchantAdminService struct {
+	v1.UnimplementedMerchantAdminServer
+
+	uc *biz.MerchantUsecase
+}
+
+// NewMerchantAdminService new a merchant admin service.
+func NewMerchantAdminService(uc *biz.MerchantUsecase) *MerchantAdminService {
+	return &MerchantAdminService{uc: uc}
+}
+
+// ListApplications implements v1.MerchantAdminServer.
+func (s *MerchantAdminService) ListApplications(ctx context.Context, in *v1.ListApplicationsRequest) (*v1.ListApplicationsReply, error) {
+	as, total, err := s.uc.ListApplications(ctx, applicationStatusFilters[in.Status], int(in.Page), int(in.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	return toApplicationsReply(as, total), nil
+}
+
+// ReviewApplication implements v1.MerchantAdminServer.
+func (s *MerchantAdminService) ReviewApplication(ctx context.Context, in *v1.ReviewApplicationRequest) (*v1.ApplicationInfo, error) {
+	a, err := s.uc.ReviewApplication(ctx, in.Id, in.Approve, in.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return toApplicationProto(a), nil
+}
+
+// ListMerchants implements v1.MerchantAdminServer.
+func (s *MerchantAdminService) ListMerchants(ctx context.Context, in *v1.ListMerchantsRequest) (*v1.ListMerchantsReply, error) {
+	ms, total, err := s.uc.ListMerchants(ctx, merchantStatusFilters[in.Status], int(in.Page), int(in.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListMerchantsReply{Total: total}
+	for _, m := range ms {
+		reply.Merchants = append(reply.Merchants, toMerchantProto(m, true))
+	}
+	return reply, nil
+}
+
+// SuspendMerchant implements v1.MerchantAdminServer.
+func (s *MerchantAdminService) SuspendMerchant(ctx context.Context, in *v1.SuspendMerchantRequest) (*v1.MerchantInfo, error) {
+	m, err := s.uc.SuspendMerchant(ctx, in.Id, in.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return toMerchantProto(m, true), nil
+}
+
+// ReinstateMerchant implements v1.MerchantAdminServer.
+func (s *MerchantAdminService) ReinstateMerchant(ctx context.Context, in *v1.ReinstateMerchantRequest) (*v1.MerchantInfo, error) {
+	m, err := s.uc.ReinstateMerchant(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	return toMerchantProto(m, true), nil
+}
+
+var applicationStatuses = map[biz.ApplicationStatus]v1.ApplicationStatus{
+	biz.ApplicationPending:  v1.ApplicationStatus_APPLICATION_STATUS_PENDING,
+	biz.ApplicationApproved: v1.ApplicationStatus_APPLICATION_STATUS_APPROVED,
+	biz.ApplicationRejected: v1.ApplicationStatus_APPLICATION_STATUS_REJECTED,
+}
+
+var applicationStatusFilters = map[v1.ApplicationStatus]biz.ApplicationStatus{
+	v1.ApplicationStatus_APPLICATION_STATUS_PENDING:  biz.ApplicationPending,
+	v1.ApplicationStatus_APPLICATION_STATUS_APPROVED: biz.ApplicationApproved,
+	v1.ApplicationStatus_APPLICATION_STATUS_REJECTED: biz.ApplicationRejected,
+}
+
+var merchantStatuses = map[biz.MerchantStatus]v1.MerchantStatus{
+	biz.MerchantActive:    v1.MerchantStatus_ACTIVE,
+	biz.MerchantSuspended: v1.MerchantStatus_SUSPENDED,
+}
+
+var merchantStatusFilters = map[v1.MerchantStatus]biz.MerchantStatus{
+	v1.MerchantStatus_ACTIVE:    biz.MerchantActive,
+	v1.MerchantStatus_SUSPENDED: biz.MerchantSuspended,
+}
+
+var staffRoles = map[v1.StaffRole]biz.StaffRole{
+	v1.StaffRole_OWNER:    biz.RoleOwner,
+	v1.StaffRole_ADMIN:    biz.RoleAdmin,
+	v1.StaffRole_OPERATOR: biz.RoleOperator,
+	v1.StaffRole_SUPPORT:  biz.RoleSupport,
+}
+
+var staffRoleProtos = map[biz.StaffRole]v1.StaffRole{
+	biz.RoleOwner:    v1.StaffRole_OWNER,
+	biz.RoleAdmin:    v1.StaffRole_ADMIN,
+	biz.RoleOperator: v1.StaffRole_OPERATOR,
+	biz.RoleSupport:  v1.StaffRole_SUPPORT,
+}
+
+var permissions = map[v1.Permission]biz.Permission{
+	v1.Permission_PERMISSION_PROFILE: biz.PermissionProfile,
+	v1.Permission_PERMISSION_STAFF:   biz.PermissionStaff,
+	v1.Permission_PERMISSION_CATALOG: biz.PermissionCatalog,
+	v1.Permission_PERMISSION_ORDERS:  biz.PermissionOrders,
+	v1.Permission_PERMISSION_SERVICE: biz.PermissionService,
+}
+
+func toLicence(pb *v1.Licence) biz.Licence {
+	l := biz.Licence{
+		CompanyName: pb.GetCompanyName(),
+		CreditCode:  pb.GetCreditCode(),
+		LegalPerson: pb.GetLegalPerson(),
+		Address:     pb.GetAddress(),
+		Image:       pb.GetImage(),
+	}
+	if pb.GetValidUntil() != nil {
+		l.ValidUntil = pb.ValidUntil.AsTime()
+	}
+	return l
+}
+
+func toLicenceProto(l biz.Licence) *v1.Licence {
+	pb := &v1.Licence{
+		CompanyName: l.CompanyName,
+		CreditCode:  l.CreditCode,
+		LegalPerson: l.LegalPerson,
+		Address:     l.Address,
+		Image:       l.Image,
+	}
+	if !l.ValidUntil.IsZero() {
+		pb.ValidUntil = timestamppb.New(l.ValidUntil)
+	}
+	return pb
+}
+
+func toApplicationsReply(as []*biz.MerchantApplication, total int64) *v1.ListApplicationsReply {
+	reply := &v1.ListApplicationsReply{Total: total}
+	for _, a := range as {
+		reply.Applications = append(reply.Applications, toApplicationProto(a))
+	}
+	return reply
+}
+
+func toApplicationProto(a *biz.MerchantApplication) *v1.ApplicationInfo {
+	pb := &v1.ApplicationInfo{
+		Id:           a.ID,
+		UserId:       a.UserID,
+		ShopName:     a.ShopName,
+		Licence:      toLicenceProto(a.Licence),
+		ContactName:  a.ContactName,
+		ContactPhone: a.ContactPhone,
+		Status:       applicationStatuses[a.Status],
+		Reason:       a.Reason,
+		MerchantId:   a.MerchantID,
+		CreatedAt:    timestamppb.New(a.CreatedAt),
+	}
+	if !a.ReviewedAt.IsZero() {
+		pb.ReviewedAt = timestamppb.New(a.ReviewedAt)
+	}
+	return pb
+}
+
+// toMerchantProto converts a merchant, with its licence only for the
+// platform.
+func toMerchantProto(m *biz.Merchant, platform bool) *v1.MerchantInfo {
+	pb := &v1.MerchantInfo{
+		Id:            m.ID,
+		Name:          m.Name,
+		Logo:          m.Logo,
+		Description:   m.Description,
+		ServicePhone:  m.ServicePhone,
+		OwnerId:       m.OwnerID,
+		Status:        merchantStatuses[m.Status],
+		SuspendReason: m.SuspendReason,
+		Version:       m.Version,
+		CreatedAt:     timestamppb.New(m.CreatedAt),
+	}
+	if platform {
+		pb.Licence = toLicenceProto(m.Licence)
+	}
+	if !m.SuspendedAt.IsZero() {
+		pb.SuspendedAt = timestamppb.New(m.SuspendedAt)
+	}
+	return pb
+}
+
+func toStaffReply(staff []*biz.Staff) *v1.ListStaffReply {
+	reply := &v1.ListStaffReply{}
+	for _, s := range staff {
+		reply.Staff = append(reply.Staff, toStaffProto(s))
+	}
+	return reply
+}
+
+func toStaffProto(s *biz.Staff) *v1.StaffInfo {
+	return &v1.StaffInfo{
+		MerchantId: s.MerchantID,
+		UserId:     s.UserID,
+		Role:       staffRoleProtos[s.Role],
+		CreatedAt:  timestamppb.New(s.CreatedAt),
+	}
+}
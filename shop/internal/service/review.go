@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/go-kratos/kratos-layout/shop/api/shop/v1"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ReviewService is the review service buyers write and read reviews with.
+type ReviewService struct {
+	v1.UnimplementedReviewServer
+
+	uc *biz.ReviewUsecase
+}
+
+// NewReviewService new a review service.
+func NewReviewService(uc *biz.ReviewUsecase) *ReviewService {
+	return &ReviewService{uc: uc}
+}
+
+// CreateReview implements v1.ReviewServer.
+func (s *ReviewService) CreateReview(ctx context.Context, in *v1.CreateReviewRequest) (*v1.ReviewInfo, error) {
+	r, err := s.uc.CreateReview(ctx, &biz.Review{
+		UserID:    in.UserId,
+		OrderNo:   in.OrderNo,
+		SkuID:     in.SkuId,
+		Rating:    in.Rating,
+		Content:   in.Content,
+		Images:    in.Images,
+		Anonymous: in.Anonymous,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toReviewProto(r, false), nil
+}
+
+// FollowUpReview implements v1.ReviewServer.
+func (s *ReviewService) FollowUpReview(ctx context.Context, in *v1.FollowUpReviewRequest) (*v1.ReviewInfo, error) {
+	r, err := s.uc.FollowUpReview(ctx, &biz.Review{
+		ParentID: in.ReviewId,
+		UserID:   in.UserId,
+		Content:  in.Content,
+		Images:   in.Images,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toReviewProto(r, false), nil
+}
+
+// ListReviews implements v1.ReviewServer.
+func (s *ReviewService) ListReviews(ctx context.Context, in *v1.ListReviewsRequest) (*v1.ListReviewsReply, error) {
+	levels := ratingLevels[in.Level]
+	rs, total, err := s.uc.ListReviews(ctx, in.SpuId, levels[0], levels[1], in.WithImages, int(in.Page), int(in.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	return toReviewsReply(rs, total, false), nil
+}
+
+// ListUserReviews implements v1.ReviewServer.
+func (s *ReviewService) ListUserReviews(ctx context.Context, in *v1.ListUserReviewsRequest) (*v1.ListReviewsReply, error) {
+	rs, total, err := s.uc.ListUserReviews(ctx, in.UserId, int(in.Page), int(in.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	return toReviewsReply(rs, total, false), nil
+}
+
+// GetRatingStats implements v1.ReviewServer.
+func (s *ReviewService) GetRatingStats(ctx context.Context, in *v1.GetRatingStatsRequest) (*v1.RatingStatsInfo, error) {
+	st, err := s.uc.GetRatingStats(ctx, in.SpuId)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.RatingStatsInfo{
+		SpuId:      st.SpuID,
+		Count:      st.Count,
+		Average:    st.Average(),
+		GoodRate:   st.GoodRate(),
+		Stars:      st.Stars[:],
+		WithImages: st.WithImages,
+	}, nil
+}
+
+// ReviewModerationService is the review service moderators publish, reject
+// and take down reviews with.
+type ReviewModerationService struct {
+	v1.UnimplementedReviewModerationServer
+
+	uc *biz.ReviewUsecase
+}
+
+// NewReviewModerationService new a review moderation service.
+func NewReviewModerationService(uc *biz.ReviewUsecase) *ReviewModerationService {
+	return &ReviewModerationService{uc: uc}
+}
+
+// ListPendingReviews implements v1.ReviewModerationServer.
+func (s *ReviewModerationService) ListPendingReviews(ctx context.Context, in *v1.ListPendingReviewsRequest) (*v1.ListReviewsReply, error) {
+	rs, total, err := s.uc.ListPendingReviews(ctx, int(in.Page), int(in.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	return toReviewsReply(rs, total, true), nil
+}
+
+// ModerateReview implements v1.ReviewModerationServer.
+func (s *ReviewModerationService) ModerateReview(ctx context.Context, in *v1.ModerateReviewRequest) (*v1.ReviewInfo, error) {
+	r, err := s.uc.ModerateReview(ctx, in.Id, in.Publish, in.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return toReviewProto(r, true), nil
+}
+
+// ratingLevels are the stars each level lists, from and to.
+var ratingLevels = map[v1.RatingLevel][2]int32{
+	v1.RatingLevel_RATING_LEVEL_GOOD:    {4, 5},
+	v1.RatingLevel_RATING_LEVEL_NEUTRAL: {3, 3},
+	v1.RatingLevel_RATING_LEVEL_BAD:     {1, 2},
+}
+
+var reviewStatuses = map[biz.ReviewStatus]v1.ReviewStatus{
+	biz.ReviewPending:   v1.ReviewStatus_PENDING,
+	biz.ReviewPublished: v1.ReviewStatus_PUBLISHED,
+	biz.ReviewRejected:  v1.ReviewStatus_REJECTED,
+}
+
+func toReviewsReply(rs []*biz.Review, total int64, moderator bool) *v1.ListReviewsReply {
+	reply := &v1.ListReviewsReply{Total: total}
+	for _, r := range rs {
+		reply.Reviews = append(reply.Reviews, toReviewProto(r, moderator))
+	}
+	return reply
+}
+
+// toReviewProto converts a review, with the words that held it only for
+// moderators.
+func toReviewProto(r *biz.Review, moderator bool) *v1.ReviewInfo {
+	pb := &v1.ReviewInfo{
+		Id:             r.ID,
+		ParentId:       r.ParentID,
+		UserId:         r.UserID,
+		OrderNo:        r.OrderNo,
+		SpuId:          r.SpuID,
+		SkuId:          r.SkuID,
+		MerchantId:     r.MerchantID,
+		SaleAttributes: toAttributesProto(r.SaleAttributes),
+		Rating:         r.Rating,
+		Content:        r.Content,
+		Images:         r.Images,
+		Anonymous:      r.Anonymous,
+		Status:         reviewStatuses[r.Status],
+		Reason:         r.Reason,
+		CreatedAt:      timestamppb.New(r.CreatedAt),
+	}
+	if moderator {
+		pb.Words = r.Words
+	}
+	if !r.PublishedAt.IsZero() {
+		pb.PublishedAt = timestamppb.New(r.PublishedAt)
+	}
+	if r.FollowUp != nil {
+		pb.FollowUp = toReviewProto(r.FollowUp, moderator)
+	}
+	return pb
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	v1 "github.com/go-kratos/kratos-layout/shop/api/shop/v1"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+)
+
+// SearchService is the product search service buyers use.
+type SearchService struct {
+	v1.UnimplementedSearchServer
+
+	uc *biz.SearchUsecase
+}
+
+// NewSearchService new a search service.
+func NewSearchService(uc *biz.SearchUsecase) *SearchService {
+	return &SearchService{uc: uc}
+}
+
+// SearchProducts implements v1.SearchServer.
+func (s *SearchService) SearchProducts(ctx context.Context, in *v1.SearchProductsRequest) (*v1.SearchProductsReply, error) {
+	q := &biz.ProductQuery{
+		Keyword:    in.Keyword,
+		CategoryID: in.CategoryId,
+		Brands:     in.Brands,
+		MinPrice:   in.MinPrice,
+		MaxPrice:   in.MaxPrice,
+		Sort:       searchSorts[in.Sort],
+		Page:       int(in.Page),
+		PageSize:   int(in.PageSize),
+	}
+	for _, a := range in.Attributes {
+		name, value, ok := strings.Cut(a, ":")
+		if !ok || name == "" || value == "" {
+			return nil, biz.ErrInvalidSearch
+		}
+		q.Attributes = append(q.Attributes, biz.Attribute{Name: name, Value: value})
+	}
+	res, err := s.uc.SearchProducts(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.SearchProductsReply{Total: res.Total, Facets: toSearchFacetsProto(res.Facets)}
+	for _, spu := range res.Products {
+		reply.Products = append(reply.Products, toProductSummary(spu))
+	}
+	return reply, nil
+}
+
+// SuggestProducts implements v1.SearchServer.
+func (s *SearchService) SuggestProducts(ctx context.Context, in *v1.SuggestProductsRequest) (*v1.SuggestProductsReply, error) {
+	suggestions, err := s.uc.SuggestProducts(ctx, in.Prefix, int(in.Limit))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SuggestProductsReply{Suggestions: suggestions}, nil
+}
+
+var searchSorts = map[v1.SearchSort]biz.SearchSort{
+	v1.SearchSort_SEARCH_SORT_SALES:      biz.SearchSales,
+	v1.SearchSort_SEARCH_SORT_PRICE_ASC:  biz.SearchPriceAsc,
+	v1.SearchSort_SEARCH_SORT_PRICE_DESC: biz.SearchPriceDesc,
+}
+
+func toSearchFacetsProto(f *biz.SearchFacets) *v1.SearchFacets {
+	pb := &v1.SearchFacets{Brands: toFacetValuesProto(f.Brands)}
+	for _, c := range f.Categories {
+		pb.Categories = append(pb.Categories, &v1.CategoryFacet{CategoryId: c.ID, Name: c.Name, Count: c.Count})
+	}
+	for _, r := range f.PriceRanges {
+		pb.PriceRanges = append(pb.PriceRanges, &v1.PriceRangeFacet{MinPrice: r.Min, MaxPrice: r.Max, Count: r.Count})
+	}
+	for _, a := range f.Attributes {
+		pb.Attributes = append(pb.Attributes, &v1.AttributeFacet{Name: a.Name, Values: toFacetValuesProto(a.Values)})
+	}
+	return pb
+}
+
+func toFacetValuesProto(values []*biz.FacetValue) []*v1.FacetValue {
+	pbs := make([]*v1.FacetValue, 0, len(values))
+	for _, v := range values {
+		pbs = append(pbs, &v1.FacetValue{Value: v.Value, Count: v.Count})
+	}
+	return pbs
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/go-kratos/kratos-layout/shop/api/shop/v1"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ShippingService is the shipping service merchants manage their shipping
+// templates with.
+type ShippingService struct {
+	v1.UnimplementedShippingServer
+
+	uc *biz.ShippingUsecase
+}
+
+// NewShippingService new a shipping service.
+func NewShippingService(uc *biz.ShippingUsecase) *ShippingService {
+	return &ShippingService{uc: uc}
+}
+
+// CreateShippingTemplate implements v1.ShippingServer.
+func (s *ShippingService) CreateShippingTemplate(ctx context.Context, in *v1.CreateShippingTemplateRequest) (*v1.ShippingTemplateInfo, error) {
+	t, err := s.uc.CreateTemplate(ctx, &biz.ShippingTemplate{
+		MerchantID: in.MerchantId,
+		Name:       in.Name,
+		Method:     freightMethods[in.Method],
+		Rules:      toFreightRules(in.Rules),
+		FreeRules:  toFreeShippingRules(in.FreeRules),
+		Excluded:   in.Excluded,
+		Default:    in.Default,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toShippingTemplateProto(t), nil
+}
+
+// UpdateShippingTemplate implements v1.ShippingServer.
+func (s *ShippingService) UpdateShippingTemplate(ctx context.Context, in *v1.UpdateShippingTemplateRequest) (*v1.ShippingTemplateInfo, error) {
+	t, err := s.uc.UpdateTemplate(ctx, &biz.ShippingTemplate{
+		ID:         in.Id,
+		MerchantID: in.MerchantId,
+		Name:       in.Name,
+		Method:     freightMethods[in.Method],
+		Rules:      toFreightRules(in.Rules),
+		FreeRules:  toFreeShippingRules(in.FreeRules),
+		Excluded:   in.Excluded,
+		Default:    in.Default,
+		Version:    in.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toShippingTemplateProto(t), nil
+}
+
+// DeleteShippingTemplate implements v1.ShippingServer.
+func (s *ShippingService) DeleteShippingTemplate(ctx context.Context, in *v1.DeleteShippingTemplateRequest) (*v1.DeleteShippingTemplateReply, error) {
+	if err := s.uc.DeleteTemplate(ctx, in.MerchantId, in.Id); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteShippingTemplateReply{}, nil
+}
+
+// GetShippingTemplate implements v1.ShippingServer.
+func (s *ShippingService) GetShippingTemplate(ctx context.Context, in *v1.GetShippingTemplateRequest) (*v1.ShippingTemplateInfo, error) {
+	t, err := s.uc.GetTemplate(ctx, in.MerchantId, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	return toShippingTemplateProto(t), nil
+}
+
+// ListShippingTemplates implements v1.ShippingServer.
+func (s *ShippingService) ListShippingTemplates(ctx context.Context, in *v1.ListShippingTemplatesRequest) (*v1.ListShippingTemplatesReply, error) {
+	ts, err := s.uc.ListTemplates(ctx, in.MerchantId)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListShippingTemplatesReply{Templates: make([]*v1.ShippingTemplateInfo, 0, len(ts))}
+	for _, t := range ts {
+		reply.Templates = append(reply.Templates, toShippingTemplateProto(t))
+	}
+	return reply, nil
+}
+
+// FreightService is the freight service the orders price freight with.
+type FreightService struct {
+	v1.UnimplementedFreightServer
+
+	uc *biz.ShippingUsecase
+}
+
+// NewFreightService new a freight service.
+func NewFreightService(uc *biz.ShippingUsecase) *FreightService {
+	return &FreightService{uc: uc}
+}
+
+// CalculateFreight implements v1.FreightServer.
+func (s *FreightService) CalculateFreight(ctx context.Context, in *v1.CalculateFreightRequest) (*v1.CalculateFreightReply, error) {
+	items := make([]*biz.FreightItem, 0, len(in.Items))
+	for _, it := range in.Items {
+		items = append(items, &biz.FreightItem{SkuID: it.SkuId, Quantity: it.Quantity, Amount: it.Amount})
+	}
+	fs, err := s.uc.CalculateFreight(ctx, in.Province, items)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.CalculateFreightReply{Freights: make([]*v1.MerchantFreight, 0, len(fs))}
+	for _, f := range fs {
+		reply.Freights = append(reply.Freights, &v1.MerchantFreight{MerchantId: f.MerchantID, Fee: f.Fee})
+	}
+	return reply, nil
+}
+
+var freightMethods = map[v1.FreightMethod]biz.FreightMethod{
+	v1.FreightMethod_BY_COUNT:  biz.FreightByCount,
+	v1.FreightMethod_BY_WEIGHT: biz.FreightByWeight,
+}
+
+var freightMethodProtos = map[biz.FreightMethod]v1.FreightMethod{
+	biz.FreightByCount:  v1.FreightMethod_BY_COUNT,
+	biz.FreightByWeight: v1.FreightMethod_BY_WEIGHT,
+}
+
+func toFreightRules(pbs []*v1.FreightRule) []biz.FreightRule {
+	rules := make([]biz.FreightRule, 0, len(pbs))
+	for _, pb := range pbs {
+		rules = append(rules, biz.FreightRule{
+			Regions:       pb.Regions,
+			First:         pb.First,
+			FirstFee:      pb.FirstFee,
+			Additional:    pb.Additional,
+			AdditionalFee: pb.AdditionalFee,
+		})
+	}
+	return rules
+}
+
+func toFreeShippingRules(pbs []*v1.FreeShippingRule) []biz.FreeShippingRule {
+	rules := make([]biz.FreeShippingRule, 0, len(pbs))
+	for _, pb := range pbs {
+		rules = append(rules, biz.FreeShippingRule{
+			Regions:     pb.Regions,
+			MinAmount:   pb.MinAmount,
+			MinQuantity: pb.MinQuantity,
+		})
+	}
+	return rules
+}
+
+func toShippingTemplateProto(t *biz.ShippingTemplate) *v1.ShippingTemplateInfo {
+	pb := &v1.ShippingTemplateInfo{
+		Id:         t.ID,
+		MerchantId: t.MerchantID,
+		Name:       t.Name,
+		Method:     freightMethodProtos[t.Method],
+		Rules:      make([]*v1.FreightRule, 0, len(t.Rules)),
+		FreeRules:  make([]*v1.FreeShippingRule, 0, len(t.FreeRules)),
+		Excluded:   t.Excluded,
+		Default:    t.Default,
+		Version:    t.Version,
+		CreatedAt:  timestamppb.New(t.CreatedAt),
+		UpdatedAt:  timestamppb.New(t.UpdatedAt),
+	}
+	for _, r := range t.Rules {
+		pb.Rules = append(pb.Rules, &v1.FreightRule{
+			Regions:       r.Regions,
+			First:         r.First,
+			FirstFee:      r.FirstFee,
+			Additional:    r.Additional,
+			AdditionalFee: r.AdditionalFee,
+		})
+	}
+	for _, r := range t.FreeRules {
+		pb.FreeRules = append(pb.FreeRules, &v1.FreeShippingRule{
+			Regions:     r.Regions,
+			MinAmount:   r.MinAmount,
+			MinQuantity: r.MinQuantity,
+		})
+	}
+	return pb
+}
@@ -0,0 +1,197 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.3
+// source: wallet/v1/error_reason.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ErrorReason int32
+
+const (
+	ErrorReason_WALLET_UNSPECIFIED           ErrorReason = 0
+	ErrorReason_ACCOUNT_NOT_FOUND            ErrorReason = 1
+	ErrorReason_INVALID_DAY                  ErrorReason = 2
+	ErrorReason_RECONCILIATION_RUNNING       ErrorReason = 3
+	ErrorReason_DISCREPANCY_NOT_FOUND        ErrorReason = 4
+	ErrorReason_DISCREPANCY_ALREADY_RESOLVED ErrorReason = 5
+	ErrorReason_INVALID_PAGE_TOKEN           ErrorReason = 6
+	ErrorReason_INVALID_MONTH                ErrorReason = 7
+	ErrorReason_INSUFFICIENT_BALANCE         ErrorReason = 8
+	ErrorReason_HOLD_NOT_FOUND               ErrorReason = 9
+	ErrorReason_HOLD_ALREADY_RELEASED        ErrorReason = 10
+	ErrorReason_HOLD_ALREADY_CAPTURED        ErrorReason = 11
+	ErrorReason_INVALID_HOLD                 ErrorReason = 12
+	ErrorReason_HOLD_NOT_CAPTURED            ErrorReason = 13
+	ErrorReason_INVALID_REFUND               ErrorReason = 14
+	ErrorReason_INVALID_BIZ_TYPE             ErrorReason = 15
+)
+
+// Enum value maps for ErrorReason.
+var (
+	ErrorReason_name = map[int32]string{
+		0:  "WALLET_UNSPECIFIED",
+		1:  "ACCOUNT_NOT_FOUND",
+		2:  "INVALID_DAY",
+		3:  "RECONCILIATION_RUNNING",
+		4:  "DISCREPANCY_NOT_FOUND",
+		5:  "DISCREPANCY_ALREADY_RESOLVED",
+		6:  "INVALID_PAGE_TOKEN",
+		7:  "INVALID_MONTH",
+		8:  "INSUFFICIENT_BALANCE",
+		9:  "HOLD_NOT_FOUND",
+		10: "HOLD_ALREADY_RELEASED",
+		11: "HOLD_ALREADY_CAPTURED",
+		12: "INVALID_HOLD",
+		13: "HOLD_NOT_CAPTURED",
+		14: "INVALID_REFUND",
+		15: "INVALID_BIZ_TYPE",
+	}
+	ErrorReason_value = map[string]int32{
+		"WALLET_UNSPECIFIED":           0,
+		"ACCOUNT_NOT_FOUND":            1,
+		"INVALID_DAY":                  2,
+		"RECONCILIATION_RUNNING":       3,
+		"DISCREPANCY_NOT_FOUND":        4,
+		"DISCREPANCY_ALREADY_RESOLVED": 5,
+		"INVALID_PAGE_TOKEN":           6,
+		"INVALID_MONTH":                7,
+		"INSUFFICIENT_BALANCE":         8,
+		"HOLD_NOT_FOUND":               9,
+		"HOLD_ALREADY_RELEASED":        10,
+		"HOLD_ALREADY_CAPTURED":        11,
+		"INVALID_HOLD":                 12,
+		"HOLD_NOT_CAPTURED":            13,
+		"INVALID_REFUND":               14,
+		"INVALID_BIZ_TYPE":             15,
+	}
+)
+
+func (x ErrorReason) Enum() *ErrorReason {
+	p := new(ErrorReason)
+	*p = x
+	return p
+}
+
+func (x ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallet_v1_error_reason_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorReason) Type() protoreflect.EnumType {
+	return &file_wallet_v1_error_reason_proto_enumTypes[0]
+}
+
+func (x ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorReason.Descriptor instead.
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_wallet_v1_error_reason_proto_rawDescGZIP(), []int{0}
+}
+
+var File_wallet_v1_error_reason_proto protoreflect.FileDescriptor
+
+var file_wallet_v1_error_reason_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2a, 0x88, 0x03, 0x0a, 0x0b, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x57, 0x41, 0x4c,
+	0x4c, 0x45, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x43,
+	0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x55, 0x4e, 0x4e,
+	0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x49, 0x53, 0x43, 0x52, 0x45, 0x50,
+	0x41, 0x4e, 0x43, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04,
+	0x12, 0x20, 0x0a, 0x1c, 0x44, 0x49, 0x53, 0x43, 0x52, 0x45, 0x50, 0x41, 0x4e, 0x43, 0x59, 0x5f,
+	0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x44,
+	0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x41,
+	0x47, 0x45, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x07, 0x12, 0x18, 0x0a,
+	0x14, 0x49, 0x4e, 0x53, 0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x41,
+	0x4c, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x08, 0x12, 0x12, 0x0a, 0x0e, 0x48, 0x4f, 0x4c, 0x44, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x09, 0x12, 0x19, 0x0a, 0x15, 0x48,
+	0x4f, 0x4c, 0x44, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x52, 0x45, 0x4c, 0x45,
+	0x41, 0x53, 0x45, 0x44, 0x10, 0x0a, 0x12, 0x19, 0x0a, 0x15, 0x48, 0x4f, 0x4c, 0x44, 0x5f, 0x41,
+	0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x43, 0x41, 0x50, 0x54, 0x55, 0x52, 0x45, 0x44, 0x10,
+	0x0b, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x48, 0x4f, 0x4c,
+	0x44, 0x10, 0x0c, 0x12, 0x15, 0x0a, 0x11, 0x48, 0x4f, 0x4c, 0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x43, 0x41, 0x50, 0x54, 0x55, 0x52, 0x45, 0x44, 0x10, 0x0d, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x10, 0x0e, 0x12, 0x14,
+	0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x42, 0x49, 0x5a, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x10, 0x0f, 0x42, 0x57, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x50, 0x01, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x67, 0x6f, 0x2d, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73,
+	0x2d, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0xa2,
+	0x02, 0x0b, 0x41, 0x50, 0x49, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_wallet_v1_error_reason_proto_rawDescOnce sync.Once
+	file_wallet_v1_error_reason_proto_rawDescData = file_wallet_v1_error_reason_proto_rawDesc
+)
+
+func file_wallet_v1_error_reason_proto_rawDescGZIP() []byte {
+	file_wallet_v1_error_reason_proto_rawDescOnce.Do(func() {
+		file_wallet_v1_error_reason_proto_rawDescData = protoimpl.X.CompressGZIP(file_wallet_v1_error_reason_proto_rawDescData)
+	})
+	return file_wallet_v1_error_reason_proto_rawDescData
+}
+
+var file_wallet_v1_error_reason_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_wallet_v1_error_reason_proto_goTypes = []interface{}{
+	(ErrorReason)(0), // 0: wallet.v1.ErrorReason
+}
+var file_wallet_v1_error_reason_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_wallet_v1_error_reason_proto_init() }
+func file_wallet_v1_error_reason_proto_init() {
+	if File_wallet_v1_error_reason_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_wallet_v1_error_reason_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wallet_v1_error_reason_proto_goTypes,
+		DependencyIndexes: file_wallet_v1_error_reason_proto_depIdxs,
+		EnumInfos:         file_wallet_v1_error_reason_proto_enumTypes,
+	}.Build()
+	File_wallet_v1_error_reason_proto = out.File
+	file_wallet_v1_error_reason_proto_rawDesc = nil
+	file_wallet_v1_error_reason_proto_goTypes = nil
+	file_wallet_v1_error_reason_proto_depIdxs = nil
+}
@@ -31,10 +31,37 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 	greeterRepo := data.NewGreeterRepo(dataData, logger)
 	greeterUsecase := biz.NewGreeterUsecase(greeterRepo, logger)
 	greeterService := service.NewGreeterService(greeterUsecase)
-	grpcServer := server.NewGRPCServer(confServer, greeterService, logger)
-	httpServer := server.NewHTTPServer(confServer, greeterService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	journalRepo := data.NewJournalRepo(dataData, logger)
+	journalUsecase := biz.NewJournalUsecase(journalRepo, logger)
+	walletService := service.NewWalletService(journalUsecase)
+	reconciliationRepo := data.NewReconciliationRepo(dataData, logger)
+	accountRepo := data.NewAccountRepo(dataData, logger)
+	paymentClient, cleanup2, err := data.NewPaymentClient(confData)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	settlementRepo := data.NewSettlementRepo(paymentClient, logger)
+	transaction := data.NewTransaction(dataData)
+	reconciliationUsecase := biz.NewReconciliationUsecase(reconciliationRepo, accountRepo, journalRepo, settlementRepo, transaction, logger)
+	walletAdminService := service.NewWalletAdminService(reconciliationUsecase)
+	holdRepo := data.NewHoldRepo(dataData, logger)
+	holdUsecase := biz.NewHoldUsecase(accountRepo, journalRepo, holdRepo, transaction, logger)
+	balanceService := service.NewBalanceService(holdUsecase)
+	grpcServer := server.NewGRPCServer(confServer, greeterService, walletService, walletAdminService, balanceService, logger)
+	httpServer := server.NewHTTPServer(confServer, greeterService, walletService, walletAdminService, logger)
+	cronServer, err := server.NewCronServer(confServer, reconciliationUsecase, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	eventSubscriber := data.NewEventSubscriber(dataData, logger)
+	topUpUsecase := biz.NewTopUpUsecase(accountRepo, journalRepo, transaction, logger)
+	consumerServer := server.NewConsumerServer(eventSubscriber, topUpUsecase, logger)
+	app := newApp(logger, grpcServer, httpServer, cronServer, consumerServer)
 	return app, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -0,0 +1,255 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/go-kratos/kratos-layout/wallet/api/wallet/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrInsufficientBalance is returned when the available balance is short.
+	ErrInsufficientBalance = errors.BadRequest(v1.ErrorReason_INSUFFICIENT_BALANCE.String(), "insufficient balance")
+	// ErrHoldNotFound is hold not found.
+	ErrHoldNotFound = errors.NotFound(v1.ErrorReason_HOLD_NOT_FOUND.String(), "hold not found")
+	// ErrHoldReleased is returned when holding or capturing a released hold.
+	ErrHoldReleased = errors.Conflict(v1.ErrorReason_HOLD_ALREADY_RELEASED.String(), "hold already released")
+	// ErrHoldCaptured is returned when releasing a captured hold.
+	ErrHoldCaptured = errors.Conflict(v1.ErrorReason_HOLD_ALREADY_CAPTURED.String(), "hold already captured")
+	// ErrHoldNotCaptured is returned when refunding a hold never captured.
+	ErrHoldNotCaptured = errors.Conflict(v1.ErrorReason_HOLD_NOT_CAPTURED.String(), "hold not captured")
+	// ErrInvalidRefund is returned for a refund without a number or a
+	// positive amount, or one for more than is left to refund.
+	ErrInvalidRefund = errors.BadRequest(v1.ErrorReason_INVALID_REFUND.String(), "invalid refund")
+	// ErrInvalidHold is returned for a hold missing its number, business or
+	// a positive amount, or one reusing the number of a different hold.
+	ErrInvalidHold = errors.BadRequest(v1.ErrorReason_INVALID_HOLD.String(), "invalid hold")
+)
+
+// HoldStatus is the status of a balance hold.
+type HoldStatus string
+
+const (
+	HoldHeld     HoldStatus = "held"
+	HoldCaptured HoldStatus = "captured"
+	HoldReleased HoldStatus = "released"
+)
+
+// Hold is an amount frozen on an account until it is captured or released.
+type Hold struct {
+	ID        int64
+	HoldNo    string
+	AccountID int64
+	UserID    int64
+	Asset     string
+	Amount    int64
+	BizType   BizType
+	BizNo     string
+	Remark    string
+	Status    HoldStatus
+	// Refunded is the captured amount credited back by refunds.
+	Refunded  int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// HoldRepo is a Hold repo.
+type HoldRepo interface {
+	Create(context.Context, *Hold) error
+	// Lock finds a hold and locks it for the transaction carried by ctx.
+	Lock(ctx context.Context, holdNo string) (*Hold, error)
+	UpdateStatus(ctx context.Context, id int64, status HoldStatus) error
+	AddRefunded(ctx context.Context, id, amount int64) error
+}
+
+// HoldUsecase spends balances in two phases for other services.
+type HoldUsecase struct {
+	accounts AccountRepo
+	journal  JournalRepo
+	holds    HoldRepo
+	tx       Transaction
+	log      *log.Helper
+}
+
+// NewHoldUsecase new a Hold usecase.
+func NewHoldUsecase(accounts AccountRepo, journal JournalRepo, holds HoldRepo, tx Transaction, logger log.Logger) *HoldUsecase {
+	return &HoldUsecase{accounts: accounts, journal: journal, holds: holds, tx: tx, log: log.NewHelper(logger)}
+}
+
+// Hold freezes h.Amount of the available balance of the user.
+func (uc *HoldUsecase) Hold(ctx context.Context, h *Hold) (*Hold, error) {
+	if h.HoldNo == "" || h.Amount <= 0 || h.BizType == "" || h.BizNo == "" {
+		return nil, ErrInvalidHold
+	}
+	if h.Asset == "" {
+		h.Asset = AssetCNY
+	}
+	var rv *Hold
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		prev, err := uc.holds.Lock(ctx, h.HoldNo)
+		switch {
+		case errors.Is(err, ErrHoldNotFound):
+		case err != nil:
+			return err
+		case prev.Status == HoldReleased:
+			return ErrHoldReleased
+		case prev.UserID != h.UserID || prev.Asset != h.Asset || prev.Amount != h.Amount:
+			return ErrInvalidHold
+		default:
+			rv = prev
+			return nil
+		}
+		acct, err := uc.accounts.FindByUser(ctx, h.UserID, h.Asset)
+		if errors.Is(err, ErrAccountNotFound) {
+			return ErrInsufficientBalance
+		}
+		if err != nil {
+			return err
+		}
+		if err := uc.accounts.Freeze(ctx, acct.ID, h.Amount); err != nil {
+			return err
+		}
+		h.AccountID = acct.ID
+		h.Status = HoldHeld
+		if err := uc.holds.Create(ctx, h); err != nil {
+			return err
+		}
+		rv = h
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("Hold: %s %d %s of user %d", rv.HoldNo, rv.Amount, rv.Asset, rv.UserID)
+	return rv, nil
+}
+
+// Capture debits a held amount, posting it to the journal.
+func (uc *HoldUsecase) Capture(ctx context.Context, holdNo string) (*Hold, error) {
+	var rv *Hold
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		h, err := uc.holds.Lock(ctx, holdNo)
+		if err != nil {
+			return err
+		}
+		rv = h
+		switch h.Status {
+		case HoldCaptured:
+			return nil
+		case HoldReleased:
+			return ErrHoldReleased
+		}
+		balance, err := uc.accounts.DebitFrozen(ctx, h.AccountID, h.Amount)
+		if err != nil {
+			return err
+		}
+		if err := uc.journal.Append(ctx, &JournalEntry{
+			AccountID:    h.AccountID,
+			UserID:       h.UserID,
+			Asset:        h.Asset,
+			Direction:    DirectionDebit,
+			Amount:       h.Amount,
+			BalanceAfter: balance,
+			BizType:      h.BizType,
+			BizNo:        h.BizNo,
+			Remark:       h.Remark,
+		}); err != nil {
+			return err
+		}
+		h.Status = HoldCaptured
+		return uc.holds.UpdateStatus(ctx, h.ID, HoldCaptured)
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("Capture: %s", holdNo)
+	return rv, nil
+}
+
+// Release unfreezes a held amount. A hold released before it arrived is
+// recorded as released, so that it is refused when it does arrive.
+func (uc *HoldUsecase) Release(ctx context.Context, holdNo string) (*Hold, error) {
+	if holdNo == "" {
+		return nil, ErrInvalidHold
+	}
+	var rv *Hold
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		h, err := uc.holds.Lock(ctx, holdNo)
+		if errors.Is(err, ErrHoldNotFound) {
+			rv = &Hold{HoldNo: holdNo, Status: HoldReleased}
+			return uc.holds.Create(ctx, rv)
+		}
+		if err != nil {
+			return err
+		}
+		rv = h
+		switch h.Status {
+		case HoldReleased:
+			return nil
+		case HoldCaptured:
+			return ErrHoldCaptured
+		}
+		if err := uc.accounts.Unfreeze(ctx, h.AccountID, h.Amount); err != nil {
+			return err
+		}
+		h.Status = HoldReleased
+		return uc.holds.UpdateStatus(ctx, h.ID, HoldReleased)
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("Release: %s", holdNo)
+	return rv, nil
+}
+
+// Refund credits back part of a captured amount, once per refund number.
+func (uc *HoldUsecase) Refund(ctx context.Context, refundNo, holdNo string, amount int64) (*Hold, error) {
+	if refundNo == "" || amount <= 0 {
+		return nil, ErrInvalidRefund
+	}
+	var rv *Hold
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		h, err := uc.holds.Lock(ctx, holdNo)
+		if err != nil {
+			return err
+		}
+		rv = h
+		if h.Status != HoldCaptured {
+			return ErrHoldNotCaptured
+		}
+		seen, err := uc.journal.HasEntry(ctx, BizTypeRefund, refundNo)
+		if err != nil || seen {
+			return err
+		}
+		if amount > h.Amount-h.Refunded {
+			return ErrInvalidRefund
+		}
+		balance, err := uc.accounts.Credit(ctx, h.AccountID, amount)
+		if err != nil {
+			return err
+		}
+		if err := uc.journal.Append(ctx, &JournalEntry{
+			AccountID:    h.AccountID,
+			UserID:       h.UserID,
+			Asset:        h.Asset,
+			Direction:    DirectionCredit,
+			Amount:       amount,
+			BalanceAfter: balance,
+			BizType:      BizTypeRefund,
+			BizNo:        refundNo,
+			Remark:       h.BizNo,
+		}); err != nil {
+			return err
+		}
+		h.Refunded += amount
+		return uc.holds.AddRefunded(ctx, h.ID, amount)
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("Refund: %s of %s for %d", refundNo, holdNo, amount)
+	return rv, nil
+}
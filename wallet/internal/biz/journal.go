@@ -0,0 +1,166 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	v1 "github.com/go-kratos/kratos-layout/wallet/api/wallet/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrInvalidPageToken is returned when a page token cannot be decoded.
+	ErrInvalidPageToken = errors.BadRequest(v1.ErrorReason_INVALID_PAGE_TOKEN.String(), "invalid page token")
+	// ErrInvalidMonth is returned when a month is not formatted as 2006-01.
+	ErrInvalidMonth = errors.BadRequest(v1.ErrorReason_INVALID_MONTH.String(), "invalid month")
+	// ErrInvalidBizType is returned when filtering by a biz type not known.
+	ErrInvalidBizType = errors.BadRequest(v1.ErrorReason_INVALID_BIZ_TYPE.String(), "invalid biz type")
+)
+
+// MonthLayout is the layout of statement months.
+const MonthLayout = "2006-01"
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+
+	statementBatchSize = 1000
+)
+
+// JournalFilter narrows a journal listing, zero fields match all.
+type JournalFilter struct {
+	UserID    int64
+	Asset     string
+	Direction Direction
+	BizTypes  []BizType
+	// Start and End bound the posting time to [Start, End).
+	Start time.Time
+	End   time.Time
+	// Ascending lists oldest first, the default is newest first.
+	Ascending bool
+	// Cursor is the id of the last entry of the previous page.
+	Cursor int64
+	Limit  int
+}
+
+// Statement is the monthly statement of a user.
+type Statement struct {
+	UserID int64
+	Month  time.Time
+	Assets []*StatementAsset
+}
+
+// StatementAsset is the part of a statement covering one asset.
+type StatementAsset struct {
+	Asset   string
+	Opening int64
+	Credit  int64
+	Debit   int64
+	Closing int64
+	Entries []*JournalEntry
+}
+
+// JournalUsecase is a journal usecase.
+type JournalUsecase struct {
+	repo JournalRepo
+	log  *log.Helper
+}
+
+// NewJournalUsecase new a journal usecase.
+func NewJournalUsecase(repo JournalRepo, logger log.Logger) *JournalUsecase {
+	return &JournalUsecase{repo: repo, log: log.NewHelper(logger)}
+}
+
+// ParseMonth parses a 2006-01 month in local time.
+func ParseMonth(s string) (time.Time, error) {
+	month, err := time.ParseInLocation(MonthLayout, s, time.Local)
+	if err != nil {
+		return time.Time{}, ErrInvalidMonth
+	}
+	return month, nil
+}
+
+// ListEntries lists a page of the journal of a user and returns the cursor of
+// the next page, which is zero on the last one.
+func (uc *JournalUsecase) ListEntries(ctx context.Context, f *JournalFilter) ([]*JournalEntry, int64, error) {
+	if f.Limit <= 0 {
+		f.Limit = defaultPageSize
+	}
+	if f.Limit > maxPageSize {
+		f.Limit = maxPageSize
+	}
+	limit := f.Limit
+	// Fetch one extra entry to tell whether there is a next page.
+	f.Limit++
+	entries, err := uc.repo.List(ctx, f)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(entries) <= limit {
+		return entries, 0, nil
+	}
+	entries = entries[:limit]
+	return entries, entries[limit-1].ID, nil
+}
+
+// BuildStatement folds the journal of a user in a month into a statement.
+func (uc *JournalUsecase) BuildStatement(ctx context.Context, userID int64, month time.Time) (*Statement, error) {
+	start := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, month.Location())
+	end := start.AddDate(0, 1, 0)
+	openings, err := uc.repo.SumBefore(ctx, userID, start)
+	if err != nil {
+		return nil, err
+	}
+	assets := make(map[string]*StatementAsset, len(openings))
+	asset := func(name string) *StatementAsset {
+		a, ok := assets[name]
+		if !ok {
+			a = &StatementAsset{Asset: name, Opening: openings[name]}
+			assets[name] = a
+		}
+		return a
+	}
+	for name, opening := range openings {
+		if opening != 0 {
+			asset(name)
+		}
+	}
+	f := &JournalFilter{
+		UserID:    userID,
+		Start:     start,
+		End:       end,
+		Ascending: true,
+		Limit:     statementBatchSize,
+	}
+	for {
+		entries, err := uc.repo.List(ctx, f)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			a := asset(e.Asset)
+			switch e.Direction {
+			case DirectionCredit:
+				a.Credit += e.Amount
+			case DirectionDebit:
+				a.Debit += e.Amount
+			}
+			a.Entries = append(a.Entries, e)
+			f.Cursor = e.ID
+		}
+		if len(entries) < statementBatchSize {
+			break
+		}
+	}
+	s := &Statement{UserID: userID, Month: start}
+	for _, a := range assets {
+		a.Closing = a.Opening + a.Credit - a.Debit
+		s.Assets = append(s.Assets, a)
+	}
+	sort.Slice(s.Assets, func(i, j int) bool { return s.Assets[i].Asset < s.Assets[j].Asset })
+	uc.log.WithContext(ctx).Infof("BuildStatement: user %d month %s with %d assets", userID, start.Format(MonthLayout), len(s.Assets))
+	return s, nil
+}
@@ -0,0 +1,127 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/go-kratos/kratos-layout/wallet/api/wallet/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+var (
+	// ErrAccountNotFound is account not found.
+	ErrAccountNotFound = errors.NotFound(v1.ErrorReason_ACCOUNT_NOT_FOUND.String(), "account not found")
+)
+
+// AssetCNY is the asset top-ups are paid in.
+const AssetCNY = "CNY"
+
+// Direction is the side of a journal entry.
+type Direction string
+
+const (
+	DirectionCredit Direction = "credit"
+	DirectionDebit  Direction = "debit"
+)
+
+// BizType is the business a journal entry was posted for.
+type BizType string
+
+const (
+	BizTypeTopUp        BizType = "top_up"
+	BizTypeWithdraw     BizType = "withdraw"
+	BizTypeOrder        BizType = "order"
+	BizTypeRedEnvelope  BizType = "red_envelope"
+	BizTypeLotteryPrize BizType = "lottery_prize"
+	BizTypeGift         BizType = "gift"
+	BizTypeRefund       BizType = "refund"
+)
+
+// Account is the balance a user holds in one asset.
+type Account struct {
+	ID      int64
+	UserID  int64
+	Asset   string
+	Balance int64
+	// Frozen is the part of the balance held for pending payments.
+	Frozen    int64
+	Version   int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// JournalEntry is an immutable line of the wallet journal.
+type JournalEntry struct {
+	ID           int64
+	AccountID    int64
+	UserID       int64
+	Asset        string
+	Direction    Direction
+	Amount       int64
+	BalanceAfter int64
+	BizType      BizType
+	BizNo        string
+	Remark       string
+	CreatedAt    time.Time
+}
+
+// JournalSummary folds the journal of an account.
+type JournalSummary struct {
+	Credit int64
+	Debit  int64
+	Count  int64
+	// BalanceAfter of the latest entry, zero when there is none.
+	LastBalance int64
+}
+
+// Balance is the balance the journal adds up to.
+func (s *JournalSummary) Balance() int64 {
+	return s.Credit - s.Debit
+}
+
+// AccountRepo is an Account repo.
+type AccountRepo interface {
+	FindByID(context.Context, int64) (*Account, error)
+	// ListAfter pages through accounts in id order.
+	ListAfter(ctx context.Context, afterID int64, limit int) ([]*Account, error)
+	FindByUser(ctx context.Context, userID int64, asset string) (*Account, error)
+	// Create opens an account, or returns the account of the user in the
+	// asset when it was opened concurrently.
+	Create(context.Context, *Account) (*Account, error)
+	// Freeze moves an amount of the available balance to frozen, or returns
+	// ErrInsufficientBalance.
+	Freeze(ctx context.Context, id, amount int64) error
+	Unfreeze(ctx context.Context, id, amount int64) error
+	// DebitFrozen takes a frozen amount off the balance and returns the
+	// balance after.
+	DebitFrozen(ctx context.Context, id, amount int64) (int64, error)
+	// Credit adds an amount to the balance and returns the balance after.
+	Credit(ctx context.Context, id, amount int64) (int64, error)
+}
+
+// JournalRepo is a JournalEntry repo.
+type JournalRepo interface {
+	// Append posts an entry, or returns ErrEntryExists for one of the same
+	// business, account and direction.
+	Append(context.Context, *JournalEntry) error
+	// HasEntry reports whether the journal has an entry of a business.
+	HasEntry(ctx context.Context, bizType BizType, bizNo string) (bool, error)
+	List(context.Context, *JournalFilter) ([]*JournalEntry, error)
+	// SumBefore returns the balance per asset the journal of a user adds up
+	// to before t.
+	SumBefore(ctx context.Context, userID int64, t time.Time) (map[string]int64, error)
+	Summarize(ctx context.Context, accountID int64) (*JournalSummary, error)
+	// SummarizeBetween folds the journal of an account posted within
+	// [start, end), a zero start folding from the first entry.
+	SummarizeBetween(ctx context.Context, accountID int64, start, end time.Time) (*JournalSummary, error)
+	// BalanceBefore returns the BalanceAfter of the last entry of an account
+	// posted before t, zero when there is none.
+	BalanceBefore(ctx context.Context, accountID int64, t time.Time) (int64, error)
+	// SumByBizNo sums the entries of an asset posted within [start, end) per
+	// business number.
+	SumByBizNo(ctx context.Context, asset string, bizType BizType, direction Direction, start, end time.Time) (map[string]int64, error)
+	// SumOfBizNos sums the entries of an asset of the business numbers given
+	// per business number, whenever they were posted.
+	SumOfBizNos(ctx context.Context, asset string, bizType BizType, direction Direction, bizNos []string) (map[string]int64, error)
+}
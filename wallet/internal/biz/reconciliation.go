@@ -0,0 +1,484 @@
+package biz
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "github.com/go-kratos/kratos-layout/wallet/api/wallet/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrInvalidDay is returned when a day is not formatted as 2006-01-02.
+	ErrInvalidDay = errors.BadRequest(v1.ErrorReason_INVALID_DAY.String(), "invalid day")
+	// ErrReconciliationRunning is returned when another run holds the lock.
+	ErrReconciliationRunning = errors.Conflict(v1.ErrorReason_RECONCILIATION_RUNNING.String(), "reconciliation is running")
+	// ErrDiscrepancyNotFound is discrepancy not found.
+	ErrDiscrepancyNotFound = errors.NotFound(v1.ErrorReason_DISCREPANCY_NOT_FOUND.String(), "discrepancy not found")
+	// ErrDiscrepancyResolved is returned when resolving a discrepancy twice.
+	ErrDiscrepancyResolved = errors.Conflict(v1.ErrorReason_DISCREPANCY_ALREADY_RESOLVED.String(), "discrepancy already resolved")
+)
+
+// ErrSnapshotNotFound is returned by the FindSnapshot of a repo for a day
+// not snapshotted.
+var ErrSnapshotNotFound = stderrors.New("balance snapshot not found")
+
+// DayLayout is the layout of reconciliation days.
+const DayLayout = "2006-01-02"
+
+const reconcileBatchSize = 500
+
+// settlementDetailTrades bounds the trades a settlement discrepancy names.
+const settlementDetailTrades = 10
+
+// RunStatus is the status of a reconciliation run.
+type RunStatus string
+
+const (
+	RunRunning   RunStatus = "running"
+	RunSucceeded RunStatus = "succeeded"
+	RunFailed    RunStatus = "failed"
+)
+
+// DiscrepancyKind is what a reconciliation check found out of balance.
+type DiscrepancyKind string
+
+const (
+	KindBalanceMismatch    DiscrepancyKind = "balance_mismatch"
+	KindJournalBroken      DiscrepancyKind = "journal_broken"
+	KindSettlementMismatch DiscrepancyKind = "settlement_mismatch"
+	KindSnapshotMismatch   DiscrepancyKind = "snapshot_mismatch"
+)
+
+// DiscrepancyStatus is the status of a discrepancy.
+type DiscrepancyStatus string
+
+const (
+	DiscrepancyOpen     DiscrepancyStatus = "open"
+	DiscrepancyResolved DiscrepancyStatus = "resolved"
+)
+
+// ReconciliationRun is one reconciliation of a day.
+type ReconciliationRun struct {
+	ID            int64
+	Day           time.Time
+	Status        RunStatus
+	Accounts      int64
+	Discrepancies int64
+	Error         string
+	StartedAt     time.Time
+	FinishedAt    time.Time
+}
+
+// Discrepancy is a reconciliation finding waiting for an operator.
+type Discrepancy struct {
+	ID         int64
+	RunID      int64
+	Kind       DiscrepancyKind
+	AccountID  int64
+	Expected   int64
+	Actual     int64
+	Detail     string
+	Status     DiscrepancyStatus
+	Resolution string
+	ResolvedBy string
+	CreatedAt  time.Time
+	ResolvedAt time.Time
+}
+
+// BalanceSnapshot is the balance of an account at the end of a day, the
+// BalanceAfter of its last journal entry of the day or before.
+type BalanceSnapshot struct {
+	AccountID int64
+	Day       time.Time
+	Balance   int64
+}
+
+// DiscrepancyFilter narrows ListDiscrepancies, zero fields match all.
+type DiscrepancyFilter struct {
+	Status DiscrepancyStatus
+	Kind   DiscrepancyKind
+	RunID  int64
+}
+
+// ReconciliationRepo is a ReconciliationRun and Discrepancy repo.
+type ReconciliationRepo interface {
+	// Lock takes the reconciliation lock, ok is false when it is already held.
+	Lock(ctx context.Context, ttl time.Duration) (unlock func(), ok bool, err error)
+	CreateRun(context.Context, *ReconciliationRun) (*ReconciliationRun, error)
+	UpdateRun(context.Context, *ReconciliationRun) error
+	SaveSnapshot(context.Context, *BalanceSnapshot) error
+	// FindSnapshot returns the snapshot of an account on a day, or
+	// ErrSnapshotNotFound.
+	FindSnapshot(ctx context.Context, accountID int64, day time.Time) (*BalanceSnapshot, error)
+	CreateDiscrepancy(context.Context, *Discrepancy) (*Discrepancy, error)
+	FindDiscrepancy(context.Context, int64) (*Discrepancy, error)
+	// FindOpenDiscrepancy finds the open discrepancy a run of the day raised
+	// of a kind on an account, or returns ErrDiscrepancyNotFound.
+	FindOpenDiscrepancy(ctx context.Context, day time.Time, kind DiscrepancyKind, accountID int64) (*Discrepancy, error)
+	// RefreshDiscrepancy moves an open discrepancy to the run that found it
+	// again, with what that run found, or returns ErrDiscrepancyResolved.
+	RefreshDiscrepancy(context.Context, *Discrepancy) error
+	UpdateDiscrepancy(context.Context, *Discrepancy) error
+	ListDiscrepancies(ctx context.Context, filter *DiscrepancyFilter, page, pageSize int) ([]*Discrepancy, int64, error)
+}
+
+// SettledTopUp is a top-up payment settled.
+type SettledTopUp struct {
+	TradeNo string
+	Amount  int64
+	PaidAt  time.Time
+}
+
+// SettlementRepo reads what payment settled.
+type SettlementRepo interface {
+	// ListSettledTopUps lists the top-ups payment settled within [start, end).
+	ListSettledTopUps(ctx context.Context, start, end time.Time) ([]*SettledTopUp, error)
+}
+
+// ReconciliationUsecase is a reconciliation usecase.
+type ReconciliationUsecase struct {
+	repo       ReconciliationRepo
+	accounts   AccountRepo
+	journal    JournalRepo
+	settlement SettlementRepo
+	tx         Transaction
+	log        *log.Helper
+}
+
+// NewReconciliationUsecase new a reconciliation usecase.
+func NewReconciliationUsecase(repo ReconciliationRepo, accounts AccountRepo, journal JournalRepo, settlement SettlementRepo, tx Transaction, logger log.Logger) *ReconciliationUsecase {
+	return &ReconciliationUsecase{
+		repo:       repo,
+		accounts:   accounts,
+		journal:    journal,
+		settlement: settlement,
+		tx:         tx,
+		log:        log.NewHelper(logger),
+	}
+}
+
+// ParseDay parses a 2006-01-02 day in local time.
+func ParseDay(s string) (time.Time, error) {
+	day, err := time.ParseInLocation(DayLayout, s, time.Local)
+	if err != nil {
+		return time.Time{}, ErrInvalidDay
+	}
+	return day, nil
+}
+
+// Reconcile recomputes every account from the journal, compares it with the
+// stored balance, snapshots its balance at the end of the day after checking
+// it against the snapshot of the day before, and cross-checks the day's
+// top-ups against payment. It blocks until the run finishes.
+func (uc *ReconciliationUsecase) Reconcile(ctx context.Context, day time.Time) (*ReconciliationRun, error) {
+	run, unlock, err := uc.begin(ctx, day)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	if err := uc.execute(ctx, run); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// StartReconcile starts Reconcile in the background and returns the run as
+// it was created.
+func (uc *ReconciliationUsecase) StartReconcile(ctx context.Context, day time.Time) (*ReconciliationRun, error) {
+	run, unlock, err := uc.begin(ctx, day)
+	if err != nil {
+		return nil, err
+	}
+	created := *run
+	go func() {
+		defer unlock()
+		if err := uc.execute(context.Background(), run); err != nil {
+			uc.log.Errorf("Reconcile: run %d: %v", run.ID, err)
+		}
+	}()
+	return &created, nil
+}
+
+func (uc *ReconciliationUsecase) begin(ctx context.Context, day time.Time) (*ReconciliationRun, func(), error) {
+	unlock, ok, err := uc.repo.Lock(ctx, 2*time.Hour)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !ok {
+		return nil, nil, ErrReconciliationRunning
+	}
+	run, err := uc.repo.CreateRun(ctx, &ReconciliationRun{
+		Day:       time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location()),
+		Status:    RunRunning,
+		StartedAt: time.Now(),
+	})
+	if err != nil {
+		unlock()
+		return nil, nil, err
+	}
+	uc.log.WithContext(ctx).Infof("Reconcile: run %d for %s", run.ID, run.Day.Format(DayLayout))
+	return run, unlock, nil
+}
+
+func (uc *ReconciliationUsecase) execute(ctx context.Context, run *ReconciliationRun) error {
+	err := uc.reconcileAccounts(ctx, run)
+	if err == nil {
+		err = uc.reconcileSettlement(ctx, run, run.Day, run.Day.AddDate(0, 0, 1))
+	}
+	run.FinishedAt = time.Now()
+	run.Status = RunSucceeded
+	if err != nil {
+		run.Status = RunFailed
+		run.Error = err.Error()
+	}
+	if uerr := uc.repo.UpdateRun(ctx, run); uerr != nil {
+		return uerr
+	}
+	return err
+}
+
+func (uc *ReconciliationUsecase) reconcileAccounts(ctx context.Context, run *ReconciliationRun) error {
+	var afterID int64
+	for {
+		accounts, err := uc.accounts.ListAfter(ctx, afterID, reconcileBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, a := range accounts {
+			if err := uc.reconcileAccount(ctx, run, a.ID); err != nil {
+				return err
+			}
+			run.Accounts++
+			afterID = a.ID
+		}
+		if len(accounts) < reconcileBatchSize {
+			return nil
+		}
+	}
+}
+
+func (uc *ReconciliationUsecase) reconcileAccount(ctx context.Context, run *ReconciliationRun, accountID int64) error {
+	var (
+		account       *Account
+		sum, day      *JournalSummary
+		opening       int64
+		closing       int64
+		openingSource string
+	)
+	end := run.Day.AddDate(0, 0, 1)
+	// Read the balance and the journal from the same snapshot so postings
+	// landing in between cannot show up as a discrepancy.
+	err := uc.tx.InTx(ctx, func(ctx context.Context) (err error) {
+		if account, err = uc.accounts.FindByID(ctx, accountID); err != nil {
+			return err
+		}
+		if sum, err = uc.journal.Summarize(ctx, accountID); err != nil {
+			return err
+		}
+		if day, err = uc.journal.SummarizeBetween(ctx, accountID, run.Day, end); err != nil {
+			return err
+		}
+		if closing, err = uc.journal.BalanceBefore(ctx, accountID, end); err != nil {
+			return err
+		}
+		// The day opens at the snapshot of the day before, or at what the
+		// journal adds up to when that day was not reconciled.
+		prev, err := uc.repo.FindSnapshot(ctx, accountID, run.Day.AddDate(0, 0, -1))
+		switch {
+		case err == nil:
+			opening, openingSource = prev.Balance, "snapshot of the day before"
+			return nil
+		case !errors.Is(err, ErrSnapshotNotFound):
+			return err
+		}
+		before, err := uc.journal.SummarizeBetween(ctx, accountID, time.Time{}, run.Day)
+		if err != nil {
+			return err
+		}
+		opening, openingSource = before.Balance(), "journal before the day"
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if sum.Balance() != account.Balance {
+		if err := uc.raise(ctx, run, &Discrepancy{
+			Kind:      KindBalanceMismatch,
+			AccountID: account.ID,
+			Expected:  sum.Balance(),
+			Actual:    account.Balance,
+			Detail:    fmt.Sprintf("journal of %d entries adds up to %d, account holds %d", sum.Count, sum.Balance(), account.Balance),
+		}); err != nil {
+			return err
+		}
+	}
+	if sum.Count > 0 && sum.LastBalance != sum.Balance() {
+		if err := uc.raise(ctx, run, &Discrepancy{
+			Kind:      KindJournalBroken,
+			AccountID: account.ID,
+			Expected:  sum.Balance(),
+			Actual:    sum.LastBalance,
+			Detail:    fmt.Sprintf("latest entry records balance %d, journal adds up to %d", sum.LastBalance, sum.Balance()),
+		}); err != nil {
+			return err
+		}
+	}
+	if expected := opening + day.Balance(); closing != expected {
+		if err := uc.raise(ctx, run, &Discrepancy{
+			Kind:      KindSnapshotMismatch,
+			AccountID: account.ID,
+			Expected:  expected,
+			Actual:    closing,
+			Detail: fmt.Sprintf("day opens at %d by the %s and moves %d in %d entries, last entry records balance %d",
+				opening, openingSource, day.Balance(), day.Count, closing),
+		}); err != nil {
+			return err
+		}
+	}
+	return uc.repo.SaveSnapshot(ctx, &BalanceSnapshot{
+		AccountID: account.ID,
+		Day:       run.Day,
+		Balance:   closing,
+	})
+}
+
+// reconcileSettlement matches trade by trade the top-ups payment settled
+// within [start, end) against what the wallet credited them, whenever, and
+// the credits posted within it against the top-ups settled then or the day
+// before, so that one paid just before midnight and credited after it lines
+// up on both days.
+func (uc *ReconciliationUsecase) reconcileSettlement(ctx context.Context, run *ReconciliationRun, start, end time.Time) error {
+	settled, err := uc.settledTopUps(ctx, start, end)
+	if err != nil {
+		return err
+	}
+	tradeNos := make([]string, 0, len(settled))
+	for tradeNo := range settled {
+		tradeNos = append(tradeNos, tradeNo)
+	}
+	credited, err := uc.journal.SumOfBizNos(ctx, AssetCNY, BizTypeTopUp, DirectionCredit, tradeNos)
+	if err != nil {
+		return err
+	}
+	posted, err := uc.journal.SumByBizNo(ctx, AssetCNY, BizTypeTopUp, DirectionCredit, start, end)
+	if err != nil {
+		return err
+	}
+	var before map[string]int64
+	for tradeNo, amount := range posted {
+		if _, ok := settled[tradeNo]; ok {
+			continue
+		}
+		if before == nil {
+			if before, err = uc.settledTopUps(ctx, start.AddDate(0, 0, -1), start); err != nil {
+				return err
+			}
+		}
+		// Settled the day before, it is matched by the run of that day.
+		if _, ok := before[tradeNo]; !ok {
+			credited[tradeNo] = amount
+			tradeNos = append(tradeNos, tradeNo)
+		}
+	}
+	sort.Strings(tradeNos)
+
+	var (
+		expected, actual int64
+		off              []string
+	)
+	for _, tradeNo := range tradeNos {
+		if settled[tradeNo] == credited[tradeNo] {
+			continue
+		}
+		expected += settled[tradeNo]
+		actual += credited[tradeNo]
+		if len(off) < settlementDetailTrades {
+			off = append(off, fmt.Sprintf("%s settled %d credited %d", tradeNo, settled[tradeNo], credited[tradeNo]))
+		} else if len(off) == settlementDetailTrades {
+			off = append(off, "...")
+		}
+	}
+	if len(off) == 0 {
+		return nil
+	}
+	return uc.raise(ctx, run, &Discrepancy{
+		Kind:     KindSettlementMismatch,
+		Expected: expected,
+		Actual:   actual,
+		Detail:   fmt.Sprintf("top-ups settled and credited differ: %s", strings.Join(off, ", ")),
+	})
+}
+
+func (uc *ReconciliationUsecase) settledTopUps(ctx context.Context, start, end time.Time) (map[string]int64, error) {
+	topUps, err := uc.settlement.ListSettledTopUps(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+	rv := make(map[string]int64, len(topUps))
+	for _, t := range topUps {
+		rv[t.TradeNo] = t.Amount
+	}
+	return rv, nil
+}
+
+// raise records a discrepancy, or refreshes the one still open that an
+// earlier run of the day raised of the same kind on the same account, so
+// that reconciling a day again does not pile up duplicates.
+func (uc *ReconciliationUsecase) raise(ctx context.Context, run *ReconciliationRun, d *Discrepancy) error {
+	d.RunID = run.ID
+	d.Status = DiscrepancyOpen
+	prev, err := uc.repo.FindOpenDiscrepancy(ctx, run.Day, d.Kind, d.AccountID)
+	if err == nil {
+		d.ID, d.CreatedAt = prev.ID, prev.CreatedAt
+		err = uc.repo.RefreshDiscrepancy(ctx, d)
+	}
+	// Never raised, or resolved in the meantime, it is raised anew.
+	if errors.Is(err, ErrDiscrepancyNotFound) || errors.Is(err, ErrDiscrepancyResolved) {
+		d.ID, d.CreatedAt = 0, time.Now()
+		_, err = uc.repo.CreateDiscrepancy(ctx, d)
+	}
+	if err != nil {
+		return err
+	}
+	run.Discrepancies++
+	uc.log.WithContext(ctx).Warnf("Reconcile: run %d found %s on account %d: %s", run.ID, d.Kind, d.AccountID, d.Detail)
+	return nil
+}
+
+// ListDiscrepancies lists discrepancies, newest first.
+func (uc *ReconciliationUsecase) ListDiscrepancies(ctx context.Context, filter *DiscrepancyFilter, page, pageSize int) ([]*Discrepancy, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	return uc.repo.ListDiscrepancies(ctx, filter, page, pageSize)
+}
+
+// ResolveDiscrepancy records how an operator settled a discrepancy.
+func (uc *ReconciliationUsecase) ResolveDiscrepancy(ctx context.Context, id int64, operator, resolution string) (*Discrepancy, error) {
+	d, err := uc.repo.FindDiscrepancy(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if d.Status == DiscrepancyResolved {
+		return nil, ErrDiscrepancyResolved
+	}
+	d.Status = DiscrepancyResolved
+	d.ResolvedBy = operator
+	d.Resolution = resolution
+	d.ResolvedAt = time.Now()
+	if err := uc.repo.UpdateDiscrepancy(ctx, d); err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("ResolveDiscrepancy: %d by %s", id, operator)
+	return d, nil
+}
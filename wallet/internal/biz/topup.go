@@ -0,0 +1,122 @@
+package biz
+
+import (
+	"context"
+	stderrors "errors"
+
+	paymentv1 "github.com/go-kratos/kratos-layout/payment/api/payment/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// EventPaymentSucceeded is the type of the payment events top-ups are
+// credited on.
+const EventPaymentSucceeded = "payment.succeeded"
+
+// ErrEntryExists is returned by the Append of a repo for an entry of the
+// same business, account and direction posted before.
+var ErrEntryExists = stderrors.New("journal entry exists")
+
+// PaymentEvent is an event published by the payment service.
+type PaymentEvent struct {
+	// ID is the id of the event in the payment outbox.
+	ID      string
+	Type    string
+	Key     string
+	Payload []byte
+}
+
+// EventSubscriber delivers the payment events, at least once each.
+type EventSubscriber interface {
+	// Subscribe calls handle for each event until ctx is done. An event
+	// handle fails for is delivered again later.
+	Subscribe(ctx context.Context, handle func(context.Context, *PaymentEvent) error) error
+}
+
+// TopUpUsecase credits the top-ups paid through payment to the balances.
+type TopUpUsecase struct {
+	accounts AccountRepo
+	journal  JournalRepo
+	tx       Transaction
+	log      *log.Helper
+}
+
+// NewTopUpUsecase new a top-up usecase.
+func NewTopUpUsecase(accounts AccountRepo, journal JournalRepo, tx Transaction, logger log.Logger) *TopUpUsecase {
+	return &TopUpUsecase{
+		accounts: accounts,
+		journal:  journal,
+		tx:       tx,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// HandlePaymentEvent credits the top-ups paid. Events may come twice and
+// handling them again changes nothing.
+func (uc *TopUpUsecase) HandlePaymentEvent(ctx context.Context, e *PaymentEvent) error {
+	if e.Type != EventPaymentSucceeded {
+		return nil
+	}
+	var m paymentv1.PaymentSucceeded
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(e.Payload, &m); err != nil {
+		uc.log.WithContext(ctx).Errorf("HandlePaymentEvent: skipping %s %s: %v", e.Type, e.ID, err)
+		return nil
+	}
+	if m.Purpose != paymentv1.Purpose_PURPOSE_TOP_UP {
+		return nil
+	}
+	if m.TradeNo == "" || m.UserId == 0 || m.Amount <= 0 {
+		uc.log.WithContext(ctx).Errorf("HandlePaymentEvent: skipping top-up %q of user %d for %d", m.TradeNo, m.UserId, m.Amount)
+		return nil
+	}
+	return uc.credit(ctx, &m)
+}
+
+// credit credits a paid top-up to the account of the user, opening it on
+// the first top-up. The journal entry is keyed by the trade, so that a
+// top-up credited before, by this or another instance, is not again.
+func (uc *TopUpUsecase) credit(ctx context.Context, m *paymentv1.PaymentSucceeded) error {
+	a, err := uc.accounts.FindByUser(ctx, m.UserId, AssetCNY)
+	if errors.Is(err, ErrAccountNotFound) {
+		a, err = uc.accounts.Create(ctx, &Account{UserID: m.UserId, Asset: AssetCNY})
+	}
+	if err != nil {
+		return err
+	}
+	var balance int64
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		seen, err := uc.journal.HasEntry(ctx, BizTypeTopUp, m.TradeNo)
+		if err != nil {
+			return err
+		}
+		if seen {
+			return ErrEntryExists
+		}
+		if balance, err = uc.accounts.Credit(ctx, a.ID, m.Amount); err != nil {
+			return err
+		}
+		// Two deliveries passing HasEntry together meet at the unique key
+		// of the journal, the later rolling its credit back.
+		return uc.journal.Append(ctx, &JournalEntry{
+			AccountID:    a.ID,
+			UserID:       a.UserID,
+			Asset:        a.Asset,
+			Direction:    DirectionCredit,
+			Amount:       m.Amount,
+			BalanceAfter: balance,
+			BizType:      BizTypeTopUp,
+			BizNo:        m.TradeNo,
+			Remark:       m.BizNo,
+		})
+	})
+	if errors.Is(err, ErrEntryExists) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	uc.log.WithContext(ctx).Infof("TopUp: %s of user %d for %d, balance %d", m.TradeNo, m.UserId, m.Amount, balance)
+	return nil
+}
@@ -0,0 +1,161 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// Account is the accounts table.
+type Account struct {
+	ID        int64  `gorm:"primaryKey"`
+	UserID    int64  `gorm:"uniqueIndex:idx_accounts_user_asset,priority:1"`
+	Asset     string `gorm:"size:16;uniqueIndex:idx_accounts_user_asset,priority:2"`
+	Balance   int64
+	Frozen    int64
+	Version   int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type accountRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewAccountRepo .
+func NewAccountRepo(data *Data, logger log.Logger) biz.AccountRepo {
+	return &accountRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *accountRepo) FindByID(ctx context.Context, id int64) (*biz.Account, error) {
+	var po Account
+	err := r.data.DB(ctx).First(&po, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrAccountNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toAccount(&po), nil
+}
+
+func (r *accountRepo) ListAfter(ctx context.Context, afterID int64, limit int) ([]*biz.Account, error) {
+	var pos []*Account
+	if err := r.data.DB(ctx).Where("id > ?", afterID).Order("id").Limit(limit).Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	rv := make([]*biz.Account, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toAccount(po))
+	}
+	return rv, nil
+}
+
+func (r *accountRepo) FindByUser(ctx context.Context, userID int64, asset string) (*biz.Account, error) {
+	var po Account
+	err := r.data.DB(ctx).Where("user_id = ? AND asset = ?", userID, asset).First(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrAccountNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toAccount(&po), nil
+}
+
+func (r *accountRepo) Create(ctx context.Context, a *biz.Account) (*biz.Account, error) {
+	po := &Account{UserID: a.UserID, Asset: a.Asset}
+	err := r.data.DB(ctx).Create(po).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return r.FindByUser(ctx, a.UserID, a.Asset)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toAccount(po), nil
+}
+
+func (r *accountRepo) Freeze(ctx context.Context, id, amount int64) error {
+	res := r.data.DB(ctx).Model(&Account{}).
+		Where("id = ? AND balance - frozen >= ?", id, amount).
+		Updates(map[string]interface{}{
+			"frozen":  gorm.Expr("frozen + ?", amount),
+			"version": gorm.Expr("version + 1"),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return biz.ErrInsufficientBalance
+	}
+	return nil
+}
+
+func (r *accountRepo) Unfreeze(ctx context.Context, id, amount int64) error {
+	return r.data.DB(ctx).Model(&Account{}).
+		Where("id = ? AND frozen >= ?", id, amount).
+		Updates(map[string]interface{}{
+			"frozen":  gorm.Expr("frozen - ?", amount),
+			"version": gorm.Expr("version + 1"),
+		}).Error
+}
+
+func (r *accountRepo) DebitFrozen(ctx context.Context, id, amount int64) (int64, error) {
+	res := r.data.DB(ctx).Model(&Account{}).
+		Where("id = ? AND frozen >= ?", id, amount).
+		Updates(map[string]interface{}{
+			"balance": gorm.Expr("balance - ?", amount),
+			"frozen":  gorm.Expr("frozen - ?", amount),
+			"version": gorm.Expr("version + 1"),
+		})
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return 0, fmt.Errorf("account %d has less than %d frozen", id, amount)
+	}
+	var po Account
+	if err := r.data.DB(ctx).Select("balance").First(&po, id).Error; err != nil {
+		return 0, err
+	}
+	return po.Balance, nil
+}
+
+func (r *accountRepo) Credit(ctx context.Context, id, amount int64) (int64, error) {
+	err := r.data.DB(ctx).Model(&Account{}).Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"balance": gorm.Expr("balance + ?", amount),
+			"version": gorm.Expr("version + 1"),
+		}).Error
+	if err != nil {
+		return 0, err
+	}
+	var po Account
+	if err := r.data.DB(ctx).Select("balance").First(&po, id).Error; err != nil {
+		return 0, err
+	}
+	return po.Balance, nil
+}
+
+func toAccount(po *Account) *biz.Account {
+	return &biz.Account{
+		ID:        po.ID,
+		UserID:    po.UserID,
+		Asset:     po.Asset,
+		Balance:   po.Balance,
+		Frozen:    po.Frozen,
+		Version:   po.Version,
+		CreatedAt: po.CreatedAt,
+		UpdatedAt: po.UpdatedAt,
+	}
+}
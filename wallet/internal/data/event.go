@@ -0,0 +1,137 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// paymentEventStream is the Redis stream the payment service publishes to.
+	paymentEventStream = "payment:events"
+	// paymentEventGroup is the consumer group of the wallet service.
+	paymentEventGroup = "wallet"
+	// eventBatch is how many events one read returns at most.
+	eventBatch = 100
+	// eventBlock is how long a read waits for new events.
+	eventBlock = 5 * time.Second
+	// eventRetry is how long failed events wait before they are retried.
+	eventRetry = 10 * time.Second
+)
+
+type eventSubscriber struct {
+	data     *Data
+	consumer string
+	log      *log.Helper
+}
+
+// NewEventSubscriber .
+func NewEventSubscriber(data *Data, logger log.Logger) biz.EventSubscriber {
+	consumer, _ := os.Hostname()
+	return &eventSubscriber{
+		data:     data,
+		consumer: consumer,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// Subscribe reads the stream in the wallet consumer group, acknowledging the
+// events handled. Events left pending, by a failure or a crash, are read
+// again from the start of the pending list once eventRetry has passed.
+func (s *eventSubscriber) Subscribe(ctx context.Context, handle func(context.Context, *biz.PaymentEvent) error) error {
+	// The group starts at the beginning of the stream, so that the events
+	// published before the first deployment are not missed.
+	err := s.data.rdb.XGroupCreateMkStream(ctx, paymentEventStream, paymentEventGroup, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return err
+	}
+	// Reading from "0" returns the pending events of this consumer after the
+	// cursor, reading from ">" returns new events.
+	cursor, retryAt := "0", time.Time{}
+	for ctx.Err() == nil {
+		id := ">"
+		if cursor != "" {
+			id = cursor
+		}
+		streams, err := s.data.rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    paymentEventGroup,
+			Consumer: s.consumer,
+			Streams:  []string{paymentEventStream, id},
+			Count:    eventBatch,
+			Block:    eventBlock,
+		}).Result()
+		if errors.Is(err, redis.Nil) {
+			err, streams = nil, nil
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			s.log.Errorf("read payment events: %v", err)
+			sleep(ctx, time.Second)
+			continue
+		}
+		var messages []redis.XMessage
+		if len(streams) > 0 {
+			messages = streams[0].Messages
+		}
+		if cursor != "" && len(messages) == 0 {
+			cursor = ""
+		}
+		for _, m := range messages {
+			if cursor != "" {
+				cursor = m.ID
+			}
+			e := toPaymentEvent(m)
+			if err := handle(ctx, e); err != nil {
+				s.log.Errorf("handle payment event %s %s: %v", e.Type, e.ID, err)
+				if retryAt.IsZero() {
+					retryAt = time.Now().Add(eventRetry)
+				}
+				continue
+			}
+			if err := s.data.rdb.XAck(ctx, paymentEventStream, paymentEventGroup, m.ID).Err(); err != nil {
+				s.log.Errorf("ack payment event %s: %v", e.ID, err)
+			}
+		}
+		if cursor == "" && !retryAt.IsZero() && time.Now().After(retryAt) {
+			cursor, retryAt = "0", time.Time{}
+		}
+	}
+	return nil
+}
+
+func toPaymentEvent(m redis.XMessage) *biz.PaymentEvent {
+	e := &biz.PaymentEvent{ID: m.ID}
+	if v, ok := m.Values["id"]; ok {
+		e.ID = fmt.Sprint(v)
+	}
+	if v, ok := m.Values["type"].(string); ok {
+		e.Type = v
+	}
+	if v, ok := m.Values["key"].(string); ok {
+		e.Key = v
+	}
+	if v, ok := m.Values["payload"].(string); ok {
+		e.Payload = []byte(v)
+	}
+	return e
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+	case <-ctx.Done():
+	}
+}
@@ -0,0 +1,102 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Hold is the balance_holds table.
+type Hold struct {
+	ID        int64  `gorm:"primaryKey"`
+	HoldNo    string `gorm:"size:64;uniqueIndex"`
+	AccountID int64  `gorm:"index"`
+	UserID    int64
+	Asset     string `gorm:"size:16"`
+	Amount    int64
+	BizType   string `gorm:"size:32"`
+	BizNo     string `gorm:"size:64"`
+	Remark    string `gorm:"size:255"`
+	Status    string `gorm:"size:16"`
+	Refunded  int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TableName .
+func (Hold) TableName() string {
+	return "balance_holds"
+}
+
+type holdRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewHoldRepo .
+func NewHoldRepo(data *Data, logger log.Logger) biz.HoldRepo {
+	return &holdRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *holdRepo) Create(ctx context.Context, h *biz.Hold) error {
+	po := &Hold{
+		HoldNo:    h.HoldNo,
+		AccountID: h.AccountID,
+		UserID:    h.UserID,
+		Asset:     h.Asset,
+		Amount:    h.Amount,
+		BizType:   string(h.BizType),
+		BizNo:     h.BizNo,
+		Remark:    h.Remark,
+		Status:    string(h.Status),
+	}
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return err
+	}
+	h.ID, h.CreatedAt, h.UpdatedAt = po.ID, po.CreatedAt, po.UpdatedAt
+	return nil
+}
+
+func (r *holdRepo) Lock(ctx context.Context, holdNo string) (*biz.Hold, error) {
+	var po Hold
+	err := r.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("hold_no = ?", holdNo).First(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrHoldNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &biz.Hold{
+		ID:        po.ID,
+		HoldNo:    po.HoldNo,
+		AccountID: po.AccountID,
+		UserID:    po.UserID,
+		Asset:     po.Asset,
+		Amount:    po.Amount,
+		BizType:   biz.BizType(po.BizType),
+		BizNo:     po.BizNo,
+		Remark:    po.Remark,
+		Status:    biz.HoldStatus(po.Status),
+		Refunded:  po.Refunded,
+		CreatedAt: po.CreatedAt,
+		UpdatedAt: po.UpdatedAt,
+	}, nil
+}
+
+func (r *holdRepo) UpdateStatus(ctx context.Context, id int64, status biz.HoldStatus) error {
+	return r.data.DB(ctx).Model(&Hold{ID: id}).Update("status", string(status)).Error
+}
+
+func (r *holdRepo) AddRefunded(ctx context.Context, id, amount int64) error {
+	return r.data.DB(ctx).Model(&Hold{ID: id}).Update("refunded", gorm.Expr("refunded + ?", amount)).Error
+}
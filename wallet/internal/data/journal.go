@@ -0,0 +1,261 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// sumBatchSize bounds the business numbers of one IN list.
+const sumBatchSize = 500
+
+// JournalEntry is the journal_entries table.
+type JournalEntry struct {
+	ID           int64  `gorm:"primaryKey"`
+	AccountID    int64  `gorm:"index;uniqueIndex:idx_journal_entries_biz,priority:3"`
+	UserID       int64  `gorm:"index:idx_journal_entries_user_time,priority:1"`
+	Asset        string `gorm:"size:16;index:idx_journal_entries_biz_time,priority:1"`
+	Direction    string `gorm:"size:8;uniqueIndex:idx_journal_entries_biz,priority:4"`
+	Amount       int64
+	BalanceAfter int64
+	BizType      string    `gorm:"size:32;index:idx_journal_entries_biz_time,priority:2;uniqueIndex:idx_journal_entries_biz,priority:1"`
+	BizNo        string    `gorm:"size:64;uniqueIndex:idx_journal_entries_biz,priority:2"`
+	Remark       string    `gorm:"size:255"`
+	CreatedAt    time.Time `gorm:"index:idx_journal_entries_biz_time,priority:3;index:idx_journal_entries_user_time,priority:2"`
+}
+
+type journalRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewJournalRepo .
+func NewJournalRepo(data *Data, logger log.Logger) biz.JournalRepo {
+	return &journalRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *journalRepo) Append(ctx context.Context, e *biz.JournalEntry) error {
+	po := &JournalEntry{
+		AccountID:    e.AccountID,
+		UserID:       e.UserID,
+		Asset:        e.Asset,
+		Direction:    string(e.Direction),
+		Amount:       e.Amount,
+		BalanceAfter: e.BalanceAfter,
+		BizType:      string(e.BizType),
+		BizNo:        e.BizNo,
+		Remark:       e.Remark,
+	}
+	err := r.data.DB(ctx).Create(po).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return biz.ErrEntryExists
+	}
+	if err != nil {
+		return err
+	}
+	e.ID, e.CreatedAt = po.ID, po.CreatedAt
+	return nil
+}
+
+func (r *journalRepo) HasEntry(ctx context.Context, bizType biz.BizType, bizNo string) (bool, error) {
+	var n int64
+	err := r.data.DB(ctx).Model(&JournalEntry{}).
+		Where("biz_type = ? AND biz_no = ?", string(bizType), bizNo).
+		Count(&n).Error
+	return n > 0, err
+}
+
+func (r *journalRepo) Summarize(ctx context.Context, accountID int64) (*biz.JournalSummary, error) {
+	var sum struct {
+		Credit int64
+		Debit  int64
+		Count  int64
+	}
+	err := r.data.DB(ctx).Model(&JournalEntry{}).
+		Select("COALESCE(SUM(CASE WHEN direction = ? THEN amount ELSE 0 END), 0) AS credit, "+
+			"COALESCE(SUM(CASE WHEN direction = ? THEN amount ELSE 0 END), 0) AS debit, COUNT(*) AS count",
+			string(biz.DirectionCredit), string(biz.DirectionDebit)).
+		Where("account_id = ?", accountID).
+		Scan(&sum).Error
+	if err != nil {
+		return nil, err
+	}
+	rv := &biz.JournalSummary{Credit: sum.Credit, Debit: sum.Debit, Count: sum.Count}
+	var last JournalEntry
+	err = r.data.DB(ctx).Where("account_id = ?", accountID).Order("id DESC").First(&last).Error
+	switch {
+	case err == nil:
+		rv.LastBalance = last.BalanceAfter
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, err
+	}
+	return rv, nil
+}
+
+func (r *journalRepo) SummarizeBetween(ctx context.Context, accountID int64, start, end time.Time) (*biz.JournalSummary, error) {
+	var sum struct {
+		Credit int64
+		Debit  int64
+		Count  int64
+	}
+	db := r.data.DB(ctx).Model(&JournalEntry{}).
+		Select("COALESCE(SUM(CASE WHEN direction = ? THEN amount ELSE 0 END), 0) AS credit, "+
+			"COALESCE(SUM(CASE WHEN direction = ? THEN amount ELSE 0 END), 0) AS debit, COUNT(*) AS count",
+			string(biz.DirectionCredit), string(biz.DirectionDebit)).
+		Where("account_id = ? AND created_at < ?", accountID, end)
+	if !start.IsZero() {
+		db = db.Where("created_at >= ?", start)
+	}
+	if err := db.Scan(&sum).Error; err != nil {
+		return nil, err
+	}
+	rv := &biz.JournalSummary{Credit: sum.Credit, Debit: sum.Debit, Count: sum.Count}
+	if sum.Count > 0 {
+		balance, err := r.BalanceBefore(ctx, accountID, end)
+		if err != nil {
+			return nil, err
+		}
+		rv.LastBalance = balance
+	}
+	return rv, nil
+}
+
+func (r *journalRepo) BalanceBefore(ctx context.Context, accountID int64, t time.Time) (int64, error) {
+	var last JournalEntry
+	err := r.data.DB(ctx).Select("balance_after").
+		Where("account_id = ? AND created_at < ?", accountID, t).Order("id DESC").First(&last).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return last.BalanceAfter, nil
+}
+
+func (r *journalRepo) SumByBizNo(ctx context.Context, asset string, bizType biz.BizType, direction biz.Direction, start, end time.Time) (map[string]int64, error) {
+	return r.sumByBizNo(r.data.DB(ctx).Where("created_at >= ? AND created_at < ?", start, end), asset, bizType, direction)
+}
+
+func (r *journalRepo) SumOfBizNos(ctx context.Context, asset string, bizType biz.BizType, direction biz.Direction, bizNos []string) (map[string]int64, error) {
+	rv := make(map[string]int64, len(bizNos))
+	for len(bizNos) > 0 {
+		n := min(len(bizNos), sumBatchSize)
+		sums, err := r.sumByBizNo(r.data.DB(ctx).Where("biz_no IN ?", bizNos[:n]), asset, bizType, direction)
+		if err != nil {
+			return nil, err
+		}
+		for bizNo, amount := range sums {
+			rv[bizNo] = amount
+		}
+		bizNos = bizNos[n:]
+	}
+	return rv, nil
+}
+
+func (r *journalRepo) sumByBizNo(db *gorm.DB, asset string, bizType biz.BizType, direction biz.Direction) (map[string]int64, error) {
+	var sums []struct {
+		BizNo  string
+		Amount int64
+	}
+	err := db.Model(&JournalEntry{}).
+		Select("biz_no, SUM(amount) AS amount").
+		Where("asset = ? AND biz_type = ? AND direction = ?", asset, string(bizType), string(direction)).
+		Group("biz_no").Scan(&sums).Error
+	if err != nil {
+		return nil, err
+	}
+	rv := make(map[string]int64, len(sums))
+	for _, s := range sums {
+		rv[s.BizNo] = s.Amount
+	}
+	return rv, nil
+}
+
+func (r *journalRepo) List(ctx context.Context, f *biz.JournalFilter) ([]*biz.JournalEntry, error) {
+	db := r.data.DB(ctx).Where("user_id = ?", f.UserID)
+	if f.Asset != "" {
+		db = db.Where("asset = ?", f.Asset)
+	}
+	if f.Direction != "" {
+		db = db.Where("direction = ?", string(f.Direction))
+	}
+	if len(f.BizTypes) > 0 {
+		types := make([]string, 0, len(f.BizTypes))
+		for _, t := range f.BizTypes {
+			types = append(types, string(t))
+		}
+		db = db.Where("biz_type IN ?", types)
+	}
+	if !f.Start.IsZero() {
+		db = db.Where("created_at >= ?", f.Start)
+	}
+	if !f.End.IsZero() {
+		db = db.Where("created_at < ?", f.End)
+	}
+	if f.Ascending {
+		if f.Cursor != 0 {
+			db = db.Where("id > ?", f.Cursor)
+		}
+		db = db.Order("id")
+	} else {
+		if f.Cursor != 0 {
+			db = db.Where("id < ?", f.Cursor)
+		}
+		db = db.Order("id DESC")
+	}
+	var pos []*JournalEntry
+	if err := db.Limit(f.Limit).Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	rv := make([]*biz.JournalEntry, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toJournalEntry(po))
+	}
+	return rv, nil
+}
+
+func (r *journalRepo) SumBefore(ctx context.Context, userID int64, t time.Time) (map[string]int64, error) {
+	var rows []struct {
+		Asset   string
+		Balance int64
+	}
+	err := r.data.DB(ctx).Model(&JournalEntry{}).
+		Select("asset, COALESCE(SUM(CASE WHEN direction = ? THEN amount ELSE -amount END), 0) AS balance",
+			string(biz.DirectionCredit)).
+		Where("user_id = ? AND created_at < ?", userID, t).
+		Group("asset").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	rv := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		rv[row.Asset] = row.Balance
+	}
+	return rv, nil
+}
+
+func toJournalEntry(po *JournalEntry) *biz.JournalEntry {
+	return &biz.JournalEntry{
+		ID:           po.ID,
+		AccountID:    po.AccountID,
+		UserID:       po.UserID,
+		Asset:        po.Asset,
+		Direction:    biz.Direction(po.Direction),
+		Amount:       po.Amount,
+		BalanceAfter: po.BalanceAfter,
+		BizType:      biz.BizType(po.BizType),
+		BizNo:        po.BizNo,
+		Remark:       po.Remark,
+		CreatedAt:    po.CreatedAt,
+	}
+}
@@ -0,0 +1,259 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const reconcileLockKey = "wallet:reconcile:lock"
+
+// BalanceSnapshot is the balance_snapshots table.
+type BalanceSnapshot struct {
+	ID        int64     `gorm:"primaryKey"`
+	AccountID int64     `gorm:"uniqueIndex:idx_balance_snapshots_account_day,priority:1"`
+	Day       time.Time `gorm:"type:date;uniqueIndex:idx_balance_snapshots_account_day,priority:2"`
+	Balance   int64
+	CreatedAt time.Time
+}
+
+// ReconciliationRun is the reconciliation_runs table.
+type ReconciliationRun struct {
+	ID            int64     `gorm:"primaryKey"`
+	Day           time.Time `gorm:"type:date;index"`
+	Status        string    `gorm:"size:16"`
+	Accounts      int64
+	Discrepancies int64
+	Error         string `gorm:"size:1024"`
+	StartedAt     time.Time
+	FinishedAt    *time.Time
+}
+
+// Discrepancy is the discrepancies table.
+type Discrepancy struct {
+	ID         int64  `gorm:"primaryKey"`
+	RunID      int64  `gorm:"index"`
+	Kind       string `gorm:"size:32;index"`
+	AccountID  int64  `gorm:"index"`
+	Expected   int64
+	Actual     int64
+	Detail     string `gorm:"size:1024"`
+	Status     string `gorm:"size:16;index"`
+	Resolution string `gorm:"size:1024"`
+	ResolvedBy string `gorm:"size:64"`
+	CreatedAt  time.Time
+	ResolvedAt *time.Time
+}
+
+type reconciliationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewReconciliationRepo .
+func NewReconciliationRepo(data *Data, logger log.Logger) biz.ReconciliationRepo {
+	return &reconciliationRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *reconciliationRepo) Lock(ctx context.Context, ttl time.Duration) (func(), bool, error) {
+	token := strconv.FormatInt(time.Now().UnixNano(), 10)
+	ok, err := r.data.rdb.SetNX(ctx, reconcileLockKey, token, ttl).Result()
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	unlock := func() {
+		// Only release the lock if it still carries our token.
+		err := r.data.rdb.Eval(context.Background(),
+			`if redis.call("GET", KEYS[1]) == ARGV[1] then return redis.call("DEL", KEYS[1]) end return 0`,
+			[]string{reconcileLockKey}, token).Err()
+		if err != nil {
+			r.log.Errorf("release reconcile lock: %v", err)
+		}
+	}
+	return unlock, true, nil
+}
+
+func (r *reconciliationRepo) CreateRun(ctx context.Context, run *biz.ReconciliationRun) (*biz.ReconciliationRun, error) {
+	po := &ReconciliationRun{
+		Day:       run.Day,
+		Status:    string(run.Status),
+		StartedAt: run.StartedAt,
+	}
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return nil, err
+	}
+	run.ID = po.ID
+	return run, nil
+}
+
+func (r *reconciliationRepo) UpdateRun(ctx context.Context, run *biz.ReconciliationRun) error {
+	return r.data.DB(ctx).Model(&ReconciliationRun{ID: run.ID}).Updates(map[string]interface{}{
+		"status":        string(run.Status),
+		"accounts":      run.Accounts,
+		"discrepancies": run.Discrepancies,
+		"error":         run.Error,
+		"finished_at":   run.FinishedAt,
+	}).Error
+}
+
+func (r *reconciliationRepo) SaveSnapshot(ctx context.Context, s *biz.BalanceSnapshot) error {
+	return r.data.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "account_id"}, {Name: "day"}},
+		DoUpdates: clause.AssignmentColumns([]string{"balance"}),
+	}).Create(&BalanceSnapshot{
+		AccountID: s.AccountID,
+		Day:       s.Day,
+		Balance:   s.Balance,
+	}).Error
+}
+
+func (r *reconciliationRepo) FindSnapshot(ctx context.Context, accountID int64, day time.Time) (*biz.BalanceSnapshot, error) {
+	var po BalanceSnapshot
+	err := r.data.DB(ctx).Where("account_id = ? AND day = ?", accountID, day).First(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrSnapshotNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &biz.BalanceSnapshot{AccountID: po.AccountID, Day: po.Day, Balance: po.Balance}, nil
+}
+
+func (r *reconciliationRepo) CreateDiscrepancy(ctx context.Context, d *biz.Discrepancy) (*biz.Discrepancy, error) {
+	po := &Discrepancy{
+		RunID:     d.RunID,
+		Kind:      string(d.Kind),
+		AccountID: d.AccountID,
+		Expected:  d.Expected,
+		Actual:    d.Actual,
+		Detail:    d.Detail,
+		Status:    string(d.Status),
+		CreatedAt: d.CreatedAt,
+	}
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return nil, err
+	}
+	d.ID = po.ID
+	return d, nil
+}
+
+func (r *reconciliationRepo) FindDiscrepancy(ctx context.Context, id int64) (*biz.Discrepancy, error) {
+	var po Discrepancy
+	err := r.data.DB(ctx).First(&po, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrDiscrepancyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDiscrepancy(&po), nil
+}
+
+func (r *reconciliationRepo) FindOpenDiscrepancy(ctx context.Context, day time.Time, kind biz.DiscrepancyKind, accountID int64) (*biz.Discrepancy, error) {
+	var po Discrepancy
+	err := r.data.DB(ctx).
+		Joins("JOIN reconciliation_runs ON reconciliation_runs.id = discrepancies.run_id").
+		Where("reconciliation_runs.day = ? AND discrepancies.kind = ? AND discrepancies.account_id = ? AND discrepancies.status = ?",
+			day, string(kind), accountID, string(biz.DiscrepancyOpen)).
+		Order("discrepancies.id DESC").First(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrDiscrepancyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDiscrepancy(&po), nil
+}
+
+func (r *reconciliationRepo) RefreshDiscrepancy(ctx context.Context, d *biz.Discrepancy) error {
+	res := r.data.DB(ctx).Model(&Discrepancy{}).
+		Where("id = ? AND status = ?", d.ID, string(biz.DiscrepancyOpen)).
+		Updates(map[string]interface{}{
+			"run_id":   d.RunID,
+			"expected": d.Expected,
+			"actual":   d.Actual,
+			"detail":   d.Detail,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return biz.ErrDiscrepancyResolved
+	}
+	return nil
+}
+
+func (r *reconciliationRepo) UpdateDiscrepancy(ctx context.Context, d *biz.Discrepancy) error {
+	// Guard on the open status so two operators cannot both resolve it.
+	res := r.data.DB(ctx).Model(&Discrepancy{}).
+		Where("id = ? AND status = ?", d.ID, string(biz.DiscrepancyOpen)).
+		Updates(map[string]interface{}{
+			"status":      string(d.Status),
+			"resolution":  d.Resolution,
+			"resolved_by": d.ResolvedBy,
+			"resolved_at": d.ResolvedAt,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return biz.ErrDiscrepancyResolved
+	}
+	return nil
+}
+
+func (r *reconciliationRepo) ListDiscrepancies(ctx context.Context, filter *biz.DiscrepancyFilter, page, pageSize int) ([]*biz.Discrepancy, int64, error) {
+	db := r.data.DB(ctx).Model(&Discrepancy{})
+	if filter.Status != "" {
+		db = db.Where("status = ?", string(filter.Status))
+	}
+	if filter.Kind != "" {
+		db = db.Where("kind = ?", string(filter.Kind))
+	}
+	if filter.RunID != 0 {
+		db = db.Where("run_id = ?", filter.RunID)
+	}
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var pos []*Discrepancy
+	if err := db.Order("id DESC").Offset((page - 1) * pageSize).Limit(pageSize).Find(&pos).Error; err != nil {
+		return nil, 0, err
+	}
+	rv := make([]*biz.Discrepancy, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toDiscrepancy(po))
+	}
+	return rv, total, nil
+}
+
+func toDiscrepancy(po *Discrepancy) *biz.Discrepancy {
+	d := &biz.Discrepancy{
+		ID:         po.ID,
+		RunID:      po.RunID,
+		Kind:       biz.DiscrepancyKind(po.Kind),
+		AccountID:  po.AccountID,
+		Expected:   po.Expected,
+		Actual:     po.Actual,
+		Detail:     po.Detail,
+		Status:     biz.DiscrepancyStatus(po.Status),
+		Resolution: po.Resolution,
+		ResolvedBy: po.ResolvedBy,
+		CreatedAt:  po.CreatedAt,
+	}
+	if po.ResolvedAt != nil {
+		d.ResolvedAt = *po.ResolvedAt
+	}
+	return d
+}
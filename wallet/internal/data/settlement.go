@@ -0,0 +1,76 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/conf"
+	paymentv1 "github.com/go-kratos/kratos-layout/payment/api/payment/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NewPaymentClient .
+func NewPaymentClient(c *conf.Data) (paymentv1.PaymentClient, func(), error) {
+	opts := []grpc.ClientOption{
+		grpc.WithEndpoint(c.Payment.Endpoint),
+		grpc.WithMiddleware(recovery.Recovery()),
+	}
+	if c.Payment.Timeout != nil {
+		opts = append(opts, grpc.WithTimeout(c.Payment.Timeout.AsDuration()))
+	}
+	conn, err := grpc.DialInsecure(context.Background(), opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return paymentv1.NewPaymentClient(conn), func() { _ = conn.Close() }, nil
+}
+
+const settledPageSize = 1000
+
+type settlementRepo struct {
+	client paymentv1.PaymentClient
+	log    *log.Helper
+}
+
+// NewSettlementRepo .
+func NewSettlementRepo(client paymentv1.PaymentClient, logger log.Logger) biz.SettlementRepo {
+	return &settlementRepo{
+		client: client,
+		log:    log.NewHelper(logger),
+	}
+}
+
+func (r *settlementRepo) ListSettledTopUps(ctx context.Context, start, end time.Time) ([]*biz.SettledTopUp, error) {
+	var (
+		rv    []*biz.SettledTopUp
+		after string
+	)
+	for {
+		reply, err := r.client.ListSettledPayments(ctx, &paymentv1.ListSettledPaymentsRequest{
+			Purpose:      paymentv1.Purpose_PURPOSE_TOP_UP,
+			StartTime:    timestamppb.New(start),
+			EndTime:      timestamppb.New(end),
+			AfterTradeNo: after,
+			PageSize:     settledPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range reply.Payments {
+			rv = append(rv, &biz.SettledTopUp{
+				TradeNo: p.TradeNo,
+				Amount:  p.Amount,
+				PaidAt:  p.PaidAt.AsTime(),
+			})
+			after = p.TradeNo
+		}
+		if len(reply.Payments) < settledPageSize {
+			return rv, nil
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ConsumerServer feeds the payment events to the top-ups.
+type ConsumerServer struct {
+	subscriber biz.EventSubscriber
+	topUps     *biz.TopUpUsecase
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
+	log        *log.Helper
+}
+
+// NewConsumerServer new a consumer server.
+func NewConsumerServer(subscriber biz.EventSubscriber, topUps *biz.TopUpUsecase, logger log.Logger) *ConsumerServer {
+	return &ConsumerServer{subscriber: subscriber, topUps: topUps, log: log.NewHelper(logger)}
+}
+
+// Start implements transport.Server.
+func (s *ConsumerServer) Start(context.Context) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		if err := s.subscriber.Subscribe(ctx, s.topUps.HandlePaymentEvent); err != nil {
+			s.log.Errorf("subscribe payment events: %v", err)
+		}
+	}()
+	return nil
+}
+
+// Stop implements transport.Server, it waits for the event in hand.
+func (s *ConsumerServer) Stop(ctx context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+	return nil
+}
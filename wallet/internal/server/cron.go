@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+	"github.com/go-kratos/kratos-layout/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/robfig/cron/v3"
+)
+
+// CronServer runs the scheduled wallet jobs.
+type CronServer struct {
+	cron *cron.Cron
+	log  *log.Helper
+}
+
+// NewCronServer new a cron server.
+func NewCronServer(c *conf.Server, recon *biz.ReconciliationUsecase, logger log.Logger) (*CronServer, error) {
+	s := &CronServer{
+		cron: cron.New(),
+		log:  log.NewHelper(logger),
+	}
+	if spec := c.Cron.GetReconcile(); spec != "" {
+		if _, err := s.cron.AddFunc(spec, func() {
+			// Reconcile yesterday, which no posting can land in any more.
+			day := time.Now().AddDate(0, 0, -1)
+			if _, err := recon.Reconcile(context.Background(), day); err != nil {
+				s.log.Errorf("reconcile %s: %v", day.Format(biz.DayLayout), err)
+			}
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Start implements transport.Server.
+func (s *CronServer) Start(context.Context) error {
+	s.cron.Start()
+	return nil
+}
+
+// Stop implements transport.Server, it waits for running jobs.
+func (s *CronServer) Stop(ctx context.Context) error {
+	select {
+	case <-s.cron.Stop().Done():
+	case <-ctx.Done():
+	}
+	return nil
+}
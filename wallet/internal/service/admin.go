@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/go-kratos/kratos-layout/wallet/api/wallet/v1"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WalletAdminService is a wallet back office service.
+type WalletAdminService struct {
+	v1.UnimplementedWalletAdminServer
+
+	recon *biz.ReconciliationUsecase
+}
+
+// NewWalletAdminService new a wallet back office service.
+func NewWalletAdminService(recon *biz.ReconciliationUsecase) *WalletAdminService {
+	return &WalletAdminService{recon: recon}
+}
+
+// RunReconciliation implements v1.WalletAdminServer.
+func (s *WalletAdminService) RunReconciliation(ctx context.Context, in *v1.RunReconciliationRequest) (*v1.ReconciliationRun, error) {
+	day, err := biz.ParseDay(in.Day)
+	if err != nil {
+		return nil, err
+	}
+	run, err := s.recon.StartReconcile(ctx, day)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ReconciliationRun{
+		Id:            run.ID,
+		Day:           run.Day.Format(biz.DayLayout),
+		Status:        string(run.Status),
+		Accounts:      run.Accounts,
+		Discrepancies: run.Discrepancies,
+		Error:         run.Error,
+		StartedAt:     timestamppb.New(run.StartedAt),
+	}, nil
+}
+
+// ListDiscrepancies implements v1.WalletAdminServer.
+func (s *WalletAdminService) ListDiscrepancies(ctx context.Context, in *v1.ListDiscrepanciesRequest) (*v1.ListDiscrepanciesReply, error) {
+	ds, total, err := s.recon.ListDiscrepancies(ctx, &biz.DiscrepancyFilter{
+		Status: discrepancyStatuses[in.Status],
+		Kind:   discrepancyKinds[in.Kind],
+		RunID:  in.RunId,
+	}, int(in.Page), int(in.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListDiscrepanciesReply{Total: total}
+	for _, d := range ds {
+		reply.Discrepancies = append(reply.Discrepancies, toDiscrepancyProto(d))
+	}
+	return reply, nil
+}
+
+// ResolveDiscrepancy implements v1.WalletAdminServer.
+func (s *WalletAdminService) ResolveDiscrepancy(ctx context.Context, in *v1.ResolveDiscrepancyRequest) (*v1.Discrepancy, error) {
+	d, err := s.recon.ResolveDiscrepancy(ctx, in.Id, in.Operator, in.Resolution)
+	if err != nil {
+		return nil, err
+	}
+	return toDiscrepancyProto(d), nil
+}
+
+var discrepancyKinds = map[v1.DiscrepancyKind]biz.DiscrepancyKind{
+	v1.DiscrepancyKind_BALANCE_MISMATCH:    biz.KindBalanceMismatch,
+	v1.DiscrepancyKind_JOURNAL_BROKEN:      biz.KindJournalBroken,
+	v1.DiscrepancyKind_SETTLEMENT_MISMATCH: biz.KindSettlementMismatch,
+	v1.DiscrepancyKind_SNAPSHOT_MISMATCH:   biz.KindSnapshotMismatch,
+}
+
+var discrepancyStatuses = map[v1.DiscrepancyStatus]biz.DiscrepancyStatus{
+	v1.DiscrepancyStatus_DISCREPANCY_OPEN:     biz.DiscrepancyOpen,
+	v1.DiscrepancyStatus_DISCREPANCY_RESOLVED: biz.DiscrepancyResolved,
+}
+
+func toDiscrepancyProto(d *biz.Discrepancy) *v1.Discrepancy {
+	pb := &v1.Discrepancy{
+		Id:         d.ID,
+		RunId:      d.RunID,
+		AccountId:  d.AccountID,
+		Expected:   d.Expected,
+		Actual:     d.Actual,
+		Detail:     d.Detail,
+		Resolution: d.Resolution,
+		ResolvedBy: d.ResolvedBy,
+		CreatedAt:  timestamppb.New(d.CreatedAt),
+	}
+	for k, v := range discrepancyKinds {
+		if v == d.Kind {
+			pb.Kind = k
+		}
+	}
+	for k, v := range discrepancyStatuses {
+		if v == d.Status {
+			pb.Status = k
+		}
+	}
+	if !d.ResolvedAt.IsZero() {
+		pb.ResolvedAt = timestamppb.New(d.ResolvedAt)
+	}
+	return pb
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/go-kratos/kratos-layout/wallet/api/wallet/v1"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+)
+
+// BalanceService lets other services spend wallet balances.
+type BalanceService struct {
+	v1.UnimplementedBalanceServer
+
+	uc *biz.HoldUsecase
+}
+
+// NewBalanceService new a balance service.
+func NewBalanceService(uc *biz.HoldUsecase) *BalanceService {
+	return &BalanceService{uc: uc}
+}
+
+// HoldBalance implements v1.BalanceServer.
+func (s *BalanceService) HoldBalance(ctx context.Context, in *v1.HoldBalanceRequest) (*v1.BalanceHold, error) {
+	h, err := s.uc.Hold(ctx, &biz.Hold{
+		HoldNo:  in.HoldNo,
+		UserID:  in.UserId,
+		Asset:   in.Asset,
+		Amount:  in.Amount,
+		BizType: bizTypes[in.BizType],
+		BizNo:   in.BizNo,
+		Remark:  in.Remark,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toBalanceHoldProto(h), nil
+}
+
+// CaptureBalance implements v1.BalanceServer.
+func (s *BalanceService) CaptureBalance(ctx context.Context, in *v1.CaptureBalanceRequest) (*v1.BalanceHold, error) {
+	h, err := s.uc.Capture(ctx, in.HoldNo)
+	if err != nil {
+		return nil, err
+	}
+	return toBalanceHoldProto(h), nil
+}
+
+// ReleaseBalance implements v1.BalanceServer.
+func (s *BalanceService) ReleaseBalance(ctx context.Context, in *v1.ReleaseBalanceRequest) (*v1.BalanceHold, error) {
+	h, err := s.uc.Release(ctx, in.HoldNo)
+	if err != nil {
+		return nil, err
+	}
+	return toBalanceHoldProto(h), nil
+}
+
+// RefundBalance implements v1.BalanceServer.
+func (s *BalanceService) RefundBalance(ctx context.Context, in *v1.RefundBalanceRequest) (*v1.BalanceHold, error) {
+	h, err := s.uc.Refund(ctx, in.RefundNo, in.HoldNo, in.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return toBalanceHoldProto(h), nil
+}
+
+var holdStatuses = map[biz.HoldStatus]v1.HoldStatus{
+	biz.HoldHeld:     v1.HoldStatus_HOLD_HELD,
+	biz.HoldCaptured: v1.HoldStatus_HOLD_CAPTURED,
+	biz.HoldReleased: v1.HoldStatus_HOLD_RELEASED,
+}
+
+func toBalanceHoldProto(h *biz.Hold) *v1.BalanceHold {
+	return &v1.BalanceHold{
+		HoldNo:   h.HoldNo,
+		UserId:   h.UserID,
+		Asset:    h.Asset,
+		Amount:   h.Amount,
+		Status:   holdStatuses[h.Status],
+		Refunded: h.Refunded,
+	}
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+
+	v1 "github.com/go-kratos/kratos-layout/wallet/api/wallet/v1"
+
+	"github.com/go-kratos/kratos-layout/internal/biz"
+
+	"github.com/jung-kurt/gofpdf"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WalletService is a wallet service.
+type WalletService struct {
+	v1.UnimplementedWalletServer
+
+	uc *biz.JournalUsecase
+}
+
+// NewWalletService new a wallet service.
+func NewWalletService(uc *biz.JournalUsecase) *WalletService {
+	return &WalletService{uc: uc}
+}
+
+// ListTransactions implements v1.WalletServer.
+func (s *WalletService) ListTransactions(ctx context.Context, in *v1.ListTransactionsRequest) (*v1.ListTransactionsReply, error) {
+	cursor, err := decodePageToken(in.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	f := &biz.JournalFilter{
+		UserID:    in.UserId,
+		Asset:     in.Asset,
+		Direction: directions[in.Direction],
+		Cursor:    cursor,
+		Limit:     int(in.PageSize),
+	}
+	for _, t := range in.BizTypes {
+		bt, ok := bizTypes[t]
+		if !ok {
+			return nil, biz.ErrInvalidBizType
+		}
+		f.BizTypes = append(f.BizTypes, bt)
+	}
+	if in.StartTime != nil {
+		f.Start = in.StartTime.AsTime()
+	}
+	if in.EndTime != nil {
+		f.End = in.EndTime.AsTime()
+	}
+	entries, next, err := s.uc.ListEntries(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListTransactionsReply{NextPageToken: encodePageToken(next)}
+	for _, e := range entries {
+		reply.Transactions = append(reply.Transactions, toTransactionProto(e))
+	}
+	return reply, nil
+}
+
+// ExportStatement implements v1.WalletServer.
+func (s *WalletService) ExportStatement(ctx context.Context, in *v1.ExportStatementRequest) (*v1.ExportStatementReply, error) {
+	month, err := biz.ParseMonth(in.Month)
+	if err != nil {
+		return nil, err
+	}
+	st, err := s.uc.BuildStatement(ctx, in.UserId, month)
+	if err != nil {
+		return nil, err
+	}
+	name := fmt.Sprintf("statement-%d-%s", st.UserID, st.Month.Format(biz.MonthLayout))
+	if in.Format == v1.StatementFormat_PDF {
+		content, err := renderStatementPDF(st)
+		if err != nil {
+			return nil, err
+		}
+		return &v1.ExportStatementReply{FileName: name + ".pdf", ContentType: "application/pdf", Content: content}, nil
+	}
+	content, err := renderStatementCSV(st)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ExportStatementReply{FileName: name + ".csv", ContentType: "text/csv", Content: content}, nil
+}
+
+var directions = map[v1.Direction]biz.Direction{
+	v1.Direction_CREDIT: biz.DirectionCredit,
+	v1.Direction_DEBIT:  biz.DirectionDebit,
+}
+
+var bizTypes = map[v1.BizType]biz.BizType{
+	v1.BizType_TOP_UP:        biz.BizTypeTopUp,
+	v1.BizType_WITHDRAW:      biz.BizTypeWithdraw,
+	v1.BizType_ORDER:         biz.BizTypeOrder,
+	v1.BizType_RED_ENVELOPE:  biz.BizTypeRedEnvelope,
+	v1.BizType_LOTTERY_PRIZE: biz.BizTypeLotteryPrize,
+	v1.BizType_GIFT:          biz.BizTypeGift,
+	v1.BizType_REFUND:        biz.BizTypeRefund,
+}
+
+func toTransactionProto(e *biz.JournalEntry) *v1.Transaction {
+	pb := &v1.Transaction{
+		Id:           e.ID,
+		Asset:        e.Asset,
+		Amount:       e.Amount,
+		BalanceAfter: e.BalanceAfter,
+		BizNo:        e.BizNo,
+		Remark:       e.Remark,
+		CreatedAt:    timestamppb.New(e.CreatedAt),
+	}
+	for k, v := range directions {
+		if v == e.Direction {
+			pb.Direction = k
+		}
+	}
+	for k, v := range bizTypes {
+		if v == e.BizType {
+			pb.BizType = k
+		}
+	}
+	return pb
+}
+
+func encodePageToken(cursor int64) string {
+	if cursor == 0 {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(cursor, 10)))
+}
+
+func decodePageToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, biz.ErrInvalidPageToken
+	}
+	cursor, err := strconv.ParseInt(string(b), 10, 64)
+	if err != nil || cursor <= 0 {
+		return 0, biz.ErrInvalidPageToken
+	}
+	return cursor, nil
+}
+
+// formatAmount renders an amount in cents as yuan.
+func formatAmount(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+func renderStatementCSV(st *biz.Statement) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	_ = w.Write([]string{"asset", "time", "biz_type", "biz_no", "direction", "amount", "balance_after", "remark"})
+	for _, a := range st.Assets {
+		_ = w.Write([]string{a.Asset, "", "opening", "", "", "", formatAmount(a.Opening), ""})
+		for _, e := range a.Entries {
+			_ = w.Write([]string{
+				a.Asset,
+				e.CreatedAt.Format("2006-01-02 15:04:05"),
+				string(e.BizType),
+				e.BizNo,
+				string(e.Direction),
+				formatAmount(e.Amount),
+				formatAmount(e.BalanceAfter),
+				e.Remark,
+			})
+		}
+		_ = w.Write([]string{a.Asset, "", "closing", "", "", "", formatAmount(a.Closing), ""})
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// renderStatementPDF renders the statement with the core fonts, which only
+// cover Latin-1, so free-text remarks are left to the CSV export.
+func renderStatementPDF(st *biz.Statement) ([]byte, error) {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.AddPage()
+	pdf.SetFont("Helvetica", "B", 14)
+	pdf.CellFormat(0, 10, fmt.Sprintf("Wallet statement %s, user %d", st.Month.Format(biz.MonthLayout), st.UserID), "", 1, "L", false, 0, "")
+	widths := []float64{38, 30, 52, 20, 25, 25}
+	for _, a := range st.Assets {
+		pdf.SetFont("Helvetica", "B", 11)
+		pdf.CellFormat(0, 8, fmt.Sprintf("%s  opening %s  in %s  out %s  closing %s",
+			a.Asset, formatAmount(a.Opening), formatAmount(a.Credit), formatAmount(a.Debit), formatAmount(a.Closing)), "", 1, "L", false, 0, "")
+		pdf.SetFont("Helvetica", "B", 8)
+		for i, h := range []string{"Time", "Type", "Biz No", "Side", "Amount", "Balance"} {
+			pdf.CellFormat(widths[i], 6, h, "1", 0, "C", false, 0, "")
+		}
+		pdf.Ln(-1)
+		pdf.SetFont("Helvetica", "", 8)
+		for _, e := range a.Entries {
+			cells := []string{
+				e.CreatedAt.Format("2006-01-02 15:04:05"),
+				string(e.BizType),
+				e.BizNo,
+				string(e.Direction),
+				formatAmount(e.Amount),
+				formatAmount(e.BalanceAfter),
+			}
+			for i, c := range cells {
+				align := "L"
+				if i >= 4 {
+					align = "R"
+				}
+				pdf.CellFormat(widths[i], 6, c, "1", 0, align, false, 0, "")
+			}
+			pdf.Ln(-1)
+		}
+		pdf.Ln(4)
+	}
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}